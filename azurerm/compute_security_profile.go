@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// the Security Profile (Trusted Launch & Confidential VM's) isn't available in the 2018-06-01 API version used by the
+// SDK and can only be specified when the Virtual Machine (Scale Set) is created - as such those using it are created,
+// updated (so that the Security Profile is sent in every PUT) and retrieved using a newer API version
+const computeSecurityProfileApiVersion = "2022-03-01"
+
+const (
+	computeSecurityTypeTrustedLaunch  = "TrustedLaunch"
+	computeSecurityTypeConfidentialVM = "ConfidentialVM"
+)
+
+type computeSecurityProfileResource struct {
+	Properties *computeSecurityProfileProperties `json:"properties,omitempty"`
+}
+
+type computeSecurityProfileProperties struct {
+	// Virtual Machines contain these at the top-level
+	computeSecurityProfileVirtualMachineProfile
+
+	// whereas Virtual Machine Scale Sets contain these within the `virtualMachineProfile`
+	VirtualMachineProfile *computeSecurityProfileVirtualMachineProfile `json:"virtualMachineProfile,omitempty"`
+}
+
+type computeSecurityProfileVirtualMachineProfile struct {
+	SecurityProfile *computeSecurityProfile               `json:"securityProfile,omitempty"`
+	StorageProfile  *computeSecurityProfileStorageProfile `json:"storageProfile,omitempty"`
+}
+
+type computeSecurityProfile struct {
+	SecurityType *string                             `json:"securityType,omitempty"`
+	UefiSettings *computeSecurityProfileUefiSettings `json:"uefiSettings,omitempty"`
+}
+
+type computeSecurityProfileUefiSettings struct {
+	SecureBootEnabled *bool `json:"secureBootEnabled,omitempty"`
+	VTpmEnabled       *bool `json:"vTpmEnabled,omitempty"`
+}
+
+type computeSecurityProfileStorageProfile struct {
+	OsDisk *struct {
+		ManagedDisk *struct {
+			SecurityProfile *struct {
+				SecurityEncryptionType *string `json:"securityEncryptionType,omitempty"`
+			} `json:"securityProfile,omitempty"`
+		} `json:"managedDisk,omitempty"`
+	} `json:"osDisk,omitempty"`
+}
+
+func computeSecurityTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			computeSecurityTypeTrustedLaunch,
+			computeSecurityTypeConfidentialVM,
+		}, false),
+	}
+}
+
+func computeSecurityProfileUefiSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		ForceNew: true,
+		Default:  false,
+	}
+}
+
+func computeOsDiskSecurityEncryptionTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"VMGuestStateOnly",
+			"DiskWithVMGuestState",
+		}, false),
+	}
+}
+
+// validateComputeSecurityProfile ensures the combination of the `security_type`, `secure_boot_enabled`, `vtpm_enabled`
+// and `os_disk_security_encryption_type` fields is supported by Azure during `terraform plan`
+func validateComputeSecurityProfile(diff *schema.ResourceDiff) error {
+	securityType := diff.Get("security_type").(string)
+	encryptionType := diff.Get("os_disk_security_encryption_type").(string)
+
+	if securityType == "" {
+		if diff.Get("secure_boot_enabled").(bool) || diff.Get("vtpm_enabled").(bool) {
+			return fmt.Errorf("`secure_boot_enabled` and `vtpm_enabled` can only be enabled when `security_type` is set")
+		}
+	}
+
+	if securityType == computeSecurityTypeConfidentialVM {
+		if encryptionType == "" {
+			return fmt.Errorf("`os_disk_security_encryption_type` must be set when `security_type` is `%s`", computeSecurityTypeConfidentialVM)
+		}
+		if !diff.Get("vtpm_enabled").(bool) {
+			return fmt.Errorf("`vtpm_enabled` must be enabled when `security_type` is `%s`", computeSecurityTypeConfidentialVM)
+		}
+	} else if encryptionType != "" {
+		return fmt.Errorf("`os_disk_security_encryption_type` can only be set when `security_type` is `%s`", computeSecurityTypeConfidentialVM)
+	}
+
+	return nil
+}
+
+// expandComputeSecurityProfileParameters adds the Security Profile to the specified SDK model (a Virtual Machine or Virtual
+// Machine Scale Set), where `profilePath` is the path to the object containing the `storageProfile`
+func expandComputeSecurityProfileParameters(d *schema.ResourceData, model interface{}, profilePath ...string) (map[string]interface{}, error) {
+	// the SDK model flattens the properties when marshalling, so round-trip it to be able to add the extra properties
+	serialized, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the Security Profile: %+v", err)
+	}
+
+	parameters := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &parameters); err != nil {
+		return nil, fmt.Errorf("Error deserializing the Security Profile: %+v", err)
+	}
+
+	profile := computeSecurityProfileChildMap(parameters, profilePath...)
+	profile["securityProfile"] = map[string]interface{}{
+		"securityType": d.Get("security_type").(string),
+		"uefiSettings": map[string]interface{}{
+			"secureBootEnabled": d.Get("secure_boot_enabled").(bool),
+			"vTpmEnabled":       d.Get("vtpm_enabled").(bool),
+		},
+	}
+
+	if v, ok := d.GetOk("os_disk_security_encryption_type"); ok {
+		managedDisk := computeSecurityProfileChildMap(profile, "storageProfile", "osDisk", "managedDisk")
+		managedDisk["securityProfile"] = map[string]interface{}{
+			"securityEncryptionType": v.(string),
+		}
+	}
+
+	return parameters, nil
+}
+
+func computeSecurityProfileChildMap(input map[string]interface{}, path ...string) map[string]interface{} {
+	current := input
+	for _, key := range path {
+		child, ok := current[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			current[key] = child
+		}
+		current = child
+	}
+
+	return current
+}
+
+func flattenComputeSecurityProfile(d *schema.ResourceData, input *computeSecurityProfileVirtualMachineProfile) {
+	securityType := ""
+	secureBootEnabled := false
+	vtpmEnabled := false
+	encryptionType := ""
+
+	if input != nil {
+		if profile := input.SecurityProfile; profile != nil {
+			if profile.SecurityType != nil {
+				securityType = *profile.SecurityType
+			}
+			if uefi := profile.UefiSettings; uefi != nil {
+				if uefi.SecureBootEnabled != nil {
+					secureBootEnabled = *uefi.SecureBootEnabled
+				}
+				if uefi.VTpmEnabled != nil {
+					vtpmEnabled = *uefi.VTpmEnabled
+				}
+			}
+		}
+
+		if storage := input.StorageProfile; storage != nil && storage.OsDisk != nil && storage.OsDisk.ManagedDisk != nil {
+			if sp := storage.OsDisk.ManagedDisk.SecurityProfile; sp != nil && sp.SecurityEncryptionType != nil {
+				encryptionType = *sp.SecurityEncryptionType
+			}
+		}
+	}
+
+	d.Set("security_type", securityType)
+	d.Set("secure_boot_enabled", secureBootEnabled)
+	d.Set("vtpm_enabled", vtpmEnabled)
+	d.Set("os_disk_security_encryption_type", encryptionType)
+}
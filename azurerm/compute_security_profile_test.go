@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandComputeSecurityProfileParameters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceArmVirtualMachineScaleSet().Schema, map[string]interface{}{
+		"security_type":                    "ConfidentialVM",
+		"secure_boot_enabled":              true,
+		"vtpm_enabled":                     true,
+		"os_disk_security_encryption_type": "DiskWithVMGuestState",
+	})
+
+	scaleSet := compute.VirtualMachineScaleSet{
+		Location: utils.String("westeurope"),
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+					OsDisk: &compute.VirtualMachineScaleSetOSDisk{
+						CreateOption: compute.DiskCreateOptionTypesFromImage,
+						ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+							StorageAccountType: compute.StorageAccountTypesPremiumLRS,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	parameters, err := expandComputeSecurityProfileParameters(d, scaleSet, "properties", "virtualMachineProfile")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if v := parameters["location"]; v != "westeurope" {
+		t.Fatalf("Expected the existing properties to be retained but got the location %+v", v)
+	}
+
+	profile := parameters["properties"].(map[string]interface{})["virtualMachineProfile"].(map[string]interface{})
+	securityProfile := profile["securityProfile"].(map[string]interface{})
+	if v := securityProfile["securityType"]; v != "ConfidentialVM" {
+		t.Fatalf("Expected the Security Type to be `ConfidentialVM` but got %+v", v)
+	}
+
+	uefi := securityProfile["uefiSettings"].(map[string]interface{})
+	if uefi["secureBootEnabled"] != true || uefi["vTpmEnabled"] != true {
+		t.Fatalf("Expected Secure Boot and vTPM to be enabled but got %+v", uefi)
+	}
+
+	managedDisk := profile["storageProfile"].(map[string]interface{})["osDisk"].(map[string]interface{})["managedDisk"].(map[string]interface{})
+	if v := managedDisk["storageAccountType"]; v != "Premium_LRS" {
+		t.Fatalf("Expected the existing Managed Disk properties to be retained but got %+v", managedDisk)
+	}
+	if v := managedDisk["securityProfile"].(map[string]interface{})["securityEncryptionType"]; v != "DiskWithVMGuestState" {
+		t.Fatalf("Expected the Security Encryption Type to be `DiskWithVMGuestState` but got %+v", v)
+	}
+}
+
+func TestComputeSecurityProfileSchemaForceNew(t *testing.T) {
+	// the Security Profile can only be specified when the Virtual Machine (Scale Set) is created
+	resources := map[string]*schema.Resource{
+		"azurerm_virtual_machine":           resourceArmVirtualMachine(),
+		"azurerm_virtual_machine_scale_set": resourceArmVirtualMachineScaleSet(),
+	}
+
+	for resourceType, r := range resources {
+		for _, field := range []string{"security_type", "secure_boot_enabled", "vtpm_enabled", "os_disk_security_encryption_type"} {
+			if !r.Schema[field].ForceNew {
+				t.Fatalf("Expected `%s` on %q to be ForceNew", field, resourceType)
+			}
+		}
+	}
+}
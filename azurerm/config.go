@@ -7,7 +7,6 @@ import (
 	"os"
 	"strings"
 	"sync"
-	"time"
 
 	resourcesprofile "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	appinsights "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
@@ -28,6 +27,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2018-01-01/eventgrid"
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/azure-sdk-for-go/services/iotcentral/mgmt/2018-09-01/iotcentral"
 	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2018-04-01/devices"
 	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
@@ -38,6 +38,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	automationPreview "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
 	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/Azure/azure-sdk-for-go/services/preview/mariadb/mgmt/2018-06-01-preview/mariadb"
@@ -49,11 +50,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
 	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
 	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/Azure/azure-sdk-for-go/services/reservations/mgmt/2018-06-01/reservations"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
@@ -63,6 +67,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2018-07-01/storagesync"
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 
@@ -74,6 +79,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/pricing"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -85,23 +91,36 @@ type ArmClient struct {
 	tenantId                 string
 	subscriptionId           string
 	partnerId                string
+	userAgentSuffix          string
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	enableCostEstimation     bool
+	validateNameAvailability bool
+	retryOptions             *azure.RetryOptions
+	pollingOptions           *azure.PollingOptions
+	connectionOptions        *azure.ConnectionOptions
+	requestAnnotations       *azure.RequestAnnotations
+
+	relaxedMsSqlSkuValidation    bool
+	recoverDroppedMsSqlDatabases bool
+	deleteUnusedNetworkProfiles  bool
 
 	StopContext context.Context
 
 	cosmosDBClient documentdb.DatabaseAccountsClient
 
-	automationAccountClient               automation.AccountClient
-	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
-	automationCredentialClient            automation.CredentialClient
-	automationDscConfigurationClient      automation.DscConfigurationClient
-	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
-	automationModuleClient                automation.ModuleClient
-	automationRunbookClient               automation.RunbookClient
-	automationRunbookDraftClient          automation.RunbookDraftClient
-	automationScheduleClient              automation.ScheduleClient
+	automationAccountClient                  automation.AccountClient
+	automationAgentRegistrationInfoClient    automation.AgentRegistrationInformationClient
+	automationCredentialClient               automation.CredentialClient
+	automationDscConfigurationClient         automation.DscConfigurationClient
+	automationDscNodeConfigurationClient     automation.DscNodeConfigurationClient
+	automationHybridRunbookWorkerGroupClient automation.HybridRunbookWorkerGroupClient
+	automationModuleClient                   automation.ModuleClient
+	automationRunbookClient                  automation.RunbookClient
+	automationRunbookDraftClient             automation.RunbookDraftClient
+	automationScheduleClient                 automation.ScheduleClient
+	automationSourceControlClient            automationPreview.SourceControlClient
 
 	dnsClient   dns.RecordSetsClient
 	zonesClient dns.ZonesClient
@@ -111,6 +130,7 @@ type ArmClient struct {
 	containerServicesClient             containerservice.ContainerServicesClient
 	kubernetesClustersClient            containerservice.ManagedClustersClient
 	containerGroupsClient               containerinstance.ContainerGroupsClient
+	containerServiceLinksClient         containerinstance.ServiceAssociationLinkClient
 
 	eventGridTopicsClient       eventgrid.TopicsClient
 	eventHubClient              eventhub.EventHubsClient
@@ -123,6 +143,8 @@ type ArmClient struct {
 	redisFirewallClient       redis.FirewallRulesClient
 	redisPatchSchedulesClient redis.PatchSchedulesClient
 
+	reservationsClient reservations.Client
+
 	// API Management
 	apiManagementServiceClient apimanagement.ServiceClient
 
@@ -167,13 +189,17 @@ type ArmClient struct {
 	vmClient                   compute.VirtualMachinesClient
 
 	// Devices
+	iotCentralAppsClient iotcentral.AppsClient
 	iothubResourceClient devices.IotHubResourceClient
 
 	// DevTestLabs
-	devTestLabsClient            dtl.LabsClient
-	devTestPoliciesClient        dtl.PoliciesClient
-	devTestVirtualMachinesClient dtl.VirtualMachinesClient
-	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+	devTestCostsClient                dtl.CostsClient
+	devTestFormulasClient             dtl.FormulasClient
+	devTestLabsClient                 dtl.LabsClient
+	devTestNotificationChannelsClient dtl.NotificationChannelsClient
+	devTestPoliciesClient             dtl.PoliciesClient
+	devTestVirtualMachinesClient      dtl.VirtualMachinesClient
+	devTestVirtualNetworksClient      dtl.VirtualNetworksClient
 
 	// DevSpace
 	devSpaceControllerClient devspaces.ControllersClient
@@ -192,14 +218,30 @@ type ArmClient struct {
 	postgresqlServersClient                  postgresql.ServersClient
 	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
 	sqlDatabasesClient                       sql.DatabasesClient
+	sqlDatabaseBlobAuditingPoliciesClient    sqlPreview.DatabaseBlobAuditingPoliciesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
 	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	sqlReplicationLinksClient                sql.ReplicationLinksClient
+	sqlRestorableDroppedDatabasesClient      sql.RestorableDroppedDatabasesClient
 	// Client for the new 2017-10-01-preview SQL API which implements vCore, DTU, and Azure data standards
-	msSqlElasticPoolsClient              MsSql.ElasticPoolsClient
-	sqlFirewallRulesClient               sql.FirewallRulesClient
-	sqlServersClient                     sql.ServersClient
-	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
-	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
+	msSqlBackupLongTermRetentionPoliciesClient  MsSql.BaseClient
+	msSqlBackupShortTermRetentionPoliciesClient MsSql.BackupShortTermRetentionPoliciesClient
+	msSqlCapabilitiesClient                     MsSql.CapabilitiesClient
+	msSqlDatabasesClient                        MsSql.DatabasesClient
+	msSqlElasticPoolsClient                     MsSql.ElasticPoolsClient
+	msSqlFailoverGroupsClient                   sql.FailoverGroupsClient
+	msSqlServerDnsAliasesClient                 sqlPreview.ServerDNSAliasesClient
+	msSqlSyncGroupsClient                       sql.SyncGroupsClient
+	msSqlSyncMembersClient                      sql.SyncMembersClient
+	sqlFirewallRulesClient                      sql.FirewallRulesClient
+	sqlServersClient                            sql.ServersClient
+	sqlServerConnectionPoliciesClient           sql.ServerConnectionPoliciesClient
+	sqlServerAzureADAdministratorsClient        sql.ServerAzureADAdministratorsClient
+	sqlVirtualNetworkRulesClient                sql.VirtualNetworkRulesClient
+	// caches the Databases & Elastic Pools within each SQL Server, used to reduce the number of calls during a refresh
+	sqlServerCache *sqlServerCache
+	// caches the Elastic Pool SKUs available within each Location, used to validate these during a plan
+	msSqlCapabilitiesCache *msSqlCapabilitiesCache
 
 	// Data Lake Store
 	dataLakeStoreAccountClient       storeAccount.AccountsClient
@@ -236,6 +278,7 @@ type ArmClient struct {
 	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
 	monitorLogProfilesClient                insights.LogProfilesClient
 	monitorMetricAlertsClient               insights.MetricAlertsClient
+	monitorMetricDefinitionsClient          insights.MetricDefinitionsClient
 
 	// MSI
 	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
@@ -251,6 +294,7 @@ type ArmClient struct {
 	ifaceClient                     network.InterfacesClient
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
+	networkProfilesClient           network.ProfilesClient
 	packetCapturesClient            network.PacketCapturesClient
 	publicIPClient                  network.PublicIPAddressesClient
 	routesClient                    network.RoutesClient
@@ -272,6 +316,9 @@ type ArmClient struct {
 	recoveryServicesVaultsClient             recoveryservices.VaultsClient
 	recoveryServicesProtectedItemsClient     backup.ProtectedItemsGroupClient
 	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
+	recoveryServicesRecoveryPointsClient     backup.RecoveryPointsClient
+	// the Site Recovery clients are scoped to a Recovery Services Vault, so are built on demand
+	siteRecoveryRecoveryPlansClient func(resourceGroup, vaultName string) siterecovery.ReplicationRecoveryPlansClient
 
 	// Relay
 	relayNamespacesClient relay.NamespacesClient
@@ -315,6 +362,13 @@ type ArmClient struct {
 	storageServiceClient storage.AccountsClient
 	storageUsageClient   storage.UsageClient
 
+	// Storage Sync
+	storageSyncServicesClient          storagesync.ServicesClient
+	storageSyncGroupsClient            storagesync.SyncGroupsClient
+	storageSyncCloudEndpointsClient    storagesync.CloudEndpointsClient
+	storageSyncServerEndpointsClient   storagesync.ServerEndpointsClient
+	storageSyncRegisteredServersClient storagesync.RegisteredServersClient
+
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
 	trafficManagerProfilesClient               trafficmanager.ProfilesClient
@@ -328,6 +382,9 @@ type ArmClient struct {
 	policyAssignmentsClient    policy.AssignmentsClient
 	policyDefinitionsClient    policy.DefinitionsClient
 	policySetDefinitionsClient policy.SetDefinitionsClient
+
+	// Pricing
+	retailPricesClient pricing.RetailPricesClient
 }
 
 var (
@@ -351,15 +408,45 @@ func clientRequestID() string {
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client, c.partnerId)
+	setUserAgent(client, c.partnerId, c.userAgentSuffix)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.Sender = c.buildSender()
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	client.PollingDuration = c.pollingOptions.Timeout
 }
 
-func setUserAgent(client *autorest.Client, partnerID string) {
+// buildSender returns a Sender which retries requests which are throttled or fail with a transient error, and
+// annotates each request with the configured Client Request ID. Requests are sent over connections which are shared
+// by every client, rather than each client opening its own.
+func (c *ArmClient) buildSender() autorest.Sender {
+	return autorest.DecorateSender(azure.BuildSender(c.connectionOptions), azure.WithRequestAnnotations(c.requestAnnotations), azure.WithRetries(c.retryOptions), azure.WithPolling(c.pollingOptions))
+}
+
+// the MsSQL clients in the vendored SDK are generated from the 2017-10-01-preview API, which lacks a number of newer
+// properties - these requests/responses are compatible with the (stable) API Versions since, which can be opted into
+// via the `api_version` field within the `mssql` block of the `features` block
+const msSqlDefaultApiVersion = "2017-10-01-preview"
+
+// configureMsSqlApiVersion switches the MsSQL clients to the specified API Version, which is done once the Provider
+// has been configured (since the clients are built before the `features` block is read)
+func (c *ArmClient) configureMsSqlApiVersion(apiVersion string) {
+	if apiVersion == "" || apiVersion == msSqlDefaultApiVersion {
+		return
+	}
+
+	log.Printf("[DEBUG] Using API Version %q for the MsSQL clients", apiVersion)
+
+	for _, client := range []*autorest.Client{
+		&c.msSqlBackupShortTermRetentionPoliciesClient.Client,
+		&c.msSqlDatabasesClient.Client,
+		&c.msSqlElasticPoolsClient.Client,
+	} {
+		client.Sender = autorest.DecorateSender(c.buildSender(), azure.WithApiVersion(msSqlDefaultApiVersion, apiVersion))
+	}
+}
+
+func setUserAgent(client *autorest.Client, partnerID string, suffix string) {
 	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
 	tfUserAgent := httpclient.UserAgentString()
 
@@ -376,26 +463,60 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
 	}
 
+	// allows automation to be traced in the Activity Log, which records the User Agent of each request
+	if suffix != "" {
+		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, suffix)
+	}
+
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
 
+// armClientOptions configures the ArmClient beyond the credentials it authenticates using. Since the polling and
+// connection options are applied to each client as it's built these must be specified here - where nil the defaults
+// are used.
+type armClientOptions struct {
+	skipProviderRegistration bool
+	partnerId                string
+	userAgentSuffix          string
+	pollingOptions           *azure.PollingOptions
+	connectionOptions        *azure.ConnectionOptions
+}
+
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, options armClientOptions) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
 	}
 
+	pollingOptions := options.pollingOptions
+	if pollingOptions == nil {
+		pollingOptions = azure.DefaultPollingOptions()
+	}
+
+	connectionOptions := options.connectionOptions
+	if connectionOptions == nil {
+		connectionOptions = azure.DefaultConnectionOptions()
+	}
+
 	// client declarations:
 	client := ArmClient{
 		clientId:                 c.ClientID,
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
-		partnerId:                partnerId,
+		partnerId:                options.partnerId,
+		userAgentSuffix:          options.userAgentSuffix,
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
-		skipProviderRegistration: skipProviderRegistration,
+		skipProviderRegistration: options.skipProviderRegistration,
+		retryOptions: &azure.RetryOptions{
+			MaxRetries: azure.DefaultMaxRetries,
+			Backoff:    azure.DefaultRetryBackoff,
+		},
+		pollingOptions:     pollingOptions,
+		connectionOptions:  connectionOptions,
+		requestAnnotations: &azure.RequestAnnotations{},
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -423,15 +544,15 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	}
 
 	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+	sender := client.buildSender()
+	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, azure.CachedBearerAuthorizerCallback(func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
 		keyVaultSpt, err := c.GetAuthorizationToken(oauthConfig, resource)
 		if err != nil {
 			return nil, err
 		}
 
 		return keyVaultSpt, nil
-	})
+	}))
 
 	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
@@ -462,9 +583,11 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
 	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
+	client.registerPricingClients()
 	client.registerManagementGroupClients(endpoint, auth)
 	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
 	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
+	client.registerReservationsClients(endpoint, auth)
 	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
 	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
 	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
@@ -473,6 +596,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
 	client.registerSignalRClients(endpoint, c.SubscriptionID, auth)
 	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
+	client.registerStorageSyncClients(endpoint, c.SubscriptionID, auth)
 	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
 	client.registerWebClients(endpoint, c.SubscriptionID, auth)
 
@@ -516,6 +640,10 @@ func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, a
 	c.configureClient(&dscNodeConfigurationClient.Client, auth)
 	c.automationDscNodeConfigurationClient = dscNodeConfigurationClient
 
+	hybridRunbookWorkerGroupClient := automation.NewHybridRunbookWorkerGroupClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&hybridRunbookWorkerGroupClient.Client, auth)
+	c.automationHybridRunbookWorkerGroupClient = hybridRunbookWorkerGroupClient
+
 	moduleClient := automation.NewModuleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&moduleClient.Client, auth)
 	c.automationModuleClient = moduleClient
@@ -528,6 +656,10 @@ func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, a
 	c.configureClient(&scheduleClient.Client, auth)
 	c.automationScheduleClient = scheduleClient
 
+	sourceControlClient := automationPreview.NewSourceControlClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sourceControlClient.Client, auth)
+	c.automationSourceControlClient = sourceControlClient
+
 	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookDraftClient.Client, auth)
 	c.automationRunbookDraftClient = runbookDraftClient
@@ -645,6 +777,10 @@ func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId st
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cgc.Client, auth)
 	c.containerGroupsClient = cgc
+
+	cslc := containerinstance.NewServiceAssociationLinkClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&cslc.Client, auth)
+	c.containerServiceLinksClient = cslc
 }
 
 func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -728,12 +864,19 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
 
 	// SQL Azure
+	c.sqlServerCache = newSqlServerCache()
+	c.msSqlCapabilitiesCache = newMsSqlCapabilitiesCache()
+
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlDBClient.Client, auth)
 	c.sqlDatabasesClient = sqlDBClient
 
+	sqlDBAPClient := sqlPreview.NewDatabaseBlobAuditingPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDBAPClient.Client, auth)
+	c.sqlDatabaseBlobAuditingPoliciesClient = sqlDBAPClient
+
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client, "")
+	setUserAgent(&sqlDTDPClient.Client, c.partnerId, c.userAgentSuffix)
 	sqlDTDPClient.Authorizer = auth
 	sqlDTDPClient.Sender = sender
 	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
@@ -747,14 +890,60 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	sqlRLClient := sql.NewReplicationLinksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlRLClient.Client, auth)
+	c.sqlReplicationLinksClient = sqlRLClient
+
+	sqlRDDClient := sql.NewRestorableDroppedDatabasesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlRDDClient.Client, auth)
+	c.sqlRestorableDroppedDatabasesClient = sqlRDDClient
+
+	MsSqlBSTRPClient := MsSql.NewBackupShortTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlBSTRPClient.Client, auth)
+	c.msSqlBackupShortTermRetentionPoliciesClient = MsSqlBSTRPClient
+
+	// Long Term Retention Policies are managed using raw requests with their own API Version, so this client isn't
+	// switched to the API Version configured in the `mssql` block of the `features` block
+	MsSqlBLTRPClient := MsSql.NewWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlBLTRPClient.Client, auth)
+	c.msSqlBackupLongTermRetentionPoliciesClient = MsSqlBLTRPClient
+
+	MsSqlCapabilitiesClient := MsSql.NewCapabilitiesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlCapabilitiesClient.Client, auth)
+	c.msSqlCapabilitiesClient = MsSqlCapabilitiesClient
+
+	MsSqlDBClient := MsSql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlDBClient.Client, auth)
+	c.msSqlDatabasesClient = MsSqlDBClient
+
 	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&MsSqlEPClient.Client, auth)
 	c.msSqlElasticPoolsClient = MsSqlEPClient
 
+	MsSqlFailoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlFailoverGroupsClient.Client, auth)
+	c.msSqlFailoverGroupsClient = MsSqlFailoverGroupsClient
+
+	MsSqlDnsAliasesClient := sqlPreview.NewServerDNSAliasesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlDnsAliasesClient.Client, auth)
+	c.msSqlServerDnsAliasesClient = MsSqlDnsAliasesClient
+
+	MsSqlSyncGroupsClient := sql.NewSyncGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlSyncGroupsClient.Client, auth)
+	c.msSqlSyncGroupsClient = MsSqlSyncGroupsClient
+
+	MsSqlSyncMembersClient := sql.NewSyncMembersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlSyncMembersClient.Client, auth)
+	c.msSqlSyncMembersClient = MsSqlSyncMembersClient
+
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
 	c.sqlServersClient = sqlSrvClient
 
+	sqlSrvConnPolicyClient := sql.NewServerConnectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlSrvConnPolicyClient.Client, auth)
+	c.sqlServerConnectionPoliciesClient = sqlSrvConnPolicyClient
+
 	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlADClient.Client, auth)
 	c.sqlServerAzureADAdministratorsClient = sqlADClient
@@ -787,16 +976,32 @@ func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string
 }
 
 func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	iotCentralAppsClient := iotcentral.NewAppsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&iotCentralAppsClient.Client, auth)
+	c.iotCentralAppsClient = iotCentralAppsClient
+
 	iotClient := devices.NewIotHubResourceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&iotClient.Client, auth)
 	c.iothubResourceClient = iotClient
 }
 
 func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	devTestCostsClient := dtl.NewCostsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&devTestCostsClient.Client, auth)
+	c.devTestCostsClient = devTestCostsClient
+
+	devTestFormulasClient := dtl.NewFormulasClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&devTestFormulasClient.Client, auth)
+	c.devTestFormulasClient = devTestFormulasClient
+
 	labsClient := dtl.NewLabsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&labsClient.Client, auth)
 	c.devTestLabsClient = labsClient
 
+	devTestNotificationChannelsClient := dtl.NewNotificationChannelsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&devTestNotificationChannelsClient.Client, auth)
+	c.devTestNotificationChannelsClient = devTestNotificationChannelsClient
+
 	devTestPoliciesClient := dtl.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestPoliciesClient.Client, auth)
 	c.devTestPoliciesClient = devTestPoliciesClient
@@ -883,6 +1088,10 @@ func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth
 	c.configureClient(&mac.Client, auth)
 	c.monitorMetricAlertsClient = mac
 
+	mdc := insights.NewMetricDefinitionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mdc.Client, auth)
+	c.monitorMetricDefinitionsClient = mdc
+
 	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
 	c.autoscaleSettingsClient = autoscaleSettingsClient
@@ -949,6 +1158,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&networksClient.Client, auth)
 	c.vnetClient = networksClient
 
+	networkProfilesClient := network.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&networkProfilesClient.Client, auth)
+	c.networkProfilesClient = networkProfilesClient
+
 	packetCapturesClient := network.NewPacketCapturesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&packetCapturesClient.Client, auth)
 	c.packetCapturesClient = packetCapturesClient
@@ -1026,6 +1239,16 @@ func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId stri
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectionPoliciesClient.Client, auth)
 	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+
+	recoveryPointsClient := backup.NewRecoveryPointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&recoveryPointsClient.Client, auth)
+	c.recoveryServicesRecoveryPointsClient = recoveryPointsClient
+
+	c.siteRecoveryRecoveryPlansClient = func(resourceGroup, vaultName string) siterecovery.ReplicationRecoveryPlansClient {
+		recoveryPlansClient := siterecovery.NewReplicationRecoveryPlansClientWithBaseURI(endpoint, subscriptionId, resourceGroup, vaultName)
+		c.configureClient(&recoveryPlansClient.Client, auth)
+		return recoveryPlansClient
+	}
 }
 
 func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1048,6 +1271,13 @@ func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth a
 	c.relayNamespacesClient = relayNamespacesClient
 }
 
+func (c *ArmClient) registerReservationsClients(endpoint string, auth autorest.Authorizer) {
+	// Reservations are tenant-level resources, rather than being scoped to a Subscription
+	reservationsClient := reservations.NewClientWithBaseURI(endpoint)
+	c.configureClient(&reservationsClient.Client, auth)
+	c.reservationsClient = reservationsClient
+}
+
 func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	locksClient := locks.NewManagementLocksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&locksClient.Client, auth)
@@ -1155,6 +1385,28 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	c.storageUsageClient = usageClient
 }
 
+func (c *ArmClient) registerStorageSyncClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	servicesClient := storagesync.NewServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&servicesClient.Client, auth)
+	c.storageSyncServicesClient = servicesClient
+
+	syncGroupsClient := storagesync.NewSyncGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&syncGroupsClient.Client, auth)
+	c.storageSyncGroupsClient = syncGroupsClient
+
+	cloudEndpointsClient := storagesync.NewCloudEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&cloudEndpointsClient.Client, auth)
+	c.storageSyncCloudEndpointsClient = cloudEndpointsClient
+
+	serverEndpointsClient := storagesync.NewServerEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&serverEndpointsClient.Client, auth)
+	c.storageSyncServerEndpointsClient = serverEndpointsClient
+
+	registeredServersClient := storagesync.NewRegisteredServersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&registeredServersClient.Client, auth)
+	c.storageSyncRegisteredServersClient = registeredServersClient
+}
+
 func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
@@ -1193,6 +1445,14 @@ func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth
 	c.policySetDefinitionsClient = policySetDefinitionsClient
 }
 
+func (c *ArmClient) registerPricingClients() {
+	// the Retail Prices API is unauthenticated and global, so there's no endpoint/authorizer to configure
+	retailPricesClient := pricing.NewRetailPricesClient()
+	setUserAgent(&retailPricesClient.Client, c.partnerId, c.userAgentSuffix)
+	retailPricesClient.Sender = c.buildSender()
+	c.retailPricesClient = retailPricesClient
+}
+
 func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
 	managementGroupsClient := managementgroups.NewClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsClient.Client, auth)
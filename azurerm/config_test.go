@@ -1,6 +1,11 @@
 package azurerm
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
 
 func TestClientRequestID(t *testing.T) {
 	first := clientRequestID()
@@ -14,3 +19,50 @@ func TestClientRequestID(t *testing.T) {
 		t.Fatal("subsequent request ID not the same as the first")
 	}
 }
+
+func TestSetUserAgent(t *testing.T) {
+	cases := []struct {
+		PartnerID string
+		Suffix    string
+		Contains  []string
+		Excludes  []string
+	}{
+		{
+			Excludes: []string{"pid-"},
+		},
+		{
+			PartnerID: "11111111-1111-1111-1111-111111111111",
+			Contains:  []string{" pid-11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			PartnerID: "11111111-1111-1111-1111-111111111111",
+			Suffix:    "pipeline/1234",
+			Contains:  []string{" pid-11111111-1111-1111-1111-111111111111 pipeline/1234"},
+		},
+	}
+
+	for _, tc := range cases {
+		client := autorest.Client{}
+		setUserAgent(&client, tc.PartnerID, tc.Suffix)
+
+		if !strings.Contains(client.UserAgent, "terraform-provider-azurerm/") {
+			t.Fatalf("Expected the User Agent %q to contain the Provider version", client.UserAgent)
+		}
+
+		for _, v := range tc.Contains {
+			if !strings.Contains(client.UserAgent, v) {
+				t.Fatalf("Expected the User Agent %q to contain %q", client.UserAgent, v)
+			}
+		}
+
+		for _, v := range tc.Excludes {
+			if strings.Contains(client.UserAgent, v) {
+				t.Fatalf("Expected the User Agent %q not to contain %q", client.UserAgent, v)
+			}
+		}
+
+		if tc.Suffix != "" && !strings.HasSuffix(client.UserAgent, tc.Suffix) {
+			t.Fatalf("Expected the User Agent %q to end with %q", client.UserAgent, tc.Suffix)
+		}
+	}
+}
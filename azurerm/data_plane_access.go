@@ -0,0 +1,91 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Local Authentication (access using keys/SAS tokens, rather than Azure AD) and Public Network Access aren't present
+// in the vendored SDKs for these services, so are managed using raw requests against a newer API Version of each
+const (
+	eventGridTopicDataPlaneAccessApiVersion      = "2022-06-15"
+	serviceBusNamespaceDataPlaneAccessApiVersion = "2021-11-01"
+	signalRDataPlaneAccessApiVersion             = "2023-02-01"
+)
+
+type armDataPlaneAccess struct {
+	Properties *armDataPlaneAccessProperties `json:"properties,omitempty"`
+}
+
+type armDataPlaneAccessProperties struct {
+	DisableLocalAuth    *bool   `json:"disableLocalAuth,omitempty"`
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+}
+
+// createUpdateArmResourceWithDataPlaneAccess PUT's the resource with the specified ID using the (older) model from the
+// vendored SDK, with the Local Authentication & Public Network Access properties added to it. These are sent within
+// the same request, rather than updated afterwards, so that the resource is never accessible in-between.
+func createUpdateArmResourceWithDataPlaneAccess(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string, parameters interface{}, localAuthenticationEnabled bool, publicNetworkAccessEnabled bool) error {
+	body, err := expandArmDataPlaneAccessRequest(parameters, localAuthenticationEnabled, publicNetworkAccessEnabled)
+	if err != nil {
+		return err
+	}
+
+	return armRawPut(ctx, client, baseURI, id, apiVersion, body)
+}
+
+func expandArmDataPlaneAccessRequest(parameters interface{}, localAuthenticationEnabled bool, publicNetworkAccessEnabled bool) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the request: %+v", err)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(serialized, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing the request: %+v", err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+
+	publicNetworkAccess := "Enabled"
+	if !publicNetworkAccessEnabled {
+		publicNetworkAccess = "Disabled"
+	}
+
+	properties["disableLocalAuth"] = !localAuthenticationEnabled
+	properties["publicNetworkAccess"] = publicNetworkAccess
+	body["properties"] = properties
+
+	return body, nil
+}
+
+// retrieveArmDataPlaneAccess returns whether Local Authentication & Public Network Access are enabled for the resource
+// with the specified ID - both of which are enabled when omitted by the API
+func retrieveArmDataPlaneAccess(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string) (localAuthenticationEnabled bool, publicNetworkAccessEnabled bool, err error) {
+	var access armDataPlaneAccess
+	if _, err := armRawGet(ctx, client, baseURI, id, apiVersion, &access); err != nil {
+		return false, false, err
+	}
+
+	localAuthenticationEnabled = true
+	publicNetworkAccessEnabled = true
+
+	if props := access.Properties; props != nil {
+		if v := props.DisableLocalAuth; v != nil {
+			localAuthenticationEnabled = !*v
+		}
+
+		if v := props.PublicNetworkAccess; v != nil {
+			publicNetworkAccessEnabled = !strings.EqualFold(*v, "Disabled")
+		}
+	}
+
+	return localAuthenticationEnabled, publicNetworkAccessEnabled, nil
+}
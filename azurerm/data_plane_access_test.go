@@ -0,0 +1,69 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandArmDataPlaneAccessRequest(t *testing.T) {
+	parameters := signalr.CreateParameters{
+		Location: utils.String("westeurope"),
+		Sku: &signalr.ResourceSku{
+			Name:     utils.String("Standard_S1"),
+			Capacity: utils.Int32(1),
+		},
+		Properties: &signalr.CreateOrUpdateProperties{
+			HostNamePrefix: utils.String("example"),
+		},
+	}
+
+	cases := []struct {
+		LocalAuthenticationEnabled  bool
+		PublicNetworkAccessEnabled  bool
+		ExpectedDisableLocalAuth    bool
+		ExpectedPublicNetworkAccess string
+	}{
+		{
+			LocalAuthenticationEnabled:  true,
+			PublicNetworkAccessEnabled:  true,
+			ExpectedDisableLocalAuth:    false,
+			ExpectedPublicNetworkAccess: "Enabled",
+		},
+		{
+			LocalAuthenticationEnabled:  false,
+			PublicNetworkAccessEnabled:  false,
+			ExpectedDisableLocalAuth:    true,
+			ExpectedPublicNetworkAccess: "Disabled",
+		},
+	}
+
+	for _, v := range cases {
+		body, err := expandArmDataPlaneAccessRequest(parameters, v.LocalAuthenticationEnabled, v.PublicNetworkAccessEnabled)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if body["location"] != "westeurope" {
+			t.Fatalf("Expected `location` to be %q but got %+v", "westeurope", body["location"])
+		}
+
+		if _, ok := body["sku"]; !ok {
+			t.Fatalf("Expected `sku` to be retained but it wasn't")
+		}
+
+		properties := body["properties"].(map[string]interface{})
+		if properties["hostNamePrefix"] != "example" {
+			t.Fatalf("Expected `properties.hostNamePrefix` to be retained but got %+v", properties["hostNamePrefix"])
+		}
+
+		if properties["disableLocalAuth"] != v.ExpectedDisableLocalAuth {
+			t.Fatalf("Expected `properties.disableLocalAuth` to be %t but got %+v", v.ExpectedDisableLocalAuth, properties["disableLocalAuth"])
+		}
+
+		if properties["publicNetworkAccess"] != v.ExpectedPublicNetworkAccess {
+			t.Fatalf("Expected `properties.publicNetworkAccess` to be %q but got %+v", v.ExpectedPublicNetworkAccess, properties["publicNetworkAccess"])
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAutomationHybridRunbookWorkerGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAutomationHybridRunbookWorkerGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"automation_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"group_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"credential_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hybrid_runbook_worker": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registration_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_seen_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmAutomationHybridRunbookWorkerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationHybridRunbookWorkerGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Hybrid Runbook Worker Group %q (Automation Account %q / Resource Group %q) was not found", name, accountName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Hybrid Runbook Worker Group %q (Automation Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Hybrid Runbook Worker Group %q (Automation Account %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("automation_account_name", accountName)
+	d.Set("group_type", string(resp.GroupType))
+
+	credentialName := ""
+	if credential := resp.Credential; credential != nil && credential.Name != nil {
+		credentialName = *credential.Name
+	}
+	d.Set("credential_name", credentialName)
+
+	if err := d.Set("hybrid_runbook_worker", flattenArmAutomationHybridRunbookWorkers(resp.HybridRunbookWorkers)); err != nil {
+		return fmt.Errorf("Error setting `hybrid_runbook_worker`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmAutomationHybridRunbookWorkers(input *[]automation.HybridRunbookWorker) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, worker := range *input {
+		result := make(map[string]interface{})
+
+		if worker.Name != nil {
+			result["name"] = *worker.Name
+		}
+
+		if worker.IP != nil {
+			result["ip_address"] = *worker.IP
+		}
+
+		if worker.RegistrationTime != nil {
+			result["registration_time"] = worker.RegistrationTime.Format(time.RFC3339)
+		}
+
+		if worker.LastSeenDateTime != nil {
+			result["last_seen_time"] = worker.LastSeenDateTime.Format(time.RFC3339)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMAutomationHybridRunbookWorkerGroup_basic(t *testing.T) {
+	// Hybrid Runbook Worker Groups can't be created via the API, they're created when the first
+	// Hybrid Runbook Worker registers with the Automation Account - as such this requires an existing group
+	resourceGroupEnvVariable := "ARM_TEST_AUTOMATION_RESOURCE_GROUP"
+	resourceGroup := os.Getenv(resourceGroupEnvVariable)
+	if resourceGroup == "" {
+		t.Skipf("Skipping as %q is not specified", resourceGroupEnvVariable)
+	}
+
+	accountEnvVariable := "ARM_TEST_AUTOMATION_ACCOUNT"
+	accountName := os.Getenv(accountEnvVariable)
+	if accountName == "" {
+		t.Skipf("Skipping as %q is not specified", accountEnvVariable)
+	}
+
+	groupEnvVariable := "ARM_TEST_AUTOMATION_HYBRID_WORKER_GROUP"
+	groupName := os.Getenv(groupEnvVariable)
+	if groupName == "" {
+		t.Skipf("Skipping as %q is not specified", groupEnvVariable)
+	}
+
+	dataSourceName := "data.azurerm_automation_hybrid_runbook_worker_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAutomationHybridRunbookWorkerGroup_basic(resourceGroup, accountName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", groupName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "group_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hybrid_runbook_worker.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAutomationHybridRunbookWorkerGroup_basic(resourceGroup string, accountName string, groupName string) string {
+	return fmt.Sprintf(`
+data "azurerm_automation_hybrid_runbook_worker_group" "test" {
+  name                    = "%s"
+  resource_group_name     = "%s"
+  automation_account_name = "%s"
+}
+`, groupName, resourceGroup, accountName)
+}
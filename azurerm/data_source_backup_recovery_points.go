@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmBackupRecoveryPoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBackupRecoveryPointsRead,
+
+		Schema: map[string]*schema.Schema{
+			// e.g. the ID of an `azurerm_recovery_services_protected_vm`
+			"protected_item_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"latest_recovery_point_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// sorted by the time the Recovery Point was created, with the most recent first
+			"recovery_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"recovery_point_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"consistency_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBackupRecoveryPointsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesRecoveryPointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	protectedItemId := d.Get("protected_item_id").(string)
+	id, err := parseAzureResourceID(protectedItemId)
+	if err != nil {
+		return err
+	}
+
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+	protectedItemName := id.Path["protectedItems"]
+	if vaultName == "" || fabricName == "" || containerName == "" || protectedItemName == "" {
+		return fmt.Errorf("`protected_item_id` must be the ID of a Protected Item within a Recovery Services Vault but got %q", protectedItemId)
+	}
+
+	results := make([]backup.RecoveryPointResource, 0)
+	iterator, err := client.ListComplete(ctx, vaultName, id.ResourceGroup, fabricName, containerName, protectedItemName, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Recovery Points for Protected Item %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, id.ResourceGroup, err)
+	}
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Recovery Points for Protected Item %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, id.ResourceGroup, err)
+		}
+	}
+
+	recoveryPoints := flattenArmBackupRecoveryPoints(results)
+
+	latestRecoveryPointId := ""
+	if len(recoveryPoints) > 0 {
+		latestRecoveryPointId = recoveryPoints[0].(map[string]interface{})["id"].(string)
+	}
+
+	d.SetId(fmt.Sprintf("%s/recoveryPoints", protectedItemId))
+
+	d.Set("protected_item_id", protectedItemId)
+	d.Set("latest_recovery_point_id", latestRecoveryPointId)
+
+	if err := d.Set("recovery_points", recoveryPoints); err != nil {
+		return fmt.Errorf("Error setting `recovery_points`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmBackupRecoveryPoints(input []backup.RecoveryPointResource) []interface{} {
+	results := make([]interface{}, 0)
+	times := make(map[string]time.Time)
+
+	for _, v := range input {
+		if v.ID == nil {
+			continue
+		}
+
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		recoveryPointTime, consistencyType := backupRecoveryPointDetails(v.Properties)
+
+		formattedTime := ""
+		if !recoveryPointTime.IsZero() {
+			formattedTime = recoveryPointTime.Format(time.RFC3339)
+		}
+		times[*v.ID] = recoveryPointTime
+
+		results = append(results, map[string]interface{}{
+			"id":                  *v.ID,
+			"name":                name,
+			"recovery_point_time": formattedTime,
+			"consistency_type":    consistencyType,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		first := times[results[i].(map[string]interface{})["id"].(string)]
+		second := times[results[j].(map[string]interface{})["id"].(string)]
+		return first.After(second)
+	})
+
+	return results
+}
+
+// backupRecoveryPointDetails returns the time a Recovery Point was created and whether it's (for example) crash or
+// application consistent, which are exposed on each of the different kinds of Recovery Point
+func backupRecoveryPointDetails(input backup.BasicRecoveryPoint) (time.Time, string) {
+	if input == nil {
+		return time.Time{}, ""
+	}
+
+	var recoveryPointTime *time.Time
+	var consistencyType *string
+
+	if v, ok := input.AsIaasVMRecoveryPoint(); ok && v != nil {
+		consistencyType = v.RecoveryPointType
+		if v.RecoveryPointTime != nil {
+			recoveryPointTime = &v.RecoveryPointTime.Time
+		}
+	} else if v, ok := input.AsAzureFileShareRecoveryPoint(); ok && v != nil {
+		consistencyType = v.RecoveryPointType
+		if v.RecoveryPointTime != nil {
+			recoveryPointTime = &v.RecoveryPointTime.Time
+		}
+	} else if v, ok := input.AsGenericRecoveryPoint(); ok && v != nil {
+		consistencyType = v.RecoveryPointType
+		if v.RecoveryPointTime != nil {
+			recoveryPointTime = &v.RecoveryPointTime.Time
+		}
+	}
+
+	result := time.Time{}
+	if recoveryPointTime != nil {
+		result = *recoveryPointTime
+	}
+
+	resultType := ""
+	if consistencyType != nil {
+		resultType = *consistencyType
+	}
+
+	return result, resultType
+}
@@ -0,0 +1,95 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMBackupRecoveryPoints_protectedVm(t *testing.T) {
+	dataSourceName := "data.azurerm_backup_recovery_points.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectedVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				// no Recovery Points exist until the first backup has run
+				Config: testAccDataSourceAzureRMBackupRecoveryPoints_protectedVm(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "protected_item_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "recovery_points.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest_recovery_point_id", ""),
+				),
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMRecoveryServicesProtectedVm_base(ri, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func TestFlattenArmBackupRecoveryPoints(t *testing.T) {
+	recoveryPoint := func(name string, created time.Time, consistencyType string) backup.RecoveryPointResource {
+		return backup.RecoveryPointResource{
+			ID:   utils.String(fmt.Sprintf("/recoveryPoints/%s", name)),
+			Name: utils.String(name),
+			Properties: backup.IaasVMRecoveryPoint{
+				RecoveryPointTime: &date.Time{Time: created},
+				RecoveryPointType: utils.String(consistencyType),
+			},
+		}
+	}
+
+	now := time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC)
+	input := []backup.RecoveryPointResource{
+		recoveryPoint("older", now.Add(-48*time.Hour), "CrashConsistent"),
+		recoveryPoint("latest", now, "AppConsistent"),
+		{
+			// Recovery Points without an ID should be ignored
+			Name: utils.String("invalid"),
+		},
+		recoveryPoint("middle", now.Add(-24*time.Hour), "FileSystemConsistent"),
+	}
+
+	results := flattenArmBackupRecoveryPoints(input)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 Recovery Points but got %d", len(results))
+	}
+
+	expectedNames := []string{"latest", "middle", "older"}
+	for i, expected := range expectedNames {
+		actual := results[i].(map[string]interface{})["name"].(string)
+		if actual != expected {
+			t.Fatalf("Expected Recovery Point %d to be %q but got %q", i, expected, actual)
+		}
+	}
+
+	latest := results[0].(map[string]interface{})
+	if latest["recovery_point_time"].(string) != "2019-05-01T12:00:00Z" {
+		t.Fatalf("Expected the `recovery_point_time` to be %q but got %q", "2019-05-01T12:00:00Z", latest["recovery_point_time"])
+	}
+	if latest["consistency_type"].(string) != "AppConsistent" {
+		t.Fatalf("Expected the `consistency_type` to be %q but got %q", "AppConsistent", latest["consistency_type"])
+	}
+}
+
+func testAccDataSourceAzureRMBackupRecoveryPoints_protectedVm(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_backup_recovery_points" "test" {
+  protected_item_id = "${azurerm_recovery_services_protected_vm.test.id}"
+}
+`, testAccAzureRMRecoveryServicesProtectedVm_basic(rInt, location))
+}
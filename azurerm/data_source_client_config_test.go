@@ -33,6 +33,23 @@ func TestAccDataSourceAzureRMClientConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMClientConfig_partnerId(t *testing.T) {
+	dataSourceName := "data.azurerm_client_config.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckArmClientConfig_partnerId,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "partner_id", "11111111-1111-1111-1111-111111111111"),
+				),
+			},
+		},
+	})
+}
+
 // Wraps resource.TestCheckResourceAttr to prevent leaking values to console
 // in case of mismatch
 func testAzureRMClientConfigAttr(name, key, value string) resource.TestCheckFunc {
@@ -55,3 +72,12 @@ func testAzureRMClientConfigGUIDAttr(name, key string) resource.TestCheckFunc {
 const testAccCheckArmClientConfig_basic = `
 data "azurerm_client_config" "current" { }
 `
+
+const testAccCheckArmClientConfig_partnerId = `
+provider "azurerm" {
+  partner_id        = "11111111-1111-1111-1111-111111111111"
+  user_agent_suffix = "acctest"
+}
+
+data "azurerm_client_config" "current" { }
+`
@@ -2,7 +2,6 @@ package azurerm
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,6 +46,12 @@ func dataSourceEventHubNamespace() *schema.Resource {
 				Computed: true,
 			},
 
+			"export_default_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -103,20 +108,17 @@ func dataSourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("sku", string(resp.Sku.Name))
 	d.Set("capacity", resp.Sku.Capacity)
 
-	keys, err := client.ListKeys(ctx, resourceGroup, name, eventHubNamespaceDefaultAuthorizationRule)
-	if err != nil {
-		log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
-	} else {
-		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
-		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
-		d.Set("default_primary_key", keys.PrimaryKey)
-		d.Set("default_secondary_key", keys.SecondaryKey)
-	}
+	setEventHubNamespaceDefaultKeys(ctx, d, client, resourceGroup, name, d.Get("export_default_keys").(bool))
 
 	if props := resp.EHNamespaceProperties; props != nil {
 		d.Set("auto_inflate_enabled", props.IsAutoInflateEnabled)
 		d.Set("kafka_enabled", props.KafkaEnabled)
-		d.Set("maximum_throughput_units", int(*props.MaximumThroughputUnits))
+
+		maximumThroughputUnits := 0
+		if v := props.MaximumThroughputUnits; v != nil {
+			maximumThroughputUnits = int(*v)
+		}
+		d.Set("maximum_throughput_units", maximumThroughputUnits)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Extended Locations (Edge Zones) are only returned from newer versions of the Subscriptions API than is vendored,
+// so are listed using a raw request
+const extendedLocationsApiVersion = "2022-12-01"
+
+type extendedLocationList struct {
+	Value *[]extendedLocation `json:"value,omitempty"`
+}
+
+type extendedLocation struct {
+	Name     *string                   `json:"name,omitempty"`
+	Type     *string                   `json:"type,omitempty"`
+	Metadata *extendedLocationMetadata `json:"metadata,omitempty"`
+}
+
+type extendedLocationMetadata struct {
+	HomeLocation *string `json:"homeLocation,omitempty"`
+}
+
+func dataSourceArmExtendedLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmExtendedLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"extended_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmExtendedLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	locations, err := listArmExtendedLocations(ctx, client.Client, client.BaseURI, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("Error listing Extended Locations for Location %q: %+v", location, err)
+	}
+
+	d.SetId(fmt.Sprintf("extended-locations-%s", location))
+
+	d.Set("location", location)
+	d.Set("extended_locations", flattenArmExtendedLocations(locations, location))
+
+	return nil
+}
+
+func listArmExtendedLocations(ctx context.Context, client autorest.Client, baseURI string, subscriptionId string) (*[]extendedLocation, error) {
+	path := fmt.Sprintf("/subscriptions/%s/locations", subscriptionId)
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version":              extendedLocationsApiVersion,
+			"includeExtendedLocations": true,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request for %q: %+v", path, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return nil, fmt.Errorf("Error sending request for %q: %+v", path, err)
+	}
+
+	var result extendedLocationList
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing response for %q: %+v", path, err)
+	}
+
+	return result.Value, nil
+}
+
+// flattenArmExtendedLocations returns the sorted names of the Edge Zones homed in the specified Location
+func flattenArmExtendedLocations(input *[]extendedLocation, location string) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Name == nil || v.Type == nil || !strings.EqualFold(*v.Type, "EdgeZone") {
+			continue
+		}
+
+		if v.Metadata == nil || v.Metadata.HomeLocation == nil || azureRMNormalizeLocation(*v.Metadata.HomeLocation) != location {
+			continue
+		}
+
+		results = append(results, *v.Name)
+	}
+
+	sort.Strings(results)
+	return results
+}
@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMExtendedLocations_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_extended_locations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMExtendedLocations_basic("westus"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "location", "westus"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "extended_locations.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenArmExtendedLocations(t *testing.T) {
+	edgeZone := func(name, homeLocation string) extendedLocation {
+		return extendedLocation{
+			Name: utils.String(name),
+			Type: utils.String("EdgeZone"),
+			Metadata: &extendedLocationMetadata{
+				HomeLocation: utils.String(homeLocation),
+			},
+		}
+	}
+
+	input := []extendedLocation{
+		edgeZone("microsoftlosangeles1", "westus"),
+		edgeZone("attatlanta1", "eastus2"),
+		edgeZone("microsoftlasvegas1", "West US"),
+		{
+			Name: utils.String("westus"),
+			Type: utils.String("Region"),
+		},
+		{
+			Name: utils.String("noMetadata"),
+			Type: utils.String("EdgeZone"),
+		},
+	}
+
+	actual := flattenArmExtendedLocations(&input, "westus")
+	expected := []string{"microsoftlasvegas1", "microsoftlosangeles1"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := flattenArmExtendedLocations(nil, "westus"); len(actual) != 0 {
+		t.Fatalf("Expected no Extended Locations but got %+v", actual)
+	}
+}
+
+func testAccDataSourceAzureRMExtendedLocations_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_extended_locations" "test" {
+  location = "%s"
+}
+`, location)
+}
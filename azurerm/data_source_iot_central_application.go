@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmIotCentralApplication() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmIotCentralApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IoTCentralApplicationName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"sub_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmIotCentralApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: IoT Central Application %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of IoT Central Application %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	if props := resp.AppProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("template", props.Template)
+		d.Set("application_id", props.ApplicationID)
+
+		if subdomain := props.Subdomain; subdomain != nil {
+			d.Set("sub_domain", *subdomain)
+			d.Set("url", fmt.Sprintf("https://%s.azureiotcentral.com", *subdomain))
+		}
+	}
+
+	var extended iotCentralApplicationExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, *resp.ID, iotCentralApplicationExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Identity/Network Access for IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("identity", flattenArmIotCentralApplicationIdentity(extended.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	publicNetworkAccessEnabled := true
+	if props := extended.Properties; props != nil && props.PublicNetworkAccess != nil {
+		publicNetworkAccessEnabled = strings.EqualFold(*props.PublicNetworkAccess, "Enabled")
+	}
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
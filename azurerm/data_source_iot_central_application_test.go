@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMIotCentralApplication_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_iot_central_application.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "application_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "sub_domain", fmt.Sprintf("acctest-iotcentral-app-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "url", fmt.Sprintf("https://acctest-iotcentral-app-%d.azureiotcentral.com", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "sku", "ST1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_network_access_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMIotCentralApplication_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_iot_central_application" "test" {
+  name                = "${azurerm_iot_central_application.test.name}"
+  resource_group_name = "${azurerm_iot_central_application.test.resource_group_name}"
+}
+`, testAccAzureRMIotCentralApplication_basic(rInt, location))
+}
@@ -277,6 +277,11 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"power_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"role_based_access_control": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -351,6 +356,11 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	powerState, err := retrieveKubernetesClusterPowerState(ctx, client, *resp.ID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Power State for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	d.SetId(*resp.ID)
 
 	d.Set("name", resp.Name)
@@ -358,6 +368,7 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
+	d.Set("power_state", powerState)
 
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
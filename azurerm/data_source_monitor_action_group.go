@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -81,6 +82,146 @@ func dataSourceArmMonitorActionGroup() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"aad_auth": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"identifier_uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"event_hub_receiver": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_hub_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_hub_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subscription_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"logic_app_receiver": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"callback_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"automation_runbook_receiver": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"automation_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runbook_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"webhook_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_global_runbook": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"arm_role_receiver": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -95,30 +236,52 @@ func dataSourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroup, name)
+	id := monitorActionGroupID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	var group monitorActionGroup
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorActionGroupApiVersion, &group)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
 			return fmt.Errorf("Error: Action Group %q (Resource Group %q) was not found", name, resourceGroup)
 		}
 		return fmt.Errorf("Error making Read request on Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
-	d.SetId(*resp.ID)
+	if group.ID == nil {
+		return fmt.Errorf("Error retrieving Action Group %q (Resource Group %q): ID was nil", name, resourceGroup)
+	}
+	d.SetId(*group.ID)
 
-	if group := resp.ActionGroup; group != nil {
-		d.Set("short_name", group.GroupShortName)
-		d.Set("enabled", group.Enabled)
+	if props := group.Properties; props != nil {
+		d.Set("short_name", props.GroupShortName)
+		d.Set("enabled", props.Enabled)
 
-		if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(group.EmailReceivers)); err != nil {
+		if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(props.EmailReceivers)); err != nil {
 			return fmt.Errorf("Error setting `email_receiver`: %+v", err)
 		}
 
-		if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(group.SmsReceivers)); err != nil {
+		if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(props.SmsReceivers)); err != nil {
 			return fmt.Errorf("Error setting `sms_receiver`: %+v", err)
 		}
 
-		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
+		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(props.WebhookReceivers)); err != nil {
 			return fmt.Errorf("Error setting `webhook_receiver`: %+v", err)
 		}
+
+		if err = d.Set("event_hub_receiver", flattenMonitorActionGroupEventHubReceiver(props.EventHubReceivers)); err != nil {
+			return fmt.Errorf("Error setting `event_hub_receiver`: %+v", err)
+		}
+
+		if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(props.LogicAppReceivers)); err != nil {
+			return fmt.Errorf("Error setting `logic_app_receiver`: %+v", err)
+		}
+
+		if err = d.Set("automation_runbook_receiver", flattenMonitorActionGroupAutomationRunbookReceiver(props.AutomationRunbookReceivers)); err != nil {
+			return fmt.Errorf("Error setting `automation_runbook_receiver`: %+v", err)
+		}
+
+		if err = d.Set("arm_role_receiver", flattenMonitorActionGroupArmRoleReceiver(props.ArmRoleReceivers)); err != nil {
+			return fmt.Errorf("Error setting `arm_role_receiver`: %+v", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmMsSqlDatabaseList() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMsSqlDatabaseListRead,
+
+		Schema: map[string]*schema.Schema{
+			"elastic_pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"database": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sku_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"service_objective_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"max_size_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMsSqlDatabaseListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlDatabasesClient
+	elasticPoolsClient := meta.(*ArmClient).msSqlElasticPoolsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	elasticPoolName := d.Get("elastic_pool_name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	pool, err := elasticPoolsClient.Get(ctx, resourceGroup, serverName, elasticPoolName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Elastic Pool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resourceGroup, err)
+	}
+
+	if pool.ID == nil || *pool.ID == "" {
+		return fmt.Errorf("Error retrieving Elastic Pool %q (MSSQL Server %q / Resource Group %q): ID was nil or empty", elasticPoolName, serverName, resourceGroup)
+	}
+
+	iterator, err := client.ListByElasticPoolComplete(ctx, resourceGroup, serverName, elasticPoolName)
+	if err != nil {
+		return fmt.Errorf("Error listing Databases for Elastic Pool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resourceGroup, err)
+	}
+
+	names := make([]string, 0)
+	ids := make([]string, 0)
+	databases := make([]interface{}, 0)
+	for iterator.NotDone() {
+		database := flattenArmMsSqlDatabaseListDatabase(iterator.Value())
+		names = append(names, database["name"].(string))
+		ids = append(ids, database["id"].(string))
+		databases = append(databases, database)
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Databases for Elastic Pool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/databases", *pool.ID))
+	d.Set("elastic_pool_name", elasticPoolName)
+	d.Set("server_name", serverName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting `ids`: %+v", err)
+	}
+
+	if err := d.Set("database", databases); err != nil {
+		return fmt.Errorf("Error setting `database`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmMsSqlDatabaseListDatabase(input sql.Database) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":                     "",
+		"name":                   "",
+		"sku_name":               "",
+		"tier":                   "",
+		"capacity":               0,
+		"service_objective_name": "",
+		"status":                 "",
+		"max_size_gb":            float64(0),
+	}
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	if sku := input.Sku; sku != nil {
+		if sku.Name != nil {
+			output["sku_name"] = *sku.Name
+		}
+
+		if sku.Tier != nil {
+			output["tier"] = *sku.Tier
+		}
+
+		if sku.Capacity != nil {
+			output["capacity"] = int(*sku.Capacity)
+		}
+	}
+
+	if props := input.DatabaseProperties; props != nil {
+		if props.CurrentServiceObjectiveName != nil {
+			output["service_objective_name"] = *props.CurrentServiceObjectiveName
+		}
+
+		output["status"] = string(props.Status)
+
+		if props.MaxSizeBytes != nil {
+			output["max_size_gb"] = float64(*props.MaxSizeBytes) / 1024 / 1024 / 1024
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,135 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMMsSqlDatabaseList_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_database_list.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMMsSqlDatabaseList_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.service_objective_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.sku_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.0.status", "Online"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMMsSqlDatabaseList_empty(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_database_list.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMMsSqlDatabaseList_empty(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMMsSqlDatabaseList_basic(rInt int, location string) string {
+	template := testAccDataSourceAzureRMMsSqlDatabaseList_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "first" {
+  name                = "acctestdb1-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+}
+
+resource "azurerm_sql_database" "second" {
+  name                = "acctestdb2-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+}
+
+data "azurerm_mssql_database_list" "test" {
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_sql_database.first", "azurerm_sql_database.second"]
+}
+`, template, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMMsSqlDatabaseList_empty(rInt int, location string) string {
+	template := testAccDataSourceAzureRMMsSqlDatabaseList_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_database_list" "test" {
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMMsSqlDatabaseList_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_gb         = 4.8828125
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+}
+`, rInt, location)
+}
@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// the metric used to measure the utilisation of an Elastic Pool differs between DTU based and vCore based pools
+const (
+	msSqlElasticPoolDtuUtilizationMetricName   = "dtu_consumption_percent"
+	msSqlElasticPoolVCoreUtilizationMetricName = "cpu_percent"
+	msSqlElasticPoolStorageMetricName          = "storage_percent"
+	msSqlElasticPoolSessionsMetricName         = "sessions_percent"
+)
+
+func dataSourceArmMsSqlElasticPoolMetricDefinitions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMsSqlElasticPoolMetricDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"elastic_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"utilization_metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sessions_metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"primary_aggregation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"supported_aggregations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMsSqlElasticPoolMetricDefinitionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	elasticPoolId := d.Get("elastic_pool_id").(string)
+	id, err := parseAzureResourceID(elasticPoolId)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := id.Path["elasticPools"]; !ok {
+		return fmt.Errorf("`elastic_pool_id` must be the ID of a SQL Elastic Pool but got %q", elasticPoolId)
+	}
+
+	// trim off the leading `/` since the List method doesn't expect it
+	resp, err := client.List(ctx, strings.TrimPrefix(elasticPoolId, "/"), "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metric Definitions for SQL Elastic Pool %q: %+v", elasticPoolId, err)
+	}
+
+	metrics := flattenArmMsSqlElasticPoolMetricDefinitions(resp.Value)
+
+	names := make([]string, 0)
+	for _, v := range metrics {
+		names = append(names, v.(map[string]interface{})["name"].(string))
+	}
+
+	d.SetId(elasticPoolId)
+
+	d.Set("elastic_pool_id", elasticPoolId)
+	d.Set("names", names)
+	d.Set("utilization_metric_name", msSqlElasticPoolFirstMetricName(names, msSqlElasticPoolDtuUtilizationMetricName, msSqlElasticPoolVCoreUtilizationMetricName))
+	d.Set("storage_metric_name", msSqlElasticPoolFirstMetricName(names, msSqlElasticPoolStorageMetricName))
+	d.Set("sessions_metric_name", msSqlElasticPoolFirstMetricName(names, msSqlElasticPoolSessionsMetricName))
+
+	if err := d.Set("metric", metrics); err != nil {
+		return fmt.Errorf("Error setting `metric`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmMsSqlElasticPoolMetricDefinitions(input *[]insights.MetricDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Name == nil || v.Name.Value == nil {
+			continue
+		}
+
+		displayName := ""
+		if v.Name.LocalizedValue != nil {
+			displayName = *v.Name.LocalizedValue
+		}
+
+		namespace := ""
+		if v.Namespace != nil {
+			namespace = *v.Namespace
+		}
+
+		aggregations := make([]interface{}, 0)
+		if v.SupportedAggregationTypes != nil {
+			for _, aggregation := range *v.SupportedAggregationTypes {
+				aggregations = append(aggregations, string(aggregation))
+			}
+		}
+
+		dimensions := make([]interface{}, 0)
+		if v.Dimensions != nil {
+			for _, dimension := range *v.Dimensions {
+				if dimension.Value != nil {
+					dimensions = append(dimensions, *dimension.Value)
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                   *v.Name.Value,
+			"display_name":           displayName,
+			"namespace":              namespace,
+			"unit":                   string(v.Unit),
+			"primary_aggregation":    string(v.PrimaryAggregationType),
+			"supported_aggregations": aggregations,
+			"dimensions":             dimensions,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["name"].(string) < results[j].(map[string]interface{})["name"].(string)
+	})
+
+	return results
+}
+
+// msSqlElasticPoolFirstMetricName returns the first of the candidate metric names which is available for the Elastic Pool,
+// since some metrics (such as the eDTU percentage) are only emitted for DTU based pools
+func msSqlElasticPoolFirstMetricName(names []string, candidates ...string) string {
+	for _, candidate := range candidates {
+		for _, name := range names {
+			if name == candidate {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,157 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_dtu(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_elasticpool_metric_definitions.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_dtu(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "utilization_metric_name", "dtu_consumption_percent"),
+					resource.TestCheckResourceAttr(dataSourceName, "storage_metric_name", "storage_percent"),
+					resource.TestCheckResourceAttr(dataSourceName, "sessions_metric_name", "sessions_percent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_vCore(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_elasticpool_metric_definitions.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_vCore(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "utilization_metric_name", "cpu_percent"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenArmMsSqlElasticPoolMetricDefinitions(t *testing.T) {
+	metric := func(name, displayName string, unit insights.Unit) insights.MetricDefinition {
+		return insights.MetricDefinition{
+			Name: &insights.LocalizableString{
+				Value:          utils.String(name),
+				LocalizedValue: utils.String(displayName),
+			},
+			Unit:                      unit,
+			PrimaryAggregationType:    insights.Average,
+			SupportedAggregationTypes: &[]insights.AggregationType{insights.Average, insights.Maximum},
+		}
+	}
+
+	input := []insights.MetricDefinition{
+		metric("storage_percent", "Data space used percent", insights.UnitPercent),
+		metric("dtu_consumption_percent", "DTU percentage", insights.UnitPercent),
+		{
+			// metrics without a name should be ignored
+			Unit: insights.UnitCount,
+		},
+		metric("allocated_data_storage", "Data space allocated", insights.UnitBytes),
+	}
+
+	metrics := flattenArmMsSqlElasticPoolMetricDefinitions(&input)
+	if len(metrics) != 3 {
+		t.Fatalf("Expected 3 metrics but got %d", len(metrics))
+	}
+
+	names := make([]string, 0)
+	for _, v := range metrics {
+		names = append(names, v.(map[string]interface{})["name"].(string))
+	}
+
+	expectedNames := []string{"allocated_data_storage", "dtu_consumption_percent", "storage_percent"}
+	if fmt.Sprintf("%v", names) != fmt.Sprintf("%v", expectedNames) {
+		t.Fatalf("Expected names %v but got %v", expectedNames, names)
+	}
+
+	first := metrics[0].(map[string]interface{})
+	if first["display_name"] != "Data space allocated" {
+		t.Fatalf("Expected display_name to be %q but got %q", "Data space allocated", first["display_name"])
+	}
+	if first["unit"] != "Bytes" {
+		t.Fatalf("Expected unit to be %q but got %q", "Bytes", first["unit"])
+	}
+	if len(first["supported_aggregations"].([]interface{})) != 2 {
+		t.Fatalf("Expected 2 supported aggregations but got %d", len(first["supported_aggregations"].([]interface{})))
+	}
+}
+
+func TestMsSqlElasticPoolFirstMetricName(t *testing.T) {
+	cases := []struct {
+		Names      []string
+		Candidates []string
+		Expected   string
+	}{
+		{
+			Names:      []string{"cpu_percent", "dtu_consumption_percent", "storage_percent"},
+			Candidates: []string{"dtu_consumption_percent", "cpu_percent"},
+			Expected:   "dtu_consumption_percent",
+		},
+		{
+			Names:      []string{"cpu_percent", "storage_percent"},
+			Candidates: []string{"dtu_consumption_percent", "cpu_percent"},
+			Expected:   "cpu_percent",
+		},
+		{
+			Names:      []string{"storage_percent"},
+			Candidates: []string{"sessions_percent"},
+			Expected:   "",
+		},
+	}
+
+	for _, tc := range cases {
+		actual := msSqlElasticPoolFirstMetricName(tc.Names, tc.Candidates...)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_dtu(rInt int, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_basic_DTU(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_elasticpool_metric_definitions" "test" {
+  elastic_pool_id = "${azurerm_mssql_elasticpool.test.id}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMMsSqlElasticPoolMetricDefinitions_vCore(rInt int, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_basic_vCore(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_elasticpool_metric_definitions" "test" {
+  elastic_pool_id = "${azurerm_mssql_elasticpool.test.id}"
+}
+`, template)
+}
@@ -0,0 +1,273 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmMsSqlElasticPoolSkus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMsSqlElasticPoolSkusRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"families": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_database_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"zone_redundant": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"included_max_size_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"supported_max_size": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_gb": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+
+									"max_gb": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+
+									"step_gb": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMsSqlElasticPoolSkusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlCapabilitiesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	resp, err := client.ListByLocation(ctx, location, sql.SupportedElasticPoolEditions)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Elastic Pool Capabilities for Location %q: %+v", location, err)
+	}
+
+	skus := flattenArmMsSqlElasticPoolSkus(resp.SupportedServerVersions)
+
+	d.SetId(fmt.Sprintf("mssql-elasticpool-skus-%s", location))
+
+	d.Set("location", location)
+	d.Set("names", uniqueArmMsSqlElasticPoolSkuValues(skus, "name"))
+	d.Set("tiers", uniqueArmMsSqlElasticPoolSkuValues(skus, "tier"))
+	d.Set("families", uniqueArmMsSqlElasticPoolSkuValues(skus, "family"))
+
+	if err := d.Set("sku", skus); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmMsSqlElasticPoolSkus(input *[]sql.ServerVersionCapability) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the same SKU can be returned for multiple Server Versions
+	seen := make(map[string]bool)
+
+	for _, version := range *input {
+		if version.Status == sql.Disabled || version.SupportedElasticPoolEditions == nil {
+			continue
+		}
+
+		for _, edition := range *version.SupportedElasticPoolEditions {
+			if edition.Status == sql.Disabled || edition.SupportedElasticPoolPerformanceLevels == nil {
+				continue
+			}
+
+			zoneRedundant := false
+			if edition.ZoneRedundant != nil {
+				zoneRedundant = *edition.ZoneRedundant
+			}
+
+			for _, level := range *edition.SupportedElasticPoolPerformanceLevels {
+				if level.Status == sql.Disabled || level.Sku == nil || level.Sku.Name == nil {
+					continue
+				}
+
+				sku := level.Sku
+				result := map[string]interface{}{
+					"name":           *sku.Name,
+					"zone_redundant": zoneRedundant,
+				}
+
+				if sku.Tier != nil {
+					result["tier"] = *sku.Tier
+				}
+
+				if sku.Family != nil {
+					result["family"] = *sku.Family
+				}
+
+				capacity := 0
+				if sku.Capacity != nil {
+					capacity = int(*sku.Capacity)
+				}
+				result["capacity"] = capacity
+
+				key := fmt.Sprintf("%s/%d", *sku.Name, capacity)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				if level.MaxDatabaseCount != nil {
+					result["max_database_count"] = int(*level.MaxDatabaseCount)
+				}
+
+				if size, ok := msSqlMaxSizeCapabilityInGB(level.IncludedMaxSize); ok {
+					result["included_max_size_gb"] = size
+				}
+
+				result["supported_max_size"] = flattenArmMsSqlElasticPoolSkuMaxSizes(level.SupportedMaxSizes)
+
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
+
+func flattenArmMsSqlElasticPoolSkuMaxSizes(input *[]sql.MaxSizeRangeCapability) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, size := range *input {
+		if size.Status == sql.Disabled {
+			continue
+		}
+
+		result := make(map[string]interface{})
+
+		if v, ok := msSqlMaxSizeCapabilityInGB(size.MinValue); ok {
+			result["min_gb"] = v
+		}
+
+		if v, ok := msSqlMaxSizeCapabilityInGB(size.MaxValue); ok {
+			result["max_gb"] = v
+		}
+
+		if v, ok := msSqlMaxSizeCapabilityInGB(size.ScaleSize); ok {
+			result["step_gb"] = v
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func uniqueArmMsSqlElasticPoolSkuValues(skus []interface{}, key string) []string {
+	seen := make(map[string]bool)
+	results := make([]string, 0)
+
+	for _, v := range skus {
+		value, ok := v.(map[string]interface{})[key].(string)
+		if !ok || value == "" || seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		results = append(results, value)
+	}
+
+	sort.Strings(results)
+	return results
+}
+
+// msSqlMaxSizeCapabilityInGB converts the size returned from the Capabilities API into Gigabytes
+func msSqlMaxSizeCapabilityInGB(input *sql.MaxSizeCapability) (float64, bool) {
+	if input == nil || input.Limit == nil {
+		return 0, false
+	}
+
+	limit := float64(*input.Limit)
+	switch input.Unit {
+	case sql.MaxSizeUnitMegabytes:
+		return limit / 1024, true
+	case sql.MaxSizeUnitGigabytes:
+		return limit, true
+	case sql.MaxSizeUnitTerabytes:
+		return limit * 1024, true
+	case sql.MaxSizeUnitPetabytes:
+		return limit * 1024 * 1024, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMMsSqlElasticPoolSkus_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_elasticpool_skus.test"
+	config := testAccDataSourceAzureRMMsSqlElasticPoolSkus_basic(testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tiers.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sku.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sku.0.tier"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sku.0.capacity"),
+				),
+			},
+		},
+	})
+}
+
+func TestMsSqlMaxSizeCapabilityInGB(t *testing.T) {
+	cases := []struct {
+		Input    *sql.MaxSizeCapability
+		Expected float64
+		Parsed   bool
+	}{
+		{
+			Input:  nil,
+			Parsed: false,
+		},
+		{
+			Input: &sql.MaxSizeCapability{
+				Unit: sql.MaxSizeUnitGigabytes,
+			},
+			Parsed: false,
+		},
+		{
+			Input: &sql.MaxSizeCapability{
+				Limit: utils.Int32(512),
+				Unit:  sql.MaxSizeUnitMegabytes,
+			},
+			Expected: 0.5,
+			Parsed:   true,
+		},
+		{
+			Input: &sql.MaxSizeCapability{
+				Limit: utils.Int32(250),
+				Unit:  sql.MaxSizeUnitGigabytes,
+			},
+			Expected: 250,
+			Parsed:   true,
+		},
+		{
+			Input: &sql.MaxSizeCapability{
+				Limit: utils.Int32(4),
+				Unit:  sql.MaxSizeUnitTerabytes,
+			},
+			Expected: 4096,
+			Parsed:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, parsed := msSqlMaxSizeCapabilityInGB(tc.Input)
+		if parsed != tc.Parsed {
+			t.Fatalf("Expected parsed to be %t but got %t", tc.Parsed, parsed)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %f but got %f", tc.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenArmMsSqlElasticPoolSkus(t *testing.T) {
+	level := func(name, tier, family string, capacity int32, status sql.CapabilityStatus) sql.ElasticPoolPerformanceLevelCapability {
+		return sql.ElasticPoolPerformanceLevelCapability{
+			Sku: &sql.Sku{
+				Name:     utils.String(name),
+				Tier:     utils.String(tier),
+				Family:   utils.String(family),
+				Capacity: utils.Int32(capacity),
+			},
+			Status: status,
+		}
+	}
+
+	editions := []sql.ElasticPoolEditionCapability{
+		{
+			Name:          utils.String("Standard"),
+			ZoneRedundant: utils.Bool(false),
+			SupportedElasticPoolPerformanceLevels: &[]sql.ElasticPoolPerformanceLevelCapability{
+				level("StandardPool", "Standard", "", 50, sql.Available),
+				level("StandardPool", "Standard", "", 100, sql.Default),
+			},
+		},
+		{
+			Name:          utils.String("GeneralPurpose"),
+			ZoneRedundant: utils.Bool(true),
+			SupportedElasticPoolPerformanceLevels: &[]sql.ElasticPoolPerformanceLevelCapability{
+				level("GP_Gen5", "GeneralPurpose", "Gen5", 2, sql.Available),
+				level("GP_Gen4", "GeneralPurpose", "Gen4", 2, sql.Disabled),
+			},
+		},
+	}
+
+	input := []sql.ServerVersionCapability{
+		{
+			Name:                         utils.String("12.0"),
+			SupportedElasticPoolEditions: &editions,
+		},
+		{
+			Name:                         utils.String("12.1"),
+			SupportedElasticPoolEditions: &editions,
+		},
+	}
+
+	skus := flattenArmMsSqlElasticPoolSkus(&input)
+	if len(skus) != 3 {
+		t.Fatalf("Expected 3 SKUs but got %d", len(skus))
+	}
+
+	names := uniqueArmMsSqlElasticPoolSkuValues(skus, "name")
+	expectedNames := []string{"GP_Gen5", "StandardPool"}
+	if fmt.Sprintf("%v", names) != fmt.Sprintf("%v", expectedNames) {
+		t.Fatalf("Expected names %v but got %v", expectedNames, names)
+	}
+
+	families := uniqueArmMsSqlElasticPoolSkuValues(skus, "family")
+	expectedFamilies := []string{"Gen5"}
+	if fmt.Sprintf("%v", families) != fmt.Sprintf("%v", expectedFamilies) {
+		t.Fatalf("Expected families %v but got %v", expectedFamilies, families)
+	}
+}
+
+func testAccDataSourceAzureRMMsSqlElasticPoolSkus_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_mssql_elasticpool_skus" "test" {
+  location = "%s"
+}
+`, location)
+}
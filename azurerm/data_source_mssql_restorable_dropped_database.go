@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmMsSqlRestorableDroppedDatabase() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMsSqlRestorableDroppedDatabaseRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"database": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"edition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_level_objective": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"elastic_pool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"max_size_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"deletion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"earliest_restore_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMsSqlRestorableDroppedDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlRestorableDroppedDatabasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	resp, err := client.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error listing Restorable Dropped Databases for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	ids := make([]string, 0)
+	databases := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			database := flattenArmMsSqlRestorableDroppedDatabase(v)
+			if databaseName != "" && !strings.EqualFold(database["name"].(string), databaseName) {
+				continue
+			}
+
+			ids = append(ids, database["id"].(string))
+			databases = append(databases, database)
+		}
+	}
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	d.SetId(fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/restorableDroppedDatabases", subscriptionId, resourceGroup, serverName))
+	d.Set("server_name", serverName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting `ids`: %+v", err)
+	}
+
+	if err := d.Set("database", databases); err != nil {
+		return fmt.Errorf("Error setting `database`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmMsSqlRestorableDroppedDatabase(input sql.RestorableDroppedDatabase) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":                      "",
+		"name":                    "",
+		"edition":                 "",
+		"service_level_objective": "",
+		"elastic_pool_name":       "",
+		"max_size_bytes":          "",
+		"creation_date":           "",
+		"deletion_date":           "",
+		"earliest_restore_date":   "",
+	}
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if props := input.RestorableDroppedDatabaseProperties; props != nil {
+		if props.DatabaseName != nil {
+			output["name"] = *props.DatabaseName
+		}
+
+		if props.Edition != nil {
+			output["edition"] = *props.Edition
+		}
+
+		if props.ServiceLevelObjective != nil {
+			output["service_level_objective"] = *props.ServiceLevelObjective
+		}
+
+		if props.ElasticPoolName != nil {
+			output["elastic_pool_name"] = *props.ElasticPoolName
+		}
+
+		if props.MaxSizeBytes != nil {
+			output["max_size_bytes"] = *props.MaxSizeBytes
+		}
+
+		if props.CreationDate != nil {
+			output["creation_date"] = props.CreationDate.Format(time.RFC3339)
+		}
+
+		if props.DeletionDate != nil {
+			output["deletion_date"] = props.DeletionDate.Format(time.RFC3339)
+		}
+
+		if props.EarliestRestoreDate != nil {
+			output["earliest_restore_date"] = props.EarliestRestoreDate.Format(time.RFC3339)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_restorable_dropped_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_database(ri, location),
+			},
+			{
+				// drop the Database, so that it can be restored
+				Config: testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.0.name", fmt.Sprintf("acctestdb-%d", ri)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.deletion_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "database.0.edition"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_empty(t *testing.T) {
+	dataSourceName := "data.azurerm_mssql_restorable_dropped_database.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "database.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_database(rInt int, location string) string {
+	template := testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestdb-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, template, rInt)
+}
+
+func testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_basic(rInt int, location string) string {
+	template := testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_restorable_dropped_database" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  database_name       = "acctestdb-%d"
+}
+`, template, rInt)
+}
+
+func testAccDataSourceAzureRMMsSqlRestorableDroppedDatabase_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+`, rInt, location)
+}
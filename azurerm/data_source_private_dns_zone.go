@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmPrivateDnsZone() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateDnsZoneRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"registration_virtual_network_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"resolution_virtual_network_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	var zone *dns.Zone
+	if resourceGroup != "" {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Private DNS Zone %q (Resource Group %q) was not found", name, resourceGroup)
+			}
+			return fmt.Errorf("Error reading Private DNS Zone %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.ZoneProperties; props == nil || props.ZoneType != dns.Private {
+			return fmt.Errorf("Error: DNS Zone %q (Resource Group %q) is not a Private DNS Zone", name, resourceGroup)
+		}
+
+		zone = &resp
+	} else {
+		// Private DNS Zones are commonly managed centrally (e.g. in a hub), so look across the Subscription
+		zones := make([]dns.Zone, 0)
+		iterator, err := client.ListComplete(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing DNS Zones: %+v", err)
+		}
+
+		for iterator.NotDone() {
+			z := iterator.Value()
+			if z.Name != nil && *z.Name == name && z.ZoneProperties != nil && z.ZoneProperties.ZoneType == dns.Private {
+				zones = append(zones, z)
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing DNS Zones: %+v", err)
+			}
+		}
+
+		if len(zones) == 0 {
+			return fmt.Errorf("Error: Private DNS Zone %q was not found", name)
+		}
+
+		if len(zones) > 1 {
+			return fmt.Errorf("Error: %d Private DNS Zones named %q were found - please specify the `resource_group_name`", len(zones), name)
+		}
+
+		zone = &zones[0]
+
+		id, err := parseAzureResourceID(*zone.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup = id.ResourceGroup
+	}
+
+	d.SetId(*zone.ID)
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := zone.ZoneProperties; props != nil {
+		d.Set("number_of_record_sets", props.NumberOfRecordSets)
+		d.Set("max_number_of_record_sets", props.MaxNumberOfRecordSets)
+
+		registrationVNets := make([]string, 0)
+		if rvns := props.RegistrationVirtualNetworks; rvns != nil {
+			for _, rvn := range *rvns {
+				registrationVNets = append(registrationVNets, *rvn.ID)
+			}
+		}
+		if err := d.Set("registration_virtual_network_ids", registrationVNets); err != nil {
+			return err
+		}
+
+		resolutionVNets := make([]string, 0)
+		if rvns := props.ResolutionVirtualNetworks; rvns != nil {
+			for _, rvn := range *rvns {
+				resolutionVNets = append(resolutionVNets, *rvn.ID)
+			}
+		}
+		if err := d.Set("resolution_virtual_network_ids", resolutionVNets); err != nil {
+			return err
+		}
+	}
+
+	flattenAndSetTags(d, zone.Tags)
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package azurerm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmPrivateDnsZoneRecordSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateDnsZoneRecordSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dns.A),
+					string(dns.AAAA),
+					string(dns.CAA),
+					string(dns.CNAME),
+					string(dns.MX),
+					string(dns.NS),
+					string(dns.PTR),
+					string(dns.SOA),
+					string(dns.SRV),
+					string(dns.TXT),
+				}, false),
+			},
+
+			"name_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"record_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmPrivateDnsZoneRecordSetsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	zoneName := d.Get("zone_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	recordType := d.Get("type").(string)
+	nameSuffix := d.Get("name_suffix").(string)
+
+	var (
+		iterator dns.RecordSetListResultIterator
+		err      error
+	)
+	if recordType != "" {
+		iterator, err = client.ListByTypeComplete(ctx, resourceGroup, zoneName, dns.RecordType(recordType), nil, nameSuffix)
+	} else {
+		iterator, err = client.ListByDNSZoneComplete(ctx, resourceGroup, zoneName, nil, nameSuffix)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Record Sets for Private DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+
+	names := make([]string, 0)
+	recordSets := make([]interface{}, 0)
+	for iterator.NotDone() {
+		recordSet := flattenArmPrivateDnsZoneRecordSet(iterator.Value())
+		names = append(names, recordSet["name"].(string))
+		recordSets = append(recordSets, recordSet)
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Record Sets for Private DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/recordSets/%s%s", zoneName, recordType, nameSuffix))
+	d.Set("zone_name", zoneName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	if err := d.Set("record_set", recordSets); err != nil {
+		return fmt.Errorf("Error setting `record_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmPrivateDnsZoneRecordSet(input dns.RecordSet) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":       "",
+		"name":     "",
+		"type":     "",
+		"fqdn":     "",
+		"ttl":      0,
+		"records":  make([]interface{}, 0),
+		"metadata": make(map[string]interface{}),
+	}
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	// the type is returned in the form `Microsoft.Network/dnszones/A`
+	if input.Type != nil {
+		segments := strings.Split(*input.Type, "/")
+		output["type"] = segments[len(segments)-1]
+	}
+
+	if props := input.RecordSetProperties; props != nil {
+		if props.Fqdn != nil {
+			output["fqdn"] = *props.Fqdn
+		}
+
+		if props.TTL != nil {
+			output["ttl"] = int(*props.TTL)
+		}
+
+		records := make([]interface{}, 0)
+		for _, record := range flattenArmPrivateDnsZoneRecordSetRecords(props) {
+			records = append(records, record)
+		}
+		output["records"] = records
+
+		metadata := make(map[string]interface{})
+		for k, v := range props.Metadata {
+			if v != nil {
+				metadata[k] = *v
+			}
+		}
+		output["metadata"] = metadata
+	}
+
+	return output
+}
+
+// flattenArmPrivateDnsZoneRecordSetRecords returns the records within a Record Set in their zone file representation
+func flattenArmPrivateDnsZoneRecordSetRecords(props *dns.RecordSetProperties) []string {
+	records := make([]string, 0)
+
+	if props.ARecords != nil {
+		for _, r := range *props.ARecords {
+			if r.Ipv4Address != nil {
+				records = append(records, *r.Ipv4Address)
+			}
+		}
+	}
+
+	if props.AaaaRecords != nil {
+		for _, r := range *props.AaaaRecords {
+			if r.Ipv6Address != nil {
+				records = append(records, *r.Ipv6Address)
+			}
+		}
+	}
+
+	if props.CaaRecords != nil {
+		for _, r := range *props.CaaRecords {
+			if r.Flags != nil && r.Tag != nil && r.Value != nil {
+				records = append(records, fmt.Sprintf("%d %s %q", *r.Flags, *r.Tag, *r.Value))
+			}
+		}
+	}
+
+	if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+		records = append(records, *props.CnameRecord.Cname)
+	}
+
+	if props.MxRecords != nil {
+		for _, r := range *props.MxRecords {
+			if r.Preference != nil && r.Exchange != nil {
+				records = append(records, fmt.Sprintf("%d %s", *r.Preference, *r.Exchange))
+			}
+		}
+	}
+
+	if props.NsRecords != nil {
+		for _, r := range *props.NsRecords {
+			if r.Nsdname != nil {
+				records = append(records, *r.Nsdname)
+			}
+		}
+	}
+
+	if props.PtrRecords != nil {
+		for _, r := range *props.PtrRecords {
+			if r.Ptrdname != nil {
+				records = append(records, *r.Ptrdname)
+			}
+		}
+	}
+
+	if r := props.SoaRecord; r != nil && r.Host != nil && r.Email != nil {
+		values := []string{*r.Host, *r.Email}
+		for _, v := range []*int64{r.SerialNumber, r.RefreshTime, r.RetryTime, r.ExpireTime, r.MinimumTTL} {
+			if v != nil {
+				values = append(values, strconv.FormatInt(*v, 10))
+			}
+		}
+		records = append(records, strings.Join(values, " "))
+	}
+
+	if props.SrvRecords != nil {
+		for _, r := range *props.SrvRecords {
+			if r.Priority != nil && r.Weight != nil && r.Port != nil && r.Target != nil {
+				records = append(records, fmt.Sprintf("%d %d %d %s", *r.Priority, *r.Weight, *r.Port, *r.Target))
+			}
+		}
+	}
+
+	if props.TxtRecords != nil {
+		for _, r := range *props.TxtRecords {
+			if r.Value != nil {
+				records = append(records, strings.Join(*r.Value, ""))
+			}
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMPrivateDNSZoneRecordSets_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone_record_sets.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZoneRecordSets_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					// the SOA record is created alongside the zone
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPrivateDNSZoneRecordSets_type(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone_record_sets.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZoneRecordSets_type(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenArmPrivateDnsZoneRecordSetRecords(t *testing.T) {
+	cases := []struct {
+		Input    dns.RecordSetProperties
+		Expected []string
+	}{
+		{
+			Input:    dns.RecordSetProperties{},
+			Expected: []string{},
+		},
+		{
+			Input: dns.RecordSetProperties{
+				ARecords: &[]dns.ARecord{
+					{Ipv4Address: utils.String("10.0.0.4")},
+					{Ipv4Address: utils.String("10.0.0.5")},
+				},
+			},
+			Expected: []string{"10.0.0.4", "10.0.0.5"},
+		},
+		{
+			Input: dns.RecordSetProperties{
+				CnameRecord: &dns.CnameRecord{Cname: utils.String("example.privatelink.database.windows.net")},
+			},
+			Expected: []string{"example.privatelink.database.windows.net"},
+		},
+		{
+			Input: dns.RecordSetProperties{
+				MxRecords: &[]dns.MxRecord{
+					{Preference: utils.Int32(10), Exchange: utils.String("mail.example.com")},
+				},
+			},
+			Expected: []string{"10 mail.example.com"},
+		},
+		{
+			Input: dns.RecordSetProperties{
+				SrvRecords: &[]dns.SrvRecord{
+					{Priority: utils.Int32(1), Weight: utils.Int32(5), Port: utils.Int32(8080), Target: utils.String("target.example.com")},
+				},
+			},
+			Expected: []string{"1 5 8080 target.example.com"},
+		},
+		{
+			Input: dns.RecordSetProperties{
+				TxtRecords: &[]dns.TxtRecord{
+					{Value: &[]string{"v=spf1 ", "-all"}},
+				},
+			},
+			Expected: []string{"v=spf1 -all"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := flattenArmPrivateDnsZoneRecordSetRecords(&tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func testAccDataSourcePrivateDNSZoneRecordSets_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_type           = "Private"
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["10.0.0.4", "10.0.0.5"]
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccDataSourcePrivateDNSZoneRecordSets_basic(rInt int, location string) string {
+	template := testAccDataSourcePrivateDNSZoneRecordSets_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone_record_sets" "test" {
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_dns_zone.test.resource_group_name}"
+
+  depends_on = ["azurerm_dns_a_record.test", "azurerm_dns_cname_record.test"]
+}
+`, template)
+}
+
+func testAccDataSourcePrivateDNSZoneRecordSets_type(rInt int, location string) string {
+	template := testAccDataSourcePrivateDNSZoneRecordSets_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone_record_sets" "test" {
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_dns_zone.test.resource_group_name}"
+  type                = "A"
+
+  depends_on = ["azurerm_dns_a_record.test", "azurerm_dns_cname_record.test"]
+}
+`, template)
+}
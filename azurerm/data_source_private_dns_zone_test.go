@@ -0,0 +1,103 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateDNSZone_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZone_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "number_of_record_sets"),
+					resource.TestCheckResourceAttr(dataSourceName, "resolution_virtual_network_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPrivateDNSZone_withoutResourceGroupName(t *testing.T) {
+	dataSourceName := "data.azurerm_private_dns_zone.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+	resourceGroupName := fmt.Sprintf("acctestRG-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivateDNSZone_onlyName(rInt, location, resourceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_group_name", resourceGroupName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePrivateDNSZone_template(rInt int, location, resourceGroupName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "%s"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+  dns_servers         = ["168.63.129.16"]
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                           = "acctestzone%d.internal"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  zone_type                      = "Private"
+  resolution_virtual_network_ids = ["${azurerm_virtual_network.test.id}"]
+
+  tags {
+    hello = "world"
+  }
+}
+`, resourceGroupName, location, rInt, rInt)
+}
+
+func testAccDataSourcePrivateDNSZone_basic(rInt int, location string) string {
+	template := testAccDataSourcePrivateDNSZone_template(rInt, location, fmt.Sprintf("acctestRG-%d", rInt))
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone" "test" {
+  name                = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_dns_zone.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccDataSourcePrivateDNSZone_onlyName(rInt int, location, resourceGroupName string) string {
+	template := testAccDataSourcePrivateDNSZone_template(rInt, location, resourceGroupName)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone" "test" {
+  name = "${azurerm_dns_zone.test.name}"
+}
+`, template)
+}
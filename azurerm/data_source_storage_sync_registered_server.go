@@ -0,0 +1,98 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmStorageSyncRegisteredServer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageSyncRegisteredServerRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_sync_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"server_os_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_heartbeat": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageSyncRegisteredServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncRegisteredServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageSyncId, err := parseAzureResourceID(d.Get("storage_sync_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := storageSyncId.ResourceGroup
+	storageSyncName := storageSyncId.Path["storageSyncServices"]
+	friendlyName := d.Get("friendly_name").(string)
+
+	resp, err := client.ListByStorageSyncService(ctx, resourceGroup, storageSyncName)
+	if err != nil {
+		return fmt.Errorf("Error listing Registered Servers for Storage Sync Service %q (Resource Group %q): %+v", storageSyncName, resourceGroup, err)
+	}
+
+	if resp.Value != nil {
+		for _, server := range *resp.Value {
+			props := server.RegisteredServerProperties
+			if props == nil || props.FriendlyName == nil || !strings.EqualFold(*props.FriendlyName, friendlyName) {
+				continue
+			}
+
+			if server.ID == nil || *server.ID == "" {
+				return fmt.Errorf("Error retrieving Registered Server %q (Storage Sync Service %q / Resource Group %q): ID was nil or empty", friendlyName, storageSyncName, resourceGroup)
+			}
+
+			d.SetId(*server.ID)
+			d.Set("friendly_name", props.FriendlyName)
+			d.Set("server_id", props.ServerID)
+			d.Set("agent_version", props.AgentVersion)
+			d.Set("server_os_version", props.ServerOSVersion)
+			d.Set("last_heartbeat", props.LastHeartBeat)
+			d.Set("cluster_name", props.ClusterName)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Error: Registered Server %q was not found in Storage Sync Service %q (Resource Group %q)", friendlyName, storageSyncName, resourceGroup)
+}
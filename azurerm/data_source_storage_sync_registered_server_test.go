@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageSyncRegisteredServer_basic(t *testing.T) {
+	// Servers can only be registered by the Azure File Sync agent running on them,
+	// as such this test requires an existing Storage Sync Service with a Registered Server
+	storageSyncIdEnvVariable := "ARM_TEST_STORAGE_SYNC_ID"
+	storageSyncId := os.Getenv(storageSyncIdEnvVariable)
+	if storageSyncId == "" {
+		t.Skipf("Skipping as %q is not specified", storageSyncIdEnvVariable)
+	}
+
+	serverNameEnvVariable := "ARM_TEST_STORAGE_SYNC_SERVER_NAME"
+	serverName := os.Getenv(serverNameEnvVariable)
+	if serverName == "" {
+		t.Skipf("Skipping as %q is not specified", serverNameEnvVariable)
+	}
+
+	dataSourceName := "data.azurerm_storage_sync_registered_server.test"
+	config := testAccDataSourceAzureRMStorageSyncRegisteredServer_basic(storageSyncId, serverName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "server_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "agent_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageSyncRegisteredServer_basic(storageSyncId string, serverName string) string {
+	return fmt.Sprintf(`
+data "azurerm_storage_sync_registered_server" "test" {
+  storage_sync_id = "%s"
+  friendly_name   = "%s"
+}
+`, storageSyncId, serverName)
+}
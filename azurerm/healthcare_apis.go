@@ -0,0 +1,230 @@
+package azurerm
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Healthcare APIs (Workspaces and the FHIR, DICOM and MedTech Services within them) aren't present in the
+// vendored SDK, so are managed using raw requests
+const healthcareApisApiVersion = "2023-11-01"
+
+type healthcareIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type healthcareCorsConfiguration struct {
+	Origins          *[]string `json:"origins,omitempty"`
+	Headers          *[]string `json:"headers,omitempty"`
+	Methods          *[]string `json:"methods,omitempty"`
+	MaxAge           *int32    `json:"maxAge,omitempty"`
+	AllowCredentials *bool     `json:"allowCredentials,omitempty"`
+}
+
+func healthcareIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SystemAssigned",
+					}, true),
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func healthcareCorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"allowed_headers": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"allowed_methods": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"OPTIONS",
+							"PATCH",
+							"POST",
+							"PUT",
+						}, false),
+					},
+				},
+
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 99999),
+				},
+
+				"credentials_allowed": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandArmHealthcareIdentity(input []interface{}) *healthcareIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &healthcareIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &healthcareIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmHealthcareIdentity(input *healthcareIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandArmHealthcareCors(input []interface{}) *healthcareCorsConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := &healthcareCorsConfiguration{
+		Origins:          expandArmHealthcareStringSet(v["allowed_origins"].(*schema.Set)),
+		Headers:          expandArmHealthcareStringSet(v["allowed_headers"].(*schema.Set)),
+		Methods:          expandArmHealthcareStringSet(v["allowed_methods"].(*schema.Set)),
+		AllowCredentials: utils.Bool(v["credentials_allowed"].(bool)),
+	}
+
+	if maxAge := v["max_age_in_seconds"].(int); maxAge != 0 {
+		output.MaxAge = utils.Int32(int32(maxAge))
+	}
+
+	return output
+}
+
+func flattenArmHealthcareCors(input *healthcareCorsConfiguration) []interface{} {
+	if input == nil || input.Origins == nil || len(*input.Origins) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	maxAge := 0
+	if input.MaxAge != nil {
+		maxAge = int(*input.MaxAge)
+	}
+
+	credentialsAllowed := false
+	if input.AllowCredentials != nil {
+		credentialsAllowed = *input.AllowCredentials
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_origins":     flattenArmHealthcareStringSet(input.Origins),
+			"allowed_headers":     flattenArmHealthcareStringSet(input.Headers),
+			"allowed_methods":     flattenArmHealthcareStringSet(input.Methods),
+			"max_age_in_seconds":  maxAge,
+			"credentials_allowed": credentialsAllowed,
+		},
+	}
+}
+
+func expandArmHealthcareStringSet(input *schema.Set) *[]string {
+	output := make([]string, 0)
+	for _, v := range input.List() {
+		output = append(output, v.(string))
+	}
+
+	return &output
+}
+
+func flattenArmHealthcareStringSet(input *[]string) *schema.Set {
+	output := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			output = append(output, v)
+		}
+	}
+
+	return schema.NewSet(schema.HashString, output)
+}
+
+func healthcarePublicNetworkAccess(enabled bool) *string {
+	if enabled {
+		return utils.String("Enabled")
+	}
+
+	return utils.String("Disabled")
+}
+
+func healthcarePublicNetworkAccessEnabled(input *string) bool {
+	// the API defaults to Enabled when this isn't returned
+	return input == nil || strings.EqualFold(*input, "Enabled")
+}
@@ -0,0 +1,28 @@
+package azure
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const apiVersionQueryParameter = "api-version"
+
+// WithApiVersion replaces the API Version of requests made using the `from` API Version with the `to` API Version,
+// which allows a client from the vendored SDK to target a newer (compatible) API Version. Requests made using any
+// other API Version (such as polling a long-running operation, where the URI is returned by the API) are left as-is.
+func WithApiVersion(from string, to string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL != nil && from != to {
+				query := r.URL.Query()
+				if query.Get(apiVersionQueryParameter) == from {
+					query.Set(apiVersionQueryParameter, to)
+					r.URL.RawQuery = query.Encode()
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithApiVersion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		URI      string
+		Expected string
+	}{
+		{
+			Name:     "Matching API Version",
+			URI:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example/elasticPools/pool?api-version=2017-10-01-preview",
+			Expected: "2021-11-01",
+		},
+		{
+			Name:     "Other API Version",
+			URI:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Sql/locations/westeurope/elasticPoolOperationResults/abc?api-version=2021-11-01",
+			Expected: "2021-11-01",
+		},
+		{
+			Name:     "Unrelated API Version",
+			URI:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2018-05-01",
+			Expected: "2018-05-01",
+		},
+		{
+			Name:     "No API Version",
+			URI:      "https://management.azure.com/subscriptions",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var actual string
+			sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				actual = r.URL.Query().Get(apiVersionQueryParameter)
+				return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+			}), WithApiVersion("2017-10-01-preview", "2021-11-01"))
+
+			req, _ := http.NewRequest(http.MethodGet, tc.URI, nil)
+			if _, err := sender.Do(req); err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected the API Version to be %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
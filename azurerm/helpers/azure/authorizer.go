@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// CachedBearerAuthorizerCallback returns a BearerAuthorizerCallbackFunc which only invokes the callback once for each
+// Tenant and Resource, reusing the Authorizer for subsequent challenges.
+//
+// Data Plane APIs (such as Key Vault) challenge every request for a token - without this a new token would be
+// requested from Azure Active Directory for each request, rather than the existing token being reused (and
+// refreshed by the Authorizer when it's about to expire).
+func CachedBearerAuthorizerCallback(callback autorest.BearerAuthorizerCallbackFunc) autorest.BearerAuthorizerCallbackFunc {
+	var lock sync.Mutex
+	authorizers := make(map[string]*autorest.BearerAuthorizer)
+
+	return func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		key := fmt.Sprintf("%s|%s", tenantID, resource)
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if authorizer, ok := authorizers[key]; ok {
+			return authorizer, nil
+		}
+
+		authorizer, err := callback(tenantID, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizers[key] = authorizer
+		return authorizer, nil
+	}
+}
@@ -0,0 +1,55 @@
+package azure
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestCachedBearerAuthorizerCallback(t *testing.T) {
+	calls := make(map[string]int)
+	callback := CachedBearerAuthorizerCallback(func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		calls[resource]++
+		return autorest.NewBearerAuthorizer(&adal.Token{AccessToken: resource}), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		for _, resource := range []string{"https://vault.azure.net", "https://storage.azure.com"} {
+			if _, err := callback("00000000-0000-0000-0000-000000000000", resource); err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+		}
+	}
+
+	for resource, count := range calls {
+		if count != 1 {
+			t.Fatalf("Expected the callback to be invoked once for %q but it was invoked %d times", resource, count)
+		}
+	}
+}
+
+func TestCachedBearerAuthorizerCallbackError(t *testing.T) {
+	calls := 0
+	callback := CachedBearerAuthorizerCallback(func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("transient error")
+		}
+
+		return autorest.NewBearerAuthorizer(&adal.Token{AccessToken: resource}), nil
+	})
+
+	if _, err := callback("tenant", "https://vault.azure.net"); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if _, err := callback("tenant", "https://vault.azure.net"); err != nil {
+		t.Fatalf("Expected the error not to be cached but got: %+v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected the callback to be invoked twice but it was invoked %d times", calls)
+	}
+}
@@ -0,0 +1,183 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ErrorDiagnostics contains the details of a failed request to Azure which are useful when raising a support ticket
+type ErrorDiagnostics struct {
+	StatusCode           int
+	CorrelationRequestID string
+	RequestID            string
+	Code                 string
+	Message              string
+	Details              []ErrorDiagnosticsDetail
+}
+
+// ErrorDiagnosticsDetail is an additional error returned by Azure alongside the top-level error
+type ErrorDiagnosticsDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Target  string `json:"target"`
+}
+
+type armErrorBody struct {
+	Code    string                   `json:"code"`
+	Message string                   `json:"message"`
+	Details []ErrorDiagnosticsDetail `json:"details"`
+}
+
+type armErrorResponse struct {
+	Error *armErrorBody `json:"error"`
+
+	// some API's return the error at the top-level rather than nested within `error`
+	armErrorBody
+}
+
+// causer is implemented by errors which wrap the error which caused them, such as those from `github.com/pkg/errors`
+type causer interface {
+	Cause() error
+}
+
+// ErrorDiagnosticsFromError returns the details of the failed request which caused the specified error, if known.
+//
+// These are read from the response attached to the error returned by the SDK - as such they're unavailable once
+// the error has been flattened into a string (for example by a resource wrapping it using `%+v`)
+func ErrorDiagnosticsFromError(err error) *ErrorDiagnostics {
+	for err != nil {
+		switch e := err.(type) {
+		case *az.RequestError:
+			return errorDiagnosticsFromRequestError(*e)
+		case az.RequestError:
+			return errorDiagnosticsFromRequestError(e)
+		case autorest.DetailedError:
+			if diagnostics := errorDiagnosticsFromDetailedError(e); diagnostics != nil {
+				return diagnostics
+			}
+			err = e.Original
+		case *autorest.DetailedError:
+			if diagnostics := errorDiagnosticsFromDetailedError(*e); diagnostics != nil {
+				return diagnostics
+			}
+			err = e.Original
+		case causer:
+			err = e.Cause()
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func errorDiagnosticsFromRequestError(e az.RequestError) *ErrorDiagnostics {
+	diagnostics := ErrorDiagnostics{
+		RequestID: e.RequestID,
+	}
+	if v, ok := e.StatusCode.(int); ok {
+		diagnostics.StatusCode = v
+	}
+	populateErrorDiagnosticsFromResponse(&diagnostics, e.Response)
+
+	if se := e.ServiceError; se != nil {
+		diagnostics.Code = se.Code
+		diagnostics.Message = se.Message
+		for _, detail := range se.Details {
+			diagnostics.Details = append(diagnostics.Details, ErrorDiagnosticsDetail{
+				Code:    stringFromErrorDetail(detail, "code"),
+				Message: stringFromErrorDetail(detail, "message"),
+				Target:  stringFromErrorDetail(detail, "target"),
+			})
+		}
+	}
+
+	return &diagnostics
+}
+
+// errorDiagnosticsFromDetailedError returns the diagnostics for a failed response which wasn't parsed into an
+// `az.RequestError` - where the SDK returns the response body instead
+func errorDiagnosticsFromDetailedError(e autorest.DetailedError) *ErrorDiagnostics {
+	if e.Response == nil || e.Response.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	// the original error contains more detail where the body was parsed by the SDK
+	if _, ok := e.Original.(*az.RequestError); ok {
+		return nil
+	}
+
+	diagnostics := ErrorDiagnostics{
+		StatusCode: e.Response.StatusCode,
+	}
+	populateErrorDiagnosticsFromResponse(&diagnostics, e.Response)
+
+	var parsed armErrorResponse
+	if len(e.ServiceError) > 0 && json.Unmarshal(e.ServiceError, &parsed) == nil {
+		errorBody := parsed.armErrorBody
+		if parsed.Error != nil {
+			errorBody = *parsed.Error
+		}
+
+		diagnostics.Code = errorBody.Code
+		diagnostics.Message = errorBody.Message
+		diagnostics.Details = errorBody.Details
+	}
+
+	return &diagnostics
+}
+
+func populateErrorDiagnosticsFromResponse(diagnostics *ErrorDiagnostics, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	if diagnostics.StatusCode == 0 {
+		diagnostics.StatusCode = resp.StatusCode
+	}
+	if diagnostics.RequestID == "" {
+		diagnostics.RequestID = resp.Header.Get("x-ms-request-id")
+	}
+
+	diagnostics.CorrelationRequestID = resp.Header.Get(correlationRequestIDHeader)
+	if diagnostics.CorrelationRequestID == "" && resp.Request != nil {
+		diagnostics.CorrelationRequestID = resp.Request.Header.Get(correlationRequestIDHeader)
+	}
+}
+
+func stringFromErrorDetail(detail map[string]interface{}, key string) string {
+	if v, ok := detail[key].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+func (d ErrorDiagnostics) String() string {
+	lines := []string{
+		"Azure Diagnostics:",
+		fmt.Sprintf("  Correlation Request ID: %s", d.CorrelationRequestID),
+		fmt.Sprintf("  Request ID: %s", d.RequestID),
+		fmt.Sprintf("  Status Code: %d", d.StatusCode),
+		fmt.Sprintf("  Error Code: %s", d.Code),
+		fmt.Sprintf("  Error Message: %s", d.Message),
+	}
+
+	if len(d.Details) > 0 {
+		lines = append(lines, "  Details:")
+		for _, detail := range d.Details {
+			line := fmt.Sprintf("    - Code: %s, Message: %s", detail.Code, detail.Message)
+			if detail.Target != "" {
+				line += fmt.Sprintf(", Target: %s", detail.Target)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,173 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestErrorDiagnosticsFromError(t *testing.T) {
+	cases := []struct {
+		Name            string
+		Body            string
+		ExpectedCode    string
+		ExpectedMessage string
+		ExpectedDetails int
+	}{
+		{
+			Name:            "Nested Error",
+			Body:            `{"error": {"code": "InvalidParameter", "message": "The value is invalid.", "details": [{"code": "Inner", "message": "Detailed message", "target": "properties.sku"}]}}`,
+			ExpectedCode:    "InvalidParameter",
+			ExpectedMessage: "The value is invalid.",
+			ExpectedDetails: 1,
+		},
+		{
+			Name:            "Top-Level Error",
+			Body:            `{"code": "Conflict", "message": "Another operation is in progress."}`,
+			ExpectedCode:    "Conflict",
+			ExpectedMessage: "Another operation is in progress.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := testErrorFromResponse(http.StatusBadRequest, tc.Body)
+
+			actual := ErrorDiagnosticsFromError(err)
+			if actual == nil {
+				t.Fatalf("Expected the Diagnostics to be found but got nil")
+			}
+			if actual.StatusCode != http.StatusBadRequest {
+				t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusBadRequest, actual.StatusCode)
+			}
+			if actual.CorrelationRequestID != "11111111-1111-1111-1111-111111111111" {
+				t.Fatalf("Expected the Correlation Request ID to be found but got %q", actual.CorrelationRequestID)
+			}
+			if actual.RequestID != "33333333-3333-3333-3333-333333333333" {
+				t.Fatalf("Expected the Request ID to be found but got %q", actual.RequestID)
+			}
+			if actual.Code != tc.ExpectedCode {
+				t.Fatalf("Expected the Code to be %q but got %q", tc.ExpectedCode, actual.Code)
+			}
+			if actual.Message != tc.ExpectedMessage {
+				t.Fatalf("Expected the Message to be %q but got %q", tc.ExpectedMessage, actual.Message)
+			}
+			if len(actual.Details) != tc.ExpectedDetails {
+				t.Fatalf("Expected %d Details but got %d", tc.ExpectedDetails, len(actual.Details))
+			}
+		})
+	}
+}
+
+func TestErrorDiagnosticsFromError_detailedError(t *testing.T) {
+	resp := testErrorResponse(http.StatusBadGateway, "<html>Bad Gateway</html>")
+	err := autorest.NewErrorWithResponse("example.Client", "Get", resp, "Failure responding to request")
+
+	actual := ErrorDiagnosticsFromError(err)
+	if actual == nil {
+		t.Fatalf("Expected the Diagnostics to be found but got nil")
+	}
+	if actual.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusBadGateway, actual.StatusCode)
+	}
+	if actual.RequestID != "33333333-3333-3333-3333-333333333333" {
+		t.Fatalf("Expected the Request ID to be found but got %q", actual.RequestID)
+	}
+	if actual.Code != "" {
+		t.Fatalf("Expected no Code for a non-JSON body but got %q", actual.Code)
+	}
+}
+
+func TestErrorDiagnosticsFromError_output(t *testing.T) {
+	err := testErrorFromResponse(http.StatusConflict, `{"error": {"code": "AnotherOperationInProgress", "message": "Another operation is in progress.", "details": [{"code": "Inner", "message": "Detailed message", "target": "example"}]}}`)
+
+	diagnostics := ErrorDiagnosticsFromError(err)
+	if diagnostics == nil {
+		t.Fatalf("Expected the Diagnostics to be found but got nil")
+	}
+
+	output := diagnostics.String()
+	for _, expected := range []string{
+		"Correlation Request ID: 11111111-1111-1111-1111-111111111111",
+		"Request ID: 33333333-3333-3333-3333-333333333333",
+		"Status Code: 409",
+		"Error Code: AnotherOperationInProgress",
+		"- Code: Inner, Message: Detailed message, Target: example",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("Expected the Diagnostics to contain %q but got %q", expected, output)
+		}
+	}
+}
+
+func TestErrorDiagnosticsFromError_cause(t *testing.T) {
+	// errors which retain the error which caused them are followed to find the response
+	err := testCauseError{
+		cause: testErrorFromResponse(http.StatusConflict, `{"code": "Conflict", "message": "Conflict"}`),
+	}
+
+	actual := ErrorDiagnosticsFromError(err)
+	if actual == nil {
+		t.Fatalf("Expected the Diagnostics to be found but got nil")
+	}
+	if actual.StatusCode != http.StatusConflict {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusConflict, actual.StatusCode)
+	}
+	if actual.Code != "Conflict" {
+		t.Fatalf("Expected the Code to be %q but got %q", "Conflict", actual.Code)
+	}
+}
+
+func TestErrorDiagnosticsFromError_notAvailable(t *testing.T) {
+	// once flattened into a string the response is no longer available
+	flattened := fmt.Errorf("Error creating Example %q: %+v", "example", testErrorFromResponse(http.StatusConflict, `{"code": "Conflict", "message": "Conflict"}`))
+	if diagnostics := ErrorDiagnosticsFromError(flattened); diagnostics != nil {
+		t.Fatalf("Expected no Diagnostics for a flattened error but got: %+v", diagnostics)
+	}
+
+	if diagnostics := ErrorDiagnosticsFromError(fmt.Errorf("Error: something unrelated")); diagnostics != nil {
+		t.Fatalf("Expected no Diagnostics for an unrelated error but got: %+v", diagnostics)
+	}
+
+	if diagnostics := ErrorDiagnosticsFromError(nil); diagnostics != nil {
+		t.Fatalf("Expected no Diagnostics for a nil error but got: %+v", diagnostics)
+	}
+}
+
+type testCauseError struct {
+	cause error
+}
+
+func (e testCauseError) Error() string {
+	return fmt.Sprintf("Error creating Example: %+v", e.cause)
+}
+
+func (e testCauseError) Cause() error {
+	return e.cause
+}
+
+func testErrorResponse(statusCode int, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions", nil)
+	req.Header.Set(correlationRequestIDHeader, "11111111-1111-1111-1111-111111111111")
+
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+	resp.Header.Set("x-ms-request-id", "33333333-3333-3333-3333-333333333333")
+	return resp
+}
+
+// testErrorFromResponse returns the error in the same shape as the SDK clients do for a failed response
+func testErrorFromResponse(statusCode int, body string) error {
+	resp := testErrorResponse(statusCode, body)
+	err := autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK))
+	return autorest.NewErrorWithError(err, "example.Client", "CreateOrUpdate", resp, "Failure responding to request")
+}
@@ -0,0 +1,208 @@
+package azure
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MsSqlElasticPoolSku describes the constraints which apply to an Elastic Pool using a given SKU
+type MsSqlElasticPoolSku struct {
+	Name string
+	Tier string
+
+	// VCore specifies whether this SKU is vCore based - otherwise it's DTU based
+	VCore bool
+
+	// Capacities is the list of supported capacities (vCores) - where empty the capacity isn't validated
+	Capacities []int
+
+	// PerDatabaseCapacities is the list of supported per-database capacities (vCores), which includes fractional
+	// vCores - the minimum capacity can additionally be `0`. Where empty these are only validated against the pool
+	PerDatabaseCapacities []float64
+
+	// MaxPerDatabaseCapacity is the largest capacity (DTUs) a single database can use - where zero this is limited
+	// only by the capacity of the pool
+	MaxPerDatabaseCapacity float64
+
+	// SupportsMaxSize specifies whether the maximum data size of the Elastic Pool can be specified - for
+	// example Hyperscale storage grows automatically
+	SupportsMaxSize bool
+}
+
+var (
+	msSqlElasticPoolGeneralPurposeCapacities   = []int{1, 2, 4, 8, 16, 24}
+	msSqlElasticPoolBusinessCriticalCapacities = []int{2, 4, 8, 16, 24, 32, 40, 80}
+
+	msSqlElasticPoolGen4PerDatabaseCapacities = []float64{0.25, 0.5, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 16, 24}
+	msSqlElasticPoolGen5PerDatabaseCapacities = []float64{0.25, 0.5, 1, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 24, 32, 40, 80}
+)
+
+// msSqlElasticPoolSkus is the list of SKUs known to the provider - new SKUs can be supported by adding them here
+var msSqlElasticPoolSkus = []MsSqlElasticPoolSku{
+	{Name: "BasicPool", Tier: "Basic", MaxPerDatabaseCapacity: 5, SupportsMaxSize: true},
+	{Name: "StandardPool", Tier: "Standard", SupportsMaxSize: true},
+	{Name: "PremiumPool", Tier: "Premium", SupportsMaxSize: true},
+	{Name: "GP_Gen4", Tier: "GeneralPurpose", VCore: true, Capacities: msSqlElasticPoolGeneralPurposeCapacities, PerDatabaseCapacities: msSqlElasticPoolGen4PerDatabaseCapacities, SupportsMaxSize: true},
+	{Name: "GP_Gen5", Tier: "GeneralPurpose", VCore: true, Capacities: msSqlElasticPoolGeneralPurposeCapacities, PerDatabaseCapacities: msSqlElasticPoolGen5PerDatabaseCapacities, SupportsMaxSize: true},
+	{Name: "BC_Gen4", Tier: "BusinessCritical", VCore: true, Capacities: msSqlElasticPoolBusinessCriticalCapacities, PerDatabaseCapacities: msSqlElasticPoolGen4PerDatabaseCapacities, SupportsMaxSize: true},
+	{Name: "BC_Gen5", Tier: "BusinessCritical", VCore: true, Capacities: msSqlElasticPoolBusinessCriticalCapacities, PerDatabaseCapacities: msSqlElasticPoolGen5PerDatabaseCapacities, SupportsMaxSize: true},
+	{Name: "HS_Gen4", Tier: "Hyperscale", VCore: true, Capacities: []int{2, 4, 8, 16, 24}, PerDatabaseCapacities: msSqlElasticPoolGen4PerDatabaseCapacities},
+	{Name: "HS_Gen5", Tier: "Hyperscale", VCore: true, Capacities: []int{4, 6, 8, 10, 12, 14, 16, 18, 20, 24, 32, 40, 80}, PerDatabaseCapacities: msSqlElasticPoolGen5PerDatabaseCapacities},
+}
+
+// MsSqlElasticPoolSkuForName returns the constraints for the specified SKU name (which is case-insensitive), if it's known
+func MsSqlElasticPoolSkuForName(name string) (*MsSqlElasticPoolSku, bool) {
+	for _, sku := range msSqlElasticPoolSkus {
+		if strings.EqualFold(sku.Name, name) {
+			return &sku, true
+		}
+	}
+
+	return nil, false
+}
+
+// MsSqlElasticPoolSkuNames returns the names of the SKUs known to the provider
+func MsSqlElasticPoolSkuNames() []string {
+	names := make([]string, 0)
+	for _, sku := range msSqlElasticPoolSkus {
+		names = append(names, sku.Name)
+	}
+
+	return names
+}
+
+// MsSqlElasticPoolTiers returns the (unique) tiers of the SKUs known to the provider
+func MsSqlElasticPoolTiers() []string {
+	tiers := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, sku := range msSqlElasticPoolSkus {
+		if !seen[sku.Tier] {
+			seen[sku.Tier] = true
+			tiers = append(tiers, sku.Tier)
+		}
+	}
+
+	return tiers
+}
+
+// ValidateCapacity validates that the specified capacity is supported by this SKU
+func (sku MsSqlElasticPoolSku) ValidateCapacity(capacity int) error {
+	if len(sku.Capacities) == 0 {
+		return nil
+	}
+
+	capacities := make([]int, len(sku.Capacities))
+	copy(capacities, sku.Capacities)
+	sort.Ints(capacities)
+
+	for _, c := range capacities {
+		if c == capacity {
+			return nil
+		}
+	}
+
+	values := make([]string, 0)
+	for _, c := range capacities {
+		values = append(values, strconv.Itoa(c))
+	}
+
+	last := values[len(values)-1]
+	supported := last
+	if len(values) > 1 {
+		supported = fmt.Sprintf("%s, or %s", strings.Join(values[:len(values)-1], ", "), last)
+	}
+
+	return fmt.Errorf("%s pricing tier must have a capacity of %s vCores when using the %q SKU", sku.Tier, supported, sku.Name)
+}
+
+// ValidatePerDatabaseCapacity validates the per-database minimum and maximum capacities against this SKU and the
+// capacity of the pool - DTU based SKUs must use whole numbers, whereas vCore based SKUs support fractional vCores
+func (sku MsSqlElasticPoolSku) ValidatePerDatabaseCapacity(minCapacity float64, maxCapacity float64, capacity int) error {
+	if !sku.VCore {
+		if maxCapacity != math.Trunc(maxCapacity) {
+			return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs must have whole numbers as their maxCapacity")
+		}
+
+		if minCapacity != math.Trunc(minCapacity) {
+			return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs must have whole numbers as their minCapacity")
+		}
+
+		if minCapacity < 0.0 {
+			return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs per_database_settings min_capacity must be equal to or greater than zero")
+		}
+
+		return nil
+	}
+
+	if maxCapacity > float64(capacity) {
+		return fmt.Errorf("%s pricing tier perDatabaseSettings maxCapacity must not be higher than the SKUs capacity value", sku.Tier)
+	}
+
+	if minCapacity > maxCapacity {
+		return fmt.Errorf("perDatabaseSettings maxCapacity must be greater than or equal to the perDatabaseSettings minCapacity value")
+	}
+
+	if len(sku.PerDatabaseCapacities) == 0 {
+		return nil
+	}
+
+	// a minimum capacity of `0` allows databases to use no vCores when idle
+	if minCapacity != 0 && !sku.supportsPerDatabaseCapacity(minCapacity) {
+		return fmt.Errorf("%s pricing tier per_database_settings min_capacity must be 0, %s vCores when using the %q SKU but got %s", sku.Tier, sku.perDatabaseCapacitiesUpTo(capacity), sku.Name, strconv.FormatFloat(minCapacity, 'f', -1, 64))
+	}
+
+	if !sku.supportsPerDatabaseCapacity(maxCapacity) {
+		return fmt.Errorf("%s pricing tier per_database_settings max_capacity must be %s vCores when using the %q SKU but got %s", sku.Tier, sku.perDatabaseCapacitiesUpTo(capacity), sku.Name, strconv.FormatFloat(maxCapacity, 'f', -1, 64))
+	}
+
+	return nil
+}
+
+// DefaultPerDatabaseCapacity returns the per-database minimum and maximum capacities used when these aren't specified,
+// which allow each database to scale from `0` up to the largest capacity supported within a pool of this capacity
+func (sku MsSqlElasticPoolSku) DefaultPerDatabaseCapacity(capacity int) (float64, float64) {
+	maxCapacity := float64(capacity)
+	if sku.MaxPerDatabaseCapacity > 0 && maxCapacity > sku.MaxPerDatabaseCapacity {
+		maxCapacity = sku.MaxPerDatabaseCapacity
+	}
+
+	if len(sku.PerDatabaseCapacities) > 0 {
+		supported := 0.0
+		for _, v := range sku.PerDatabaseCapacities {
+			if v <= maxCapacity && v > supported {
+				supported = v
+			}
+		}
+		maxCapacity = supported
+	}
+
+	return 0, maxCapacity
+}
+
+func (sku MsSqlElasticPoolSku) supportsPerDatabaseCapacity(input float64) bool {
+	for _, v := range sku.PerDatabaseCapacities {
+		if v == input {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (sku MsSqlElasticPoolSku) perDatabaseCapacitiesUpTo(capacity int) string {
+	values := make([]string, 0)
+	for _, v := range sku.PerDatabaseCapacities {
+		if v <= float64(capacity) {
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+
+	if len(values) > 1 {
+		return fmt.Sprintf("%s, or %s", strings.Join(values[:len(values)-1], ", "), values[len(values)-1])
+	}
+
+	return strings.Join(values, "")
+}
@@ -0,0 +1,282 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestMsSqlElasticPoolSkuForName(t *testing.T) {
+	cases := []struct {
+		Name         string
+		ExpectedTier string
+		Exists       bool
+	}{
+		{
+			Name:         "BasicPool",
+			ExpectedTier: "Basic",
+			Exists:       true,
+		},
+		{
+			Name:         "gp_gen5",
+			ExpectedTier: "GeneralPurpose",
+			Exists:       true,
+		},
+		{
+			Name:         "HS_Gen4",
+			ExpectedTier: "Hyperscale",
+			Exists:       true,
+		},
+		{
+			Name:         "HS_Gen5",
+			ExpectedTier: "Hyperscale",
+			Exists:       true,
+		},
+		{
+			Name:   "XX_Gen9",
+			Exists: false,
+		},
+	}
+
+	for _, tc := range cases {
+		sku, exists := MsSqlElasticPoolSkuForName(tc.Name)
+		if exists != tc.Exists {
+			t.Fatalf("Expected %q to exist to be %t but got %t", tc.Name, tc.Exists, exists)
+		}
+
+		if exists && sku.Tier != tc.ExpectedTier {
+			t.Fatalf("Expected the tier of %q to be %q but got %q", tc.Name, tc.ExpectedTier, sku.Tier)
+		}
+	}
+}
+
+func TestMsSqlElasticPoolSkuValidateCapacity(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Capacity int
+		Valid    bool
+	}{
+		{
+			Name:     "BasicPool",
+			Capacity: 50,
+			Valid:    true,
+		},
+		{
+			Name:     "GP_Gen5",
+			Capacity: 4,
+			Valid:    true,
+		},
+		{
+			Name:     "GP_Gen5",
+			Capacity: 6,
+			Valid:    false,
+		},
+		{
+			Name:     "BC_Gen5",
+			Capacity: 1,
+			Valid:    false,
+		},
+		{
+			Name:     "BC_Gen5",
+			Capacity: 80,
+			Valid:    true,
+		},
+		{
+			Name:     "HS_Gen5",
+			Capacity: 2,
+			Valid:    false,
+		},
+		{
+			Name:     "HS_Gen5",
+			Capacity: 18,
+			Valid:    true,
+		},
+		{
+			Name:     "HS_Gen4",
+			Capacity: 24,
+			Valid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		sku, _ := MsSqlElasticPoolSkuForName(tc.Name)
+		err := sku.ValidateCapacity(tc.Capacity)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %q with capacity %d to be valid %t but got %t (%+v)", tc.Name, tc.Capacity, tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestMsSqlElasticPoolTiers(t *testing.T) {
+	expected := []string{"Basic", "Standard", "Premium", "GeneralPurpose", "BusinessCritical", "Hyperscale"}
+	actual := MsSqlElasticPoolTiers()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d tiers but got %d: %+v", len(expected), len(actual), actual)
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("Expected tier %d to be %q but got %q", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestMsSqlElasticPoolSkuValidatePerDatabaseCapacity(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Capacity    int
+		MinCapacity float64
+		MaxCapacity float64
+		Valid       bool
+	}{
+		{
+			Name:        "BasicPool",
+			Capacity:    50,
+			MinCapacity: 0,
+			MaxCapacity: 5,
+			Valid:       true,
+		},
+		{
+			Name:        "StandardPool",
+			Capacity:    50,
+			MinCapacity: 0.5,
+			MaxCapacity: 50,
+			Valid:       false,
+		},
+		{
+			Name:        "StandardPool",
+			Capacity:    50,
+			MinCapacity: 0,
+			MaxCapacity: 10.5,
+			Valid:       false,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MinCapacity: 0,
+			MaxCapacity: 4,
+			Valid:       true,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MinCapacity: 0.25,
+			MaxCapacity: 0.5,
+			Valid:       true,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MinCapacity: 0.75,
+			MaxCapacity: 4,
+			Valid:       false,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    8,
+			MinCapacity: 0,
+			MaxCapacity: 3,
+			Valid:       false,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MinCapacity: 0,
+			MaxCapacity: 0,
+			Valid:       false,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MinCapacity: 0,
+			MaxCapacity: 8,
+			Valid:       false,
+		},
+		{
+			Name:        "BC_Gen5",
+			Capacity:    8,
+			MinCapacity: 2,
+			MaxCapacity: 1,
+			Valid:       false,
+		},
+		{
+			Name:        "GP_Gen4",
+			Capacity:    8,
+			MinCapacity: 0.5,
+			MaxCapacity: 3,
+			Valid:       true,
+		},
+		{
+			Name:        "HS_Gen5",
+			Capacity:    4,
+			MinCapacity: 0.25,
+			MaxCapacity: 2,
+			Valid:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		sku, _ := MsSqlElasticPoolSkuForName(tc.Name)
+		err := sku.ValidatePerDatabaseCapacity(tc.MinCapacity, tc.MaxCapacity, tc.Capacity)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %q with per-database capacity %v-%v (pool capacity %d) to be valid %t but got %t (%+v)", tc.Name, tc.MinCapacity, tc.MaxCapacity, tc.Capacity, tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestMsSqlElasticPoolSkuValidatePerDatabaseCapacityMessage(t *testing.T) {
+	sku, _ := MsSqlElasticPoolSkuForName("GP_Gen5")
+	err := sku.ValidatePerDatabaseCapacity(0.75, 2, 2)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	expected := `GeneralPurpose pricing tier per_database_settings min_capacity must be 0, 0.25, 0.5, 1, or 2 vCores when using the "GP_Gen5" SKU but got 0.75`
+	if err.Error() != expected {
+		t.Fatalf("Expected %q but got %q", expected, err.Error())
+	}
+}
+
+func TestMsSqlElasticPoolSkuDefaultPerDatabaseCapacity(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Capacity    int
+		MaxCapacity float64
+	}{
+		{
+			Name:        "BasicPool",
+			Capacity:    100,
+			MaxCapacity: 5,
+		},
+		{
+			Name:        "StandardPool",
+			Capacity:    100,
+			MaxCapacity: 100,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    4,
+			MaxCapacity: 4,
+		},
+		{
+			Name:        "BC_Gen4",
+			Capacity:    40,
+			MaxCapacity: 24,
+		},
+		{
+			Name:        "HS_Gen5",
+			Capacity:    6,
+			MaxCapacity: 6,
+		},
+	}
+
+	for _, tc := range cases {
+		sku, _ := MsSqlElasticPoolSkuForName(tc.Name)
+		minCapacity, maxCapacity := sku.DefaultPerDatabaseCapacity(tc.Capacity)
+		if minCapacity != 0 || maxCapacity != tc.MaxCapacity {
+			t.Fatalf("Expected %q with capacity %d to default to 0-%v but got %v-%v", tc.Name, tc.Capacity, tc.MaxCapacity, minCapacity, maxCapacity)
+		}
+
+		if err := sku.ValidatePerDatabaseCapacity(minCapacity, maxCapacity, tc.Capacity); err != nil {
+			t.Fatalf("Expected the default per-database capacity for %q with capacity %d to be valid but got: %+v", tc.Name, tc.Capacity, err)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultLongRunningOperationTimeout is how long a long-running operation is polled for by default
+	DefaultLongRunningOperationTimeout = 60 * time.Minute
+
+	// DefaultInitialPollingInterval is the delay before the first poll of a long-running operation when a
+	// polling interval is configured, which is doubled on each subsequent poll up to the polling interval
+	DefaultInitialPollingInterval = 5 * time.Second
+
+	headerAzureAsyncOperation = "Azure-AsyncOperation"
+	headerLocation            = "Location"
+	headerRetryAfter          = "Retry-After"
+)
+
+// PollingOptions configures how long-running operations are polled for completion
+type PollingOptions struct {
+	// Interval is the maximum delay between polls - where this is zero the delay returned by the API is used
+	Interval time.Duration
+
+	// InitialInterval is the delay before the first poll, which is doubled on each poll up to the Interval
+	InitialInterval time.Duration
+
+	// Timeout is how long a long-running operation is polled for before giving up
+	Timeout time.Duration
+}
+
+// DefaultPollingOptions returns the PollingOptions used when none are configured
+func DefaultPollingOptions() *PollingOptions {
+	return &PollingOptions{
+		InitialInterval: DefaultInitialPollingInterval,
+		Timeout:         DefaultLongRunningOperationTimeout,
+	}
+}
+
+// WithPolling returns a SendDecorator which caps the delay between polls of a long-running operation (the Retry-After
+// header returned by the API, which the Azure SDK waits for before polling again) at the configured Interval.
+//
+// Polling starts at the InitialInterval and doubles with each poll, so that short operations are noticed as complete
+// quickly without polling long-running operations (such as resizing an Elastic Pool) more frequently than necessary.
+func WithPolling(options *PollingOptions) autorest.SendDecorator {
+	tracker := &pollingTracker{
+		operations: make(map[string]*pollingOperation),
+	}
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || resp == nil || options == nil || options.Interval <= 0 {
+				return resp, err
+			}
+
+			key, ok := tracker.key(r, resp)
+			if !ok {
+				return resp, err
+			}
+
+			delay := tracker.next(key, options, time.Now())
+			if current, ok := retryAfter(resp); !ok || delay < current {
+				if resp.Header == nil {
+					resp.Header = http.Header{}
+				}
+
+				resp.Header.Set(headerRetryAfter, strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			}
+
+			return resp, err
+		})
+	}
+}
+
+type pollingOperation struct {
+	polls    int
+	lastSeen time.Time
+
+	// asyncOperation is whether the operation is polled using the Azure-AsyncOperation header (where the status of the
+	// operation is returned in the body) rather than the Location header (where it's complete once a 202 isn't returned)
+	asyncOperation bool
+}
+
+// pollingTracker tracks the number of times each long-running operation has been polled, keyed by the URI
+// which the operation is polled using
+type pollingTracker struct {
+	lock       sync.Mutex
+	operations map[string]*pollingOperation
+}
+
+// key returns the URI used to poll the long-running operation which this response is a part of, if any - operations
+// are no longer tracked once polling them returns a terminal state
+func (t *pollingTracker) key(r *http.Request, resp *http.Response) (string, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// this is a poll of an operation we're already tracking
+	if r.Method == http.MethodGet && r.URL != nil {
+		if operation, ok := t.operations[r.URL.String()]; ok {
+			if pollingOperationIsComplete(resp, operation.asyncOperation) {
+				delete(t.operations, r.URL.String())
+				return "", false
+			}
+
+			return r.URL.String(), true
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted:
+	default:
+		return "", false
+	}
+
+	// otherwise the response starting a long-running operation returns the URI it should be polled using - however a
+	// 201 with a Location header is just the URI of the created resource, rather than an operation to be polled
+	if v := resp.Header.Get(headerAzureAsyncOperation); v != "" {
+		t.track(v, true)
+		return v, true
+	}
+
+	if v := resp.Header.Get(headerLocation); v != "" && resp.StatusCode == http.StatusAccepted {
+		t.track(v, false)
+		return v, true
+	}
+
+	return "", false
+}
+
+// track starts tracking the operation polled using the specified URI, if it's not already being tracked
+func (t *pollingTracker) track(key string, asyncOperation bool) {
+	if _, ok := t.operations[key]; ok {
+		return
+	}
+
+	t.operations[key] = &pollingOperation{
+		lastSeen:       time.Now(),
+		asyncOperation: asyncOperation,
+	}
+}
+
+// pollingOperationIsComplete returns whether the response to a poll of a long-running operation is a terminal state
+func pollingOperationIsComplete(resp *http.Response, asyncOperation bool) bool {
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return false
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		// the operation failed - or polling it did, which the Azure SDK surfaces as an error
+		return true
+	}
+
+	if !asyncOperation {
+		return true
+	}
+
+	// the status is returned in the body, which is read and then restored so it can be parsed by the Azure SDK
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var status struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return false
+	}
+
+	for _, v := range []string{"Succeeded", "Failed", "Canceled"} {
+		if strings.EqualFold(status.Status, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// next returns the delay before the next poll of the specified operation
+func (t *pollingTracker) next(key string, options *PollingOptions, now time.Time) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// operations which are no longer being polled have either completed or been given up on
+	for k, v := range t.operations {
+		if now.Sub(v.lastSeen) > options.Interval+time.Minute {
+			delete(t.operations, k)
+		}
+	}
+
+	operation, ok := t.operations[key]
+	if !ok {
+		operation = &pollingOperation{}
+		t.operations[key] = operation
+	}
+
+	initial := options.InitialInterval
+	if initial <= 0 || initial > options.Interval {
+		initial = options.Interval
+	}
+
+	delay := initial
+	for i := 0; i < operation.polls && delay < options.Interval; i++ {
+		delay *= 2
+	}
+	if delay > options.Interval {
+		delay = options.Interval
+	}
+
+	operation.polls++
+	operation.lastSeen = now
+
+	return delay
+}
@@ -0,0 +1,231 @@
+package azure
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testPollingURI = "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Sql/locations/westeurope/elasticPoolAzureAsyncOperation/abc?api-version=2017-10-01-preview"
+
+func testPollingSender(statusCode int, headers map[string]string) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Request:    r,
+		}
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	})
+}
+
+func TestWithPolling(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Options    *PollingOptions
+		Method     string
+		URI        string
+		StatusCode int
+		Headers    map[string]string
+		Expected   []string
+	}{
+		{
+			Name:       "No Interval configured",
+			Options:    DefaultPollingOptions(),
+			Method:     http.MethodPut,
+			URI:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			StatusCode: http.StatusCreated,
+			Headers: map[string]string{
+				"Azure-AsyncOperation": testPollingURI,
+				"Retry-After":          "30",
+			},
+			Expected: []string{"30", "30"},
+		},
+		{
+			Name: "Polling starts at the Initial Interval and doubles",
+			Options: &PollingOptions{
+				Interval:        12 * time.Second,
+				InitialInterval: 3 * time.Second,
+			},
+			Method:     http.MethodGet,
+			URI:        testPollingURI,
+			StatusCode: http.StatusOK,
+			Headers: map[string]string{
+				"Azure-AsyncOperation": testPollingURI,
+				"Retry-After":          "30",
+			},
+			Expected: []string{"3", "6", "12", "12"},
+		},
+		{
+			Name: "Retry-After is added where it's not returned",
+			Options: &PollingOptions{
+				Interval:        10 * time.Second,
+				InitialInterval: 5 * time.Second,
+			},
+			Method:     http.MethodPut,
+			URI:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			StatusCode: http.StatusAccepted,
+			Headers: map[string]string{
+				"Location": testPollingURI,
+			},
+			Expected: []string{"5", "10"},
+		},
+		{
+			Name: "Shorter Retry-After from the API is honoured",
+			Options: &PollingOptions{
+				Interval: 10 * time.Second,
+			},
+			Method:     http.MethodGet,
+			URI:        testPollingURI,
+			StatusCode: http.StatusOK,
+			Headers: map[string]string{
+				"Azure-AsyncOperation": testPollingURI,
+				"Retry-After":          "2",
+			},
+			Expected: []string{"2"},
+		},
+		{
+			Name: "Requests which aren't long-running operations are untouched",
+			Options: &PollingOptions{
+				Interval: 10 * time.Second,
+			},
+			Method:     http.MethodGet,
+			URI:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			StatusCode: http.StatusOK,
+			Expected:   []string{""},
+		},
+		{
+			Name: "Created resources with a Location aren't long-running operations",
+			Options: &PollingOptions{
+				Interval: 10 * time.Second,
+			},
+			Method:     http.MethodPut,
+			URI:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			StatusCode: http.StatusCreated,
+			Headers: map[string]string{
+				"Location": "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			},
+			Expected: []string{""},
+		},
+		{
+			Name: "Throttled requests are untouched",
+			Options: &PollingOptions{
+				Interval: 10 * time.Second,
+			},
+			Method:     http.MethodGet,
+			URI:        testPollingURI,
+			StatusCode: http.StatusTooManyRequests,
+			Headers: map[string]string{
+				"Azure-AsyncOperation": testPollingURI,
+				"Retry-After":          "60",
+			},
+			Expected: []string{"60"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			sender := autorest.DecorateSender(testPollingSender(tc.StatusCode, tc.Headers), WithPolling(tc.Options))
+
+			for i, expected := range tc.Expected {
+				req, _ := http.NewRequest(tc.Method, tc.URI, nil)
+				resp, err := sender.Do(req)
+				if err != nil {
+					t.Fatalf("Expected no error but got: %+v", err)
+				}
+
+				if actual := resp.Header.Get("Retry-After"); actual != expected {
+					t.Fatalf("Expected the Retry-After for request %d to be %q but got %q", i+1, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestWithPollingCompletedOperations(t *testing.T) {
+	type testPollingResponse struct {
+		Method     string
+		StatusCode int
+		Headers    map[string]string
+		Body       string
+		Expected   string
+	}
+
+	uri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	cases := []struct {
+		Name      string
+		Responses []testPollingResponse
+	}{
+		{
+			Name: "Azure-AsyncOperation",
+			Responses: []testPollingResponse{
+				{Method: http.MethodPut, StatusCode: http.StatusCreated, Headers: map[string]string{"Azure-AsyncOperation": testPollingURI}, Expected: "5"},
+				{Method: http.MethodGet, StatusCode: http.StatusOK, Body: `{"status": "InProgress"}`, Expected: "10"},
+				{Method: http.MethodGet, StatusCode: http.StatusOK, Body: `{"status": "Succeeded"}`, Expected: ""},
+				{Method: http.MethodGet, StatusCode: http.StatusOK, Body: `{"status": "InProgress"}`, Expected: ""},
+			},
+		},
+		{
+			Name: "Location",
+			Responses: []testPollingResponse{
+				{Method: http.MethodDelete, StatusCode: http.StatusAccepted, Headers: map[string]string{"Location": testPollingURI}, Expected: "5"},
+				{Method: http.MethodGet, StatusCode: http.StatusAccepted, Expected: "10"},
+				{Method: http.MethodGet, StatusCode: http.StatusNoContent, Expected: ""},
+				{Method: http.MethodGet, StatusCode: http.StatusAccepted, Expected: ""},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			calls := 0
+			sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				v := tc.Responses[calls]
+				calls++
+
+				resp := &http.Response{
+					StatusCode: v.StatusCode,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader(v.Body)),
+					Request:    r,
+				}
+				for k, v := range v.Headers {
+					resp.Header.Set(k, v)
+				}
+				return resp, nil
+			}), WithPolling(&PollingOptions{
+				Interval:        10 * time.Second,
+				InitialInterval: 5 * time.Second,
+			}))
+
+			for i, v := range tc.Responses {
+				target := testPollingURI
+				if v.Method != http.MethodGet {
+					target = uri
+				}
+
+				req, _ := http.NewRequest(v.Method, target, nil)
+				resp, err := sender.Do(req)
+				if err != nil {
+					t.Fatalf("Expected no error but got: %+v", err)
+				}
+
+				if actual := resp.Header.Get("Retry-After"); actual != v.Expected {
+					t.Fatalf("Expected the Retry-After for request %d to be %q but got %q", i+1, v.Expected, actual)
+				}
+
+				// the body must still be readable by the Azure SDK once the status has been checked
+				if body, _ := ioutil.ReadAll(resp.Body); string(body) != v.Body {
+					t.Fatalf("Expected the body for request %d to be %q but got %q", i+1, v.Body, string(body))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/satori/go.uuid"
+)
+
+const clientRequestIDHeader = "x-ms-client-request-id"
+
+// RequestAnnotations configures the metadata (such as a pipeline ID or commit SHA) which is stamped onto the
+// Client Request ID of each request, allowing the changes recorded in the Azure Activity Log to be correlated
+// back to the Terraform run which made them
+type RequestAnnotations struct {
+	ClientRequestIDPrefix string
+	Tags                  map[string]string
+}
+
+// Enabled returns whether any annotations have been configured
+func (a *RequestAnnotations) Enabled() bool {
+	return a != nil && (a.ClientRequestIDPrefix != "" || len(a.Tags) > 0)
+}
+
+// ClientRequestID returns a unique Client Request ID for a single request, in the format
+// `{prefix};{key}={value};...;{uuid}` where the tags are sorted by key
+func (a *RequestAnnotations) ClientRequestID() string {
+	segments := make([]string, 0)
+	if a.ClientRequestIDPrefix != "" {
+		segments = append(segments, a.ClientRequestIDPrefix)
+	}
+
+	keys := make([]string, 0)
+	for k := range a.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		segments = append(segments, fmt.Sprintf("%s=%s", k, a.Tags[k]))
+	}
+
+	segments = append(segments, uuid.NewV4().String())
+	return strings.Join(segments, ";")
+}
+
+// WithRequestAnnotations sets the annotated Client Request ID on each request when annotations are configured
+func WithRequestAnnotations(annotations *RequestAnnotations) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if annotations.Enabled() {
+				if r.Header == nil {
+					r.Header = http.Header{}
+				}
+
+				r.Header.Set(clientRequestIDHeader, annotations.ClientRequestID())
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+// ValidateRequestAnnotation validates that a Client Request ID prefix or tag can be embedded within a header
+// without conflicting with the separators used in the Client Request ID
+func ValidateRequestAnnotation(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	if strings.ContainsAny(v, ";= \t\r\n") {
+		errors = append(errors, fmt.Errorf("%q must not contain whitespace, `;` or `=` characters, got %q", k, v))
+	}
+
+	return warnings, errors
+}
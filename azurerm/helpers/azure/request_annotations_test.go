@@ -0,0 +1,100 @@
+package azure
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRequestAnnotations(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Annotations *RequestAnnotations
+		Expected    string
+	}{
+		{
+			Name:        "Not Configured",
+			Annotations: nil,
+			Expected:    "^$",
+		},
+		{
+			Name:        "Empty",
+			Annotations: &RequestAnnotations{},
+			Expected:    "^$",
+		},
+		{
+			Name: "Prefix Only",
+			Annotations: &RequestAnnotations{
+				ClientRequestIDPrefix: "deploy-pipeline",
+			},
+			Expected: "^deploy-pipeline;[0-9a-f-]{36}$",
+		},
+		{
+			Name: "Prefix and Tags",
+			Annotations: &RequestAnnotations{
+				ClientRequestIDPrefix: "deploy-pipeline",
+				Tags: map[string]string{
+					"pipeline_id": "1234",
+					"commit_sha":  "abc123",
+				},
+			},
+			Expected: "^deploy-pipeline;commit_sha=abc123;pipeline_id=1234;[0-9a-f-]{36}$",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var actual string
+			sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				actual = r.Header.Get(clientRequestIDHeader)
+				return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+			}), WithRequestAnnotations(tc.Annotations))
+
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+			if _, err := sender.Do(req); err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if !regexp.MustCompile(tc.Expected).MatchString(actual) {
+				t.Fatalf("Expected the Client Request ID to match %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateRequestAnnotation(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "deploy-pipeline",
+			Errors: 0,
+		},
+		{
+			Value:  "deploy pipeline",
+			Errors: 1,
+		},
+		{
+			Value:  "deploy;pipeline",
+			Errors: 1,
+		},
+		{
+			Value:  "deploy=pipeline",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidateRequestAnnotation(tc.Value, "client_request_id_prefix")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jmespath/go-jmespath"
+)
+
+// SchemaResponseExportValues is the (opt-in) list of JMESPath expressions which should be evaluated
+// against the raw ARM response for a resource, allowing properties which aren't (yet) exposed in the
+// schema to be consumed.
+func SchemaResponseExportValues() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateJMESPathExpression,
+		},
+	}
+}
+
+// SchemaResponseExport is the computed map containing the result of each expression in `response_export_values`
+func SchemaResponseExport() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+func validateJMESPathExpression(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if _, err := jmespath.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid JMESPath expression (%q): %+v", k, value, err))
+	}
+
+	return warnings, errors
+}
+
+// FlattenResponseExportValues evaluates each of the JMESPath expressions against the raw (unmarshalled) ARM response,
+// returning a map keyed by the expression. Strings, numbers and booleans are returned as-is - whilst objects and
+// arrays are returned JSON encoded. Expressions which don't match anything are omitted.
+func FlattenResponseExportValues(response interface{}, expressions []interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	for _, v := range expressions {
+		expression := v.(string)
+
+		result, err := jmespath.Search(expression, response)
+		if err != nil {
+			return nil, fmt.Errorf("Error evaluating JMESPath expression %q: %+v", expression, err)
+		}
+
+		switch value := result.(type) {
+		case nil:
+			continue
+		case string:
+			output[expression] = value
+		case bool:
+			output[expression] = strconv.FormatBool(value)
+		case float64:
+			output[expression] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("Error encoding the result of JMESPath expression %q: %+v", expression, err)
+			}
+			output[expression] = string(encoded)
+		}
+	}
+
+	return output, nil
+}
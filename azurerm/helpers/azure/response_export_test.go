@@ -0,0 +1,87 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateJMESPathExpression(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors bool
+	}{
+		{
+			Value:  "properties.status",
+			Errors: false,
+		},
+		{
+			Value:  "properties.tags[?key=='env'].value | [0]",
+			Errors: false,
+		},
+		{
+			Value:  "properties.[",
+			Errors: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateJMESPathExpression(tc.Value, "response_export_values")
+
+		hasErrors := len(errors) > 0
+		if hasErrors != tc.Errors {
+			t.Fatalf("Expected validateJMESPathExpression to return %t for %q but got %t", tc.Errors, tc.Value, hasErrors)
+		}
+	}
+}
+
+func TestFlattenResponseExportValues(t *testing.T) {
+	body := `{
+  "name": "example",
+  "properties": {
+    "status": "Online",
+    "maxSizeBytes": 5368709120,
+    "zoneRedundant": false,
+    "perDatabaseSettings": {
+      "minCapacity": 0.25
+    },
+    "replicas": ["a", "b"]
+  }
+}`
+
+	var response interface{}
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		t.Fatalf("Error unmarshalling the test response: %+v", err)
+	}
+
+	expressions := []interface{}{
+		"properties.status",
+		"properties.maxSizeBytes",
+		"properties.zoneRedundant",
+		"properties.perDatabaseSettings",
+		"properties.replicas",
+		"properties.doesNotExist",
+	}
+
+	actual, err := FlattenResponseExportValues(response, expressions)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]string{
+		"properties.status":              "Online",
+		"properties.maxSizeBytes":        "5368709120",
+		"properties.zoneRedundant":       "false",
+		"properties.perDatabaseSettings": `{"minCapacity":0.25}`,
+		"properties.replicas":            `["a","b"]`,
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d values but got %d: %+v", len(expected), len(actual), actual)
+	}
+
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("Expected %q to be %q but got %q", k, v, actual[k])
+		}
+	}
+}
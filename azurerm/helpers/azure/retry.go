@@ -0,0 +1,160 @@
+package azure
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a throttled/failed request is retried by default
+	DefaultMaxRetries = 3
+
+	// DefaultRetryBackoff is the initial delay between retries when no Retry-After header is returned, which is doubled
+	// on each subsequent retry
+	DefaultRetryBackoff = 5 * time.Second
+)
+
+// RetryOptions configures how requests which are throttled (429) or fail with a transient error (5xx) are retried
+type RetryOptions struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// WithRetries returns a SendDecorator which retries requests which were throttled or failed with a transient error,
+// honouring the Retry-After header when it's returned and otherwise backing off exponentially. POST requests aren't
+// idempotent, so these are only retried when they were rejected outright or the API asks for them to be retried.
+//
+// Once the retries have been exhausted (or a POST isn't retried) the response is returned alongside a permanent error -
+// the retry logic within the Azure SDK (`DoRetryWithRegistration`) wraps this Sender and would otherwise send the
+// request again, indefinitely for a 429. This can't be avoided by setting `RetryAttempts` on the client to 0, since the
+// SDK then doesn't send the request at all.
+func WithRetries(options *RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if err != nil || !autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+					return resp, err
+				}
+
+				if !responseIsRetriable(r, resp) {
+					return resp, newPermanentRetryError(resp, fmt.Sprintf("%s %s returned %d, which isn't retried since the request isn't idempotent", r.Method, r.URL, resp.StatusCode))
+				}
+
+				if attempt >= options.MaxRetries {
+					return resp, newPermanentRetryError(resp, fmt.Sprintf("%s %s returned %d after %d request(s)", r.Method, r.URL, resp.StatusCode, attempt+1))
+				}
+
+				delay, ok := retryAfter(resp)
+				if !ok {
+					delay = time.Duration(float64(options.Backoff) * math.Pow(2, float64(attempt)))
+				}
+
+				log.Printf("[DEBUG] %s %s returned %d - retrying in %s (retry %d of %d)", r.Method, r.URL, resp.StatusCode, delay, attempt+1, options.MaxRetries)
+
+				// drain the body so that the connection can be reused
+				io.Copy(ioutil.Discard, resp.Body)
+				resp.Body.Close()
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// responseIsRetriable returns whether a request which failed with a retriable status code should be retried - since a
+// POST triggers an action which may have started despite the error, these are only retried when the request timed out
+// or was throttled (and so wasn't processed), or when the API returns a Retry-After header
+func responseIsRetriable(r *http.Request, resp *http.Response) bool {
+	if r.Method != http.MethodPost {
+		return true
+	}
+
+	if resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	_, ok := retryAfter(resp)
+	return ok
+}
+
+// retryAfter parses the Retry-After header, which can either be a number of seconds or a HTTP Date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// permanentRetryError is returned once a request has been retried the maximum number of times, or when a request
+// isn't retried. It implements net.Error so that it can be reported as permanent, which the Azure SDK's retry logic
+// doesn't retry.
+type permanentRetryError struct {
+	message string
+}
+
+func (e permanentRetryError) Error() string {
+	return e.message
+}
+
+func (e permanentRetryError) Timeout() bool {
+	return false
+}
+
+func (e permanentRetryError) Temporary() bool {
+	return false
+}
+
+// newPermanentRetryError returns a permanentRetryError including the body of the response - which is re-buffered so
+// that the response can still be inspected by the caller
+func newPermanentRetryError(resp *http.Response, message string) error {
+	if resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if err == nil && len(body) > 0 {
+			if len(body) > 4096 {
+				body = body[:4096]
+			}
+			message = fmt.Sprintf("%s: %s", message, string(body))
+		}
+	}
+
+	return permanentRetryError{
+		message: message,
+	}
+}
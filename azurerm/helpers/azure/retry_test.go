@@ -0,0 +1,306 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+func testRetrySender(statusCodes []int, headers map[string]string, calls *int) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		statusCode := statusCodes[len(statusCodes)-1]
+		if *calls < len(statusCodes) {
+			statusCode = statusCodes[*calls]
+		}
+		*calls++
+
+		resp := &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"code":"TooManyRequests"}}`)),
+			Request:    r,
+		}
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	})
+}
+
+func TestWithRetries(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Method        string
+		StatusCodes   []int
+		Headers       map[string]string
+		MaxRetries    int
+		ExpectedCalls int
+		ExpectedCode  int
+		Errors        bool
+	}{
+		{
+			Name:          "Success",
+			StatusCodes:   []int{http.StatusOK},
+			MaxRetries:    3,
+			ExpectedCalls: 1,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "Not Found isn't retried",
+			StatusCodes:   []int{http.StatusNotFound},
+			MaxRetries:    3,
+			ExpectedCalls: 1,
+			ExpectedCode:  http.StatusNotFound,
+		},
+		{
+			Name:          "Throttled then Success",
+			StatusCodes:   []int{http.StatusTooManyRequests, http.StatusOK},
+			Headers:       map[string]string{"Retry-After": "0"},
+			MaxRetries:    3,
+			ExpectedCalls: 2,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "Transient Errors then Success",
+			StatusCodes:   []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			MaxRetries:    3,
+			ExpectedCalls: 3,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "Retries Exhausted",
+			StatusCodes:   []int{http.StatusTooManyRequests},
+			MaxRetries:    2,
+			ExpectedCalls: 3,
+			ExpectedCode:  http.StatusTooManyRequests,
+			Errors:        true,
+		},
+		{
+			Name:          "POST isn't retried for a Transient Error",
+			Method:        http.MethodPost,
+			StatusCodes:   []int{http.StatusInternalServerError, http.StatusOK},
+			MaxRetries:    3,
+			ExpectedCalls: 1,
+			ExpectedCode:  http.StatusInternalServerError,
+			Errors:        true,
+		},
+		{
+			Name:          "POST is retried for a Transient Error with Retry-After",
+			Method:        http.MethodPost,
+			StatusCodes:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			Headers:       map[string]string{"Retry-After": "0"},
+			MaxRetries:    3,
+			ExpectedCalls: 2,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "POST is retried when Throttled",
+			Method:        http.MethodPost,
+			StatusCodes:   []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:    3,
+			ExpectedCalls: 2,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "POST is retried when Timed Out",
+			Method:        http.MethodPost,
+			StatusCodes:   []int{http.StatusRequestTimeout, http.StatusOK},
+			MaxRetries:    3,
+			ExpectedCalls: 2,
+			ExpectedCode:  http.StatusOK,
+		},
+		{
+			Name:          "Retries Disabled",
+			StatusCodes:   []int{http.StatusInternalServerError},
+			MaxRetries:    0,
+			ExpectedCalls: 1,
+			ExpectedCode:  http.StatusInternalServerError,
+			Errors:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			calls := 0
+			options := &RetryOptions{
+				MaxRetries: tc.MaxRetries,
+				Backoff:    0,
+			}
+			sender := autorest.DecorateSender(testRetrySender(tc.StatusCodes, tc.Headers, &calls), WithRetries(options))
+
+			method := tc.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req, _ := http.NewRequest(method, "https://management.azure.com/subscriptions", nil)
+			resp, err := sender.Do(req)
+
+			if calls != tc.ExpectedCalls {
+				t.Fatalf("Expected %d calls but got %d", tc.ExpectedCalls, calls)
+			}
+
+			if tc.Errors && err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if !tc.Errors && err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			// the response is returned alongside the error, so that the Status Code and body can still be inspected
+			if resp == nil {
+				t.Fatalf("Expected a response but didn't get one")
+			}
+
+			if tc.Errors {
+				if body, _ := ioutil.ReadAll(resp.Body); len(body) == 0 {
+					t.Fatalf("Expected the body of the response to be readable")
+				}
+			}
+
+			if resp.StatusCode != tc.ExpectedCode {
+				t.Fatalf("Expected Status Code %d but got %d", tc.ExpectedCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// the generated SDK clients send requests via DoRetryWithRegistration, which retries on top of the Sender - so the
+// number of requests sent should still be bounded by the configured number of retries
+func TestWithRetriesWithinSDKRetries(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCode    int
+		MaxRetries    int
+		ExpectedCalls int
+	}{
+		{
+			Name:          "Throttled with Retries Disabled",
+			StatusCode:    http.StatusTooManyRequests,
+			MaxRetries:    0,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Throttled",
+			StatusCode:    http.StatusTooManyRequests,
+			MaxRetries:    DefaultMaxRetries,
+			ExpectedCalls: DefaultMaxRetries + 1,
+		},
+		{
+			Name:          "Transient Error",
+			StatusCode:    http.StatusServiceUnavailable,
+			MaxRetries:    DefaultMaxRetries,
+			ExpectedCalls: DefaultMaxRetries + 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			calls := 0
+			options := &RetryOptions{
+				MaxRetries: tc.MaxRetries,
+				Backoff:    0,
+			}
+			sender := autorest.DecorateSender(testRetrySender([]int{tc.StatusCode}, nil, &calls), WithRetries(options))
+
+			client := autorest.NewClientWithUserAgent("")
+			client.RetryDuration = 0
+			client.SkipResourceProviderRegistration = true
+
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+			_, err := autorest.SendWithSender(sender, req, az.DoRetryWithRegistration(client))
+
+			if calls != tc.ExpectedCalls {
+				t.Fatalf("Expected %d calls but got %d", tc.ExpectedCalls, calls)
+			}
+
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			expected := fmt.Sprintf("returned %d after %d request(s)", tc.StatusCode, tc.ExpectedCalls)
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("Expected the error to contain %q but got %q", expected, err.Error())
+			}
+		})
+	}
+}
+
+// a POST which fails with a transient error isn't retried, including by the retry logic within the Azure SDK
+func TestWithRetriesWithinSDKRetriesForPost(t *testing.T) {
+	calls := 0
+	options := &RetryOptions{
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    0,
+	}
+	sender := autorest.DecorateSender(testRetrySender([]int{http.StatusInternalServerError}, nil, &calls), WithRetries(options))
+
+	client := autorest.NewClientWithUserAgent("")
+	client.RetryDuration = 0
+	client.SkipResourceProviderRegistration = true
+
+	req, _ := http.NewRequest(http.MethodPost, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example/databases/example/failover", nil)
+	_, err := autorest.SendWithSender(sender, req, az.DoRetryWithRegistration(client))
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call but got %d", calls)
+	}
+
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if !strings.Contains(err.Error(), "returned 500") {
+		t.Fatalf("Expected the error to contain the Status Code but got %q", err.Error())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected time.Duration
+		Parsed   bool
+	}{
+		{
+			Value:  "",
+			Parsed: false,
+		},
+		{
+			Value:    "10",
+			Expected: 10 * time.Second,
+			Parsed:   true,
+		},
+		{
+			Value:    "Wed, 21 Oct 2015 07:28:00 GMT",
+			Expected: 0,
+			Parsed:   true,
+		},
+		{
+			Value:  "soon",
+			Parsed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		resp.Header.Set("Retry-After", tc.Value)
+
+		actual, parsed := retryAfter(resp)
+		if parsed != tc.Parsed {
+			t.Fatalf("Expected %q to be parsed %t but got %t", tc.Value, tc.Parsed, parsed)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q to return %s but got %s", tc.Value, tc.Expected, actual)
+		}
+	}
+}
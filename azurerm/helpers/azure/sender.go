@@ -1,19 +1,109 @@
 package azure
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"sync"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/satori/go.uuid"
 )
 
-func BuildSender() autorest.Sender {
+const (
+	correlationRequestIDHeader = "x-ms-correlation-request-id"
+
+	// DefaultMaxIdleConnectionsPerHost is the number of idle connections kept open to each host by default, which is
+	// higher than Go's default (2) since Terraform makes many requests to each service concurrently
+	DefaultMaxIdleConnectionsPerHost = 10
+
+	idleConnectionTimeout = 90 * time.Second
+)
+
+var (
+	correlationRequestID     string
+	correlationRequestIDOnce sync.Once
+
+	sharedTransports     = make(map[int]*http.Transport)
+	sharedTransportsLock sync.Mutex
+)
+
+// ConnectionOptions configures the connections which requests are sent over
+type ConnectionOptions struct {
+	// MaxIdleConnectionsPerHost is the number of idle connections kept open (and reused) for each host. This doesn't
+	// limit concurrency - requests beyond this which are made concurrently to the same host open (and then close) a
+	// new connection
+	MaxIdleConnectionsPerHost int
+}
+
+// DefaultConnectionOptions returns the ConnectionOptions used when none are configured
+func DefaultConnectionOptions() *ConnectionOptions {
+	return &ConnectionOptions{
+		MaxIdleConnectionsPerHost: DefaultMaxIdleConnectionsPerHost,
+	}
+}
+
+// CorrelationRequestID returns the Correlation Request ID which is sent with every request made by this instance
+// of the Provider - Azure Resource Manager uses this to correlate the requests made during a Terraform run,
+// which allows them to be located in the Activity Log or by Azure Support
+func CorrelationRequestID() string {
+	correlationRequestIDOnce.Do(func() {
+		correlationRequestID = uuid.NewV4().String()
+	})
+
+	return correlationRequestID
+}
+
+// BuildSender returns a Sender which logs each request and sends it over the connections shared by all Senders built
+// with the same ConnectionOptions - where nil the defaults are used
+func BuildSender(options *ConnectionOptions) autorest.Sender {
+	if options == nil {
+		options = DefaultConnectionOptions()
+	}
+
 	return autorest.DecorateSender(&http.Client{
-		Transport: &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-		},
-	}, withRequestLogging())
+		Transport: sharedTransport(options.MaxIdleConnectionsPerHost),
+	}, withRequestLogging(), withCorrelationRequestID(CorrelationRequestID()))
+}
+
+// sharedTransport returns the Transport used by every Sender with the same number of idle connections per host, so
+// that connections (and their TLS sessions) are reused across the clients for each service, rather than each of the
+// (several hundred) clients maintaining its own connection pool
+func sharedTransport(maxIdleConnectionsPerHost int) *http.Transport {
+	sharedTransportsLock.Lock()
+	defer sharedTransportsLock.Unlock()
+
+	if transport, ok := sharedTransports[maxIdleConnectionsPerHost]; ok {
+		return transport
+	}
+
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConnsPerHost: maxIdleConnectionsPerHost,
+		IdleConnTimeout:     idleConnectionTimeout,
+	}
+	sharedTransports[maxIdleConnectionsPerHost] = transport
+
+	return transport
+}
+
+// withCorrelationRequestID sets the Correlation Request ID on each request, unless one's already been specified
+func withCorrelationRequestID(id string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Header == nil {
+				r.Header = http.Header{}
+			}
+
+			if r.Header.Get(correlationRequestIDHeader) == "" {
+				r.Header.Set(correlationRequestIDHeader, id)
+			}
+
+			return s.Do(r)
+		})
+	}
 }
 
 func withRequestLogging() autorest.SendDecorator {
@@ -39,7 +129,10 @@ func withRequestLogging() autorest.SendDecorator {
 				r.Header.Add(authHeaderName, auth)
 			}
 
+			start := time.Now()
 			resp, err := s.Do(r)
+			duration := time.Since(start)
+
 			if resp != nil {
 				// dump response to wire format
 				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
@@ -48,6 +141,8 @@ func withRequestLogging() autorest.SendDecorator {
 					// fallback to basic message
 					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
 				}
+
+				log.Printf("[DEBUG] AzureRM Request Summary: %s", requestSummary(r, resp, duration))
 			} else {
 				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
 			}
@@ -55,3 +150,14 @@ func withRequestLogging() autorest.SendDecorator {
 		})
 	}
 }
+
+// requestSummary returns a single line summary of the request, which is easier to search through than the wire dumps
+func requestSummary(r *http.Request, resp *http.Response, duration time.Duration) string {
+	// Azure Resource Manager echoes back the Correlation Request ID, however not every API does
+	correlationId := resp.Header.Get(correlationRequestIDHeader)
+	if correlationId == "" {
+		correlationId = r.Header.Get(correlationRequestIDHeader)
+	}
+
+	return fmt.Sprintf("method=%s url=%s status=%d duration=%s correlation_request_id=%s request_id=%s", r.Method, r.URL, resp.StatusCode, duration.Round(time.Millisecond), correlationId, resp.Header.Get("x-ms-request-id"))
+}
@@ -0,0 +1,107 @@
+package azure
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithCorrelationRequestID(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Existing string
+		Expected string
+	}{
+		{
+			Name:     "Not Set",
+			Existing: "",
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Name:     "Already Set",
+			Existing: "22222222-2222-2222-2222-222222222222",
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var actual string
+			sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				actual = r.Header.Get(correlationRequestIDHeader)
+				return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+			}), withCorrelationRequestID("11111111-1111-1111-1111-111111111111"))
+
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+			if tc.Existing != "" {
+				req.Header.Set(correlationRequestIDHeader, tc.Existing)
+			}
+
+			if _, err := sender.Do(req); err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected the Correlation Request ID to be %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCorrelationRequestIDIsStable(t *testing.T) {
+	first := CorrelationRequestID()
+	if first == "" {
+		t.Fatalf("Expected a Correlation Request ID to be generated")
+	}
+
+	if second := CorrelationRequestID(); first != second {
+		t.Fatalf("Expected the Correlation Request ID to be stable but got %q and %q", first, second)
+	}
+}
+
+func TestRequestSummary(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	req.Header.Set(correlationRequestIDHeader, "11111111-1111-1111-1111-111111111111")
+
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{},
+	}
+	resp.Header.Set("x-ms-request-id", "33333333-3333-3333-3333-333333333333")
+
+	summary := requestSummary(req, resp, 1500*time.Millisecond)
+	for _, expected := range []string{
+		"method=GET",
+		"url=https://management.azure.com/subscriptions",
+		"status=404",
+		"duration=1.5s",
+		"correlation_request_id=11111111-1111-1111-1111-111111111111",
+		"request_id=33333333-3333-3333-3333-333333333333",
+	} {
+		if !strings.Contains(summary, expected) {
+			t.Fatalf("Expected the summary to contain %q but got %q", expected, summary)
+		}
+	}
+}
+
+func TestBuildSenderSharesTransport(t *testing.T) {
+	first := sharedTransport(DefaultMaxIdleConnectionsPerHost)
+	if first.MaxIdleConnsPerHost != DefaultMaxIdleConnectionsPerHost {
+		t.Fatalf("Expected MaxIdleConnsPerHost to be %d but got %d", DefaultMaxIdleConnectionsPerHost, first.MaxIdleConnsPerHost)
+	}
+
+	if second := sharedTransport(DefaultMaxIdleConnectionsPerHost); first != second {
+		t.Fatalf("Expected the Transport to be shared between Senders with the same Connection Options")
+	}
+
+	other := sharedTransport(DefaultMaxIdleConnectionsPerHost + 10)
+	if other == first {
+		t.Fatalf("Expected a separate Transport for different Connection Options")
+	}
+	if other.MaxIdleConnsPerHost != DefaultMaxIdleConnectionsPerHost+10 {
+		t.Fatalf("Expected MaxIdleConnsPerHost to be %d but got %d", DefaultMaxIdleConnectionsPerHost+10, other.MaxIdleConnsPerHost)
+	}
+}
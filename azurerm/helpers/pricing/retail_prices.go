@@ -0,0 +1,124 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// DefaultBaseURI is the endpoint of the (unauthenticated) Azure Retail Prices API
+const DefaultBaseURI = "https://prices.azure.com"
+
+// HoursPerMonth is the number of hours Azure uses when converting hourly prices into monthly prices
+const HoursPerMonth = 730
+
+// RetailPrice is a single meter returned from the Azure Retail Prices API
+type RetailPrice struct {
+	CurrencyCode  string  `json:"currencyCode"`
+	RetailPrice   float64 `json:"retailPrice"`
+	UnitPrice     float64 `json:"unitPrice"`
+	ArmRegionName string  `json:"armRegionName"`
+	ProductName   string  `json:"productName"`
+	SkuName       string  `json:"skuName"`
+	ServiceName   string  `json:"serviceName"`
+	MeterName     string  `json:"meterName"`
+	UnitOfMeasure string  `json:"unitOfMeasure"`
+	Type          string  `json:"type"`
+}
+
+type retailPricesPage struct {
+	Items        []RetailPrice `json:"Items"`
+	NextPageLink *string       `json:"NextPageLink"`
+}
+
+// RetailPricesClient retrieves prices from the Azure Retail Prices API
+type RetailPricesClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewRetailPricesClient returns a RetailPricesClient pointed at the public Azure Retail Prices API
+func NewRetailPricesClient() RetailPricesClient {
+	return RetailPricesClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: DefaultBaseURI,
+	}
+}
+
+// List returns all of the prices matching the specified OData filter, following any pagination
+func (client RetailPricesClient) List(ctx context.Context, filter string) ([]RetailPrice, error) {
+	prices := make([]RetailPrice, 0)
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath("/api/retail/prices"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"$filter": autorest.Encode("query", filter),
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+
+	for err == nil && req != nil {
+		var resp *http.Response
+		resp, err = autorest.SendWithSender(client, req)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Retail Prices (filter %q): %+v", filter, err)
+		}
+
+		var page retailPricesPage
+		err = autorest.Respond(resp,
+			client.ByInspecting(),
+			autorest.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing Retail Prices (filter %q): %+v", filter, err)
+		}
+
+		prices = append(prices, page.Items...)
+
+		req = nil
+		if page.NextPageLink != nil && *page.NextPageLink != "" {
+			req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextPageLink))
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing Retail Prices request (filter %q): %+v", filter, err)
+	}
+
+	return prices, nil
+}
+
+// MonthlyPrice converts the retail price of a meter into a monthly price for the specified quantity,
+// based on the meter's unit of measure.
+func MonthlyPrice(price RetailPrice, quantity float64) (float64, error) {
+	unit := strings.ToLower(strings.TrimSpace(price.UnitOfMeasure))
+
+	switch unit {
+	case "1 hour", "1/hour", "1 hours":
+		return price.RetailPrice * quantity * HoursPerMonth, nil
+	case "1 day", "1/day":
+		return price.RetailPrice * quantity * HoursPerMonth / 24, nil
+	case "1 month", "1/month":
+		return price.RetailPrice * quantity, nil
+	}
+
+	return 0, fmt.Errorf("Unsupported Unit of Measure %q for meter %q", price.UnitOfMeasure, price.MeterName)
+}
+
+// FilterByConsumption returns only the pay-as-you-go prices, excluding reservations and dev/test prices
+func FilterByConsumption(input []RetailPrice) []RetailPrice {
+	output := make([]RetailPrice, 0)
+	for _, v := range input {
+		if strings.EqualFold(v.Type, "Consumption") {
+			output = append(output, v)
+		}
+	}
+	return output
+}
@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMonthlyPrice(t *testing.T) {
+	cases := []struct {
+		Price    RetailPrice
+		Quantity float64
+		Expected float64
+		Errors   bool
+	}{
+		{
+			Price:    RetailPrice{RetailPrice: 0.5, UnitOfMeasure: "1 Hour"},
+			Quantity: 4,
+			Expected: 1460,
+		},
+		{
+			Price:    RetailPrice{RetailPrice: 2.4, UnitOfMeasure: "1/Day"},
+			Quantity: 1,
+			Expected: 73,
+		},
+		{
+			Price:    RetailPrice{RetailPrice: 100, UnitOfMeasure: "1/Month"},
+			Quantity: 2,
+			Expected: 200,
+		},
+		{
+			Price:  RetailPrice{RetailPrice: 1, UnitOfMeasure: "1 GB"},
+			Errors: true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := MonthlyPrice(tc.Price, tc.Quantity)
+		if tc.Errors {
+			if err == nil {
+				t.Fatalf("Expected an error for Unit of Measure %q but didn't get one", tc.Price.UnitOfMeasure)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for Unit of Measure %q but got: %+v", tc.Price.UnitOfMeasure, err)
+		}
+
+		if math.Abs(actual-tc.Expected) > 0.0001 {
+			t.Fatalf("Expected %f for Unit of Measure %q but got %f", tc.Expected, tc.Price.UnitOfMeasure, actual)
+		}
+	}
+}
+
+func TestFilterByConsumption(t *testing.T) {
+	input := []RetailPrice{
+		{Type: "Consumption", MeterName: "vCore"},
+		{Type: "Reservation", MeterName: "vCore"},
+		{Type: "DevTestConsumption", MeterName: "vCore"},
+	}
+
+	actual := FilterByConsumption(input)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 price but got %d", len(actual))
+	}
+
+	if actual[0].Type != "Consumption" {
+		t.Fatalf("Expected a `Consumption` price but got %q", actual[0].Type)
+	}
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func GrafanaName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 2 and 23 alphanumeric characters or hyphens, which must start with a letter and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-zA-Z][0-9a-zA-Z-]{0,21}[0-9a-zA-Z]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 23 characters, may only contain alphanumeric characters and dashes, must start with a letter and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
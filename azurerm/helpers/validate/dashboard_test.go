@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateGrafanaName(t *testing.T) {
+	validNames := []string{
+		"ab",
+		"valid-name",
+		"Valid01",
+		"a" + strings.Repeat("b", 22),
+	}
+	for _, v := range validNames {
+		_, errors := GrafanaName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Grafana Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"a",
+		"1starts-with-number",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"invalid_name",
+		"a" + strings.Repeat("b", 23),
+	}
+	for _, v := range invalidNames {
+		_, errors := GrafanaName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Grafana Name", v)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func VirtualDesktopName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// between 3 and 64 alphanumeric characters, hyphens, periods or underscores, which must start with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{2,63}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 64 characters, may only contain alphanumeric characters, hyphens, periods and underscores and must start with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+// VirtualDesktopScheduleTime validates a time of day in the 24-hour format `HH:MM`
+func VirtualDesktopScheduleTime(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a time in the 24-hour format `HH:MM`, got %q", k, value))
+	}
+
+	return warnings, errors
+}
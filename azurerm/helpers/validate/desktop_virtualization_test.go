@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateVirtualDesktopName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name",
+		"valid_name.01",
+		"Valid01",
+		"a" + strings.Repeat("b", 63),
+	}
+	for _, v := range validNames {
+		_, errors := VirtualDesktopName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Virtual Desktop Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"-starts-with-dash",
+		"_starts-with-underscore",
+		"invalid name",
+		"invalid/name",
+		"a" + strings.Repeat("b", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := VirtualDesktopName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Virtual Desktop Name", v)
+		}
+	}
+}
+
+func TestValidateVirtualDesktopScheduleTime(t *testing.T) {
+	validTimes := []string{
+		"00:00",
+		"09:30",
+		"23:59",
+	}
+	for _, v := range validTimes {
+		_, errors := VirtualDesktopScheduleTime(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Schedule Time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"",
+		"9:30",
+		"24:00",
+		"12:60",
+		"12:00:00",
+		"noon",
+	}
+	for _, v := range invalidTimes {
+		_, errors := VirtualDesktopScheduleTime(v, "time")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Schedule Time", v)
+		}
+	}
+}
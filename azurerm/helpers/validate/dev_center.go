@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DevCenterName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{2,25}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 26 characters, may only contain alphanumeric characters and dashes and must start with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+// DevCenterProjectName validates the name of a Dev Center Project or Catalog, which share the same rules
+func DevCenterProjectName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{2,62}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, may only contain alphanumeric characters, dashes, underscores and periods and must start with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+func DevCenterEnvironmentTypeName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 63 characters, may only contain alphanumeric characters, dashes, underscores and periods and must start with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,92 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDevCenterName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name",
+		"1Valid",
+		strings.Repeat("a", 26),
+	}
+	for _, v := range validNames {
+		_, errors := DevCenterName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Dev Center Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"-invalid",
+		"invalid_name",
+		strings.Repeat("a", 27),
+	}
+	for _, v := range invalidNames {
+		_, errors := DevCenterName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Dev Center Name", v)
+		}
+	}
+}
+
+func TestValidateDevCenterProjectName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name",
+		"valid_name.01",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validNames {
+		_, errors := DevCenterProjectName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Dev Center Project Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"_invalid",
+		"invalid name",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := DevCenterProjectName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Dev Center Project Name", v)
+		}
+	}
+}
+
+func TestValidateDevCenterEnvironmentTypeName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"dev",
+		"valid-name_01.test",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validNames {
+		_, errors := DevCenterEnvironmentTypeName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Dev Center Environment Type Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		".invalid",
+		"invalid/name",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := DevCenterEnvironmentTypeName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Dev Center Environment Type Name", v)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DnsSecurityPolicyName validates the name of a DNS Security Policy, and of the Domain Lists, Rules and
+// Virtual Network Links associated with it - which share the same naming rules
+func DnsSecurityPolicyName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 1 and 80 alphanumerics, underscores or hyphens, which must start and end with an alphanumeric
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]([0-9a-zA-Z_-]{0,78}[0-9a-zA-Z])?$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, may only contain alphanumeric characters, underscores and dashes and must start and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+// DnsSecurityPolicyDomain validates a Domain within a DNS Security Policy Domain List, which must be a
+// fully qualified domain name ending with a period (e.g. `contoso.com.`)
+func DnsSecurityPolicyDomain(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 254 {
+		errors = append(errors, fmt.Errorf("%q must be at most 254 characters, got %q", k, value))
+		return warnings, errors
+	}
+
+	if matched := regexp.MustCompile(`^([0-9a-zA-Z_]([0-9a-zA-Z_-]{0,61}[0-9a-zA-Z_])?\.)+$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name ending with a period (e.g. `contoso.com.`), got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDnsSecurityPolicyName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"valid_name01",
+		strings.Repeat("a", 80),
+	}
+	for _, v := range validNames {
+		_, errors := DnsSecurityPolicyName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DNS Security Policy Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"ends_with_underscore_",
+		"invalid.name",
+		strings.Repeat("a", 81),
+	}
+	for _, v := range invalidNames {
+		_, errors := DnsSecurityPolicyName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DNS Security Policy Name", v)
+		}
+	}
+}
+
+func TestValidateDnsSecurityPolicyDomain(t *testing.T) {
+	validDomains := []string{
+		"com.",
+		"contoso.com.",
+		"sub-domain.contoso.com.",
+		"_service.contoso.com.",
+	}
+	for _, v := range validDomains {
+		_, errors := DnsSecurityPolicyDomain(v, "domains")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DNS Security Policy Domain: %q", v, errors)
+		}
+	}
+
+	invalidDomains := []string{
+		"",
+		".",
+		"contoso.com",
+		"-contoso.com.",
+		"contoso..com.",
+		"*.contoso.com.",
+		strings.Repeat("a.", 128),
+	}
+	for _, v := range invalidDomains {
+		_, errors := DnsSecurityPolicyDomain(v, "domains")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DNS Security Policy Domain", v)
+		}
+	}
+}
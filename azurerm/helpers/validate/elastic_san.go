@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ElasticSanName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]{1,22})[a-z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters, may only contain lowercase letters, numbers, dashes and underscores and must start and end with a lowercase letter or number", k))
+	}
+
+	return warnings, errors
+}
+
+// ElasticSanVolumeName validates the name of an Elastic SAN Volume Group or Volume, which share the same rules
+func ElasticSanVolumeName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]{1,61})[a-z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, may only contain lowercase letters, numbers, dashes and underscores and must start and end with a lowercase letter or number", k))
+	}
+
+	return warnings, errors
+}
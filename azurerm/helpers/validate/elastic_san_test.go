@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateElasticSanName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name_01",
+		"1san",
+		strings.Repeat("a", 24),
+	}
+	for _, v := range validNames {
+		_, errors := ElasticSanName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Elastic SAN Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"Invalid",
+		"-invalid",
+		"invalid_",
+		strings.Repeat("a", 25),
+	}
+	for _, v := range invalidNames {
+		_, errors := ElasticSanName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Elastic SAN Name", v)
+		}
+	}
+}
+
+func TestValidateElasticSanVolumeName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name_01",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validNames {
+		_, errors := ElasticSanVolumeName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Elastic SAN Volume Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"Invalid",
+		"_invalid",
+		"invalid.name",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := ElasticSanVolumeName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Elastic SAN Volume Name", v)
+		}
+	}
+}
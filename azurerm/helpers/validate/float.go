@@ -24,3 +24,22 @@ func FloatAtLeast(min float64) schema.SchemaValidateFunc {
 		return nil, errors
 	}
 }
+
+// FloatBetween returns a SchemaValidateFunc which tests if the provided value
+// is of type float64 and is between min and max (inclusive)
+func FloatBetween(min, max float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(float64)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be float64", k))
+			return nil, errors
+		}
+
+		if v < min || v > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%f - %f), got %f", k, min, max, v))
+			return nil, errors
+		}
+
+		return nil, errors
+	}
+}
@@ -81,3 +81,59 @@ func TestAzureFloatAtLeast(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureFloatBetween(t *testing.T) {
+	cases := []struct {
+		Name        string
+		MinValue    float64
+		MaxValue    float64
+		ActualValue float64
+		Errors      int
+	}{
+		{
+			Name:        "Within_Range",
+			MinValue:    0,
+			MaxValue:    100,
+			ActualValue: 25.5,
+			Errors:      0,
+		},
+		{
+			Name:        "Equal_To_Min",
+			MinValue:    0.25,
+			MaxValue:    100,
+			ActualValue: 0.25,
+			Errors:      0,
+		},
+		{
+			Name:        "Equal_To_Max",
+			MinValue:    0,
+			MaxValue:    100,
+			ActualValue: 100,
+			Errors:      0,
+		},
+		{
+			Name:        "Lesser_Than_Min",
+			MinValue:    0.25,
+			MaxValue:    100,
+			ActualValue: 0.24,
+			Errors:      1,
+		},
+		{
+			Name:        "Greater_Than_Max",
+			MinValue:    0,
+			MaxValue:    100,
+			ActualValue: 100.01,
+			Errors:      1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := FloatBetween(tc.MinValue, tc.MaxValue)(tc.ActualValue, "floatValue")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected FloatBetween to have %d not %d errors for %q", tc.Errors, len(errors), tc.Name)
+			}
+		})
+	}
+}
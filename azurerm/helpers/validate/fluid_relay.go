@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func FluidRelayName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[-0-9a-zA-Z]{1,50}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 50 characters and may only contain alphanumeric characters and dashes", k))
+	}
+
+	return warnings, errors
+}
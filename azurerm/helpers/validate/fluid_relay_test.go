@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFluidRelayName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"Valid01",
+		strings.Repeat("a", 50),
+	}
+	for _, v := range validNames {
+		_, errors := FluidRelayName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Fluid Relay Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"invalid_name",
+		"invalid.name",
+		strings.Repeat("a", 51),
+	}
+	for _, v := range invalidNames {
+		_, errors := FluidRelayName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Fluid Relay Name", v)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func HealthcareWorkspaceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 3 and 24 lowercase letters and numbers, which must start with a letter
+	if matched := regexp.MustCompile(`^[a-z][0-9a-z]{2,23}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters, may only contain lowercase letters and numbers and must start with a letter", k))
+	}
+
+	return warnings, errors
+}
+
+// HealthcareServiceName validates the name of a FHIR Service, DICOM Service or MedTech Service within a Workspace
+func HealthcareServiceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 3 and 24 lowercase letters, numbers and hyphens, which must start and end with a letter or number
+	if matched := regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters, may only contain lowercase letters, numbers and dashes and must start and end with a letter or number", k))
+	}
+
+	return warnings, errors
+}
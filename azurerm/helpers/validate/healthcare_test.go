@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateHealthcareWorkspaceName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"workspace01",
+		"a" + strings.Repeat("b", 23),
+	}
+	for _, v := range validNames {
+		_, errors := HealthcareWorkspaceName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Healthcare Workspace Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"1workspace",
+		"Workspace",
+		"work-space",
+		"work_space",
+		"a" + strings.Repeat("b", 24),
+	}
+	for _, v := range invalidNames {
+		_, errors := HealthcareWorkspaceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Healthcare Workspace Name", v)
+		}
+	}
+}
+
+func TestValidateHealthcareServiceName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"fhir-service",
+		"1dicom",
+		"a" + strings.Repeat("b", 23),
+	}
+	for _, v := range validNames {
+		_, errors := HealthcareServiceName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Healthcare Service Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"Uppercase",
+		"invalid_name",
+		"a" + strings.Repeat("b", 24),
+	}
+	for _, v := range invalidNames {
+		_, errors := HealthcareServiceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Healthcare Service Name", v)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func IoTCentralApplicationName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 2 and 63 alphanumeric characters or hyphens, which can't start or end with a hyphen
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z-]{0,61}[0-9a-zA-Z]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 63 characters, may only contain alphanumeric characters and dashes and can't start or end with a dash", k))
+	}
+
+	return warnings, errors
+}
+
+func IoTCentralApplicationSubdomain(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 2 and 63 lowercase alphanumeric characters or hyphens, which can't start or end with a hyphen
+	if matched := regexp.MustCompile(`^[0-9a-z][0-9a-z-]{0,61}[0-9a-z]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 63 characters, may only contain lowercase alphanumeric characters and dashes and can't start or end with a dash", k))
+	}
+
+	return warnings, errors
+}
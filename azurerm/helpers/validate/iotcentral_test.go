@@ -0,0 +1,64 @@
+package validate
+
+import "testing"
+
+func TestValidateIoTCentralApplicationName(t *testing.T) {
+	validNames := []string{
+		"valid-name",
+		"valid02-name",
+		"ValidName1",
+		"double-hyphen--valid",
+		"ab",
+	}
+	for _, v := range validNames {
+		_, errors := IoTCentralApplicationName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT Central Application Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"a",
+		"-invalidname",
+		"invalidname-",
+		"invalid_name",
+		"hello.world",
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	for _, v := range invalidNames {
+		_, errors := IoTCentralApplicationName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT Central Application Name", v)
+		}
+	}
+}
+
+func TestValidateIoTCentralApplicationSubdomain(t *testing.T) {
+	validNames := []string{
+		"valid-subdomain",
+		"valid02-subdomain",
+		"ab",
+	}
+	for _, v := range validNames {
+		_, errors := IoTCentralApplicationSubdomain(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT Central Application Subdomain: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"a",
+		"Invalid",
+		"-invalid",
+		"invalid-",
+		"invalid.subdomain",
+	}
+	for _, v := range invalidNames {
+		_, errors := IoTCentralApplicationSubdomain(v, "sub_domain")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT Central Application Subdomain", v)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func ManagedHsmName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters, may only contain letters, numbers and dashes, must start with a letter and must end with a letter or number", k))
+	}
+
+	if strings.Contains(value, "--") {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive dashes", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateManagedHsmName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"Valid-Name01",
+		"hsm1",
+		strings.Repeat("a", 24),
+	}
+	for _, v := range validNames {
+		_, errors := ManagedHsmName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Managed HSM Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"1hsm",
+		"invalid-",
+		"in--valid",
+		"invalid_name",
+		strings.Repeat("a", 25),
+	}
+	for _, v := range invalidNames {
+		_, errors := ManagedHsmName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Managed HSM Name", v)
+		}
+	}
+}
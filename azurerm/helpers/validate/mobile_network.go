@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// MobileNetworkName validates the name of a Mobile Network or any of its related resources, which share the same rules
+func MobileNetworkName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,63}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters, may only contain letters, numbers, dashes and underscores and must start with a letter or number", k))
+	}
+
+	return warnings, errors
+}
+
+func MobileCountryCode(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9]{3}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a 3 digit Mobile Country Code", k))
+	}
+
+	return warnings, errors
+}
+
+func MobileNetworkCode(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9]{2,3}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a 2 or 3 digit Mobile Network Code", k))
+	}
+
+	return warnings, errors
+}
+
+// MobileNetworkSimIccid validates an Integrated Circuit Card Identifier, which is 19 or 20 digits beginning with `89`
+func MobileNetworkSimIccid(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^89[0-9]{17,18}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be 19 or 20 digits beginning with `89`", k))
+	}
+
+	return warnings, errors
+}
+
+// MobileNetworkSimImsi validates an International Mobile Subscriber Identity, which is between 5 and 15 digits
+func MobileNetworkSimImsi(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9]{5,15}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 5 and 15 digits", k))
+	}
+
+	return warnings, errors
+}
+
+// MobileNetworkSimKey validates the Authentication Key or Operator Key Code of a SIM, which are 128-bit hex strings
+func MobileNetworkSimKey(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-fA-F]{32}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a 32 character hex string", k))
+	}
+
+	return warnings, errors
+}
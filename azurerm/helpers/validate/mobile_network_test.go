@@ -0,0 +1,166 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMobileNetworkName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name_01",
+		"1network",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range validNames {
+		_, errors := MobileNetworkName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Mobile Network Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-invalid",
+		"_invalid",
+		"invalid.name",
+		strings.Repeat("a", 65),
+	}
+	for _, v := range invalidNames {
+		_, errors := MobileNetworkName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Mobile Network Name", v)
+		}
+	}
+}
+
+func TestValidateMobileCountryCode(t *testing.T) {
+	validCodes := []string{
+		"001",
+		"310",
+	}
+	for _, v := range validCodes {
+		_, errors := MobileCountryCode(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Mobile Country Code: %q", v, errors)
+		}
+	}
+
+	invalidCodes := []string{
+		"",
+		"01",
+		"0001",
+		"abc",
+	}
+	for _, v := range invalidCodes {
+		_, errors := MobileCountryCode(v, "example")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Mobile Country Code", v)
+		}
+	}
+}
+
+func TestValidateMobileNetworkCode(t *testing.T) {
+	validCodes := []string{
+		"01",
+		"001",
+	}
+	for _, v := range validCodes {
+		_, errors := MobileNetworkCode(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Mobile Network Code: %q", v, errors)
+		}
+	}
+
+	invalidCodes := []string{
+		"",
+		"1",
+		"0001",
+		"ab",
+	}
+	for _, v := range invalidCodes {
+		_, errors := MobileNetworkCode(v, "example")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Mobile Network Code", v)
+		}
+	}
+}
+
+func TestValidateMobileNetworkSimIccid(t *testing.T) {
+	validIccids := []string{
+		"8900000000000000000",
+		"89000000000000000001",
+	}
+	for _, v := range validIccids {
+		_, errors := MobileNetworkSimIccid(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ICCID: %q", v, errors)
+		}
+	}
+
+	invalidIccids := []string{
+		"",
+		"890000000000000000",
+		"890000000000000000001",
+		"1900000000000000000",
+		"89abcdefabcdefabcde",
+	}
+	for _, v := range invalidIccids {
+		_, errors := MobileNetworkSimIccid(v, "example")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ICCID", v)
+		}
+	}
+}
+
+func TestValidateMobileNetworkSimImsi(t *testing.T) {
+	validImsis := []string{
+		"00101",
+		"001010000000001",
+	}
+	for _, v := range validImsis {
+		_, errors := MobileNetworkSimImsi(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IMSI: %q", v, errors)
+		}
+	}
+
+	invalidImsis := []string{
+		"",
+		"0010",
+		"0010100000000001",
+		"00101abc",
+	}
+	for _, v := range invalidImsis {
+		_, errors := MobileNetworkSimImsi(v, "example")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IMSI", v)
+		}
+	}
+}
+
+func TestValidateMobileNetworkSimKey(t *testing.T) {
+	validKeys := []string{
+		"00112233445566778899aabbccddeeff",
+		"00112233445566778899AABBCCDDEEFF",
+	}
+	for _, v := range validKeys {
+		_, errors := MobileNetworkSimKey(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SIM Key: %q", v, errors)
+		}
+	}
+
+	invalidKeys := []string{
+		"",
+		"00112233445566778899aabbccddeef",
+		"00112233445566778899aabbccddeeff0",
+		"00112233445566778899aabbccddeegg",
+	}
+	for _, v := range invalidKeys {
+		_, errors := MobileNetworkSimKey(v, "example")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SIM Key", v)
+		}
+	}
+}
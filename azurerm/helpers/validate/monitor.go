@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+func MonitorWorkspaceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 4 and 44 alphanumeric characters or hyphens, which must start and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z-]{2,42}[0-9a-zA-Z]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 44 characters, may only contain alphanumeric characters and dashes and must start and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+func MonitorPrometheusRuleGroupName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 1 and 260 characters, excluding `<>*%{}&:\?+/#|` and control characters
+	if matched := regexp.MustCompile(`^[^<>*%{}&:\\?+/#|\x00-\x1f]{1,260}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 260 characters and can't contain any of the characters `<>*%%{}&:\\?+/#|`", k))
+	}
+
+	return warnings, errors
+}
+
+// MonitorPrometheusRuleGroupInterval validates the evaluation interval is an ISO 8601 duration between 1 and 15 minutes
+func MonitorPrometheusRuleGroupInterval(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	matches := regexp.MustCompile(`^PT([0-9]+)M$`).FindStringSubmatch(value)
+	if len(matches) != 2 {
+		errors = append(errors, fmt.Errorf("%q must be an ISO 8601 duration in minutes (e.g. `PT1M`), got %q", k, value))
+		return warnings, errors
+	}
+
+	if minutes, _ := strconv.Atoi(matches[1]); minutes < 1 || minutes > 15 {
+		errors = append(errors, fmt.Errorf("%q must be between `PT1M` and `PT15M`, got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMonitorWorkspaceName(t *testing.T) {
+	validNames := []string{
+		"abcd",
+		"valid-name",
+		"Valid01",
+		strings.Repeat("a", 44),
+	}
+	for _, v := range validNames {
+		_, errors := MonitorWorkspaceName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Monitor Workspace Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"abc",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"invalid_name",
+		"invalid.name",
+		strings.Repeat("a", 45),
+	}
+	for _, v := range invalidNames {
+		_, errors := MonitorWorkspaceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Monitor Workspace Name", v)
+		}
+	}
+}
+
+func TestValidateMonitorPrometheusRuleGroupName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"Valid Name_01.rules",
+		strings.Repeat("a", 260),
+	}
+	for _, v := range validNames {
+		_, errors := MonitorPrometheusRuleGroupName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Prometheus Rule Group Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"invalid/name",
+		"invalid#name",
+		"invalid:name",
+		"invalid?name",
+		strings.Repeat("a", 261),
+	}
+	for _, v := range invalidNames {
+		_, errors := MonitorPrometheusRuleGroupName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Prometheus Rule Group Name", v)
+		}
+	}
+}
+
+func TestValidateMonitorPrometheusRuleGroupInterval(t *testing.T) {
+	validIntervals := []string{
+		"PT1M",
+		"PT5M",
+		"PT15M",
+	}
+	for _, v := range validIntervals {
+		_, errors := MonitorPrometheusRuleGroupInterval(v, "interval")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Prometheus Rule Group Interval: %q", v, errors)
+		}
+	}
+
+	invalidIntervals := []string{
+		"",
+		"1M",
+		"PT0M",
+		"PT16M",
+		"PT30S",
+		"PT1H",
+		"P1D",
+	}
+	for _, v := range invalidIntervals {
+		_, errors := MonitorPrometheusRuleGroupInterval(v, "interval")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Prometheus Rule Group Interval", v)
+		}
+	}
+}
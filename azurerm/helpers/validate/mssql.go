@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// MsSqlBackupLongTermRetentionDuration validates the retention is an ISO 8601 duration of at most 10 years, or `PT0S`
+// which disables the retention
+func MsSqlBackupLongTermRetentionDuration(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if value == "PT0S" {
+		return warnings, errors
+	}
+
+	matches := regexp.MustCompile(`^P([0-9]+)([DWMY])$`).FindStringSubmatch(value)
+	if len(matches) != 3 {
+		errors = append(errors, fmt.Errorf("%q must be an ISO 8601 duration in days, weeks, months or years (e.g. `P12W`) or `PT0S`, got %q", k, value))
+		return warnings, errors
+	}
+
+	maximums := map[string]int{
+		"D": 3650,
+		"W": 520,
+		"M": 120,
+		"Y": 10,
+	}
+	if count, _ := strconv.Atoi(matches[1]); count < 1 || count > maximums[matches[2]] {
+		errors = append(errors, fmt.Errorf("%q must be between 1 day and 10 years, got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,36 @@
+package validate
+
+import "testing"
+
+func TestValidateMsSqlBackupLongTermRetentionDuration(t *testing.T) {
+	validDurations := []string{
+		"PT0S",
+		"P1D",
+		"P12W",
+		"P520W",
+		"P120M",
+		"P10Y",
+	}
+	for _, v := range validDurations {
+		_, errors := MsSqlBackupLongTermRetentionDuration(v, "weekly_retention")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Long Term Retention Duration: %q", v, errors)
+		}
+	}
+
+	invalidDurations := []string{
+		"",
+		"12W",
+		"P0W",
+		"P521W",
+		"P11Y",
+		"PT1H",
+		"P1Y2M",
+	}
+	for _, v := range invalidDurations {
+		_, errors := MsSqlBackupLongTermRetentionDuration(v, "weekly_retention")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Long Term Retention Duration", v)
+		}
+	}
+}
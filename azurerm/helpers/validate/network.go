@@ -107,3 +107,38 @@ func validatePortNumber(i interface{}, k string, allowZero bool) (warnings []str
 
 	return warnings, errors
 }
+
+func FQDN(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(v) > 253 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 253 characters: %q", k, v))
+		return
+	}
+
+	re := regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`)
+	if !re.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q is not a valid fully qualified domain name: %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// PrivateLinkName validates the name of a Private Endpoint, Private Service Connection or Private DNS Zone Group
+func PrivateLinkName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 80 characters, start with a letter or number, end with a letter, number or underscore and contain only letters, numbers, underscores, periods and hyphens: %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -331,3 +332,123 @@ func TestPortNumberOrZero(t *testing.T) {
 		})
 	}
 }
+
+func TestFQDN(t *testing.T) {
+	cases := []struct {
+		FQDN   string
+		Errors int
+	}{
+		{
+			FQDN:   "",
+			Errors: 1,
+		},
+		{
+			FQDN:   "localhost",
+			Errors: 1,
+		},
+		{
+			FQDN:   "example.com",
+			Errors: 0,
+		},
+		{
+			FQDN:   "myaccount.blob.core.windows.net",
+			Errors: 0,
+		},
+		{
+			FQDN:   "my-server.database.windows.net",
+			Errors: 0,
+		},
+		{
+			FQDN:   "-invalid.example.com",
+			Errors: 1,
+		},
+		{
+			FQDN:   "invalid-.example.com",
+			Errors: 1,
+		},
+		{
+			FQDN:   "*.example.com",
+			Errors: 1,
+		},
+		{
+			FQDN:   "https://example.com",
+			Errors: 1,
+		},
+		{
+			FQDN:   "127.0.0.1",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.FQDN, func(t *testing.T) {
+			_, errors := FQDN(tc.FQDN, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected FQDN to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestPrivateLinkName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "a",
+			Errors: 1,
+		},
+		{
+			Name:   "ab",
+			Errors: 0,
+		},
+		{
+			Name:   "my-endpoint.sql_1",
+			Errors: 0,
+		},
+		{
+			Name:   "ends-with-underscore_",
+			Errors: 0,
+		},
+		{
+			Name:   "-starts-with-hyphen",
+			Errors: 1,
+		},
+		{
+			Name:   "ends-with-hyphen-",
+			Errors: 1,
+		},
+		{
+			Name:   "ends-with-period.",
+			Errors: 1,
+		},
+		{
+			Name:   "invalid!character",
+			Errors: 1,
+		},
+		{
+			Name:   strings.Repeat("a", 80),
+			Errors: 0,
+		},
+		{
+			Name:   strings.Repeat("a", 81),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := PrivateLinkName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PrivateLinkName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func NginxDeploymentName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 1 and 30 alphanumeric characters or hyphens, which must start and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,28}[a-zA-Z0-9])?$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 30 characters, may only contain alphanumeric characters and dashes and must start and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateNginxDeploymentName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"1",
+		"valid-name",
+		"Valid01",
+		"a" + strings.Repeat("b", 29),
+	}
+	for _, v := range validNames {
+		_, errors := NginxDeploymentName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid NGINX Deployment Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"invalid_name",
+		"invalid.name",
+		"a" + strings.Repeat("b", 30),
+	}
+	for _, v := range invalidNames {
+		_, errors := NginxDeploymentName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid NGINX Deployment Name", v)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ServiceFabricManagedClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 4 and 23 lowercase letters, numbers or hyphens, which must start with a letter
+	// and end with a letter or number
+	if matched := regexp.MustCompile(`^[a-z][a-z0-9-]{2,21}[a-z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 23 characters, may only contain lowercase letters, numbers and dashes, must start with a letter and end with a letter or number", k))
+	}
+
+	return warnings, errors
+}
+
+func ServiceFabricManagedClusterNodeTypeName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// the Node Type name is used as the prefix for the Virtual Machine names, so is limited to 9 characters
+	if matched := regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,8}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 9 characters, may only contain alphanumeric characters and must start with a letter", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateServiceFabricManagedClusterName(t *testing.T) {
+	validNames := []string{
+		"abcd",
+		"valid-name",
+		"cluster01",
+		strings.Repeat("a", 23),
+	}
+	for _, v := range validNames {
+		_, errors := ServiceFabricManagedClusterName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Service Fabric Managed Cluster Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"abc",
+		"1cluster",
+		"ends-with-dash-",
+		"Uppercase",
+		"invalid_name",
+		strings.Repeat("a", 24),
+	}
+	for _, v := range invalidNames {
+		_, errors := ServiceFabricManagedClusterName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Service Fabric Managed Cluster Name", v)
+		}
+	}
+}
+
+func TestValidateServiceFabricManagedClusterNodeTypeName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"primary",
+		"NodeType1",
+	}
+	for _, v := range validNames {
+		_, errors := ServiceFabricManagedClusterNodeTypeName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Node Type Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1nodetype",
+		"node-type",
+		"nodetype10",
+	}
+	for _, v := range invalidNames {
+		_, errors := ServiceFabricManagedClusterNodeTypeName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Node Type Name", v)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// TrafficControllerName validates the name of an Application Gateway for Containers (Traffic Controller), and of
+// its Frontends and Associations, which share the same naming rules
+func TrafficControllerName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 1 and 64 alphanumerics, underscores, periods or hyphens, starting with an alphanumeric
+	// and ending with an alphanumeric or underscore
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]([0-9a-zA-Z_.-]{0,62}[0-9a-zA-Z_])?$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters, may only contain alphanumeric characters, underscores, periods and dashes, must start with an alphanumeric character and end with an alphanumeric character or underscore", k))
+	}
+
+	return warnings, errors
+}
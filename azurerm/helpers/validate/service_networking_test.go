@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateTrafficControllerName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"valid.name_",
+		"Valid_01",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range validNames {
+		_, errors := TrafficControllerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Traffic Controller Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-starts-with-dash",
+		"_starts-with-underscore",
+		"ends-with-dash-",
+		"ends-with-period.",
+		"invalid/name",
+		strings.Repeat("a", 65),
+	}
+	for _, v := range invalidNames {
+		_, errors := TrafficControllerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Traffic Controller Name", v)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageSyncName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: between 1 and 260 alphanumeric characters, spaces, periods, underscores or hyphens, which can't end with a space or period
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z_\-. ]{0,259}[0-9a-zA-Z_\-]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 260 characters, may only contain alphanumeric characters, spaces, periods, underscores and dashes and can't end with a space or period", k))
+	}
+
+	return warnings, errors
+}
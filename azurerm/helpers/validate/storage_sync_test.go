@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateStorageSyncName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"valid_name.02",
+		"Valid Name",
+		"-starts-with-dash",
+		strings.Repeat("a", 260),
+	}
+	for _, v := range validNames {
+		_, errors := StorageSyncName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Storage Sync Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ends-with-period.",
+		"ends-with-space ",
+		"invalid/name",
+		"invalid#name",
+		strings.Repeat("a", 261),
+	}
+	for _, v := range invalidNames {
+		_, errors := StorageSyncName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Storage Sync Name", v)
+		}
+	}
+}
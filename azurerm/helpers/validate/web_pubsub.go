@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func WebPubSubName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// between 3 and 63 alphanumeric characters or hyphens, which must start with a letter and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-zA-Z][0-9a-zA-Z-]{1,61}[0-9a-zA-Z]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, may only contain alphanumeric characters and dashes, must start with a letter and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
+
+func WebPubSubHubName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// up to 128 alphanumeric characters or underscores, which must start with a letter
+	if matched := regexp.MustCompile(`^[a-zA-Z][0-9a-zA-Z_]{0,127}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters, may only contain alphanumeric characters and underscores and must start with a letter", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateWebPubSubName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"valid-name",
+		"Valid01",
+		"a" + strings.Repeat("b", 62),
+	}
+	for _, v := range validNames {
+		_, errors := WebPubSubName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Web PubSub Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"1starts-with-number",
+		"-starts-with-dash",
+		"ends-with-dash-",
+		"invalid_name",
+		"a" + strings.Repeat("b", 63),
+	}
+	for _, v := range invalidNames {
+		_, errors := WebPubSubName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Web PubSub Name", v)
+		}
+	}
+}
+
+func TestValidateWebPubSubHubName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid_hub",
+		"Hub01",
+		"a" + strings.Repeat("b", 127),
+	}
+	for _, v := range validNames {
+		_, errors := WebPubSubHubName(v, "example")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Web PubSub Hub Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1starts_with_number",
+		"_starts_with_underscore",
+		"invalid-hub",
+		"a" + strings.Repeat("b", 128),
+	}
+	for _, v := range invalidNames {
+		_, errors := WebPubSubHubName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Web PubSub Hub Name", v)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// getKeyVaultSecretValue retrieves the value of the Key Vault Secret with the specified (versioned) ID - this allows
+// sensitive values such as passwords to be sourced from a Key Vault at apply time, rather than being specified in the
+// configuration. Since the value is only used to build the request it's never persisted into the state.
+func getKeyVaultSecretValue(ctx context.Context, client keyvault.BaseClient, secretId string) (string, error) {
+	id, err := azure.ParseKeyVaultChildID(secretId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Key Vault Secret %q (Version %q / Key Vault %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Value == nil {
+		return "", fmt.Errorf("Key Vault Secret %q (Version %q / Key Vault %q) has no value", id.Name, id.Version, id.KeyVaultBaseUrl)
+	}
+
+	return *resp.Value, nil
+}
@@ -0,0 +1,342 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// monitorDiagnosticsSchema returns the schema for a `diagnostics` block, which manages a Diagnostic Setting for the
+// resource as part of its lifecycle - sending all of the available Metrics and Logs (unless filtered) to a Log
+// Analytics Workspace and/or Storage Account, without needing to know the Category names up front.
+func monitorDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "terraform-diagnostics",
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"log_analytics_workspace_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"storage_account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"logs_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"log_categories": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+					Set: schema.HashString,
+				},
+
+				"metrics_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"retention_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+// applyArmMonitorDiagnostics creates, updates or removes the Diagnostic Setting defined in the `diagnostics` block
+func applyArmMonitorDiagnostics(d *schema.ResourceData, meta interface{}, resourceId string) error {
+	if !d.HasChange("diagnostics") {
+		return nil
+	}
+
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	categoriesClient := meta.(*ArmClient).monitorDiagnosticSettingsCategoryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	targetResourceId := strings.TrimPrefix(resourceId, "/")
+
+	old, new := d.GetChange("diagnostics")
+	oldName := ""
+	if vs := old.([]interface{}); len(vs) > 0 && vs[0] != nil {
+		oldName = vs[0].(map[string]interface{})["name"].(string)
+	}
+
+	newBlocks := new.([]interface{})
+	if len(newBlocks) == 0 || newBlocks[0] == nil {
+		return deleteArmMonitorDiagnosticSetting(meta, resourceId, oldName)
+	}
+
+	diagnostics := newBlocks[0].(map[string]interface{})
+	name := diagnostics["name"].(string)
+	workspaceId := diagnostics["log_analytics_workspace_id"].(string)
+	storageAccountId := diagnostics["storage_account_id"].(string)
+	logsEnabled := diagnostics["logs_enabled"].(bool)
+	metricsEnabled := diagnostics["metrics_enabled"].(bool)
+	retentionDays := diagnostics["retention_days"].(int)
+
+	if workspaceId == "" && storageAccountId == "" {
+		return fmt.Errorf("Either `log_analytics_workspace_id` or `storage_account_id` must be specified within the `diagnostics` block")
+	}
+
+	if !logsEnabled && !metricsEnabled {
+		return fmt.Errorf("At least one of `logs_enabled` or `metrics_enabled` must be set within the `diagnostics` block")
+	}
+
+	if oldName != "" && oldName != name {
+		if err := deleteArmMonitorDiagnosticSetting(meta, resourceId, oldName); err != nil {
+			return err
+		}
+	}
+
+	categories, err := categoriesClient.List(ctx, targetResourceId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: %+v", resourceId, err)
+	}
+
+	logCategories := make([]string, 0)
+	metricCategories := make([]string, 0)
+	if categories.Value != nil {
+		for _, v := range *categories.Value {
+			if v.Name == nil || v.DiagnosticSettingsCategory == nil {
+				continue
+			}
+
+			switch v.DiagnosticSettingsCategory.CategoryType {
+			case insights.Logs:
+				logCategories = append(logCategories, *v.Name)
+			case insights.Metrics:
+				metricCategories = append(metricCategories, *v.Name)
+			}
+		}
+	}
+
+	// when no categories are specified all of the available log categories are enabled
+	enabledLogCategories := make(map[string]bool)
+	if v, ok := diagnostics["log_categories"].(*schema.Set); ok && v.Len() > 0 {
+		for _, category := range v.List() {
+			found := false
+			for _, available := range logCategories {
+				if strings.EqualFold(available, category.(string)) {
+					enabledLogCategories[available] = true
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("The Log Category %q isn't available for Resource %q - possible values are: %s", category.(string), resourceId, strings.Join(logCategories, ", "))
+			}
+		}
+	} else {
+		for _, category := range logCategories {
+			enabledLogCategories[category] = true
+		}
+	}
+
+	retentionPolicy := &insights.RetentionPolicy{
+		Enabled: utils.Bool(retentionDays > 0),
+		Days:    utils.Int32(int32(retentionDays)),
+	}
+
+	logs := make([]insights.LogSettings, 0)
+	for _, category := range logCategories {
+		logs = append(logs, insights.LogSettings{
+			Category:        utils.String(category),
+			Enabled:         utils.Bool(logsEnabled && enabledLogCategories[category]),
+			RetentionPolicy: retentionPolicy,
+		})
+	}
+
+	metrics := make([]insights.MetricSettings, 0)
+	for _, category := range metricCategories {
+		metrics = append(metrics, insights.MetricSettings{
+			Category:        utils.String(category),
+			Enabled:         utils.Bool(metricsEnabled),
+			RetentionPolicy: retentionPolicy,
+		})
+	}
+
+	properties := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			Logs:    &logs,
+			Metrics: &metrics,
+		},
+	}
+
+	if workspaceId != "" {
+		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
+	}
+
+	if storageAccountId != "" {
+		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
+		return fmt.Errorf("Error creating Monitor Diagnostics Setting %q for Resource %q: %+v", name, resourceId, err)
+	}
+
+	return nil
+}
+
+// readArmMonitorDiagnostics refreshes the `diagnostics` block - the Diagnostic Setting is only read when it's
+// managed by this resource, so that Diagnostic Settings managed elsewhere aren't imported into the block.
+func readArmMonitorDiagnostics(d *schema.ResourceData, meta interface{}, resourceId string) error {
+	blocks := d.Get("diagnostics").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := blocks[0].(map[string]interface{})["name"].(string)
+	targetResourceId := strings.TrimPrefix(resourceId, "/")
+	resp, err := client.Get(ctx, targetResourceId, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource %q - removing from the `diagnostics` block", name, resourceId)
+			return d.Set("diagnostics", []interface{}{})
+		}
+
+		return fmt.Errorf("Error retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", name, resourceId, err)
+	}
+
+	if err := d.Set("diagnostics", flattenArmMonitorDiagnostics(name, resp.DiagnosticSettings)); err != nil {
+		return fmt.Errorf("Error setting `diagnostics`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmMonitorDiagnostics(name string, input *insights.DiagnosticSettings) []interface{} {
+	workspaceId := ""
+	storageAccountId := ""
+	logsEnabled := false
+	metricsEnabled := false
+	retentionDays := 0
+	logCategories := make([]interface{}, 0)
+
+	if input != nil {
+		if input.WorkspaceID != nil {
+			workspaceId = *input.WorkspaceID
+		}
+
+		if input.StorageAccountID != nil {
+			storageAccountId = *input.StorageAccountID
+		}
+
+		if input.Logs != nil {
+			enabled := make([]string, 0)
+			for _, v := range *input.Logs {
+				if v.Enabled == nil || !*v.Enabled {
+					continue
+				}
+
+				logsEnabled = true
+				if v.Category != nil {
+					enabled = append(enabled, *v.Category)
+				}
+				if policy := v.RetentionPolicy; policy != nil && policy.Days != nil {
+					retentionDays = int(*policy.Days)
+				}
+			}
+
+			sort.Strings(enabled)
+			for _, category := range enabled {
+				logCategories = append(logCategories, category)
+			}
+		}
+
+		if input.Metrics != nil {
+			for _, v := range *input.Metrics {
+				if v.Enabled == nil || !*v.Enabled {
+					continue
+				}
+
+				metricsEnabled = true
+				if policy := v.RetentionPolicy; policy != nil && policy.Days != nil {
+					retentionDays = int(*policy.Days)
+				}
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                       name,
+			"log_analytics_workspace_id": workspaceId,
+			"storage_account_id":         storageAccountId,
+			"logs_enabled":               logsEnabled,
+			"log_categories":             schema.NewSet(schema.HashString, logCategories),
+			"metrics_enabled":            metricsEnabled,
+			"retention_days":             retentionDays,
+		},
+	}
+}
+
+// deleteArmMonitorDiagnostics removes the Diagnostic Setting defined in the `diagnostics` block (if any), since
+// Diagnostic Settings can outlive the resource they're configured on
+func deleteArmMonitorDiagnostics(d *schema.ResourceData, meta interface{}, resourceId string) error {
+	blocks := d.Get("diagnostics").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	name := blocks[0].(map[string]interface{})["name"].(string)
+	return deleteArmMonitorDiagnosticSetting(meta, resourceId, name)
+}
+
+func deleteArmMonitorDiagnosticSetting(meta interface{}, resourceId string, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	targetResourceId := strings.TrimPrefix(resourceId, "/")
+	resp, err := client.Delete(ctx, targetResourceId, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Monitor Diagnostics Setting %q for Resource %q: %+v", name, resourceId, err)
+		}
+	}
+
+	return nil
+}
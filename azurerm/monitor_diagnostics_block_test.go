@@ -0,0 +1,102 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenArmMonitorDiagnostics(t *testing.T) {
+	input := &insights.DiagnosticSettings{
+		WorkspaceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1"),
+		Logs: &[]insights.LogSettings{
+			{
+				Category: utils.String("Timeouts"),
+				Enabled:  utils.Bool(true),
+				RetentionPolicy: &insights.RetentionPolicy{
+					Enabled: utils.Bool(true),
+					Days:    utils.Int32(7),
+				},
+			},
+			{
+				Category: utils.String("Errors"),
+				Enabled:  utils.Bool(true),
+			},
+			{
+				Category: utils.String("Blocks"),
+				Enabled:  utils.Bool(false),
+			},
+		},
+		Metrics: &[]insights.MetricSettings{
+			{
+				Category: utils.String("Basic"),
+				Enabled:  utils.Bool(false),
+			},
+		},
+	}
+
+	output := flattenArmMonitorDiagnostics("example", input)
+	if len(output) != 1 {
+		t.Fatalf("Expected 1 block but got %d", len(output))
+	}
+
+	block := output[0].(map[string]interface{})
+	if block["name"].(string) != "example" {
+		t.Fatalf("Expected the name to be %q but got %q", "example", block["name"].(string))
+	}
+
+	if !block["logs_enabled"].(bool) {
+		t.Fatalf("Expected `logs_enabled` to be true")
+	}
+
+	if block["metrics_enabled"].(bool) {
+		t.Fatalf("Expected `metrics_enabled` to be false")
+	}
+
+	if block["retention_days"].(int) != 7 {
+		t.Fatalf("Expected `retention_days` to be 7 but got %d", block["retention_days"].(int))
+	}
+
+	categories := block["log_categories"].(*schema.Set)
+	if categories.Len() != 2 || !categories.Contains("Errors") || !categories.Contains("Timeouts") {
+		t.Fatalf("Expected `log_categories` to be [Errors Timeouts] but got %+v", categories.List())
+	}
+}
+
+func testCheckAzureRMMonitorDiagnosticsExists(resourceName string, settingName string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, strings.TrimPrefix(rs.Primary.ID, "/"), settingName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				if shouldExist {
+					return fmt.Errorf("Bad: Monitor Diagnostics Setting %q for Resource %q does not exist", settingName, rs.Primary.ID)
+				}
+
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on monitorDiagnosticSettingsClient: %+v", err)
+		}
+
+		if !shouldExist {
+			return fmt.Errorf("Bad: Monitor Diagnostics Setting %q for Resource %q still exists", settingName, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
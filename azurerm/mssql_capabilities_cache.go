@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+)
+
+// msSqlCapabilitiesCache holds the Elastic Pool SKUs available within each Location (for each Server Version), populated
+// from a single call to the Capabilities API per Location - so that these can be validated during `terraform plan`
+// without an API call per Elastic Pool.
+//
+// Since Capabilities change infrequently these are cached for the lifetime of the Provider; where these can't be
+// retrieved nothing is cached, and the SKU is treated as available so that the API remains the source of truth.
+type msSqlCapabilitiesCache struct {
+	lock      sync.Mutex
+	locations map[string]*msSqlCapabilitiesCacheEntry
+}
+
+type msSqlCapabilitiesCacheEntry struct {
+	// held whilst the entry is being populated, so that concurrent plans wait on a single request
+	lock sync.Mutex
+
+	// a nil map means the entry hasn't been populated - keyed by Server Version, then the SKU name,
+	// with the supported capacities as the value
+	elasticPoolSkus map[string]map[string][]int
+}
+
+func newMsSqlCapabilitiesCache() *msSqlCapabilitiesCache {
+	return &msSqlCapabilitiesCache{
+		locations: make(map[string]*msSqlCapabilitiesCacheEntry),
+	}
+}
+
+func (c *msSqlCapabilitiesCache) entry(location string) *msSqlCapabilitiesCacheEntry {
+	key := azureRMNormalizeLocation(location)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.locations[key]
+	if !ok {
+		entry = &msSqlCapabilitiesCacheEntry{}
+		c.locations[key] = entry
+	}
+
+	return entry
+}
+
+// validateElasticPoolSku returns an error when the specified Elastic Pool SKU (and capacity) isn't available within the
+// Location for the Server Version - where `serverVersion` is empty any Server Version is considered
+func (c *msSqlCapabilitiesCache) validateElasticPoolSku(ctx context.Context, client sql.CapabilitiesClient, location string, serverVersion string, skuName string, capacity int) error {
+	entry := c.entry(location)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.elasticPoolSkus == nil {
+		resp, err := client.ListByLocation(ctx, azureRMNormalizeLocation(location), sql.SupportedElasticPoolEditions)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve the SQL Elastic Pool Capabilities for Location %q - skipping validation: %+v", location, err)
+			return nil
+		}
+
+		entry.elasticPoolSkus = expandMsSqlCapabilitiesElasticPoolSkus(resp.SupportedServerVersions)
+	}
+
+	return entry.validateElasticPoolSku(location, serverVersion, skuName, capacity)
+}
+
+// validateElasticPoolFamily returns an error when no Elastic Pool SKUs for the specified vCore family (e.g. `Gen5`) are
+// available within the Location
+func (c *msSqlCapabilitiesCache) validateElasticPoolFamily(ctx context.Context, client sql.CapabilitiesClient, location string, family string) error {
+	entry := c.entry(location)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.elasticPoolSkus == nil {
+		resp, err := client.ListByLocation(ctx, azureRMNormalizeLocation(location), sql.SupportedElasticPoolEditions)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve the SQL Elastic Pool Capabilities for Location %q - skipping validation: %+v", location, err)
+			return nil
+		}
+
+		entry.elasticPoolSkus = expandMsSqlCapabilitiesElasticPoolSkus(resp.SupportedServerVersions)
+	}
+
+	return entry.validateElasticPoolFamily(location, family)
+}
+
+func (entry *msSqlCapabilitiesCacheEntry) validateElasticPoolFamily(location string, family string) error {
+	found := false
+	known := false
+
+	// vCore SKU names are suffixed with the family, e.g. `GP_Gen5`
+	suffix := "_" + strings.ToLower(family)
+	for _, skus := range entry.elasticPoolSkus {
+		for name := range skus {
+			known = true
+			if strings.HasSuffix(strings.ToLower(name), suffix) {
+				found = true
+			}
+		}
+	}
+
+	// where nothing's known for this Location (e.g. a newly added region) the API is the source of truth
+	if !known || found {
+		return nil
+	}
+
+	return fmt.Errorf("the %q family isn't available for Elastic Pools in %q", family, azureRMNormalizeLocation(location))
+}
+
+func (entry *msSqlCapabilitiesCacheEntry) validateElasticPoolSku(location string, serverVersion string, skuName string, capacity int) error {
+	// capacities supported by the SKU across the matching Server Versions
+	capacities := make(map[int]bool)
+	found := false
+	available := make(map[string]bool)
+
+	for version, skus := range entry.elasticPoolSkus {
+		if serverVersion != "" && !strings.EqualFold(version, serverVersion) {
+			continue
+		}
+
+		for name, values := range skus {
+			available[name] = true
+
+			if !strings.EqualFold(name, skuName) {
+				continue
+			}
+
+			found = true
+			for _, v := range values {
+				capacities[v] = true
+			}
+		}
+	}
+
+	// where nothing's known for this Location/Server Version (e.g. a newly added region) the API is the source of truth
+	if len(available) == 0 {
+		return nil
+	}
+
+	if !found {
+		names := make([]string, 0)
+		for name := range available {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return fmt.Errorf("the %q SKU isn't available for Elastic Pools in %q - the available SKUs are %s", skuName, azureRMNormalizeLocation(location), strings.Join(names, ", "))
+	}
+
+	// DTU based SKUs are returned with per-pool eDTU levels which are validated by the API, so only vCore capacities are checked
+	if len(capacities) == 0 || capacities[capacity] {
+		return nil
+	}
+
+	values := make([]int, 0)
+	for v := range capacities {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+
+	supported := make([]string, 0)
+	for _, v := range values {
+		supported = append(supported, strconv.Itoa(v))
+	}
+
+	return fmt.Errorf("the %q SKU doesn't support a capacity of %d in %q - the available capacities are %s", skuName, capacity, azureRMNormalizeLocation(location), strings.Join(supported, ", "))
+}
+
+func expandMsSqlCapabilitiesElasticPoolSkus(input *[]sql.ServerVersionCapability) map[string]map[string][]int {
+	results := make(map[string]map[string][]int)
+	if input == nil {
+		return results
+	}
+
+	for _, version := range *input {
+		if version.Name == nil || version.Status == sql.Disabled || version.SupportedElasticPoolEditions == nil {
+			continue
+		}
+
+		skus := make(map[string][]int)
+		for _, edition := range *version.SupportedElasticPoolEditions {
+			if edition.Status == sql.Disabled || edition.SupportedElasticPoolPerformanceLevels == nil {
+				continue
+			}
+
+			for _, level := range *edition.SupportedElasticPoolPerformanceLevels {
+				if level.Status == sql.Disabled || level.Sku == nil || level.Sku.Name == nil {
+					continue
+				}
+
+				name := *level.Sku.Name
+				if _, ok := skus[name]; !ok {
+					skus[name] = make([]int, 0)
+				}
+
+				// only vCore based SKUs report a family, with the capacity being the number of vCores
+				if level.Sku.Family != nil && *level.Sku.Family != "" && level.Sku.Capacity != nil {
+					skus[name] = append(skus[name], int(*level.Sku.Capacity))
+				}
+			}
+		}
+
+		results[*version.Name] = skus
+	}
+
+	return results
+}
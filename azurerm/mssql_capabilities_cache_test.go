@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestMsSqlCapabilitiesCache_validateElasticPoolSku(t *testing.T) {
+	cache := newMsSqlCapabilitiesCache()
+	ctx := context.TODO()
+
+	entry := cache.entry("West Europe")
+	entry.elasticPoolSkus = map[string]map[string][]int{
+		"12.0": {
+			"StandardPool": {},
+			"GP_Gen5":      {2, 4, 8},
+		},
+		"13.0": {
+			"BC_Gen5": {4, 8},
+		},
+	}
+
+	cases := []struct {
+		ServerVersion string
+		SkuName       string
+		Capacity      int
+		Error         string
+	}{
+		{ServerVersion: "12.0", SkuName: "GP_Gen5", Capacity: 4},
+		{ServerVersion: "12.0", SkuName: "StandardPool", Capacity: 100},
+		{ServerVersion: "", SkuName: "BC_Gen5", Capacity: 8},
+		{ServerVersion: "12.0", SkuName: "BC_Gen4", Capacity: 4, Error: "the available SKUs are GP_Gen5, StandardPool"},
+		{ServerVersion: "12.0", SkuName: "BC_Gen5", Capacity: 4, Error: `the "BC_Gen5" SKU isn't available`},
+		{ServerVersion: "12.0", SkuName: "GP_Gen5", Capacity: 6, Error: "the available capacities are 2, 4, 8"},
+	}
+
+	for _, tc := range cases {
+		// since the entry is populated no API calls are made, so an unconfigured client is fine here
+		err := cache.validateElasticPoolSku(ctx, sql.CapabilitiesClient{}, "westeurope", tc.ServerVersion, tc.SkuName, tc.Capacity)
+		if tc.Error == "" {
+			if err != nil {
+				t.Fatalf("Expected %q (capacity %d) to be valid but got: %+v", tc.SkuName, tc.Capacity, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tc.Error) {
+			t.Fatalf("Expected %q (capacity %d) to fail with %q but got: %+v", tc.SkuName, tc.Capacity, tc.Error, err)
+		}
+	}
+}
+
+func TestMsSqlCapabilitiesCache_unknownServerVersion(t *testing.T) {
+	cache := newMsSqlCapabilitiesCache()
+
+	entry := cache.entry("westeurope")
+	entry.elasticPoolSkus = map[string]map[string][]int{
+		"12.0": {
+			"GP_Gen5": {2, 4},
+		},
+	}
+
+	// nothing is known for this Server Version, so the API is treated as the source of truth
+	if err := cache.validateElasticPoolSku(context.TODO(), sql.CapabilitiesClient{}, "westeurope", "14.0", "BC_Gen4", 4); err != nil {
+		t.Fatalf("Expected no error for an unknown Server Version but got: %+v", err)
+	}
+}
+
+func TestMsSqlCapabilitiesCache_validateElasticPoolFamily(t *testing.T) {
+	cache := newMsSqlCapabilitiesCache()
+
+	entry := cache.entry("westeurope")
+	entry.elasticPoolSkus = map[string]map[string][]int{
+		"12.0": {
+			"StandardPool": {},
+			"GP_Gen5":      {2, 4},
+		},
+	}
+
+	if err := cache.validateElasticPoolFamily(context.TODO(), sql.CapabilitiesClient{}, "West Europe", "Gen5"); err != nil {
+		t.Fatalf("Expected Gen5 to be available but got: %+v", err)
+	}
+
+	if err := cache.validateElasticPoolFamily(context.TODO(), sql.CapabilitiesClient{}, "westeurope", "Gen4"); err == nil || !strings.Contains(err.Error(), `the "Gen4" family isn't available`) {
+		t.Fatalf("Expected Gen4 to be unavailable but got: %+v", err)
+	}
+
+	// nothing is known for this Location, so the API is treated as the source of truth
+	cache.entry("northeurope").elasticPoolSkus = map[string]map[string][]int{}
+	if err := cache.validateElasticPoolFamily(context.TODO(), sql.CapabilitiesClient{}, "northeurope", "Gen5"); err != nil {
+		t.Fatalf("Expected no error for an unknown Location but got: %+v", err)
+	}
+}
+
+func TestExpandMsSqlCapabilitiesElasticPoolSkus(t *testing.T) {
+	input := []sql.ServerVersionCapability{
+		{
+			Name:   utils.String("12.0"),
+			Status: sql.Default,
+			SupportedElasticPoolEditions: &[]sql.ElasticPoolEditionCapability{
+				{
+					Name:   utils.String("GeneralPurpose"),
+					Status: sql.Available,
+					SupportedElasticPoolPerformanceLevels: &[]sql.ElasticPoolPerformanceLevelCapability{
+						{
+							Status: sql.Available,
+							Sku:    &sql.Sku{Name: utils.String("GP_Gen5"), Family: utils.String("Gen5"), Capacity: utils.Int32(2)},
+						},
+						{
+							Status: sql.Disabled,
+							Sku:    &sql.Sku{Name: utils.String("GP_Gen5"), Family: utils.String("Gen5"), Capacity: utils.Int32(80)},
+						},
+					},
+				},
+				{
+					Name:   utils.String("BusinessCritical"),
+					Status: sql.Disabled,
+					SupportedElasticPoolPerformanceLevels: &[]sql.ElasticPoolPerformanceLevelCapability{
+						{
+							Status: sql.Available,
+							Sku:    &sql.Sku{Name: utils.String("BC_Gen4"), Family: utils.String("Gen4"), Capacity: utils.Int32(2)},
+						},
+					},
+				},
+				{
+					Name:   utils.String("Standard"),
+					Status: sql.Available,
+					SupportedElasticPoolPerformanceLevels: &[]sql.ElasticPoolPerformanceLevelCapability{
+						{
+							Status: sql.Available,
+							Sku:    &sql.Sku{Name: utils.String("StandardPool"), Capacity: utils.Int32(50)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	skus := expandMsSqlCapabilitiesElasticPoolSkus(&input)["12.0"]
+	if len(skus) != 2 {
+		t.Fatalf("Expected 2 SKUs but got %d: %+v", len(skus), skus)
+	}
+
+	if capacities := skus["GP_Gen5"]; len(capacities) != 1 || capacities[0] != 2 {
+		t.Fatalf("Expected `GP_Gen5` to support a capacity of 2 but got %+v", capacities)
+	}
+
+	if capacities, ok := skus["StandardPool"]; !ok || len(capacities) != 0 {
+		t.Fatalf("Expected `StandardPool` to be available without capacities but got %+v", capacities)
+	}
+}
@@ -0,0 +1,60 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// nameAvailabilityFunc checks whether the specified name is available for a globally-named resource, returning
+// the reason when it isn't
+type nameAvailabilityFunc func(ctx context.Context, client *ArmClient, name string) (available bool, reason string, err error)
+
+// customizeDiffNameAvailability returns a CustomizeDiffFunc which, when the `validate_name_availability` Provider
+// option is enabled, checks that the name of a new resource is available at plan time - rather than the apply
+// failing partway through.
+func customizeDiffNameAvailability(resourceType string, check nameAvailabilityFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*ArmClient)
+		if !ok || client == nil || !client.validateNameAvailability {
+			return nil
+		}
+
+		// only new resources (or those being recreated with a new name) need a name which isn't in use
+		if d.Id() != "" && !d.HasChange("name") {
+			return nil
+		}
+
+		// the name may not be known until apply time, e.g. when it's interpolated from another resource
+		if !d.NewValueKnown("name") {
+			return nil
+		}
+
+		name := d.Get("name").(string)
+		if name == "" {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Checking the availability of the name %q for %s", name, resourceType)
+		available, reason, err := check(client.StopContext, client, name)
+		if err != nil {
+			return fmt.Errorf("Error checking the availability of the name %q for %s: %+v", name, resourceType, err)
+		}
+
+		if !available {
+			return fmt.Errorf("The name %q is not available for %s: %s", name, resourceType, reason)
+		}
+
+		return nil
+	}
+}
+
+func nameAvailabilityReason(message *string, reason string) string {
+	if message != nil && *message != "" {
+		return *message
+	}
+
+	return reason
+}
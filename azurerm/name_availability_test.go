@@ -0,0 +1,116 @@
+package azurerm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCustomizeDiffNameAvailability(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Enabled   bool
+		State     *terraform.InstanceState
+		Config    map[string]interface{}
+		Available bool
+		Checked   bool
+		Errors    bool
+	}{
+		{
+			Name:      "Disabled",
+			Enabled:   false,
+			Config:    map[string]interface{}{"name": "example"},
+			Available: false,
+			Checked:   false,
+		},
+		{
+			Name:      "New Resource with an Available Name",
+			Enabled:   true,
+			Config:    map[string]interface{}{"name": "example"},
+			Available: true,
+			Checked:   true,
+		},
+		{
+			Name:      "New Resource with an Unavailable Name",
+			Enabled:   true,
+			Config:    map[string]interface{}{"name": "example"},
+			Available: false,
+			Checked:   true,
+			Errors:    true,
+		},
+		{
+			Name:    "Existing Resource",
+			Enabled: true,
+			State: &terraform.InstanceState{
+				ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/example",
+				Attributes: map[string]string{"name": "example"},
+			},
+			Config:    map[string]interface{}{"name": "example", "tag": "updated"},
+			Available: false,
+			Checked:   false,
+		},
+		{
+			Name:    "Existing Resource being Renamed",
+			Enabled: true,
+			State: &terraform.InstanceState{
+				ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/example",
+				Attributes: map[string]string{"name": "example"},
+			},
+			Config:    map[string]interface{}{"name": "renamed"},
+			Available: false,
+			Checked:   true,
+			Errors:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			checked := false
+			check := func(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+				checked = true
+				return tc.Available, "AlreadyExists", nil
+			}
+
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"tag": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				CustomizeDiff: customizeDiffNameAvailability("Example", check),
+			}
+
+			raw, err := config.NewRawConfig(tc.Config)
+			if err != nil {
+				t.Fatalf("Error building config: %+v", err)
+			}
+
+			meta := &ArmClient{
+				StopContext:              context.Background(),
+				validateNameAvailability: tc.Enabled,
+			}
+
+			_, err = resource.Diff(tc.State, terraform.NewResourceConfig(raw), meta)
+			if checked != tc.Checked {
+				t.Fatalf("Expected the name availability check to be called: %t but got %t", tc.Checked, checked)
+			}
+
+			if tc.Errors && err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if !tc.Errors && err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+		})
+	}
+}
@@ -6,15 +6,22 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
+// terraformPartnerId is the Partner ID registered for Terraform, which is sent in the User Agent when no `partner_id`
+// is specified (unless `disable_terraform_partner_id` is set) to attribute usage to Terraform
+const terraformPartnerId = "222c6c49-1b0a-5959-a213-6608f9eb8820"
+
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
 	p := &schema.Provider{
@@ -83,6 +90,18 @@ func Provider() terraform.ResourceProvider {
 				ValidateFunc: validate.UUIDOrEmpty,
 			},
 
+			"disable_terraform_partner_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_TERRAFORM_PARTNER_ID", false),
+			},
+
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USER_AGENT_SUFFIX", ""),
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -95,190 +114,383 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"enable_cost_estimation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENABLE_COST_ESTIMATION", false),
+			},
+
+			"validate_name_availability": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_VALIDATE_NAME_AVAILABILITY", false),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", azure.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", int(azure.DefaultRetryBackoff.Seconds())),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"polling_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_POLLING_INTERVAL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"long_running_operation_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_LONG_RUNNING_OPERATION_TIMEOUT", int(azure.DefaultLongRunningOperationTimeout.Minutes())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"max_idle_connections_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_IDLE_CONNECTIONS_PER_HOST", azure.DefaultMaxIdleConnectionsPerHost),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"request_annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_request_id_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateRequestAnnotation,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mssql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"relaxed_sku_validation": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"recover_dropped_databases": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"api_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  msSqlDefaultApiVersion,
+										ValidateFunc: validation.StringInSlice([]string{
+											msSqlDefaultApiVersion,
+											"2021-11-01",
+										}, false),
+									},
+								},
+							},
+						},
+
+						"network": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_unused_network_profiles_on_subnet_destroy": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"azurerm_api_management":                        dataSourceApiManagementService(),
-			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
-			"azurerm_app_service":                           dataSourceArmAppService(),
-			"azurerm_application_insights":                  dataSourceArmApplicationInsights(),
-			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
-			"azurerm_azuread_application":                   dataSourceArmAzureADApplication(),
-			"azurerm_azuread_service_principal":             dataSourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_batch_account":                         dataSourceArmBatchAccount(),
-			"azurerm_batch_pool":                            dataSourceArmBatchPool(),
-			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
-			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
-			"azurerm_client_config":                         dataSourceArmClientConfig(),
-			"azurerm_container_registry":                    dataSourceArmContainerRegistry(),
-			"azurerm_cosmosdb_account":                      dataSourceArmCosmosDBAccount(),
-			"azurerm_data_lake_store":                       dataSourceArmDataLakeStoreAccount(),
-			"azurerm_dev_test_lab":                          dataSourceArmDevTestLab(),
-			"azurerm_dns_zone":                              dataSourceArmDnsZone(),
-			"azurerm_eventhub_namespace":                    dataSourceEventHubNamespace(),
-			"azurerm_image":                                 dataSourceArmImage(),
-			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_key":                         dataSourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                      dataSourceArmKeyVaultSecret(),
-			"azurerm_key_vault":                             dataSourceArmKeyVault(),
-			"azurerm_kubernetes_cluster":                    dataSourceArmKubernetesCluster(),
-			"azurerm_lb":                                    dataSourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":               dataSourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_log_analytics_workspace":               dataSourceLogAnalyticsWorkspace(),
-			"azurerm_logic_app_workflow":                    dataSourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
-			"azurerm_management_group":                      dataSourceArmManagementGroup(),
-			"azurerm_monitor_action_group":                  dataSourceArmMonitorActionGroup(),
-			"azurerm_monitor_diagnostic_categories":         dataSourceArmMonitorDiagnosticCategories(),
-			"azurerm_monitor_log_profile":                   dataSourceArmMonitorLogProfile(),
-			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
-			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
-			"azurerm_notification_hub_namespace":            dataSourceNotificationHubNamespace(),
-			"azurerm_notification_hub":                      dataSourceNotificationHub(),
-			"azurerm_platform_image":                        dataSourceArmPlatformImage(),
-			"azurerm_public_ip":                             dataSourceArmPublicIP(),
-			"azurerm_public_ips":                            dataSourceArmPublicIPs(),
-			"azurerm_recovery_services_vault":               dataSourceArmRecoveryServicesVault(),
-			"azurerm_resource_group":                        dataSourceArmResourceGroup(),
-			"azurerm_role_definition":                       dataSourceArmRoleDefinition(),
-			"azurerm_route_table":                           dataSourceArmRouteTable(),
-			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
-			"azurerm_shared_image_gallery":                  dataSourceArmSharedImageGallery(),
-			"azurerm_shared_image_version":                  dataSourceArmSharedImageVersion(),
-			"azurerm_shared_image":                          dataSourceArmSharedImage(),
-			"azurerm_snapshot":                              dataSourceArmSnapshot(),
-			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
-			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
-			"azurerm_subnet":                                dataSourceArmSubnet(),
-			"azurerm_subscription":                          dataSourceArmSubscription(),
-			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
-			"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
-			"azurerm_virtual_machine":                       dataSourceArmVirtualMachine(),
-			"azurerm_virtual_network_gateway":               dataSourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network":                       dataSourceArmVirtualNetwork(),
+			"azurerm_api_management":                         dataSourceApiManagementService(),
+			"azurerm_app_service_plan":                       dataSourceAppServicePlan(),
+			"azurerm_app_service":                            dataSourceArmAppService(),
+			"azurerm_application_insights":                   dataSourceArmApplicationInsights(),
+			"azurerm_application_security_group":             dataSourceArmApplicationSecurityGroup(),
+			"azurerm_automation_hybrid_runbook_worker_group": dataSourceArmAutomationHybridRunbookWorkerGroup(),
+			"azurerm_azuread_application":                    dataSourceArmAzureADApplication(),
+			"azurerm_azuread_service_principal":              dataSourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_backup_recovery_points":                 dataSourceArmBackupRecoveryPoints(),
+			"azurerm_batch_account":                          dataSourceArmBatchAccount(),
+			"azurerm_batch_pool":                             dataSourceArmBatchPool(),
+			"azurerm_builtin_role_definition":                dataSourceArmBuiltInRoleDefinition(),
+			"azurerm_cdn_profile":                            dataSourceArmCdnProfile(),
+			"azurerm_client_config":                          dataSourceArmClientConfig(),
+			"azurerm_container_registry":                     dataSourceArmContainerRegistry(),
+			"azurerm_cosmosdb_account":                       dataSourceArmCosmosDBAccount(),
+			"azurerm_data_lake_store":                        dataSourceArmDataLakeStoreAccount(),
+			"azurerm_dev_test_lab":                           dataSourceArmDevTestLab(),
+			"azurerm_dns_zone":                               dataSourceArmDnsZone(),
+			"azurerm_eventhub_namespace":                     dataSourceEventHubNamespace(),
+			"azurerm_extended_locations":                     dataSourceArmExtendedLocations(),
+			"azurerm_image":                                  dataSourceArmImage(),
+			"azurerm_iot_central_application":                dataSourceArmIotCentralApplication(),
+			"azurerm_key_vault_access_policy":                dataSourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_key":                          dataSourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                       dataSourceArmKeyVaultSecret(),
+			"azurerm_key_vault":                              dataSourceArmKeyVault(),
+			"azurerm_kubernetes_cluster":                     dataSourceArmKubernetesCluster(),
+			"azurerm_lb":                                     dataSourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_log_analytics_workspace":                dataSourceLogAnalyticsWorkspace(),
+			"azurerm_logic_app_workflow":                     dataSourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                           dataSourceArmManagedDisk(),
+			"azurerm_management_group":                       dataSourceArmManagementGroup(),
+			"azurerm_monitor_action_group":                   dataSourceArmMonitorActionGroup(),
+			"azurerm_monitor_diagnostic_categories":          dataSourceArmMonitorDiagnosticCategories(),
+			"azurerm_monitor_log_profile":                    dataSourceArmMonitorLogProfile(),
+			"azurerm_mssql_database_list":                    dataSourceArmMsSqlDatabaseList(),
+			"azurerm_mssql_elasticpool_metric_definitions":   dataSourceArmMsSqlElasticPoolMetricDefinitions(),
+			"azurerm_mssql_elasticpool_skus":                 dataSourceArmMsSqlElasticPoolSkus(),
+			"azurerm_mssql_restorable_dropped_database":      dataSourceArmMsSqlRestorableDroppedDatabase(),
+			"azurerm_network_interface":                      dataSourceArmNetworkInterface(),
+			"azurerm_network_security_group":                 dataSourceArmNetworkSecurityGroup(),
+			"azurerm_notification_hub_namespace":             dataSourceNotificationHubNamespace(),
+			"azurerm_notification_hub":                       dataSourceNotificationHub(),
+			"azurerm_platform_image":                         dataSourceArmPlatformImage(),
+			"azurerm_private_dns_zone":                       dataSourceArmPrivateDnsZone(),
+			"azurerm_private_dns_zone_record_sets":           dataSourceArmPrivateDnsZoneRecordSets(),
+			"azurerm_public_ip":                              dataSourceArmPublicIP(),
+			"azurerm_public_ips":                             dataSourceArmPublicIPs(),
+			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
+			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
+			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
+			"azurerm_route_table":                            dataSourceArmRouteTable(),
+			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
+			"azurerm_shared_image_gallery":                   dataSourceArmSharedImageGallery(),
+			"azurerm_shared_image_version":                   dataSourceArmSharedImageVersion(),
+			"azurerm_shared_image":                           dataSourceArmSharedImage(),
+			"azurerm_snapshot":                               dataSourceArmSnapshot(),
+			"azurerm_storage_account_sas":                    dataSourceArmStorageAccountSharedAccessSignature(),
+			"azurerm_storage_account":                        dataSourceArmStorageAccount(),
+			"azurerm_storage_sync_registered_server":         dataSourceArmStorageSyncRegisteredServer(),
+			"azurerm_subnet":                                 dataSourceArmSubnet(),
+			"azurerm_subscription":                           dataSourceArmSubscription(),
+			"azurerm_subscriptions":                          dataSourceArmSubscriptions(),
+			"azurerm_traffic_manager_geographical_location":  dataSourceArmTrafficManagerGeographicalLocation(),
+			"azurerm_virtual_machine":                        dataSourceArmVirtualMachine(),
+			"azurerm_virtual_network_gateway":                dataSourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network":                        dataSourceArmVirtualNetwork(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_api_management":                         resourceArmApiManagementService(),
-			"azurerm_app_service_active_slot":                resourceArmAppServiceActiveSlot(),
-			"azurerm_app_service_custom_hostname_binding":    resourceArmAppServiceCustomHostnameBinding(),
-			"azurerm_app_service_plan":                       resourceArmAppServicePlan(),
-			"azurerm_app_service_slot":                       resourceArmAppServiceSlot(),
-			"azurerm_app_service":                            resourceArmAppService(),
-			"azurerm_application_gateway":                    resourceArmApplicationGateway(),
-			"azurerm_application_insights_api_key":           resourceArmApplicationInsightsAPIKey(),
-			"azurerm_application_insights":                   resourceArmApplicationInsights(),
-			"azurerm_application_security_group":             resourceArmApplicationSecurityGroup(),
-			"azurerm_automation_account":                     resourceArmAutomationAccount(),
-			"azurerm_automation_credential":                  resourceArmAutomationCredential(),
-			"azurerm_automation_dsc_configuration":           resourceArmAutomationDscConfiguration(),
-			"azurerm_automation_dsc_nodeconfiguration":       resourceArmAutomationDscNodeConfiguration(),
-			"azurerm_automation_module":                      resourceArmAutomationModule(),
-			"azurerm_automation_runbook":                     resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":                    resourceArmAutomationSchedule(),
-			"azurerm_autoscale_setting":                      resourceArmAutoScaleSetting(),
-			"azurerm_availability_set":                       resourceArmAvailabilitySet(),
-			"azurerm_azuread_application":                    resourceArmActiveDirectoryApplication(),
-			"azurerm_azuread_service_principal_password":     resourceArmActiveDirectoryServicePrincipalPassword(),
-			"azurerm_azuread_service_principal":              resourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_batch_account":                          resourceArmBatchAccount(),
-			"azurerm_batch_pool":                             resourceArmBatchPool(),
-			"azurerm_cdn_endpoint":                           resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                            resourceArmCdnProfile(),
-			"azurerm_cognitive_account":                      resourceArmCognitiveAccount(),
-			"azurerm_container_group":                        resourceArmContainerGroup(),
-			"azurerm_container_registry":                     resourceArmContainerRegistry(),
-			"azurerm_container_service":                      resourceArmContainerService(),
-			"azurerm_cosmosdb_account":                       resourceArmCosmosDBAccount(),
-			"azurerm_data_lake_analytics_account":            resourceArmDataLakeAnalyticsAccount(),
-			"azurerm_data_lake_analytics_firewall_rule":      resourceArmDataLakeAnalyticsFirewallRule(),
-			"azurerm_data_lake_store_file":                   resourceArmDataLakeStoreFile(),
-			"azurerm_data_lake_store_firewall_rule":          resourceArmDataLakeStoreFirewallRule(),
-			"azurerm_data_lake_store":                        resourceArmDataLakeStore(),
-			"azurerm_databricks_workspace":                   resourceArmDatabricksWorkspace(),
-			"azurerm_ddos_protection_plan":                   resourceArmDDoSProtectionPlan(),
-			"azurerm_dev_test_lab":                           resourceArmDevTestLab(),
-			"azurerm_dev_test_linux_virtual_machine":         resourceArmDevTestLinuxVirtualMachine(),
-			"azurerm_dev_test_policy":                        resourceArmDevTestPolicy(),
-			"azurerm_dev_test_virtual_network":               resourceArmDevTestVirtualNetwork(),
-			"azurerm_dev_test_windows_virtual_machine":       resourceArmDevTestWindowsVirtualMachine(),
-			"azurerm_devspace_controller":                    resourceArmDevSpaceController(),
-			"azurerm_dns_a_record":                           resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":                        resourceArmDnsAAAARecord(),
-			"azurerm_dns_caa_record":                         resourceArmDnsCaaRecord(),
-			"azurerm_dns_cname_record":                       resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":                          resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":                          resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":                         resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":                         resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":                         resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                               resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":                        resourceArmEventGridTopic(),
-			"azurerm_eventhub_authorization_rule":            resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":                resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace_authorization_rule":  resourceArmEventHubNamespaceAuthorizationRule(),
-			"azurerm_eventhub_namespace":                     resourceArmEventHubNamespace(),
-			"azurerm_eventhub":                               resourceArmEventHub(),
-			"azurerm_express_route_circuit_authorization":    resourceArmExpressRouteCircuitAuthorization(),
-			"azurerm_express_route_circuit_peering":          resourceArmExpressRouteCircuitPeering(),
-			"azurerm_express_route_circuit":                  resourceArmExpressRouteCircuit(),
-			"azurerm_firewall_application_rule_collection":   resourceArmFirewallApplicationRuleCollection(),
-			"azurerm_firewall_network_rule_collection":       resourceArmFirewallNetworkRuleCollection(),
-			"azurerm_firewall":                               resourceArmFirewall(),
-			"azurerm_function_app":                           resourceArmFunctionApp(),
-			"azurerm_image":                                  resourceArmImage(),
-			"azurerm_iothub_consumer_group":                  resourceArmIotHubConsumerGroup(),
-			"azurerm_iothub":                                 resourceArmIotHub(),
-			"azurerm_key_vault_access_policy":                resourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_certificate":                  resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":                          resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                       resourceArmKeyVaultSecret(),
-			"azurerm_key_vault":                              resourceArmKeyVault(),
-			"azurerm_kubernetes_cluster":                     resourceArmKubernetesCluster(),
-			"azurerm_lb_backend_address_pool":                resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_pool":                            resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_nat_rule":                            resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_probe":                               resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                                resourceArmLoadBalancerRule(),
-			"azurerm_lb":                                     resourceArmLoadBalancer(),
-			"azurerm_local_network_gateway":                  resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_solution":                 resourceArmLogAnalyticsSolution(),
-			"azurerm_log_analytics_linked_service":           resourceArmLogAnalyticsLinkedService(),
-			"azurerm_log_analytics_workspace_linked_service": resourceArmLogAnalyticsWorkspaceLinkedService(),
-			"azurerm_log_analytics_workspace":                resourceArmLogAnalyticsWorkspace(),
-			"azurerm_logic_app_action_custom":                resourceArmLogicAppActionCustom(),
-			"azurerm_logic_app_action_http":                  resourceArmLogicAppActionHTTP(),
-			"azurerm_logic_app_trigger_custom":               resourceArmLogicAppTriggerCustom(),
-			"azurerm_logic_app_trigger_http_request":         resourceArmLogicAppTriggerHttpRequest(),
-			"azurerm_logic_app_trigger_recurrence":           resourceArmLogicAppTriggerRecurrence(),
-			"azurerm_logic_app_workflow":                     resourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                           resourceArmManagedDisk(),
-			"azurerm_management_group":                       resourceArmManagementGroup(),
-			"azurerm_management_lock":                        resourceArmManagementLock(),
-			"azurerm_mariadb_database":                       resourceArmMariaDbDatabase(),
-			"azurerm_mariadb_server":                         resourceArmMariaDbServer(),
-			"azurerm_metric_alertrule":                       resourceArmMetricAlertRule(),
-			"azurerm_monitor_autoscale_setting":              resourceArmMonitorAutoScaleSetting(),
-			"azurerm_monitor_action_group":                   resourceArmMonitorActionGroup(),
-			"azurerm_monitor_activity_log_alert":             resourceArmMonitorActivityLogAlert(),
-			"azurerm_monitor_diagnostic_setting":             resourceArmMonitorDiagnosticSetting(),
-			"azurerm_monitor_log_profile":                    resourceArmMonitorLogProfile(),
-			"azurerm_monitor_metric_alert":                   resourceArmMonitorMetricAlert(),
-			"azurerm_monitor_metric_alertrule":               resourceArmMonitorMetricAlertRule(),
-			"azurerm_mssql_elasticpool":                      resourceArmMsSqlElasticPool(),
-			"azurerm_mysql_configuration":                    resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":                         resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":                    resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                           resourceArmMySqlServer(),
-			"azurerm_mysql_virtual_network_rule":             resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_api_management":                                    resourceArmApiManagementService(),
+			"azurerm_api_management_custom_domain":                      resourceArmApiManagementCustomDomain(),
+			"azurerm_app_configuration":                                 resourceArmAppConfiguration(),
+			"azurerm_app_service_active_slot":                           resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_custom_hostname_binding":               resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_plan":                                  resourceArmAppServicePlan(),
+			"azurerm_app_service_slot":                                  resourceArmAppServiceSlot(),
+			"azurerm_app_service":                                       resourceArmAppService(),
+			"azurerm_application_gateway":                               resourceArmApplicationGateway(),
+			"azurerm_application_insights_api_key":                      resourceArmApplicationInsightsAPIKey(),
+			"azurerm_application_insights":                              resourceArmApplicationInsights(),
+			"azurerm_application_security_group":                        resourceArmApplicationSecurityGroup(),
+			"azurerm_automation_account":                                resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                             resourceArmAutomationCredential(),
+			"azurerm_automation_dsc_configuration":                      resourceArmAutomationDscConfiguration(),
+			"azurerm_automation_dsc_nodeconfiguration":                  resourceArmAutomationDscNodeConfiguration(),
+			"azurerm_automation_module":                                 resourceArmAutomationModule(),
+			"azurerm_automation_python3_package":                        resourceArmAutomationPython3Package(),
+			"azurerm_automation_runbook":                                resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                               resourceArmAutomationSchedule(),
+			"azurerm_automation_source_control":                         resourceArmAutomationSourceControl(),
+			"azurerm_autoscale_setting":                                 resourceArmAutoScaleSetting(),
+			"azurerm_availability_set":                                  resourceArmAvailabilitySet(),
+			"azurerm_azuread_application":                               resourceArmActiveDirectoryApplication(),
+			"azurerm_azuread_service_principal_password":                resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_azuread_service_principal":                         resourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_batch_account":                                     resourceArmBatchAccount(),
+			"azurerm_batch_pool":                                        resourceArmBatchPool(),
+			"azurerm_cdn_endpoint":                                      resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                                       resourceArmCdnProfile(),
+			"azurerm_cognitive_account":                                 resourceArmCognitiveAccount(),
+			"azurerm_container_group":                                   resourceArmContainerGroup(),
+			"azurerm_container_registry":                                resourceArmContainerRegistry(),
+			"azurerm_container_service":                                 resourceArmContainerService(),
+			"azurerm_cosmosdb_account":                                  resourceArmCosmosDBAccount(),
+			"azurerm_data_lake_analytics_account":                       resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":                 resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store_file":                              resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_firewall_rule":                     resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_data_lake_store":                                   resourceArmDataLakeStore(),
+			"azurerm_databricks_workspace":                              resourceArmDatabricksWorkspace(),
+			"azurerm_ddos_protection_plan":                              resourceArmDDoSProtectionPlan(),
+			"azurerm_dev_center":                                        resourceArmDevCenter(),
+			"azurerm_dev_center_catalog":                                resourceArmDevCenterCatalog(),
+			"azurerm_dev_center_environment_type":                       resourceArmDevCenterEnvironmentType(),
+			"azurerm_dev_center_project":                                resourceArmDevCenterProject(),
+			"azurerm_dev_center_project_environment_type":               resourceArmDevCenterProjectEnvironmentType(),
+			"azurerm_dev_test_formula":                                  resourceArmDevTestFormula(),
+			"azurerm_dev_test_lab":                                      resourceArmDevTestLab(),
+			"azurerm_dev_test_linux_virtual_machine":                    resourceArmDevTestLinuxVirtualMachine(),
+			"azurerm_dev_test_notification_channel":                     resourceArmDevTestNotificationChannel(),
+			"azurerm_dev_test_policy":                                   resourceArmDevTestPolicy(),
+			"azurerm_dev_test_target_cost":                              resourceArmDevTestTargetCost(),
+			"azurerm_dev_test_virtual_network":                          resourceArmDevTestVirtualNetwork(),
+			"azurerm_dev_test_windows_virtual_machine":                  resourceArmDevTestWindowsVirtualMachine(),
+			"azurerm_devspace_controller":                               resourceArmDevSpaceController(),
+			"azurerm_dns_a_record":                                      resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                                   resourceArmDnsAAAARecord(),
+			"azurerm_dns_caa_record":                                    resourceArmDnsCaaRecord(),
+			"azurerm_dns_cname_record":                                  resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                                     resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                                     resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                                    resourceArmDnsPtrRecord(),
+			"azurerm_dns_security_policy":                               resourceArmDnsSecurityPolicy(),
+			"azurerm_dns_security_policy_domain_list":                   resourceArmDnsSecurityPolicyDomainList(),
+			"azurerm_dns_security_policy_rule":                          resourceArmDnsSecurityPolicyRule(),
+			"azurerm_dns_security_policy_virtual_network_link":          resourceArmDnsSecurityPolicyVirtualNetworkLink(),
+			"azurerm_dns_srv_record":                                    resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                                    resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                          resourceArmDnsZone(),
+			"azurerm_elastic_san":                                       resourceArmElasticSan(),
+			"azurerm_elastic_san_volume":                                resourceArmElasticSanVolume(),
+			"azurerm_elastic_san_volume_group":                          resourceArmElasticSanVolumeGroup(),
+			"azurerm_eventgrid_topic":                                   resourceArmEventGridTopic(),
+			"azurerm_eventhub_authorization_rule":                       resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                           resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace_authorization_rule":             resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_eventhub_namespace":                                resourceArmEventHubNamespace(),
+			"azurerm_eventhub":                                          resourceArmEventHub(),
+			"azurerm_express_route_circuit_authorization":               resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":                     resourceArmExpressRouteCircuitPeering(),
+			"azurerm_express_route_circuit":                             resourceArmExpressRouteCircuit(),
+			"azurerm_firewall_application_rule_collection":              resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_network_rule_collection":                  resourceArmFirewallNetworkRuleCollection(),
+			"azurerm_firewall":                                          resourceArmFirewall(),
+			"azurerm_fluid_relay":                                       resourceArmFluidRelay(),
+			"azurerm_function_app":                                      resourceArmFunctionApp(),
+			"azurerm_grafana":                                           resourceArmGrafana(),
+			"azurerm_healthcare_dicom_service":                          resourceArmHealthcareDicomService(),
+			"azurerm_healthcare_fhir_service":                           resourceArmHealthcareFhirService(),
+			"azurerm_healthcare_medtech_service":                        resourceArmHealthcareMedTechService(),
+			"azurerm_healthcare_workspace":                              resourceArmHealthcareWorkspace(),
+			"azurerm_image":                                             resourceArmImage(),
+			"azurerm_iot_central_application":                           resourceArmIotCentralApplication(),
+			"azurerm_iothub_consumer_group":                             resourceArmIotHubConsumerGroup(),
+			"azurerm_iothub":                                            resourceArmIotHub(),
+			"azurerm_key_vault_access_policies":                         resourceArmKeyVaultAccessPolicies(),
+			"azurerm_key_vault_access_policy":                           resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                             resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                                     resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                                  resourceArmKeyVaultSecret(),
+			"azurerm_key_vault":                                         resourceArmKeyVault(),
+			"azurerm_kubernetes_cluster":                                resourceArmKubernetesCluster(),
+			"azurerm_lb_backend_address_pool":                           resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_pool":                                       resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_nat_rule":                                       resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_probe":                                          resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                           resourceArmLoadBalancerRule(),
+			"azurerm_lb":                                                resourceArmLoadBalancer(),
+			"azurerm_local_network_gateway":                             resourceArmLocalNetworkGateway(),
+			"azurerm_log_analytics_solution":                            resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_linked_service":                      resourceArmLogAnalyticsLinkedService(),
+			"azurerm_log_analytics_workspace_linked_service":            resourceArmLogAnalyticsWorkspaceLinkedService(),
+			"azurerm_log_analytics_workspace":                           resourceArmLogAnalyticsWorkspace(),
+			"azurerm_logic_app_action_custom":                           resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                             resourceArmLogicAppActionHTTP(),
+			"azurerm_logic_app_trigger_custom":                          resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":                    resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":                      resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                                resourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                                      resourceArmManagedDisk(),
+			"azurerm_managed_hsm":                                       resourceArmManagedHsm(),
+			"azurerm_managed_hsm_key":                                   resourceArmManagedHsmKey(),
+			"azurerm_managed_hsm_role_assignment":                       resourceArmManagedHsmRoleAssignment(),
+			"azurerm_management_group":                                  resourceArmManagementGroup(),
+			"azurerm_management_lock":                                   resourceArmManagementLock(),
+			"azurerm_mariadb_database":                                  resourceArmMariaDbDatabase(),
+			"azurerm_mariadb_server":                                    resourceArmMariaDbServer(),
+			"azurerm_marketplace_private_offer":                         resourceArmMarketplacePrivateOffer(),
+			"azurerm_marketplace_private_store":                         resourceArmMarketplacePrivateStore(),
+			"azurerm_marketplace_private_store_collection":              resourceArmMarketplacePrivateStoreCollection(),
+			"azurerm_metric_alertrule":                                  resourceArmMetricAlertRule(),
+			"azurerm_mobile_network":                                    resourceArmMobileNetwork(),
+			"azurerm_mobile_network_data_network":                       resourceArmMobileNetworkDataNetwork(),
+			"azurerm_mobile_network_packet_core_control_plane":          resourceArmMobileNetworkPacketCoreControlPlane(),
+			"azurerm_mobile_network_sim":                                resourceArmMobileNetworkSim(),
+			"azurerm_mobile_network_sim_group":                          resourceArmMobileNetworkSimGroup(),
+			"azurerm_mobile_network_site":                               resourceArmMobileNetworkSite(),
+			"azurerm_monitor_autoscale_setting":                         resourceArmMonitorAutoScaleSetting(),
+			"azurerm_monitor_action_group":                              resourceArmMonitorActionGroup(),
+			"azurerm_monitor_activity_log_alert":                        resourceArmMonitorActivityLogAlert(),
+			"azurerm_monitor_alert_prometheus_rule_group":               resourceArmMonitorAlertPrometheusRuleGroup(),
+			"azurerm_monitor_diagnostic_setting":                        resourceArmMonitorDiagnosticSetting(),
+			"azurerm_monitor_log_profile":                               resourceArmMonitorLogProfile(),
+			"azurerm_monitor_metric_alert":                              resourceArmMonitorMetricAlert(),
+			"azurerm_monitor_metric_alertrule":                          resourceArmMonitorMetricAlertRule(),
+			"azurerm_monitor_workspace":                                 resourceArmMonitorWorkspace(),
+			"azurerm_mssql_database_backup_long_term_retention_policy":  resourceArmMsSqlDatabaseBackupLongTermRetentionPolicy(),
+			"azurerm_mssql_database_backup_short_term_retention_policy": resourceArmMsSqlDatabaseBackupShortTermRetentionPolicy(),
+			"azurerm_mssql_database_replication_link":                   resourceArmMsSqlDatabaseReplicationLink(),
+			"azurerm_mssql_database_workload_classifier":                resourceArmMsSqlDatabaseWorkloadClassifier(),
+			"azurerm_mssql_database_workload_group":                     resourceArmMsSqlDatabaseWorkloadGroup(),
+			"azurerm_mssql_elasticpool":                                 resourceArmMsSqlElasticPool(),
+			"azurerm_mssql_failover_group":                              resourceArmMsSqlFailoverGroup(),
+			"azurerm_mssql_instance_pool":                               resourceArmMsSqlInstancePool(),
+			"azurerm_mssql_outbound_firewall_rule":                      resourceArmMsSqlOutboundFirewallRule(),
+			"azurerm_mssql_server_dns_alias":                            resourceArmMsSqlServerDnsAlias(),
+			"azurerm_mssql_sync_group":                                  resourceArmMsSqlSyncGroup(),
+			"azurerm_mssql_sync_group_schema":                           resourceArmMsSqlSyncGroupSchema(),
+			"azurerm_mssql_sync_member":                                 resourceArmMsSqlSyncMember(),
+			"azurerm_mysql_configuration":                               resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                                    resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                               resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                                      resourceArmMySqlServer(),
+			"azurerm_mysql_virtual_network_rule":                        resourceArmMySqlVirtualNetworkRule(),
 			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
 			"azurerm_network_interface":                                                      resourceArmNetworkInterface(),
+			"azurerm_network_profile":                                                        resourceArmNetworkProfile(),
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+			"azurerm_nginx_certificate":                                                      resourceArmNginxCertificate(),
+			"azurerm_nginx_configuration":                                                    resourceArmNginxConfiguration(),
+			"azurerm_nginx_deployment":                                                       resourceArmNginxDeployment(),
 			"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
 			"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
 			"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
@@ -291,6 +503,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_endpoint":                                                       resourceArmPrivateEndpoint(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
 			"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
@@ -298,7 +511,10 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 			"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
 			"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
+			"azurerm_reservation_scope":                                                      resourceArmReservationScope(),
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+			"azurerm_resource_mover_move_collection":                                         resourceArmResourceMoverMoveCollection(),
+			"azurerm_resource_mover_move_resource":                                           resourceArmResourceMoverMoveResource(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
@@ -310,6 +526,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_security_center_subscription_pricing":                                   resourceArmSecurityCenterSubscriptionPricing(),
 			"azurerm_security_center_workspace":                                              resourceArmSecurityCenterWorkspace(),
 			"azurerm_service_fabric_cluster":                                                 resourceArmServiceFabricCluster(),
+			"azurerm_service_fabric_managed_cluster":                                         resourceArmServiceFabricManagedCluster(),
+			"azurerm_service_plan":                                                           resourceArmServicePlan(),
 			"azurerm_servicebus_namespace_authorization_rule":                                resourceArmServiceBusNamespaceAuthorizationRule(),
 			"azurerm_servicebus_namespace":                                                   resourceArmServiceBusNamespace(),
 			"azurerm_servicebus_queue_authorization_rule":                                    resourceArmServiceBusQueueAuthorizationRule(),
@@ -322,9 +540,11 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_shared_image_version":                                                   resourceArmSharedImageVersion(),
 			"azurerm_shared_image":                                                           resourceArmSharedImage(),
 			"azurerm_signalr_service":                                                        resourceArmSignalRService(),
+			"azurerm_site_recovery_recovery_plan":                                            resourceArmSiteRecoveryRecoveryPlan(),
 			"azurerm_snapshot":                                                               resourceArmSnapshot(),
 			"azurerm_sql_active_directory_administrator":                                     resourceArmSqlAdministrator(),
 			"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
+			"azurerm_sql_database_threat_detection_export":                                   resourceArmSqlDatabaseThreatDetectionExport(),
 			"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
 			"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
 			"azurerm_sql_server":                                                             resourceArmSqlServer(),
@@ -334,14 +554,26 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
+			"azurerm_storage_sync":                                                           resourceArmStorageSync(),
+			"azurerm_storage_sync_cloud_endpoint":                                            resourceArmStorageSyncCloudEndpoint(),
+			"azurerm_storage_sync_group":                                                     resourceArmStorageSyncGroup(),
+			"azurerm_storage_sync_server_endpoint":                                           resourceArmStorageSyncServerEndpoint(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
+			"azurerm_traffic_controller":                                                     resourceArmTrafficController(),
+			"azurerm_traffic_controller_association":                                         resourceArmTrafficControllerAssociation(),
+			"azurerm_traffic_controller_frontend":                                            resourceArmTrafficControllerFrontend(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_desktop_application_group":                                      resourceArmVirtualDesktopApplicationGroup(),
+			"azurerm_virtual_desktop_host_pool":                                              resourceArmVirtualDesktopHostPool(),
+			"azurerm_virtual_desktop_msix_package":                                           resourceArmVirtualDesktopMsixPackage(),
+			"azurerm_virtual_desktop_scaling_plan":                                           resourceArmVirtualDesktopScalingPlan(),
+			"azurerm_virtual_desktop_workspace":                                              resourceArmVirtualDesktopWorkspace(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
@@ -350,14 +582,84 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_web_pubsub":                                                             resourceArmWebPubSub(),
+			"azurerm_web_pubsub_hub":                                                         resourceArmWebPubSubHub(),
 		},
 	}
 
+	// surface the Correlation Request ID (and the details of the failed request) in any errors, so that failed
+	// requests can be located without needing to enable debug logging
+	for _, r := range p.DataSourcesMap {
+		decorateResourceErrorsWithCorrelationRequestID(r)
+	}
+	for _, r := range p.ResourcesMap {
+		decorateResourceErrorsWithCorrelationRequestID(r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
 }
 
+// decorateResourceErrorsWithCorrelationRequestID wraps the CRUD functions for the specified resource so that
+// any errors returned include the Correlation Request ID sent with each request made by the Provider, along with
+// the details of the failed request (where known)
+func decorateResourceErrorsWithCorrelationRequestID(r *schema.Resource) {
+	r.Create = withCorrelationRequestIDError(r.Create)
+	r.Read = withCorrelationRequestIDError(r.Read)
+	r.Update = withCorrelationRequestIDError(r.Update)
+	r.Delete = withCorrelationRequestIDError(r.Delete)
+}
+
+func withCorrelationRequestIDError(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+
+		correlationId := azure.CorrelationRequestID()
+		if strings.Contains(err.Error(), correlationId) {
+			return err
+		}
+
+		// where the request which failed is known, surface the details returned from Azure in a consistent format
+		if diagnostics := azure.ErrorDiagnosticsFromError(err); diagnostics != nil {
+			return fmt.Errorf("%+v\n\n%s", err, diagnostics)
+		}
+
+		return fmt.Errorf("%+v\n\nCorrelation Request ID: %s", err, correlationId)
+	}
+}
+
+// expandProviderRequestAnnotations populates the annotations which are stamped onto the Client Request ID of each request
+func expandProviderRequestAnnotations(input []interface{}, annotations *azure.RequestAnnotations) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	annotations.ClientRequestIDPrefix = v["client_request_id_prefix"].(string)
+
+	tags := make(map[string]string)
+	for key, value := range v["tags"].(map[string]interface{}) {
+		for _, s := range []string{key, value.(string)} {
+			if _, errors := azure.ValidateRequestAnnotation(s, "request_annotations.0.tags"); len(errors) > 0 {
+				return errors[0]
+			}
+		}
+
+		tags[key] = value.(string)
+	}
+	annotations.Tags = tags
+
+	return nil
+}
+
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		builder := &authentication.Builder{
@@ -383,14 +685,44 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		partnerId := d.Get("partner_id").(string)
+		if partnerId == "" && !d.Get("disable_terraform_partner_id").(bool) {
+			partnerId = terraformPartnerId
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		userAgentSuffix := strings.TrimSpace(d.Get("user_agent_suffix").(string))
+		pollingOptions := azure.DefaultPollingOptions()
+		pollingOptions.Interval = time.Duration(d.Get("polling_interval").(int)) * time.Second
+		pollingOptions.Timeout = time.Duration(d.Get("long_running_operation_timeout").(int)) * time.Minute
+		connectionOptions := &azure.ConnectionOptions{
+			MaxIdleConnectionsPerHost: d.Get("max_idle_connections_per_host").(int),
+		}
+
+		client, err := getArmClient(config, armClientOptions{
+			skipProviderRegistration: skipProviderRegistration,
+			partnerId:                partnerId,
+			userAgentSuffix:          userAgentSuffix,
+			pollingOptions:           pollingOptions,
+			connectionOptions:        connectionOptions,
+		})
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.enableCostEstimation = d.Get("enable_cost_estimation").(bool)
+		client.validateNameAvailability = d.Get("validate_name_availability").(bool)
+		client.retryOptions.MaxRetries = d.Get("max_retries").(int)
+		client.retryOptions.Backoff = time.Duration(d.Get("retry_backoff_seconds").(int)) * time.Second
+		client.relaxedMsSqlSkuValidation = d.Get("features.0.mssql.0.relaxed_sku_validation").(bool)
+		client.recoverDroppedMsSqlDatabases = d.Get("features.0.mssql.0.recover_dropped_databases").(bool)
+		client.deleteUnusedNetworkProfiles = d.Get("features.0.network.0.delete_unused_network_profiles_on_subnet_destroy").(bool)
+		client.configureMsSqlApiVersion(d.Get("features.0.mssql.0.api_version").(string))
+
+		if err := expandProviderRequestAnnotations(d.Get("request_annotations").([]interface{}), client.requestAnnotations); err != nil {
+			return nil, err
+		}
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	helpersAzure "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -33,6 +35,34 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProvider_correlationRequestIDErrors(t *testing.T) {
+	correlationId := helpersAzure.CorrelationRequestID()
+
+	failing := withCorrelationRequestIDError(func(d *schema.ResourceData, meta interface{}) error {
+		return fmt.Errorf("Error making Read request")
+	})
+	err := failing(nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "Error making Read request") || !strings.Contains(err.Error(), correlationId) {
+		t.Fatalf("Expected the error to contain the original message and the Correlation Request ID %q but got: %+v", correlationId, err)
+	}
+
+	// errors which already contain the Correlation Request ID shouldn't be decorated twice
+	if err2 := withCorrelationRequestIDError(failing)(nil, nil); err2.Error() != err.Error() {
+		t.Fatalf("Expected the error to only be decorated once but got: %+v", err2)
+	}
+
+	succeeding := withCorrelationRequestIDError(func(d *schema.ResourceData, meta interface{}) error {
+		return nil
+	})
+	if err := succeeding(nil, nil); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if withCorrelationRequestIDError(nil) != nil {
+		t.Fatalf("Expected a nil function to remain nil")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_CLIENT_ID",
@@ -104,3 +134,33 @@ func testRequiresImportError(resourceName string) *regexp.Regexp {
 	message := "to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
 	return regexp.MustCompile(fmt.Sprintf(message, resourceName))
 }
+
+func TestProvider_expandRequestAnnotations(t *testing.T) {
+	annotations := helpersAzure.RequestAnnotations{}
+	input := []interface{}{
+		map[string]interface{}{
+			"client_request_id_prefix": "deploy-pipeline",
+			"tags": map[string]interface{}{
+				"commit_sha": "abc123",
+			},
+		},
+	}
+	if err := expandProviderRequestAnnotations(input, &annotations); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if annotations.ClientRequestIDPrefix != "deploy-pipeline" || annotations.Tags["commit_sha"] != "abc123" {
+		t.Fatalf("Expected the annotations to be populated but got: %+v", annotations)
+	}
+
+	invalid := []interface{}{
+		map[string]interface{}{
+			"client_request_id_prefix": "",
+			"tags": map[string]interface{}{
+				"commit_sha": "abc;123",
+			},
+		},
+	}
+	if err := expandProviderRequestAnnotations(invalid, &helpersAzure.RequestAnnotations{}); err == nil {
+		t.Fatalf("Expected an error for a tag containing a `;` but didn't get one")
+	}
+}
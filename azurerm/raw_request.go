@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// armRawGet retrieves the resource with the specified ID using the specified API Version, unmarshalling the
+// response into `result` - which allows properties not present in the vendored SDK to be read.
+func armRawGet(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string, result interface{}) (*http.Response, error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request for %q: %+v", id, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return resp, fmt.Errorf("Error sending request for %q: %+v", id, err)
+	}
+
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, fmt.Errorf("Error parsing response for %q: %+v", id, err)
+	}
+
+	return resp, nil
+}
+
+// armRawPatch updates the resource with the specified ID using the specified API Version, waiting for any
+// long-running operation to complete - which allows properties not present in the vendored SDK to be set.
+func armRawPatch(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string, body interface{}) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(id),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request for %q: %+v", id, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request for %q: %+v", id, err)
+	}
+
+	if err = autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted)); err != nil {
+		return fmt.Errorf("Error updating %q: %+v", id, err)
+	}
+
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error parsing response for %q: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client); err != nil {
+		return fmt.Errorf("Error waiting for update of %q: %+v", id, err)
+	}
+
+	return nil
+}
+
+// armRawPut creates or updates the resource with the specified ID using the specified API Version, waiting for any
+// long-running operation to complete - which allows properties not present in the vendored SDK to be set when the
+// resource doesn't support PATCH'ing them.
+func armRawPut(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string, body interface{}) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(id),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request for %q: %+v", id, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request for %q: %+v", id, err)
+	}
+
+	if err = autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusAccepted)); err != nil {
+		return fmt.Errorf("Error creating/updating %q: %+v", id, err)
+	}
+
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error parsing response for %q: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of %q: %+v", id, err)
+	}
+
+	return nil
+}
+
+// armRawPost invokes the action at the specified path (such as `{id}/stop`) using the specified API Version, waiting for
+// any long-running operation to complete - which allows actions not present in the vendored SDK to be performed.
+func armRawPost(ctx context.Context, client autorest.Client, baseURI string, path string, apiVersion string) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsPost(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request for %q: %+v", path, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request for %q: %+v", path, err)
+	}
+
+	if err = autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent)); err != nil {
+		return fmt.Errorf("Error invoking %q: %+v", path, err)
+	}
+
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error parsing response for %q: %+v", path, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client); err != nil {
+		return fmt.Errorf("Error waiting for %q to complete: %+v", path, err)
+	}
+
+	return nil
+}
+
+// armRawPostWithBody invokes the action at the specified path (such as `{id}/prepare`) with the specified JSON body
+// using the specified API Version, waiting for any long-running operation to complete.
+func armRawPostWithBody(ctx context.Context, client autorest.Client, baseURI string, path string, apiVersion string, body interface{}) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(path),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request for %q: %+v", path, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request for %q: %+v", path, err)
+	}
+
+	if err = autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent)); err != nil {
+		return fmt.Errorf("Error invoking %q: %+v", path, err)
+	}
+
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error parsing response for %q: %+v", path, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client); err != nil {
+		return fmt.Errorf("Error waiting for %q to complete: %+v", path, err)
+	}
+
+	return nil
+}
+
+// armRawPostWithResult invokes the synchronous action at the specified path (such as `{id}/listKeys`) with the specified
+// JSON body using the specified API Version, unmarshalling the response into `result`.
+func armRawPostWithResult(ctx context.Context, client autorest.Client, baseURI string, path string, apiVersion string, body interface{}, result interface{}) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(path),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request for %q: %+v", path, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request for %q: %+v", path, err)
+	}
+
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return fmt.Errorf("Error invoking %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// armRawDelete deletes the resource with the specified ID using the specified API Version, waiting for any
+// long-running operation to complete - which allows resources not present in the vendored SDK to be deleted.
+// The response is returned so that callers can determine whether the resource was already gone.
+func armRawDelete(ctx context.Context, client autorest.Client, baseURI string, id string, apiVersion string) (*http.Response, error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request for %q: %+v", id, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return resp, fmt.Errorf("Error sending request for %q: %+v", id, err)
+	}
+
+	if err = autorest.Respond(resp, az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent)); err != nil {
+		return resp, fmt.Errorf("Error deleting %q: %+v", id, err)
+	}
+
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		return resp, fmt.Errorf("Error parsing response for %q: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client); err != nil {
+		return future.Response(), fmt.Errorf("Error waiting for deletion of %q: %+v", id, err)
+	}
+
+	return resp, nil
+}
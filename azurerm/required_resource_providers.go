@@ -16,47 +16,55 @@ import (
 func requiredResourceProviders() map[string]struct{} {
 	// NOTE: Resource Providers in this list are case sensitive
 	return map[string]struct{}{
-		"Microsoft.ApiManagement":        {},
-		"Microsoft.Authorization":        {},
-		"Microsoft.Automation":           {},
-		"Microsoft.Cache":                {},
-		"Microsoft.Cdn":                  {},
-		"Microsoft.CognitiveServices":    {},
-		"Microsoft.Compute":              {},
-		"Microsoft.ContainerInstance":    {},
-		"Microsoft.ContainerRegistry":    {},
-		"Microsoft.ContainerService":     {},
-		"Microsoft.Databricks":           {},
-		"Microsoft.DataLakeAnalytics":    {},
-		"Microsoft.DataLakeStore":        {},
-		"Microsoft.DBforMySQL":           {},
-		"Microsoft.DBforPostgreSQL":      {},
-		"Microsoft.Devices":              {},
-		"Microsoft.DevSpaces":            {},
-		"Microsoft.DevTestLab":           {},
-		"Microsoft.DocumentDB":           {},
-		"Microsoft.EventGrid":            {},
-		"Microsoft.EventHub":             {},
-		"Microsoft.KeyVault":             {},
-		"microsoft.insights":             {},
-		"Microsoft.Logic":                {},
-		"Microsoft.ManagedIdentity":      {},
-		"Microsoft.Management":           {},
-		"Microsoft.Network":              {},
-		"Microsoft.NotificationHubs":     {},
-		"Microsoft.OperationalInsights":  {},
-		"Microsoft.OperationsManagement": {},
-		"Microsoft.Relay":                {},
-		"Microsoft.RecoveryServices":     {},
-		"Microsoft.Resources":            {},
-		"Microsoft.Scheduler":            {},
-		"Microsoft.Search":               {},
-		"Microsoft.Security":             {},
-		"Microsoft.ServiceBus":           {},
-		"Microsoft.ServiceFabric":        {},
-		"Microsoft.Sql":                  {},
-		"Microsoft.Storage":              {},
-		"Microsoft.Web":                  {},
+		"Microsoft.AlertsManagement":      {},
+		"Microsoft.ApiManagement":         {},
+		"Microsoft.Authorization":         {},
+		"Microsoft.Automation":            {},
+		"Microsoft.Cache":                 {},
+		"Microsoft.Cdn":                   {},
+		"Microsoft.CognitiveServices":     {},
+		"Microsoft.Compute":               {},
+		"Microsoft.ContainerInstance":     {},
+		"Microsoft.ContainerRegistry":     {},
+		"Microsoft.ContainerService":      {},
+		"Microsoft.Dashboard":             {},
+		"Microsoft.Databricks":            {},
+		"Microsoft.DataLakeAnalytics":     {},
+		"Microsoft.DataLakeStore":         {},
+		"Microsoft.DBforMySQL":            {},
+		"Microsoft.DBforPostgreSQL":       {},
+		"Microsoft.DesktopVirtualization": {},
+		"Microsoft.Devices":               {},
+		"Microsoft.DevSpaces":             {},
+		"Microsoft.DevTestLab":            {},
+		"Microsoft.DocumentDB":            {},
+		"Microsoft.EventGrid":             {},
+		"Microsoft.EventHub":              {},
+		"Microsoft.HealthcareApis":        {},
+		"Microsoft.IoTCentral":            {},
+		"Microsoft.KeyVault":              {},
+		"microsoft.insights":              {},
+		"Microsoft.Logic":                 {},
+		"Microsoft.ManagedIdentity":       {},
+		"Microsoft.Management":            {},
+		"Microsoft.Monitor":               {},
+		"Microsoft.Network":               {},
+		"Microsoft.NotificationHubs":      {},
+		"Microsoft.OperationalInsights":   {},
+		"Microsoft.OperationsManagement":  {},
+		"Microsoft.Relay":                 {},
+		"Microsoft.RecoveryServices":      {},
+		"Microsoft.Resources":             {},
+		"Microsoft.Scheduler":             {},
+		"Microsoft.Search":                {},
+		"Microsoft.Security":              {},
+		"Microsoft.ServiceBus":            {},
+		"Microsoft.ServiceFabric":         {},
+		"Microsoft.Sql":                   {},
+		"Microsoft.Storage":               {},
+		"Microsoft.StorageSync":           {},
+		"Microsoft.Web":                   {},
+		"Nginx.NginxPlus":                 {},
 	}
 }
 
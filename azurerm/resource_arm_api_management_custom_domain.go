@@ -0,0 +1,468 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the vendored SDK predates the `identityClientId` property on Hostname Configurations, which is required to
+// retrieve Key Vault Certificates using a User Assigned Identity - so these are read & updated using a newer API Version
+const apiManagementCustomDomainApiVersion = "2019-12-01"
+
+type apiManagementCustomDomainService struct {
+	Properties *apiManagementCustomDomainServiceProperties `json:"properties,omitempty"`
+}
+
+type apiManagementCustomDomainServiceProperties struct {
+	GatewayURL             *string                                           `json:"gatewayUrl,omitempty"`
+	HostnameConfigurations *[]apiManagementCustomDomainHostnameConfiguration `json:"hostnameConfigurations,omitempty"`
+}
+
+type apiManagementCustomDomainHostnameConfiguration struct {
+	Type                       apimanagement.HostnameType `json:"type,omitempty"`
+	HostName                   *string                    `json:"hostName,omitempty"`
+	KeyVaultID                 *string                    `json:"keyVaultId,omitempty"`
+	IdentityClientID           *string                    `json:"identityClientId,omitempty"`
+	EncodedCertificate         *string                    `json:"encodedCertificate,omitempty"`
+	CertificatePassword        *string                    `json:"certificatePassword,omitempty"`
+	DefaultSslBinding          *bool                      `json:"defaultSslBinding,omitempty"`
+	NegotiateClientCertificate *bool                      `json:"negotiateClientCertificate,omitempty"`
+}
+
+func resourceArmApiManagementCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementCustomDomainCreateUpdate,
+		Read:   resourceArmApiManagementCustomDomainRead,
+		Update: resourceArmApiManagementCustomDomainCreateUpdate,
+		Delete: resourceArmApiManagementCustomDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_management_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"management": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(),
+				},
+			},
+
+			"portal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(),
+				},
+			},
+
+			"gateway": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainGatewayHostnameSchema(),
+				},
+			},
+
+			"scm": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiManagementCustomDomainHostnameSchema(),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementCustomDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	serviceId := d.Get("api_management_id").(string)
+	id, err := parseAzureResourceID(serviceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	var existing apiManagementCustomDomainService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, &existing)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return fmt.Errorf("Error: API Management Service %q (Resource Group %q) was not found", serviceName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	defaultHostnames := apiManagementCustomDomainDefaultHostnames(existing)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if len(apiManagementCustomDomainCustomHostnames(existing, defaultHostnames)) > 0 {
+			return tf.ImportAsExistsError("azurerm_api_management_custom_domain", apiManagementCustomDomainID(serviceId))
+		}
+	}
+
+	hostnameConfigurations, err := expandArmApiManagementCustomDomainHostnames(d)
+	if err != nil {
+		return err
+	}
+
+	// the default Hostnames (e.g. `example.azure-api.net`) have to be sent along with the Custom Domains
+	hostnameConfigurations = append(defaultHostnames, hostnameConfigurations...)
+
+	parameters := apiManagementCustomDomainService{
+		Properties: &apiManagementCustomDomainServiceProperties{
+			HostnameConfigurations: &hostnameConfigurations,
+		},
+	}
+
+	log.Printf("[DEBUG] Updating the Custom Domains for API Management Service %q (Resource Group %q)..", serviceName, resourceGroup)
+	if err := armRawPatch(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error updating the Custom Domains for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementCustomDomainID(serviceId))
+
+	return resourceArmApiManagementCustomDomainRead(d, meta)
+}
+
+func resourceArmApiManagementCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	serviceId := strings.TrimSuffix(d.Id(), "/customDomains/default")
+
+	var service apiManagementCustomDomainService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, &service)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] API Management Service %q (Resource Group %q) was not found - removing Custom Domains from state", serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	d.Set("api_management_id", serviceId)
+
+	customHostnames := apiManagementCustomDomainCustomHostnames(service, apiManagementCustomDomainDefaultHostnames(service))
+
+	management := make([]interface{}, 0)
+	portal := make([]interface{}, 0)
+	gateway := make([]interface{}, 0)
+	scm := make([]interface{}, 0)
+	for _, config := range customHostnames {
+		switch strings.ToLower(string(config.Type)) {
+		case strings.ToLower(string(apimanagement.Management)):
+			management = append(management, flattenArmApiManagementCustomDomainHostname(config, d, "management"))
+		case strings.ToLower(string(apimanagement.Portal)):
+			portal = append(portal, flattenArmApiManagementCustomDomainHostname(config, d, "portal"))
+		case strings.ToLower(string(apimanagement.Proxy)):
+			gateway = append(gateway, flattenArmApiManagementCustomDomainHostname(config, d, "gateway"))
+		case strings.ToLower(string(apimanagement.Scm)):
+			scm = append(scm, flattenArmApiManagementCustomDomainHostname(config, d, "scm"))
+		}
+	}
+
+	if err := d.Set("management", management); err != nil {
+		return fmt.Errorf("Error setting `management`: %+v", err)
+	}
+	if err := d.Set("portal", portal); err != nil {
+		return fmt.Errorf("Error setting `portal`: %+v", err)
+	}
+	if err := d.Set("gateway", gateway); err != nil {
+		return fmt.Errorf("Error setting `gateway`: %+v", err)
+	}
+	if err := d.Set("scm", scm); err != nil {
+		return fmt.Errorf("Error setting `scm`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	serviceId := strings.TrimSuffix(d.Id(), "/customDomains/default")
+
+	var service apiManagementCustomDomainService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, &service)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	// removing the Custom Domains means only the default Hostnames remain
+	hostnameConfigurations := apiManagementCustomDomainDefaultHostnames(service)
+	parameters := apiManagementCustomDomainService{
+		Properties: &apiManagementCustomDomainServiceProperties{
+			HostnameConfigurations: &hostnameConfigurations,
+		},
+	}
+
+	log.Printf("[DEBUG] Removing the Custom Domains from API Management Service %q (Resource Group %q)..", serviceName, resourceGroup)
+	if err := armRawPatch(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error removing the Custom Domains from API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func apiManagementCustomDomainID(serviceId string) string {
+	return fmt.Sprintf("%s/customDomains/default", serviceId)
+}
+
+// apiManagementCustomDomainDefaultHostnames returns the Hostnames assigned by Azure (e.g. `example.azure-api.net`)
+// which can't be removed from the API Management Service
+func apiManagementCustomDomainDefaultHostnames(input apiManagementCustomDomainService) []apiManagementCustomDomainHostnameConfiguration {
+	results := make([]apiManagementCustomDomainHostnameConfiguration, 0)
+	if input.Properties == nil || input.Properties.HostnameConfigurations == nil || input.Properties.GatewayURL == nil {
+		return results
+	}
+
+	gatewayUrl, err := url.Parse(*input.Properties.GatewayURL)
+	if err != nil {
+		return results
+	}
+
+	for _, config := range *input.Properties.HostnameConfigurations {
+		if config.HostName != nil && strings.EqualFold(*config.HostName, gatewayUrl.Host) {
+			results = append(results, apiManagementCustomDomainHostnameConfiguration{
+				Type:              config.Type,
+				HostName:          config.HostName,
+				DefaultSslBinding: config.DefaultSslBinding,
+			})
+		}
+	}
+
+	return results
+}
+
+func apiManagementCustomDomainCustomHostnames(input apiManagementCustomDomainService, defaults []apiManagementCustomDomainHostnameConfiguration) []apiManagementCustomDomainHostnameConfiguration {
+	results := make([]apiManagementCustomDomainHostnameConfiguration, 0)
+	if input.Properties == nil || input.Properties.HostnameConfigurations == nil {
+		return results
+	}
+
+	for _, config := range *input.Properties.HostnameConfigurations {
+		if config.HostName == nil {
+			continue
+		}
+
+		isDefault := false
+		for _, v := range defaults {
+			if strings.EqualFold(*v.HostName, *config.HostName) {
+				isDefault = true
+				break
+			}
+		}
+
+		if !isDefault {
+			results = append(results, config)
+		}
+	}
+
+	return results
+}
+
+func expandArmApiManagementCustomDomainHostnames(d *schema.ResourceData) ([]apiManagementCustomDomainHostnameConfiguration, error) {
+	results := make([]apiManagementCustomDomainHostnameConfiguration, 0)
+
+	blocks := map[string]apimanagement.HostnameType{
+		"management": apimanagement.Management,
+		"portal":     apimanagement.Portal,
+		"gateway":    apimanagement.Proxy,
+		"scm":        apimanagement.Scm,
+	}
+
+	for _, blockName := range []string{"management", "portal", "gateway", "scm"} {
+		for _, raw := range d.Get(blockName).([]interface{}) {
+			v := raw.(map[string]interface{})
+			hostName := v["host_name"].(string)
+			keyVaultId := v["key_vault_id"].(string)
+			certificate := v["certificate"].(string)
+			certificatePassword := v["certificate_password"].(string)
+			identityClientId := v["ssl_keyvault_identity_client_id"].(string)
+
+			if keyVaultId == "" && certificate == "" {
+				return nil, fmt.Errorf("Either `key_vault_id` or `certificate` must be specified for the %s Hostname %q", blockName, hostName)
+			}
+
+			if keyVaultId != "" && (certificate != "" || certificatePassword != "") {
+				return nil, fmt.Errorf("`key_vault_id` cannot be specified with `certificate` or `certificate_password` for the %s Hostname %q", blockName, hostName)
+			}
+
+			if identityClientId != "" && keyVaultId == "" {
+				return nil, fmt.Errorf("`ssl_keyvault_identity_client_id` can only be specified with `key_vault_id` for the %s Hostname %q", blockName, hostName)
+			}
+
+			output := apiManagementCustomDomainHostnameConfiguration{
+				Type:                       blocks[blockName],
+				HostName:                   utils.String(hostName),
+				NegotiateClientCertificate: utils.Bool(v["negotiate_client_certificate"].(bool)),
+			}
+
+			if keyVaultId != "" {
+				output.KeyVaultID = utils.String(keyVaultId)
+			}
+
+			if identityClientId != "" {
+				output.IdentityClientID = utils.String(identityClientId)
+			}
+
+			if certificate != "" {
+				output.EncodedCertificate = utils.String(certificate)
+			}
+
+			if certificatePassword != "" {
+				output.CertificatePassword = utils.String(certificatePassword)
+			}
+
+			if blockName == "gateway" {
+				output.DefaultSslBinding = utils.Bool(v["default_ssl_binding"].(bool))
+			}
+
+			results = append(results, output)
+		}
+	}
+
+	return results, nil
+}
+
+func flattenArmApiManagementCustomDomainHostname(input apiManagementCustomDomainHostnameConfiguration, d *schema.ResourceData, blockName string) map[string]interface{} {
+	output := map[string]interface{}{
+		"host_name":                       "",
+		"key_vault_id":                    "",
+		"ssl_keyvault_identity_client_id": "",
+		"certificate":                     "",
+		"certificate_password":            "",
+		"negotiate_client_certificate":    false,
+	}
+
+	if input.HostName != nil {
+		output["host_name"] = *input.HostName
+	}
+
+	if input.KeyVaultID != nil {
+		output["key_vault_id"] = *input.KeyVaultID
+	}
+
+	if input.IdentityClientID != nil {
+		output["ssl_keyvault_identity_client_id"] = *input.IdentityClientID
+	}
+
+	if input.NegotiateClientCertificate != nil {
+		output["negotiate_client_certificate"] = *input.NegotiateClientCertificate
+	}
+
+	if blockName == "gateway" {
+		defaultSslBinding := false
+		if input.DefaultSslBinding != nil {
+			defaultSslBinding = *input.DefaultSslBinding
+		}
+		output["default_ssl_binding"] = defaultSslBinding
+	}
+
+	// the certificate & password aren't returned by the API, so these are pulled from the existing state
+	for _, raw := range d.Get(blockName).([]interface{}) {
+		existing := raw.(map[string]interface{})
+		if input.HostName != nil && existing["host_name"].(string) == *input.HostName {
+			output["certificate"] = existing["certificate"]
+			output["certificate_password"] = existing["certificate_password"]
+		}
+	}
+
+	return output
+}
+
+func apiManagementCustomDomainHostnameSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"host_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+
+		"key_vault_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateKeyVaultChildId,
+		},
+
+		"ssl_keyvault_identity_client_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.UUID,
+		},
+
+		"certificate": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+
+		"certificate_password": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+
+		"negotiate_client_certificate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func apiManagementCustomDomainGatewayHostnameSchema() map[string]*schema.Schema {
+	hostnameSchema := apiManagementCustomDomainHostnameSchema()
+
+	hostnameSchema["default_ssl_binding"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true, // Azure has certain logic to set this, which we cannot predict
+	}
+
+	return hostnameSchema
+}
@@ -0,0 +1,384 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApiManagementCustomDomain_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMApiManagementCustomDomain_basic(ri, rs, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gateway.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "portal.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementCustomDomain_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_api_management_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementCustomDomain_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApiManagementCustomDomain_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_api_management_custom_domain"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementCustomDomain_update(t *testing.T) {
+	resourceName := "azurerm_api_management_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementCustomDomain_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "management.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementCustomDomain_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gateway.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "management.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scm.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementCustomDomain_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "management.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_custom_domain" {
+			continue
+		}
+
+		serviceId := rs.Primary.Attributes["api_management_id"]
+
+		var service apiManagementCustomDomainService
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, &service)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		customHostnames := apiManagementCustomDomainCustomHostnames(service, apiManagementCustomDomainDefaultHostnames(service))
+		if len(customHostnames) > 0 {
+			return fmt.Errorf("API Management Service %q still has %d Custom Domains", serviceId, len(customHostnames))
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementCustomDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		serviceId := rs.Primary.Attributes["api_management_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var service apiManagementCustomDomainService
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, serviceId, apiManagementCustomDomainApiVersion, &service)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: API Management Service %q does not exist", serviceId)
+			}
+
+			return fmt.Errorf("Bad: Get on apiManagementServiceClient: %+v", err)
+		}
+
+		customHostnames := apiManagementCustomDomainCustomHostnames(service, apiManagementCustomDomainDefaultHostnames(service))
+		if len(customHostnames) == 0 {
+			return fmt.Errorf("Bad: API Management Service %q has no Custom Domains", serviceId)
+		}
+
+		for _, v := range customHostnames {
+			if v.HostName != nil && !strings.HasSuffix(*v.HostName, ".example.com") {
+				return fmt.Errorf("Bad: API Management Service %q has an unexpected Custom Domain %q", serviceId, *v.HostName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagementCustomDomain_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMApiManagementCustomDomain_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_custom_domain" "test" {
+  api_management_id = "${azurerm_api_management.test.id}"
+
+  gateway {
+    host_name    = "api.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+
+  portal {
+    host_name    = "portal.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementCustomDomain_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMApiManagementCustomDomain_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_custom_domain" "import" {
+  api_management_id = "${azurerm_api_management_custom_domain.test.api_management_id}"
+
+  gateway {
+    host_name    = "api.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementCustomDomain_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMApiManagementCustomDomain_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_custom_domain" "test" {
+  api_management_id = "${azurerm_api_management.test.id}"
+
+  gateway {
+    host_name           = "api.example.com"
+    key_vault_id        = "${azurerm_key_vault_certificate.test.secret_id}"
+    default_ssl_binding = true
+  }
+
+  gateway {
+    host_name                    = "api2.example.com"
+    key_vault_id                 = "${azurerm_key_vault_certificate.test.secret_id}"
+    negotiate_client_certificate = true
+  }
+
+  management {
+    host_name    = "mgmt.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+
+  portal {
+    host_name    = "portal.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+
+  scm {
+    host_name    = "scm.example.com"
+    key_vault_id = "${azurerm_key_vault_certificate.test.secret_id}"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementCustomDomain_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "create",
+      "delete",
+      "get",
+      "update",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "get",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${azurerm_api_management.test.identity.0.tenant_id}"
+  object_id           = "${azurerm_api_management.test.identity.0.principal_id}"
+
+  secret_permissions = [
+    "get",
+  ]
+
+  certificate_permissions = [
+    "get",
+  ]
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name      = "acctestcert%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      extended_key_usage = ["1.3.6.1.5.5.7.3.1"]
+
+      key_usage = [
+        "digitalSignature",
+        "keyEncipherment",
+      ]
+
+      subject_alternative_names {
+        dns_names = [
+          "api.example.com",
+          "api2.example.com",
+          "mgmt.example.com",
+          "portal.example.com",
+          "scm.example.com",
+        ]
+      }
+
+      subject            = "CN=api.example.com"
+      validity_in_months = 12
+    }
+  }
+
+  depends_on = ["azurerm_key_vault_access_policy.test"]
+}
+`, rInt, location, rInt, rString, rString)
+}
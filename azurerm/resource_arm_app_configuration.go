@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// App Configuration isn't present in the vendored SDK, so is managed using raw requests
+const appConfigurationApiVersion = "2023-03-01"
+
+type appConfiguration struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Location   *string                     `json:"location,omitempty"`
+	Sku        *appConfigurationSku        `json:"sku,omitempty"`
+	Tags       map[string]*string          `json:"tags"`
+	Properties *appConfigurationProperties `json:"properties,omitempty"`
+}
+
+type appConfigurationSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type appConfigurationProperties struct {
+	DisableLocalAuth    *bool   `json:"disableLocalAuth,omitempty"`
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+	Endpoint            *string `json:"endpoint,omitempty"`
+}
+
+func resourceArmAppConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppConfigurationCreateUpdate,
+		Read:   resourceArmAppConfigurationRead,
+		Update: resourceArmAppConfigurationCreateUpdate,
+		Delete: resourceArmAppConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z0-9-]{5,50}$"),
+					"The name can contain only letters, numbers, and hyphens and must be between 5 and 50 characters.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "free",
+				ValidateFunc: validation.StringInSlice([]string{
+					"free",
+					"standard",
+				}, false),
+			},
+
+			"local_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			// Public Network Access can only be disabled when Private Endpoints are supported
+			if !d.Get("public_network_access_enabled").(bool) && d.Get("sku").(string) != "standard" {
+				return fmt.Errorf("`public_network_access_enabled` can only be disabled for the `standard` SKU")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmAppConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppConfiguration/configurationStores/%s", meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing appConfiguration
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, appConfigurationApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_app_configuration", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	publicNetworkAccess := "Enabled"
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Disabled"
+	}
+
+	parameters := appConfiguration{
+		Location: utils.String(location),
+		Sku: &appConfigurationSku{
+			Name: utils.String(d.Get("sku").(string)),
+		},
+		Properties: &appConfigurationProperties{
+			DisableLocalAuth:    utils.Bool(!d.Get("local_authentication_enabled").(bool)),
+			PublicNetworkAccess: utils.String(publicNetworkAccess),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, appConfigurationApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmAppConfigurationRead(d, meta)
+}
+
+func resourceArmAppConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["configurationStores"]
+
+	var resp appConfiguration
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), appConfigurationApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] App Configuration %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil && sku.Name != nil {
+		d.Set("sku", strings.ToLower(*sku.Name))
+	}
+
+	// both of these are enabled when omitted by the API
+	localAuthenticationEnabled := true
+	publicNetworkAccessEnabled := true
+	if props := resp.Properties; props != nil {
+		if v := props.DisableLocalAuth; v != nil {
+			localAuthenticationEnabled = !*v
+		}
+
+		if v := props.PublicNetworkAccess; v != nil {
+			publicNetworkAccessEnabled = !strings.EqualFold(*v, "Disabled")
+		}
+
+		d.Set("endpoint", props.Endpoint)
+	}
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["configurationStores"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), appConfigurationApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting App Configuration %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
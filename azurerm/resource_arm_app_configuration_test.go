@@ -0,0 +1,203 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppConfiguration_basic(t *testing.T) {
+	resourceName := "azurerm_app_configuration.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppConfiguration_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "free"),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppConfiguration_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_app_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAppConfiguration_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_app_configuration"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppConfiguration_update(t *testing.T) {
+	resourceName := "azurerm_app_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMAppConfiguration_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp appConfiguration
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, appConfigurationApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: App Configuration %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on App Configuration %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_configuration" {
+			continue
+		}
+
+		var resp appConfiguration
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, appConfigurationApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("App Configuration still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppConfiguration_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "acctestappconf%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAppConfiguration_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMAppConfiguration_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration" "import" {
+  name                = "${azurerm_app_configuration.test.name}"
+  resource_group_name = "${azurerm_app_configuration.test.resource_group_name}"
+  location            = "${azurerm_app_configuration.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMAppConfiguration_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                          = "acctestappconf%d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  sku                           = "standard"
+  local_authentication_enabled  = false
+  public_network_access_enabled = false
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
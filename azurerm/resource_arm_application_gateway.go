@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -147,6 +148,13 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Default:  false,
 						},
 
+						// the Host header sent to the Backend, which can't be combined with `pick_host_name_from_backend_address`
+						"host_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
 						"request_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -171,6 +179,35 @@ func resourceArmApplicationGateway() *schema.Resource {
 							},
 						},
 
+						"trusted_root_certificate_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"connection_draining": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+
+									"drain_timeout_sec": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 3600),
+									},
+								},
+							},
+						},
+
 						"probe_name": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -515,6 +552,32 @@ func resourceArmApplicationGateway() *schema.Resource {
 				},
 			},
 
+			// used for end-to-end TLS to Backends on the v2 SKU's, in place of `authentication_certificate`
+			"trusted_root_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"data": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			// TODO: @tombuildsstuff deprecate this in favour of a full `ssl_protocol` block in the future
 			"disabled_ssl_protocols": {
 				Type:     schema.TypeList,
@@ -605,11 +668,13 @@ func resourceArmApplicationGateway() *schema.Resource {
 										Default:  "*",
 									},
 
+									// either a single Status Code (e.g. `200`) or a range (e.g. `200-399`)
 									"status_code": {
 										Type:     schema.TypeList,
 										Optional: true,
 										Elem: &schema.Schema{
-											Type: schema.TypeString,
+											Type:         schema.TypeString,
+											ValidateFunc: validateApplicationGatewayProbeStatusCode,
 										},
 									},
 								},
@@ -864,6 +929,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	sku := expandApplicationGatewaySku(d)
 	sslCertificates := expandApplicationGatewaySslCertificates(d)
 	sslPolicy := expandApplicationGatewaySslPolicy(d)
+	trustedRootCertificates := expandApplicationGatewayTrustedRootCertificates(d)
 	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{}))
 	urlPathMaps := expandApplicationGatewayURLPathMaps(d, gatewayID)
 
@@ -885,11 +951,19 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 			Sku:                           sku,
 			SslCertificates:               sslCertificates,
 			SslPolicy:                     sslPolicy,
+			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     customErrorConfigurations,
 			URLPathMaps:                   urlPathMaps,
 		},
 	}
 
+	for _, setting := range *backendHTTPSettingsCollection {
+		settingProperties := *setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat
+		if settingProperties.HostName != nil && *settingProperties.PickHostNameFromBackendAddress {
+			return fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set for the `backend_http_settings` %q", *setting.Name)
+		}
+	}
+
 	for _, probe := range *probes {
 		probeProperties := *probe.ApplicationGatewayProbePropertiesFormat
 		host := *probeProperties.Host
@@ -976,6 +1050,11 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `backend_http_settings`: %+v", setErr)
 		}
 
+		flattenedTrustedRootCerts := flattenApplicationGatewayTrustedRootCertificates(props.TrustedRootCertificates, d)
+		if setErr := d.Set("trusted_root_certificate", flattenedTrustedRootCerts); setErr != nil {
+			return fmt.Errorf("Error setting `trusted_root_certificate`: %+v", setErr)
+		}
+
 		if setErr := d.Set("disabled_ssl_protocols", flattenApplicationGatewayDisabledSSLProtocols(props.SslPolicy)); setErr != nil {
 			return fmt.Errorf("Error setting `disabled_ssl_protocols`: %+v", setErr)
 		}
@@ -1127,6 +1206,66 @@ func flattenApplicationGatewayAuthenticationCertificates(input *[]network.Applic
 	return results
 }
 
+func expandApplicationGatewayTrustedRootCertificates(d *schema.ResourceData) *[]network.ApplicationGatewayTrustedRootCertificate {
+	vs := d.Get("trusted_root_certificate").([]interface{})
+	results := make([]network.ApplicationGatewayTrustedRootCertificate, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		data := v["data"].(string)
+
+		// data must be base64 encoded
+		encodedData := base64Encode(data)
+
+		output := network.ApplicationGatewayTrustedRootCertificate{
+			Name: utils.String(name),
+			ApplicationGatewayTrustedRootCertificatePropertiesFormat: &network.ApplicationGatewayTrustedRootCertificatePropertiesFormat{
+				Data: utils.String(encodedData),
+			},
+		}
+
+		results = append(results, output)
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayTrustedRootCertificates(input *[]network.ApplicationGatewayTrustedRootCertificate, d *schema.ResourceData) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for i, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		// since the certificate data isn't returned we have to load it from the same index
+		if existing, ok := d.GetOk("trusted_root_certificate"); ok && existing != nil {
+			existingVals := existing.([]interface{})
+			if i < len(existingVals) {
+				existingCerts := existingVals[i].(map[string]interface{})
+				if data := existingCerts["data"]; data != nil {
+					output["data"] = data.(string)
+				}
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
 func expandApplicationGatewayBackendAddressPools(d *schema.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
 	vs := d.Get("backend_address_pool").([]interface{})
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
@@ -1261,6 +1400,21 @@ func expandApplicationGatewayBackendHTTPSettings(d *schema.ResourceData, gateway
 			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
 		}
 
+		if hostName := v["host_name"].(string); hostName != "" {
+			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.HostName = utils.String(hostName)
+		}
+
+		trustedRootCertSubResources := make([]network.SubResource, 0)
+		for _, rawName := range v["trusted_root_certificate_names"].([]interface{}) {
+			trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, rawName.(string))
+			trustedRootCertSubResources = append(trustedRootCertSubResources, network.SubResource{
+				ID: utils.String(trustedRootCertID),
+			})
+		}
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertSubResources
+
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.ConnectionDraining = expandApplicationGatewayConnectionDraining(v["connection_draining"].([]interface{}))
+
 		probeName := v["probe_name"].(string)
 		if probeName != "" {
 			probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
@@ -1275,6 +1429,41 @@ func expandApplicationGatewayBackendHTTPSettings(d *schema.ResourceData, gateway
 	return &results
 }
 
+func expandApplicationGatewayConnectionDraining(input []interface{}) *network.ApplicationGatewayConnectionDraining {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.ApplicationGatewayConnectionDraining{
+		Enabled:           utils.Bool(v["enabled"].(bool)),
+		DrainTimeoutInSec: utils.Int32(int32(v["drain_timeout_sec"].(int))),
+	}
+}
+
+func flattenApplicationGatewayConnectionDraining(input *network.ApplicationGatewayConnectionDraining) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	drainTimeout := 0
+	if input.DrainTimeoutInSec != nil {
+		drainTimeout = int(*input.DrainTimeoutInSec)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":           enabled,
+			"drain_timeout_sec": drainTimeout,
+		},
+	}
+}
+
 func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGatewayBackendHTTPSettings) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -1327,6 +1516,29 @@ func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGa
 			}
 			output["authentication_certificate"] = authenticationCertificates
 
+			if hostName := props.HostName; hostName != nil {
+				output["host_name"] = *hostName
+			}
+
+			trustedRootCertificateNames := make([]interface{}, 0)
+			if certs := props.TrustedRootCertificates; certs != nil {
+				for _, cert := range *certs {
+					if cert.ID == nil {
+						continue
+					}
+
+					certId, err := parseAzureResourceID(*cert.ID)
+					if err != nil {
+						return nil, err
+					}
+
+					trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Path["trustedRootCertificates"])
+				}
+			}
+			output["trusted_root_certificate_names"] = trustedRootCertificateNames
+
+			output["connection_draining"] = flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)
+
 			if probe := props.Probe; probe != nil {
 				if probe.ID != nil {
 					id, err := parseAzureResourceID(*probe.ID)
@@ -2288,3 +2500,23 @@ func flattenApplicationGatewayCustomErrorConfigurations(input *[]network.Applica
 
 	return results
 }
+
+func validateApplicationGatewayProbeStatusCode(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	matches := regexp.MustCompile(`^([1-5][0-9]{2})(-([1-5][0-9]{2}))?$`).FindStringSubmatch(v)
+	if matches == nil {
+		errors = append(errors, fmt.Errorf("%q must be a HTTP Status Code between 100 and 599 (e.g. `200`) or a range of them (e.g. `200-399`), got %q", k, v))
+		return
+	}
+
+	if matches[3] != "" && matches[3] < matches[1] {
+		errors = append(errors, fmt.Errorf("the end of the range in %q must be greater than or equal to the start, got %q", k, v))
+	}
+
+	return
+}
@@ -1,11 +1,16 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -50,26 +55,50 @@ func resourceArmAutomationModule() *schema.Resource {
 							Required: true,
 						},
 
-						"hash": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"algorithm": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
 						},
+
+						"hash": automationContentHashSchema(),
 					},
 				},
 			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// automationContentHashSchema is the hash used to verify the content downloaded from a Content Link, which ensures the
+// same content is installed each time
+func automationContentHashSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SHA256",
+						"SHA384",
+						"SHA512",
+					}, true),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
 		},
 	}
 }
@@ -109,6 +138,33 @@ func resourceArmAutomationModuleCreateUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	// the module is imported asynchronously - so we need to wait for this to finish, which is when the content hash is verified
+	log.Printf("[DEBUG] Waiting for Automation Module %q (Account %q / Resource Group %q) to be imported", name, accName, resGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(automation.ModuleProvisioningStateActivitiesStored),
+			string(automation.ModuleProvisioningStateConnectionTypeImported),
+			string(automation.ModuleProvisioningStateContentDownloaded),
+			string(automation.ModuleProvisioningStateContentRetrieved),
+			string(automation.ModuleProvisioningStateContentStored),
+			string(automation.ModuleProvisioningStateContentValidated),
+			string(automation.ModuleProvisioningStateCreated),
+			string(automation.ModuleProvisioningStateCreating),
+			string(automation.ModuleProvisioningStateModuleDataStored),
+			string(automation.ModuleProvisioningStateModuleImportRunbookComplete),
+			string(automation.ModuleProvisioningStateRunningImportModuleRunbook),
+			string(automation.ModuleProvisioningStateStartingImportModuleRunbook),
+			string(automation.ModuleProvisioningStateUpdating),
+		},
+		Target:     []string{string(automation.ModuleProvisioningStateSucceeded)},
+		Refresh:    automationModuleProvisioningStateRefreshFunc(ctx, client, resGroup, accName, name),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Automation Module %q (Account %q / Resource Group %q) to be imported: %+v", name, accName, resGroup, err)
+	}
+
 	read, err := client.Get(ctx, resGroup, accName, name)
 	if err != nil {
 		return err
@@ -149,6 +205,10 @@ func resourceArmAutomationModuleRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("resource_group_name", resGroup)
 	d.Set("automation_account_name", accName)
 
+	if props := resp.ModuleProperties; props != nil {
+		d.Set("version", props.Version)
+	}
+
 	return nil
 }
 
@@ -181,6 +241,14 @@ func expandModuleLink(d *schema.ResourceData) automation.ContentLink {
 	input := inputs[0].(map[string]interface{})
 	uri := input["uri"].(string)
 
+	contentLink := automation.ContentLink{
+		URI: &uri,
+	}
+
+	if version := input["version"].(string); version != "" {
+		contentLink.Version = utils.String(version)
+	}
+
 	hashes := input["hash"].([]interface{})
 
 	if len(hashes) > 0 {
@@ -188,16 +256,36 @@ func expandModuleLink(d *schema.ResourceData) automation.ContentLink {
 		hashValue := hash["value"].(string)
 		hashAlgorithm := hash["algorithm"].(string)
 
-		return automation.ContentLink{
-			URI: &uri,
-			ContentHash: &automation.ContentHash{
-				Algorithm: &hashAlgorithm,
-				Value:     &hashValue,
-			},
+		contentLink.ContentHash = &automation.ContentHash{
+			Algorithm: &hashAlgorithm,
+			Value:     &hashValue,
 		}
 	}
 
-	return automation.ContentLink{
-		URI: &uri,
+	return contentLink
+}
+
+func automationModuleProvisioningStateRefreshFunc(ctx context.Context, client automation.ModuleClient, resourceGroup, accountName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Automation Module %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		}
+
+		props := resp.ModuleProperties
+		if props == nil {
+			return resp, "", fmt.Errorf("Error retrieving Automation Module %q (Account %q / Resource Group %q): `properties` was nil", name, accountName, resourceGroup)
+		}
+
+		if props.ProvisioningState == automation.ModuleProvisioningStateFailed || props.ProvisioningState == automation.ModuleProvisioningStateCancelled {
+			message := "no error details were returned"
+			if props.Error != nil && props.Error.Message != nil {
+				message = *props.Error.Message
+			}
+
+			return resp, string(props.ProvisioningState), fmt.Errorf("the import finished with the state %q: %s", string(props.ProvisioningState), message)
+		}
+
+		return resp, string(props.ProvisioningState), nil
 	}
 }
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -65,6 +66,42 @@ func TestAccAzureRMAutomationModule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAutomationModule_version(t *testing.T) {
+	resourceName := "azurerm_automation_module.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationModule_version(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationModuleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationModule_hashMismatch(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMAutomationModule_hashMismatch(ri, testLocation()),
+				ExpectError: regexp.MustCompile("the import finished with the state"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAutomationModuleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).automationModuleClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -178,3 +215,67 @@ resource "azurerm_automation_module" "import" {
 }
 `, template)
 }
+
+func testAccAzureRMAutomationModule_version(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_module" "test" {
+  name                    = "xActiveDirectory"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+
+  module_link = {
+    uri     = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+    version = "2.19.0"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAutomationModule_hashMismatch(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_module" "test" {
+  name                    = "xActiveDirectory"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+
+  module_link = {
+    uri = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+
+    hash {
+      algorithm = "SHA256"
+      value     = "0000000000000000000000000000000000000000000000000000000000000000"
+    }
+  }
+}
+`, rInt, location, rInt)
+}
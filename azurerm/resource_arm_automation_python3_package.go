@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Python 3 Packages aren't present in the vendored SDK, so are managed using raw requests
+const automationPython3PackageApiVersion = "2023-11-01"
+
+type automationPython3Package struct {
+	ID         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Tags       map[string]*string                  `json:"tags"`
+	Properties *automationPython3PackageProperties `json:"properties,omitempty"`
+}
+
+type automationPython3PackageProperties struct {
+	ContentLink       *automationPython3PackageContentLink `json:"contentLink,omitempty"`
+	Version           *string                              `json:"version,omitempty"`
+	SizeInBytes       *int64                               `json:"sizeInBytes,omitempty"`
+	ProvisioningState *string                              `json:"provisioningState,omitempty"`
+	Error             *automationPython3PackageError       `json:"error,omitempty"`
+}
+
+type automationPython3PackageContentLink struct {
+	URI         *string                              `json:"uri,omitempty"`
+	Version     *string                              `json:"version,omitempty"`
+	ContentHash *automationPython3PackageContentHash `json:"contentHash,omitempty"`
+}
+
+type automationPython3PackageContentHash struct {
+	Algorithm *string `json:"algorithm,omitempty"`
+	Value     *string `json:"value,omitempty"`
+}
+
+type automationPython3PackageError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func resourceArmAutomationPython3Package() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationPython3PackageCreateUpdate,
+		Read:   resourceArmAutomationPython3PackageRead,
+		Update: resourceArmAutomationPython3PackageCreateUpdate,
+		Delete: resourceArmAutomationPython3PackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"content_link": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"hash": automationContentHashSchema(),
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAutomationPython3PackageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+	id := automationPython3PackageID(meta.(*ArmClient).subscriptionId, resourceGroup, accountName, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing automationPython3Package
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, automationPython3PackageApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Automation Python 3 Package %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_automation_python3_package", *existing.ID)
+		}
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := automationPython3Package{
+		Properties: &automationPython3PackageProperties{
+			ContentLink: expandArmAutomationPython3PackageContentLink(d.Get("content_link").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, automationPython3PackageApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Automation Python 3 Package %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	// the package is installed asynchronously - so we need to wait for this to finish, which is when the content hash is verified
+	log.Printf("[DEBUG] Waiting for Automation Python 3 Package %q (Account %q / Resource Group %q) to be installed", name, accountName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"ActivitiesStored",
+			"ConnectionTypeImported",
+			"ContentDownloaded",
+			"ContentRetrieved",
+			"ContentStored",
+			"ContentValidated",
+			"Created",
+			"Creating",
+			"ModuleDataStored",
+			"ModuleImportRunbookComplete",
+			"RunningImportModuleRunbook",
+			"StartingImportModuleRunbook",
+			"Updating",
+		},
+		Target:     []string{"Succeeded"},
+		Refresh:    automationPython3PackageProvisioningStateRefreshFunc(ctx, client.Client, client.BaseURI, id),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Automation Python 3 Package %q (Account %q / Resource Group %q) to be installed: %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmAutomationPython3PackageRead(d, meta)
+}
+
+func resourceArmAutomationPython3PackageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["python3Packages"]
+
+	var pkg automationPython3Package
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), automationPython3PackageApiVersion, &pkg)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Automation Python 3 Package %q was not found in Account %q (Resource Group %q) - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Automation Python 3 Package %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("automation_account_name", accountName)
+
+	if props := pkg.Properties; props != nil {
+		d.Set("version", props.Version)
+		d.Set("size_in_bytes", props.SizeInBytes)
+	}
+
+	flattenAndSetTags(d, pkg.Tags)
+
+	return nil
+}
+
+func resourceArmAutomationPython3PackageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["python3Packages"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), automationPython3PackageApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Automation Python 3 Package %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func automationPython3PackageProvisioningStateRefreshFunc(ctx context.Context, client autorest.Client, baseURI string, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var pkg automationPython3Package
+		if _, err := armRawGet(ctx, client, baseURI, id, automationPython3PackageApiVersion, &pkg); err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Automation Python 3 Package %q: %+v", id, err)
+		}
+
+		props := pkg.Properties
+		if props == nil || props.ProvisioningState == nil {
+			return pkg, "", fmt.Errorf("Error retrieving Automation Python 3 Package %q: `properties.provisioningState` was nil", id)
+		}
+
+		state := *props.ProvisioningState
+		if state == "Failed" || state == "Cancelled" {
+			message := "no error details were returned"
+			if props.Error != nil && props.Error.Message != nil {
+				message = *props.Error.Message
+			}
+
+			return pkg, state, fmt.Errorf("the installation finished with the state %q: %s", state, message)
+		}
+
+		return pkg, state, nil
+	}
+}
+
+func expandArmAutomationPython3PackageContentLink(input []interface{}) *automationPython3PackageContentLink {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	contentLink := automationPython3PackageContentLink{
+		URI: utils.String(v["uri"].(string)),
+	}
+
+	if version := v["version"].(string); version != "" {
+		contentLink.Version = utils.String(version)
+	}
+
+	if hashes := v["hash"].([]interface{}); len(hashes) > 0 && hashes[0] != nil {
+		hash := hashes[0].(map[string]interface{})
+		contentLink.ContentHash = &automationPython3PackageContentHash{
+			Algorithm: utils.String(hash["algorithm"].(string)),
+			Value:     utils.String(hash["value"].(string)),
+		}
+	}
+
+	return &contentLink
+}
+
+func automationPython3PackageID(subscriptionId, resourceGroup, accountName, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/python3Packages/%s", subscriptionId, resourceGroup, accountName, name)
+}
@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationPython3Package_basic(t *testing.T) {
+	resourceName := "azurerm_automation_python3_package.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationPython3PackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationPython3Package_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationPython3PackageExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Content Link isn't returned by the API
+				ImportStateVerifyIgnore: []string{"content_link"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationPython3Package_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_automation_python3_package.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationPython3PackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationPython3Package_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationPython3PackageExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAutomationPython3Package_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_automation_python3_package"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationPython3PackageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_python3_package" {
+			continue
+		}
+
+		var pkg automationPython3Package
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, automationPython3PackageApiVersion, &pkg)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Python 3 Package %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationPython3PackageExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var pkg automationPython3Package
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, automationPython3PackageApiVersion, &pkg)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Automation Python 3 Package %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Automation Python 3 Package %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationPython3Package_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_python3_package" "test" {
+  name                    = "requests"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+
+  content_link {
+    uri     = "https://files.pythonhosted.org/packages/py3/r/requests/requests-2.31.0-py3-none-any.whl"
+    version = "2.31.0"
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMAutomationPython3Package_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMAutomationPython3Package_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "import" {
+  name                    = "${azurerm_automation_python3_package.test.name}"
+  resource_group_name     = "${azurerm_automation_python3_package.test.resource_group_name}"
+  automation_account_name = "${azurerm_automation_python3_package.test.automation_account_name}"
+
+  content_link {
+    uri     = "https://files.pythonhosted.org/packages/py3/r/requests/requests-2.31.0-py3-none-any.whl"
+    version = "2.31.0"
+  }
+}
+`, template)
+}
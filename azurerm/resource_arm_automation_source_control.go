@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	automationPreview "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2017-05-15-preview/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationSourceControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationSourceControlCreateUpdate,
+		Read:   resourceArmAutomationSourceControlRead,
+		Update: resourceArmAutomationSourceControlCreateUpdate,
+		Delete: resourceArmAutomationSourceControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"source_control_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(automationPreview.GitHub),
+					string(automationPreview.VsoGit),
+					string(automationPreview.VsoTfvc),
+				}, false),
+			},
+
+			"repository_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			// the API requires this to be an empty string for VsoTfvc repositories
+			"branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"folder_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"automatic_sync": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"publish_runbook_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"security": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"refresh_token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+
+						"token_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automationPreview.Oauth),
+								string(automationPreview.PersonalAccessToken),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationSourceControlCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSourceControlClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Automation Source Control %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_automation_source_control", *existing.ID)
+		}
+	}
+
+	parameters := automationPreview.SourceControlCreateOrUpdateParameters{
+		SourceControlCreateOrUpdateProperties: &automationPreview.SourceControlCreateOrUpdateProperties{
+			RepoURL:        utils.String(d.Get("repository_url").(string)),
+			Branch:         utils.String(d.Get("branch").(string)),
+			FolderPath:     utils.String(d.Get("folder_path").(string)),
+			AutoSync:       utils.Bool(d.Get("automatic_sync").(bool)),
+			PublishRunbook: utils.Bool(d.Get("publish_runbook_enabled").(bool)),
+			SourceType:     automationPreview.SourceType(d.Get("source_control_type").(string)),
+			SecurityToken:  expandArmAutomationSourceControlSecurity(d.Get("security").([]interface{})),
+			Description:    utils.String(d.Get("description").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Automation Source Control %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Source Control %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Automation Source Control %q (Account %q / Resource Group %q)", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationSourceControlRead(d, meta)
+}
+
+func resourceArmAutomationSourceControlRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSourceControlClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["sourceControls"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Automation Source Control %q (Account %q / Resource Group %q) was not found - removing from state", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Automation Source Control %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("account_name", accountName)
+
+	// the security token isn't returned from the API, so is taken from the configuration
+	if props := resp.SourceControlProperties; props != nil {
+		d.Set("source_control_type", string(props.SourceType))
+		d.Set("repository_url", props.RepoURL)
+		d.Set("branch", props.Branch)
+		d.Set("folder_path", props.FolderPath)
+		d.Set("automatic_sync", props.AutoSync)
+		d.Set("publish_runbook_enabled", props.PublishRunbook)
+		d.Set("description", props.Description)
+	}
+
+	return nil
+}
+
+func resourceArmAutomationSourceControlDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSourceControlClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["sourceControls"]
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Automation Source Control %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmAutomationSourceControlSecurity(input []interface{}) *automationPreview.SourceControlSecurityTokenProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	security := automationPreview.SourceControlSecurityTokenProperties{
+		AccessToken: utils.String(v["token"].(string)),
+		TokenType:   automationPreview.TokenType(v["token_type"].(string)),
+	}
+
+	if refreshToken := v["refresh_token"].(string); refreshToken != "" {
+		security.RefreshToken = utils.String(refreshToken)
+	}
+
+	return &security
+}
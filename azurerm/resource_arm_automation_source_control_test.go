@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Source Control needs a GitHub Personal Access Token with access to the repository, which is validated by the API
+func TestAccAzureRMAutomationSourceControl_basic(t *testing.T) {
+	token := os.Getenv("ARM_TEST_GITHUB_TOKEN")
+	if token == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_GITHUB_TOKEN")
+		return
+	}
+
+	resourceName := "azurerm_automation_source_control.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSourceControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSourceControl_basic(ri, location, token, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSourceControlExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_control_type", "GitHub"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_sync", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMAutomationSourceControl_basic(ri, location, token, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSourceControlExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automatic_sync", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationSourceControl_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	token := os.Getenv("ARM_TEST_GITHUB_TOKEN")
+	if token == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_GITHUB_TOKEN")
+		return
+	}
+
+	resourceName := "azurerm_automation_source_control.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSourceControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSourceControl_basic(ri, location, token, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSourceControlExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAutomationSourceControl_requiresImport(ri, location, token),
+				ExpectError: testRequiresImportError("azurerm_automation_source_control"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationSourceControlExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).automationSourceControlClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Automation Source Control %q (Account %q / Resource Group %q) does not exist", name, accountName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationSourceControlClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAutomationSourceControlDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).automationSourceControlClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_source_control" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Source Control %q (Account %q / Resource Group %q) still exists", name, accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAutomationSourceControl_basic(rInt int, location string, token string, automaticSync bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_source_control" "test" {
+  name                = "acctest-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  source_control_type = "GitHub"
+  repository_url      = "https://github.com/Azure-Samples/automation-sample-runbooks.git"
+  branch              = "master"
+  folder_path         = "/runbooks"
+  automatic_sync      = %[4]t
+
+  security {
+    token      = "%[3]s"
+    token_type = "PersonalAccessToken"
+  }
+}
+`, rInt, location, token, automaticSync)
+}
+
+func testAccAzureRMAutomationSourceControl_requiresImport(rInt int, location string, token string) string {
+	template := testAccAzureRMAutomationSourceControl_basic(rInt, location, token, false)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_source_control" "import" {
+  name                = "${azurerm_automation_source_control.test.name}"
+  resource_group_name = "${azurerm_automation_source_control.test.resource_group_name}"
+  account_name        = "${azurerm_automation_source_control.test.account_name}"
+  source_control_type = "${azurerm_automation_source_control.test.source_control_type}"
+  repository_url      = "${azurerm_automation_source_control.test.repository_url}"
+  branch              = "${azurerm_automation_source_control.test.branch}"
+  folder_path         = "${azurerm_automation_source_control.test.folder_path}"
+
+  security {
+    token      = "%s"
+    token_type = "PersonalAccessToken"
+  }
+}
+`, template, token)
+}
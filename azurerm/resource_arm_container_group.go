@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,9 +48,19 @@ func resourceArmContainerGroup() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerinstance.Public),
+					string(containerinstance.Private),
 				}, true),
 			},
 
+			// deploys the Container Group into the Subnet referenced by the Network Profile
+			"network_profile_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"os_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -340,6 +351,16 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 		containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel = &dnsNameLabel
 	}
 
+	if networkProfileId := d.Get("network_profile_id").(string); networkProfileId != "" {
+		if !strings.EqualFold(IPAddressType, string(containerinstance.Private)) {
+			return fmt.Errorf("`ip_address_type` must be `Private` when `network_profile_id` is specified for Container Group %q (Resource Group %q)", name, resGroup)
+		}
+
+		containerGroup.ContainerGroupProperties.NetworkProfile = &containerinstance.ContainerGroupNetworkProfile{
+			ID: utils.String(networkProfileId),
+		}
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup); err != nil {
 		return err
 	}
@@ -404,6 +425,12 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("fqdn", address.Fqdn)
 		}
 
+		networkProfileId := ""
+		if profile := props.NetworkProfile; profile != nil && profile.ID != nil {
+			networkProfileId = *profile.ID
+		}
+		d.Set("network_profile_id", networkProfileId)
+
 		d.Set("restart_policy", string(props.RestartPolicy))
 		d.Set("os_type", string(props.OsType))
 	}
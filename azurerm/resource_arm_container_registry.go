@@ -1,13 +1,15 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
-
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -60,9 +62,11 @@ func resourceArmContainerRegistry() *schema.Resource {
 			},
 
 			"georeplication_locations": {
-				Type:     schema.TypeSet,
-				MinItems: 1,
-				Optional: true,
+				Type:          schema.TypeSet,
+				MinItems:      1,
+				Optional:      true,
+				Deprecated:    "`georeplication_locations` has been replaced by `georeplications`",
+				ConflictsWith: []string{"georeplications"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.NoEmptyStrings,
@@ -70,6 +74,37 @@ func resourceArmContainerRegistry() *schema.Resource {
 				Set: azureRMHashLocation,
 			},
 
+			"georeplications": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"georeplication_locations"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validate.NoEmptyStrings,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"zone_redundancy_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"data_endpoint_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"storage_account_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -112,18 +147,31 @@ func resourceArmContainerRegistry() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"data_endpoint_host_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"tags": tagsSchema(),
 		},
 
 		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
 			sku := d.Get("sku").(string)
+			isPremium := strings.EqualFold(sku, string(containerregistry.Premium))
+
 			geoReplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+			geoReplications := d.Get("georeplications").([]interface{})
 			// if locations have been specified for geo-replication then, the SKU has to be Premium
-			if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+			if ((geoReplicationLocations != nil && geoReplicationLocations.Len() > 0) || len(geoReplications) > 0) && !isPremium {
 				return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
 			}
 
-			return nil
+			if d.Get("data_endpoint_enabled").(bool) && !isPremium {
+				return fmt.Errorf("`data_endpoint_enabled` can only be specified for a Premium Sku.")
+			}
+
+			return customizeDiffNameAvailability("Container Registry", resourceArmContainerRegistryNameAvailability)(d, v)
 		},
 	}
 }
@@ -153,7 +201,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 	sku := d.Get("sku").(string)
 	adminUserEnabled := d.Get("admin_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
-	geoReplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+	geoReplications := expandArmContainerRegistryGeoReplications(d)
 
 	parameters := containerregistry.Registry{
 		Location: &location,
@@ -190,16 +238,6 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for creation of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	// locations have been specified for geo-replication
-	if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 {
-		// the ACR is being created so no previous geo-replication locations
-		oldGeoReplicationLocations := []interface{}{}
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations, geoReplicationLocations.List())
-		if err != nil {
-			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
-	}
-
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -211,6 +249,19 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*read.ID)
 
+	if d.Get("data_endpoint_enabled").(bool) {
+		if err := updateArmContainerRegistryDataEndpoint(meta, *read.ID, true); err != nil {
+			return fmt.Errorf("Error enabling the Data Endpoint for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	// locations have been specified for geo-replication
+	if len(geoReplications) > 0 {
+		if err := applyContainerRegistryGeoReplications(meta, *read.ID, location, geoReplications); err != nil {
+			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceArmContainerRegistryRead(d, meta)
 }
 
@@ -226,10 +277,10 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	adminUserEnabled := d.Get("admin_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
-	old, new := d.GetChange("georeplication_locations")
-	hasGeoReplicationChanges := d.HasChange("georeplication_locations")
-	oldGeoReplicationLocations := old.(*schema.Set)
-	newGeoReplicationLocations := new.(*schema.Set)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	isPremium := strings.EqualFold(sku, string(containerregistry.Premium))
+	hasGeoReplicationChanges := d.HasChange("georeplication_locations") || d.HasChange("georeplications")
+	geoReplications := expandArmContainerRegistryGeoReplications(d)
 
 	parameters := containerregistry.RegistryUpdateParameters{
 		RegistryPropertiesUpdateParameters: &containerregistry.RegistryPropertiesUpdateParameters{
@@ -257,15 +308,23 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	// geo replication is only supported by Premium Sku
-	if hasGeoReplicationChanges && newGeoReplicationLocations.Len() > 0 && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+	if hasGeoReplicationChanges && len(geoReplications) > 0 && !isPremium {
 		return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
 	}
 
-	// if the registry had replications and is updated to another Sku than premium - remove old locations
-	if !strings.EqualFold(sku, string(containerregistry.Premium)) && oldGeoReplicationLocations != nil && oldGeoReplicationLocations.Len() > 0 {
-		err := applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
-		if err != nil {
-			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+	// if the registry had replications and is updated to another Sku than premium - remove them (and the Data
+	// Endpoint) first, since neither are supported by the new Sku
+	if !isPremium && d.HasChange("sku") {
+		if d.HasChange("data_endpoint_enabled") {
+			if err := updateArmContainerRegistryDataEndpoint(meta, d.Id(), false); err != nil {
+				return fmt.Errorf("Error disabling the Data Endpoint for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if hasGeoReplicationChanges {
+			if err := applyContainerRegistryGeoReplications(meta, d.Id(), location, geoReplications); err != nil {
+				return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
 		}
 	}
 
@@ -278,9 +337,15 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	if strings.EqualFold(sku, string(containerregistry.Premium)) && hasGeoReplicationChanges {
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
-		if err != nil {
+	if isPremium && d.HasChange("data_endpoint_enabled") {
+		if err := updateArmContainerRegistryDataEndpoint(meta, d.Id(), d.Get("data_endpoint_enabled").(bool)); err != nil {
+			return fmt.Errorf("Error updating the Data Endpoint for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	// replications are updated in-place, so changing them never requires the registry to be recreated
+	if isPremium && hasGeoReplicationChanges {
+		if err := applyContainerRegistryGeoReplications(meta, d.Id(), location, geoReplications); err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	}
@@ -299,78 +364,8 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	return resourceArmContainerRegistryRead(d, meta)
 }
 
-func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name string, oldGeoReplicationLocations []interface{}, newGeoReplicationLocations []interface{}) error {
-	replicationClient := meta.(*ArmClient).containerRegistryReplicationsClient
-	ctx := meta.(*ArmClient).StopContext
-	log.Printf("[INFO] preparing to apply geo-replications for AzureRM Container Registry.")
-
-	createLocations := make(map[string]bool)
-
-	// loop on the new location values
-	for _, nl := range newGeoReplicationLocations {
-		newLocation := azureRMNormalizeLocation(nl)
-		createLocations[newLocation] = true // the location needs to be created
-	}
-
-	// loop on the old location values
-	for _, ol := range oldGeoReplicationLocations {
-		// oldLocation was created from a previous deployment
-		oldLocation := azureRMNormalizeLocation(ol)
-
-		// if the list of locations to create already contains the location
-		if _, ok := createLocations[oldLocation]; ok {
-			createLocations[oldLocation] = false // the location do not need to be created, it already exists
-		}
-	}
-
-	// create new geo-replication locations
-	for locationToCreate := range createLocations {
-		// if false, the location does not need to be created, continue
-		if !createLocations[locationToCreate] {
-			continue
-		}
-
-		// create the new replication location
-		replication := containerregistry.Replication{
-			Location: &locationToCreate,
-			Name:     &locationToCreate,
-		}
-
-		future, err := replicationClient.Create(ctx, resourceGroup, name, locationToCreate, replication)
-		if err != nil {
-			return fmt.Errorf("Error creating Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
-		}
-
-		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for creation of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
-		}
-	}
-
-	// loop on the list of previously deployed locations
-	for _, ol := range oldGeoReplicationLocations {
-		oldLocation := azureRMNormalizeLocation(ol)
-		// if the old location is still in the list of locations, then continue
-		if _, ok := createLocations[oldLocation]; ok {
-			continue
-		}
-
-		// the old location is not in the list of locations, delete it
-		future, err := replicationClient.Delete(ctx, resourceGroup, name, oldLocation)
-		if err != nil {
-			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
-		}
-
-		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
-		}
-	}
-
-	return nil
-}
-
 func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containerRegistryClient
-	replicationClient := meta.(*ArmClient).containerRegistryReplicationsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(d.Id())
@@ -427,27 +422,56 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 	flattenAndSetTags(d, resp.Tags)
 
-	replications, err := replicationClient.List(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("Error making Read request on Azure Container Registry %s for replications: %s", name, err)
+	extended := containerRegistryExtended{}
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, *resp.ID, containerRegistryExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Data Endpoint for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	replicationValues := replications.Values()
+	dataEndpointEnabled := false
+	dataEndpointHostNames := make([]string, 0)
+	if props := extended.Properties; props != nil {
+		if props.DataEndpointEnabled != nil {
+			dataEndpointEnabled = *props.DataEndpointEnabled
+		}
+		if props.DataEndpointHostNames != nil {
+			dataEndpointHostNames = *props.DataEndpointHostNames
+		}
+	}
+	d.Set("data_endpoint_enabled", dataEndpointEnabled)
+	if err := d.Set("data_endpoint_host_names", dataEndpointHostNames); err != nil {
+		return fmt.Errorf("Error setting `data_endpoint_host_names`: %+v", err)
+	}
 
-	// if there is more than one location (the main one and the replicas)
-	if replicationValues != nil || len(replicationValues) > 1 {
-		georeplication_locations := &schema.Set{F: schema.HashString}
+	replications, err := listArmContainerRegistryReplications(ctx, client.Client, client.BaseURI, *resp.ID)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Azure Container Registry %s for replications: %s", name, err)
+	}
 
-		for _, value := range replicationValues {
-			if value.Location != nil {
-				valueLocation := azureRMNormalizeLocation(*value.Location)
-				if location != nil && valueLocation != azureRMNormalizeLocation(*location) {
-					georeplication_locations.Add(valueLocation)
+	// the main location is returned by the API as a replication too - but isn't one which can be managed
+	homeLocation := ""
+	if location != nil {
+		homeLocation = azureRMNormalizeLocation(*location)
+	}
+
+	// only one of `georeplication_locations` and `georeplications` can be used - so set whichever is in use,
+	// defaulting to `georeplications` (e.g. when importing)
+	legacyLocations := d.Get("georeplication_locations").(*schema.Set)
+	if legacyLocations != nil && legacyLocations.Len() > 0 && len(d.Get("georeplications").([]interface{})) == 0 {
+		georeplicationLocations := &schema.Set{F: schema.HashString}
+		for _, replication := range replications {
+			if replication.Location != nil {
+				valueLocation := azureRMNormalizeLocation(*replication.Location)
+				if valueLocation != homeLocation {
+					georeplicationLocations.Add(valueLocation)
 				}
 			}
 		}
-
-		d.Set("georeplication_locations", georeplication_locations)
+		d.Set("georeplication_locations", georeplicationLocations)
+	} else {
+		georeplications := flattenArmContainerRegistryGeoReplications(replications, homeLocation, d.Get("georeplications").([]interface{}))
+		if err := d.Set("georeplications", georeplications); err != nil {
+			return fmt.Errorf("Error setting `georeplications`: %+v", err)
+		}
 	}
 
 	return nil
@@ -499,3 +523,284 @@ func validateAzureRMContainerRegistryName(v interface{}, k string) (warnings []s
 
 	return warnings, errors
 }
+
+func resourceArmContainerRegistryNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	input := containerregistry.RegistryNameCheckRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.ContainerRegistry/registries"),
+	}
+	resp, err := client.containerRegistryClient.CheckNameAvailability(ctx, input)
+	if err != nil {
+		return false, "", err
+	}
+
+	available := resp.NameAvailable != nil && *resp.NameAvailable
+
+	reason := ""
+	if resp.Reason != nil {
+		reason = *resp.Reason
+	}
+	return available, nameAvailabilityReason(resp.Message, reason), nil
+}
+
+// the vendored SDK (2017-10-01) doesn't expose the Zone Redundancy of Replications or the Data Endpoint
+// of a Registry - so these are managed using a newer API Version
+const containerRegistryExtendedApiVersion = "2019-12-01-preview"
+
+type containerRegistryExtended struct {
+	Properties *containerRegistryExtendedProperties `json:"properties,omitempty"`
+}
+
+type containerRegistryExtendedProperties struct {
+	DataEndpointEnabled   *bool     `json:"dataEndpointEnabled,omitempty"`
+	DataEndpointHostNames *[]string `json:"dataEndpointHostNames,omitempty"`
+}
+
+type containerRegistryReplicationExtended struct {
+	ID         *string                                         `json:"id,omitempty"`
+	Location   *string                                         `json:"location,omitempty"`
+	Tags       map[string]*string                              `json:"tags"`
+	Properties *containerRegistryReplicationExtendedProperties `json:"properties,omitempty"`
+}
+
+type containerRegistryReplicationExtendedProperties struct {
+	ZoneRedundancy string `json:"zoneRedundancy,omitempty"`
+}
+
+type containerRegistryReplicationExtendedList struct {
+	Value []containerRegistryReplicationExtended `json:"value"`
+}
+
+// containerRegistryGeoReplication is a Replication which should exist - where the Zone Redundancy and Tags are
+// nil when managed through the deprecated `georeplication_locations` (and so shouldn't be changed)
+type containerRegistryGeoReplication struct {
+	Location              string
+	ZoneRedundancyEnabled *bool
+	Tags                  map[string]*string
+}
+
+func expandArmContainerRegistryGeoReplications(d *schema.ResourceData) []containerRegistryGeoReplication {
+	output := make([]containerRegistryGeoReplication, 0)
+
+	if v, ok := d.GetOk("georeplications"); ok {
+		for _, raw := range v.([]interface{}) {
+			replication := raw.(map[string]interface{})
+			output = append(output, containerRegistryGeoReplication{
+				Location:              azureRMNormalizeLocation(replication["location"].(string)),
+				ZoneRedundancyEnabled: utils.Bool(replication["zone_redundancy_enabled"].(bool)),
+				Tags:                  expandTags(replication["tags"].(map[string]interface{})),
+			})
+		}
+
+		return output
+	}
+
+	if v, ok := d.GetOk("georeplication_locations"); ok {
+		for _, location := range v.(*schema.Set).List() {
+			output = append(output, containerRegistryGeoReplication{
+				Location: azureRMNormalizeLocation(location),
+			})
+		}
+	}
+
+	return output
+}
+
+// flattenArmContainerRegistryGeoReplications returns the Replications other than that of the main location, in the
+// order they're currently defined in - with any others added to the end (sorted by location)
+func flattenArmContainerRegistryGeoReplications(input []containerRegistryReplicationExtended, homeLocation string, existing []interface{}) []interface{} {
+	replications := make(map[string]map[string]interface{})
+	for _, replication := range input {
+		if replication.Location == nil {
+			continue
+		}
+
+		location := azureRMNormalizeLocation(*replication.Location)
+		if location == homeLocation {
+			continue
+		}
+
+		zoneRedundancyEnabled := false
+		if props := replication.Properties; props != nil {
+			zoneRedundancyEnabled = strings.EqualFold(props.ZoneRedundancy, "Enabled")
+		}
+
+		tags := make(map[string]interface{})
+		for k, v := range replication.Tags {
+			if v != nil {
+				tags[k] = *v
+			}
+		}
+
+		replications[location] = map[string]interface{}{
+			"location":                location,
+			"zone_redundancy_enabled": zoneRedundancyEnabled,
+			"tags":                    tags,
+		}
+	}
+
+	output := make([]interface{}, 0)
+	for _, raw := range existing {
+		if raw == nil {
+			continue
+		}
+
+		location := azureRMNormalizeLocation(raw.(map[string]interface{})["location"].(string))
+		if replication, ok := replications[location]; ok {
+			output = append(output, replication)
+			delete(replications, location)
+		}
+	}
+
+	remaining := make([]string, 0)
+	for location := range replications {
+		remaining = append(remaining, location)
+	}
+	sort.Strings(remaining)
+
+	for _, location := range remaining {
+		output = append(output, replications[location])
+	}
+
+	return output
+}
+
+func listArmContainerRegistryReplications(ctx context.Context, client autorest.Client, baseURI string, registryId string) ([]containerRegistryReplicationExtended, error) {
+	result := containerRegistryReplicationExtendedList{}
+	if _, err := armRawGet(ctx, client, baseURI, fmt.Sprintf("%s/replications", registryId), containerRegistryExtendedApiVersion, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// applyContainerRegistryGeoReplications reconciles the Replications of the Container Registry with those specified,
+// updating existing Replications in-place where possible - since the Zone Redundancy of a Replication can only be
+// set when it's created, changing it requires that Replication (but not the Registry) to be recreated.
+func applyContainerRegistryGeoReplications(meta interface{}, registryId string, homeLocation string, desired []containerRegistryGeoReplication) error {
+	replicationClient := meta.(*ArmClient).containerRegistryReplicationsClient
+	ctx := meta.(*ArmClient).StopContext
+	log.Printf("[INFO] preparing to apply geo-replications for AzureRM Container Registry.")
+
+	id, err := parseAzureResourceID(registryId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["registries"]
+
+	replications, err := listArmContainerRegistryReplications(ctx, replicationClient.Client, replicationClient.BaseURI, registryId)
+	if err != nil {
+		return fmt.Errorf("Error listing Replications: %+v", err)
+	}
+
+	existing := make(map[string]containerRegistryReplicationExtended)
+	for _, replication := range replications {
+		if replication.Location == nil {
+			continue
+		}
+
+		location := azureRMNormalizeLocation(*replication.Location)
+		if location != homeLocation {
+			existing[location] = replication
+		}
+	}
+
+	deleteReplication := func(location string) error {
+		future, err := replicationClient.Delete(ctx, resourceGroup, name, location)
+		if err != nil {
+			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
+		}
+
+		return nil
+	}
+
+	desiredLocations := make(map[string]bool)
+	for _, replication := range desired {
+		location := azureRMNormalizeLocation(replication.Location)
+		desiredLocations[location] = true
+
+		if current, ok := existing[location]; ok {
+			// replications managed through `georeplication_locations` only need to exist
+			if replication.ZoneRedundancyEnabled == nil {
+				continue
+			}
+
+			zoneRedundancyEnabled := current.Properties != nil && strings.EqualFold(current.Properties.ZoneRedundancy, "Enabled")
+			if zoneRedundancyEnabled != *replication.ZoneRedundancyEnabled {
+				log.Printf("[DEBUG] Recreating Container Registry Replication %q (Resource Group %q, Location %q) to change the Zone Redundancy", name, resourceGroup, location)
+				if err := deleteReplication(location); err != nil {
+					return err
+				}
+			} else if containerRegistryTagsEqual(current.Tags, replication.Tags) {
+				continue
+			}
+		}
+
+		zoneRedundancy := "Disabled"
+		if replication.ZoneRedundancyEnabled != nil && *replication.ZoneRedundancyEnabled {
+			zoneRedundancy = "Enabled"
+		}
+
+		tags := replication.Tags
+		if tags == nil {
+			tags = make(map[string]*string)
+		}
+
+		// a PUT to an existing Replication updates it in-place
+		parameters := containerRegistryReplicationExtended{
+			Location: utils.String(location),
+			Tags:     tags,
+			Properties: &containerRegistryReplicationExtendedProperties{
+				ZoneRedundancy: zoneRedundancy,
+			},
+		}
+		replicationId := fmt.Sprintf("%s/replications/%s", registryId, location)
+		if err := armRawPut(ctx, replicationClient.Client, replicationClient.BaseURI, replicationId, containerRegistryExtendedApiVersion, parameters); err != nil {
+			return fmt.Errorf("Error creating/updating Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
+		}
+	}
+
+	for location := range existing {
+		if desiredLocations[location] {
+			continue
+		}
+
+		if err := deleteReplication(location); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containerRegistryTagsEqual(first map[string]*string, second map[string]*string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		other, ok := second[k]
+		if !ok || (v == nil) != (other == nil) || (v != nil && *v != *other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func updateArmContainerRegistryDataEndpoint(meta interface{}, registryId string, enabled bool) error {
+	client := meta.(*ArmClient).containerRegistryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	parameters := containerRegistryExtended{
+		Properties: &containerRegistryExtendedProperties{
+			DataEndpointEnabled: utils.Bool(enabled),
+		},
+	}
+	return armRawPatch(ctx, client.Client, client.BaseURI, registryId, containerRegistryExtendedApiVersion, parameters)
+}
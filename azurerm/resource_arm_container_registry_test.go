@@ -363,6 +363,97 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMContainerRegistry_geoReplications(t *testing.T) {
+	resourceName := "azurerm_container_registry.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplications(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.0.location", "westus"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.0.zone_redundancy_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.1.location", "eastus2"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.1.zone_redundancy_enabled", "true"),
+					testCheckAzureRMContainerRegistryGeoreplications(resourceName, "Premium", []string{"westus", "eastus2"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplicationsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_endpoint_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_endpoint_host_names.#"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.1.zone_redundancy_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "georeplications.2.location", "centralus"),
+					testCheckAzureRMContainerRegistryGeoreplications(resourceName, "Premium", []string{"westus", "eastus2", "centralus"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenArmContainerRegistryGeoReplications(t *testing.T) {
+	replication := func(location string, zoneRedundancy string) containerRegistryReplicationExtended {
+		return containerRegistryReplicationExtended{
+			Location: utils.String(location),
+			Properties: &containerRegistryReplicationExtendedProperties{
+				ZoneRedundancy: zoneRedundancy,
+			},
+		}
+	}
+
+	input := []containerRegistryReplicationExtended{
+		replication("West Europe", "Disabled"),
+		replication("eastus2", "Enabled"),
+		replication("centralus", "Disabled"),
+		replication("westus", "Disabled"),
+	}
+	existing := []interface{}{
+		map[string]interface{}{"location": "West US"},
+		map[string]interface{}{"location": "eastus2"},
+		map[string]interface{}{"location": "northeurope"},
+	}
+
+	output := flattenArmContainerRegistryGeoReplications(input, "westeurope", existing)
+
+	expectedLocations := []string{"westus", "eastus2", "centralus"}
+	if len(output) != len(expectedLocations) {
+		t.Fatalf("Expected %d Replications but got %d", len(expectedLocations), len(output))
+	}
+
+	for i, expected := range expectedLocations {
+		actual := output[i].(map[string]interface{})
+		if actual["location"].(string) != expected {
+			t.Fatalf("Expected Replication %d to be %q but got %q", i, expected, actual["location"].(string))
+		}
+
+		if zoneRedundant := actual["zone_redundancy_enabled"].(bool); zoneRedundant != (expected == "eastus2") {
+			t.Fatalf("Expected Replication %q to have `zone_redundancy_enabled` %t but got %t", expected, !zoneRedundant, zoneRedundant)
+		}
+	}
+}
+
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -605,3 +696,67 @@ resource "azurerm_container_registry" "test" {
 }
 `, rInt, location, rInt, sku)
 }
+
+func testAccAzureRMContainerRegistry_geoReplications(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "testAccRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Premium"
+
+  georeplications {
+    location = "westus"
+
+    tags {
+      environment = "testing"
+    }
+  }
+
+  georeplications {
+    location                = "eastus2"
+    zone_redundancy_enabled = true
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMContainerRegistry_geoReplicationsUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "testAccRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                  = "testacccr%d"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  location              = "${azurerm_resource_group.test.location}"
+  sku                   = "Premium"
+  data_endpoint_enabled = true
+
+  georeplications {
+    location = "westus"
+
+    tags {
+      environment = "testing"
+      cost_center = "acctest"
+    }
+  }
+
+  georeplications {
+    location                = "eastus2"
+    zone_redundancy_enabled = false
+  }
+
+  georeplications {
+    location = "centralus"
+  }
+}
+`, rInt, location, rInt)
+}
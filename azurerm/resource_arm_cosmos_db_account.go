@@ -3,6 +3,7 @@ package azurerm
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -238,6 +240,125 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				Default:  false,
 			},
 
+			"backup": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Continuous",
+								"Periodic",
+							}, false),
+						},
+
+						// the following are only applicable when `type` is `Periodic`
+						"interval_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 1440),
+						},
+
+						"retention_in_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(8, 720),
+						},
+
+						"storage_redundancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Geo",
+								"Local",
+								"Zone",
+							}, false),
+						},
+
+						// the following is only applicable when `type` is `Continuous`
+						"tier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Continuous7Days",
+								"Continuous30Days",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Default",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Default",
+					"Restore",
+				}, false),
+			},
+
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// either the ID of an existing CosmosDB Account, or of a Restorable Database Account
+						// (which is required to restore an Account which has since been deleted)
+						"source_cosmosdb_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"restore_timestamp_in_utc": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.RFC3339Time,
+						},
+
+						"database": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"collection_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			//computed
 			"endpoint": {
 				Type:     schema.TypeString,
@@ -293,7 +414,39 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceArmCosmosDBAccountCustomizeDiff,
+	}
+}
+
+func resourceArmCosmosDBAccountCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	createMode := diff.Get("create_mode").(string)
+	restore := diff.Get("restore").([]interface{})
+	if createMode == "Restore" && len(restore) == 0 {
+		return fmt.Errorf("`restore` must be specified when `create_mode` is `Restore`")
+	}
+	if createMode != "Restore" && len(restore) > 0 {
+		return fmt.Errorf("`restore` can only be specified when `create_mode` is `Restore`")
+	}
+
+	backupType := ""
+	if backup := diff.Get("backup").([]interface{}); len(backup) > 0 && backup[0] != nil {
+		backupType = backup[0].(map[string]interface{})["type"].(string)
+	}
+
+	// only accounts using Continuous backups can be restored to a point in time - and the restored account must too
+	if createMode == "Restore" && backupType != "Continuous" {
+		return fmt.Errorf("a `backup` block with the `type` `Continuous` must be specified when `create_mode` is `Restore`")
+	}
+
+	if diff.Id() != "" && diff.HasChange("backup.0.type") {
+		old, new := diff.GetChange("backup.0.type")
+		if old.(string) == "Continuous" && new.(string) == "Periodic" {
+			return fmt.Errorf("the `backup` `type` cannot be changed from `Continuous` to `Periodic` - the CosmosDB Account must be recreated")
+		}
 	}
+
+	return nil
 }
 
 func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
@@ -368,7 +521,28 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 		Tags: expandTags(tags),
 	}
 
-	resp, err := resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account)
+	// the Backup Policy, Create Mode and Restore Parameters aren't available in the SDK - and can only be specified
+	// at creation time, so when these are set the Account is created using a newer API version
+	extendedProperties := make(map[string]interface{})
+	if backupPolicy := expandAzureRmCosmosDBAccountBackupPolicy(d.Get("backup").([]interface{})); backupPolicy != nil {
+		extendedProperties["backupPolicy"] = backupPolicy
+	}
+	if createMode := d.Get("create_mode").(string); createMode == "Restore" {
+		restoreParameters, err := expandAzureRmCosmosDBAccountRestoreParameters(ctx, client, d.Get("restore").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding CosmosDB Account %q (Resource Group %q) `restore`: %+v", name, resourceGroup, err)
+		}
+
+		extendedProperties["createMode"] = createMode
+		extendedProperties["restoreParameters"] = restoreParameters
+	}
+
+	var resp *documentdb.DatabaseAccount
+	if len(extendedProperties) > 0 {
+		resp, err = resourceArmCosmosDBAccountApiUpsertExtended(client, ctx, resourceGroup, name, account, extendedProperties)
+	} else {
+		resp, err = resourceArmCosmosDBAccountApiUpsert(client, ctx, resourceGroup, name, account)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -541,6 +715,23 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error waiting on patch future CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if d.HasChange("backup") {
+		if backupPolicy := expandAzureRmCosmosDBAccountBackupPolicy(d.Get("backup").([]interface{})); backupPolicy != nil {
+			parameters := cosmosDBAccountExtended{
+				Properties: &cosmosDBAccountExtendedProperties{
+					BackupPolicy: backupPolicy,
+				},
+			}
+			if err := armRawPatch(ctx, client.Client, client.BaseURI, *id, cosmosDBAccountExtendedApiVersion, parameters); err != nil {
+				return fmt.Errorf("Error updating the Backup Policy for CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if _, err := resourceArmCosmosDBAccountWaitForProvisioning(client, ctx, resourceGroup, name); err != nil {
+				return err
+			}
+		}
+	}
+
 	d.SetId(*id)
 
 	return resourceArmCosmosDBAccountRead(d, meta)
@@ -614,6 +805,27 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error setting `virtual_network_rule`: %+v", err)
 	}
 
+	extended := cosmosDBAccountExtended{}
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, *resp.ID, cosmosDBAccountExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Backup Policy for CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	createMode := "Default"
+	var backupPolicy *cosmosDBAccountBackupPolicy
+	if props := extended.Properties; props != nil {
+		if props.CreateMode != nil && *props.CreateMode != "" {
+			createMode = *props.CreateMode
+		}
+		backupPolicy = props.BackupPolicy
+	}
+	d.Set("create_mode", createMode)
+	// `restore` isn't returned in a consistent format by the API (the source can be specified by the ID of either the
+	// CosmosDB Account or the Restorable Database Account) and is ForceNew, so it's intentionally not set here
+
+	if err = d.Set("backup", flattenAzureRmCosmosDBAccountBackupPolicy(backupPolicy)); err != nil {
+		return fmt.Errorf("Error setting `backup`: %+v", err)
+	}
+
 	if p := resp.ReadLocations; p != nil {
 		readEndpoints := make([]string, 0)
 		for _, l := range *p {
@@ -739,6 +951,10 @@ func resourceArmCosmosDBAccountApiUpsert(client documentdb.DatabaseAccountsClien
 		return nil, fmt.Errorf("Error waiting for the CosmosDB Account %q (Resource Group %q) to finish creating/updating: %+v", name, resourceGroup, err)
 	}
 
+	return resourceArmCosmosDBAccountWaitForProvisioning(client, ctx, resourceGroup, name)
+}
+
+func resourceArmCosmosDBAccountWaitForProvisioning(client documentdb.DatabaseAccountsClient, ctx context.Context, resourceGroup string, name string) (*documentdb.DatabaseAccount, error) {
 	//if a replication location is added or removed it can take some time to provision
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Creating", "Updating", "Deleting"},
@@ -845,7 +1061,7 @@ func expandAzureRmCosmosDBAccountGeoLocations(databaseName string, d *schema.Res
 	return locations, nil
 }
 
-//todo remove when deprecated field `failover_policy` is
+// todo remove when deprecated field `failover_policy` is
 func expandAzureRmCosmosDBAccountFailoverPolicy(databaseName string, d *schema.ResourceData) ([]documentdb.Location, error) {
 
 	input := d.Get("failover_policy").(*schema.Set).List()
@@ -932,7 +1148,7 @@ func flattenAzureRmCosmosDBAccountConsistencyPolicy(policy *documentdb.Consisten
 	return []interface{}{result}
 }
 
-//todo remove when failover_policy field is removed
+// todo remove when failover_policy field is removed
 func flattenAzureRmCosmosDBAccountFailoverPolicy(list *[]documentdb.FailoverPolicy) *schema.Set {
 	results := schema.Set{
 		F: resourceAzureRMCosmosDBAccountFailoverPolicyHash,
@@ -1018,7 +1234,7 @@ func flattenAzureRmCosmosDBAccountVirtualNetworkRules(rules *[]documentdb.Virtua
 	return &results
 }
 
-//todo remove once deprecated field `failover_policy` is removed
+// todo remove once deprecated field `failover_policy` is removed
 func resourceAzureRMCosmosDBAccountFailoverPolicyHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1068,3 +1284,240 @@ func resourceAzureRMCosmosDBAccountVirtualNetworkRuleHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+// the Backup Policy & Restore functionality isn't available in the 2015-04-08 API version used by the SDK - as such
+// these are set & retrieved using a newer API version. Notably newer API versions replace `ipRangeFilter` with `ipRules`
+// so we can't use this API version for all requests.
+const cosmosDBAccountExtendedApiVersion = "2023-04-15"
+
+type cosmosDBAccountExtended struct {
+	Location   *string                            `json:"location,omitempty"`
+	Properties *cosmosDBAccountExtendedProperties `json:"properties,omitempty"`
+}
+
+type cosmosDBAccountExtendedProperties struct {
+	InstanceID   *string                      `json:"instanceId,omitempty"`
+	CreateMode   *string                      `json:"createMode,omitempty"`
+	BackupPolicy *cosmosDBAccountBackupPolicy `json:"backupPolicy,omitempty"`
+}
+
+type cosmosDBAccountBackupPolicy struct {
+	Type                     *string                                  `json:"type,omitempty"`
+	PeriodicModeProperties   *cosmosDBAccountPeriodicModeProperties   `json:"periodicModeProperties,omitempty"`
+	ContinuousModeProperties *cosmosDBAccountContinuousModeProperties `json:"continuousModeProperties,omitempty"`
+}
+
+type cosmosDBAccountPeriodicModeProperties struct {
+	BackupIntervalInMinutes        *int32  `json:"backupIntervalInMinutes,omitempty"`
+	BackupRetentionIntervalInHours *int32  `json:"backupRetentionIntervalInHours,omitempty"`
+	BackupStorageRedundancy        *string `json:"backupStorageRedundancy,omitempty"`
+}
+
+type cosmosDBAccountContinuousModeProperties struct {
+	Tier *string `json:"tier,omitempty"`
+}
+
+type cosmosDBAccountRestoreParameters struct {
+	RestoreMode           *string                                   `json:"restoreMode,omitempty"`
+	RestoreSource         *string                                   `json:"restoreSource,omitempty"`
+	RestoreTimestampInUtc *string                                   `json:"restoreTimestampInUtc,omitempty"`
+	DatabasesToRestore    *[]cosmosDBAccountDatabaseRestoreResource `json:"databasesToRestore,omitempty"`
+}
+
+type cosmosDBAccountDatabaseRestoreResource struct {
+	DatabaseName    *string   `json:"databaseName,omitempty"`
+	CollectionNames *[]string `json:"collectionNames,omitempty"`
+}
+
+// resourceArmCosmosDBAccountApiUpsertExtended creates the CosmosDB Account using the newer API version, including the
+// specified properties (e.g. the `backupPolicy`) which aren't available in the SDK
+func resourceArmCosmosDBAccountApiUpsertExtended(client documentdb.DatabaseAccountsClient, ctx context.Context, resourceGroup string, name string, account documentdb.DatabaseAccountCreateUpdateParameters, extendedProperties map[string]interface{}) (*documentdb.DatabaseAccount, error) {
+	parameters, err := expandAzureRmCosmosDBAccountExtendedParameters(account, extendedProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s", client.SubscriptionID, resourceGroup, name)
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, cosmosDBAccountExtendedApiVersion, parameters); err != nil {
+		return nil, fmt.Errorf("Error creating/updating CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmCosmosDBAccountWaitForProvisioning(client, ctx, resourceGroup, name)
+}
+
+func expandAzureRmCosmosDBAccountExtendedParameters(account documentdb.DatabaseAccountCreateUpdateParameters, extendedProperties map[string]interface{}) (map[string]interface{}, error) {
+	// the SDK model flattens the properties when marshalling, so round-trip it to be able to add the extra properties
+	serialized, err := json.Marshal(account)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing CosmosDB Account: %+v", err)
+	}
+
+	parameters := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &parameters); err != nil {
+		return nil, fmt.Errorf("Error deserializing CosmosDB Account: %+v", err)
+	}
+
+	properties, ok := parameters["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+
+	// newer API versions replace the comma-separated `ipRangeFilter` with a list of `ipRules`
+	if ipRangeFilter, ok := properties["ipRangeFilter"].(string); ok {
+		ipRules := make([]interface{}, 0)
+		for _, v := range strings.Split(ipRangeFilter, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				ipRules = append(ipRules, map[string]interface{}{
+					"ipAddressOrRange": v,
+				})
+			}
+		}
+
+		delete(properties, "ipRangeFilter")
+		properties["ipRules"] = ipRules
+	}
+
+	for k, v := range extendedProperties {
+		properties[k] = v
+	}
+	parameters["properties"] = properties
+
+	return parameters, nil
+}
+
+func expandAzureRmCosmosDBAccountBackupPolicy(input []interface{}) *cosmosDBAccountBackupPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	backupType := v["type"].(string)
+	policy := cosmosDBAccountBackupPolicy{
+		Type: utils.String(backupType),
+	}
+
+	if backupType == "Continuous" {
+		properties := cosmosDBAccountContinuousModeProperties{}
+		if tier := v["tier"].(string); tier != "" {
+			properties.Tier = utils.String(tier)
+		}
+		policy.ContinuousModeProperties = &properties
+
+		return &policy
+	}
+
+	properties := cosmosDBAccountPeriodicModeProperties{}
+	if interval := v["interval_in_minutes"].(int); interval != 0 {
+		properties.BackupIntervalInMinutes = utils.Int32(int32(interval))
+	}
+	if retention := v["retention_in_hours"].(int); retention != 0 {
+		properties.BackupRetentionIntervalInHours = utils.Int32(int32(retention))
+	}
+	if redundancy := v["storage_redundancy"].(string); redundancy != "" {
+		properties.BackupStorageRedundancy = utils.String(redundancy)
+	}
+	policy.PeriodicModeProperties = &properties
+
+	return &policy
+}
+
+func flattenAzureRmCosmosDBAccountBackupPolicy(input *cosmosDBAccountBackupPolicy) []interface{} {
+	if input == nil || input.Type == nil {
+		return []interface{}{}
+	}
+
+	interval := 0
+	retention := 0
+	redundancy := ""
+	if props := input.PeriodicModeProperties; props != nil {
+		if props.BackupIntervalInMinutes != nil {
+			interval = int(*props.BackupIntervalInMinutes)
+		}
+		if props.BackupRetentionIntervalInHours != nil {
+			retention = int(*props.BackupRetentionIntervalInHours)
+		}
+		if props.BackupStorageRedundancy != nil {
+			redundancy = *props.BackupStorageRedundancy
+		}
+	}
+
+	tier := ""
+	if props := input.ContinuousModeProperties; props != nil && props.Tier != nil {
+		tier = *props.Tier
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                *input.Type,
+			"interval_in_minutes": interval,
+			"retention_in_hours":  retention,
+			"storage_redundancy":  redundancy,
+			"tier":                tier,
+		},
+	}
+}
+
+func expandAzureRmCosmosDBAccountRestoreParameters(ctx context.Context, client documentdb.DatabaseAccountsClient, input []interface{}) (*cosmosDBAccountRestoreParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("`restore` must be specified when `create_mode` is `Restore`")
+	}
+
+	v := input[0].(map[string]interface{})
+	restoreSource, err := resourceArmCosmosDBAccountRestorableDatabaseAccountId(ctx, client, v["source_cosmosdb_account_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	databases := make([]cosmosDBAccountDatabaseRestoreResource, 0)
+	for _, raw := range v["database"].(*schema.Set).List() {
+		database := raw.(map[string]interface{})
+		collectionNames := make([]string, 0)
+		for _, collectionName := range database["collection_names"].(*schema.Set).List() {
+			collectionNames = append(collectionNames, collectionName.(string))
+		}
+
+		databases = append(databases, cosmosDBAccountDatabaseRestoreResource{
+			DatabaseName:    utils.String(database["name"].(string)),
+			CollectionNames: &collectionNames,
+		})
+	}
+
+	parameters := cosmosDBAccountRestoreParameters{
+		RestoreMode:           utils.String("PointInTime"),
+		RestoreSource:         utils.String(restoreSource),
+		RestoreTimestampInUtc: utils.String(v["restore_timestamp_in_utc"].(string)),
+	}
+	// when no databases are specified the entire account is restored
+	if len(databases) > 0 {
+		parameters.DatabasesToRestore = &databases
+	}
+
+	return &parameters, nil
+}
+
+// resourceArmCosmosDBAccountRestorableDatabaseAccountId returns the ID of the Restorable Database Account for the
+// specified CosmosDB Account, which is what the API expects as the source of a restore
+func resourceArmCosmosDBAccountRestorableDatabaseAccountId(ctx context.Context, client documentdb.DatabaseAccountsClient, sourceId string) (string, error) {
+	if strings.Contains(strings.ToLower(sourceId), "/restorabledatabaseaccounts/") {
+		return sourceId, nil
+	}
+
+	id, err := parseAzureResourceID(sourceId)
+	if err != nil {
+		return "", err
+	}
+	if id.Path["databaseAccounts"] == "" {
+		return "", fmt.Errorf("`source_cosmosdb_account_id` must be the ID of either a CosmosDB Account or a Restorable Database Account but got %q", sourceId)
+	}
+
+	source := cosmosDBAccountExtended{}
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, sourceId, cosmosDBAccountExtendedApiVersion, &source); err != nil {
+		return "", fmt.Errorf("Error retrieving source CosmosDB Account %q: %+v", sourceId, err)
+	}
+
+	if source.Location == nil || source.Properties == nil || source.Properties.InstanceID == nil {
+		return "", fmt.Errorf("Error retrieving source CosmosDB Account %q: `location` or `instanceId` was nil", sourceId)
+	}
+
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.DocumentDB/locations/%s/restorableDatabaseAccounts/%s", id.SubscriptionID, azureRMNormalizeLocation(*source.Location), *source.Properties.InstanceID), nil
+}
@@ -3,13 +3,16 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // TODO: refactor the test configs
@@ -642,6 +645,194 @@ func TestAccAzureRMCosmosDBAccount_multiMaster(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCosmosDBAccount_backup(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_account.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBAccount_backupPeriodic(ri, testLocation(), 120, 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.type", "Periodic"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.interval_in_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.retention_in_hours", "8"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.storage_redundancy", "Local"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCosmosDBAccount_backupPeriodic(ri, testLocation(), 240, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.interval_in_minutes", "240"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.retention_in_hours", "24"),
+				),
+			},
+			{
+				// Periodic backups can be migrated to Continuous backups, but not the other way around
+				Config: testAccAzureRMCosmosDBAccount_backupContinuous(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.type", "Continuous"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.tier", "Continuous7Days"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBAccount_restore(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_account.restored"
+	location := testLocation()
+
+	// the source account needs to exist at the point in time it's restored to - which has to be in the past
+	restoreTimestamp := time.Now().UTC().Add(30 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBAccount_backupContinuous(ri, location),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+				),
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(time.Until(restoreTimestamp.Add(5 * time.Minute)))
+				},
+				Config: testAccAzureRMCosmosDBAccount_restore(ri, location, restoreTimestamp.Format(time.RFC3339)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_mode", "Restore"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.type", "Continuous"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore"},
+			},
+		},
+	})
+}
+
+func TestExpandAzureRmCosmosDBAccountExtendedParameters(t *testing.T) {
+	account := documentdb.DatabaseAccountCreateUpdateParameters{
+		Location: utils.String("westeurope"),
+		DatabaseAccountCreateUpdateProperties: &documentdb.DatabaseAccountCreateUpdateProperties{
+			DatabaseAccountOfferType: utils.String("Standard"),
+			IPRangeFilter:            utils.String("10.0.0.1,10.20.0.0/16"),
+		},
+	}
+	extendedProperties := map[string]interface{}{
+		"createMode": "Restore",
+	}
+
+	actual, err := expandAzureRmCosmosDBAccountExtendedParameters(account, extendedProperties)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual["location"] != "westeurope" {
+		t.Fatalf("Expected the `location` to be %q but got %q", "westeurope", actual["location"])
+	}
+
+	properties := actual["properties"].(map[string]interface{})
+	if properties["databaseAccountOfferType"] != "Standard" {
+		t.Fatalf("Expected the `databaseAccountOfferType` to be %q but got %q", "Standard", properties["databaseAccountOfferType"])
+	}
+	if properties["createMode"] != "Restore" {
+		t.Fatalf("Expected the `createMode` to be %q but got %q", "Restore", properties["createMode"])
+	}
+	if _, ok := properties["ipRangeFilter"]; ok {
+		t.Fatalf("Expected the `ipRangeFilter` to be replaced by `ipRules`")
+	}
+
+	expectedIpRules := []interface{}{
+		map[string]interface{}{"ipAddressOrRange": "10.0.0.1"},
+		map[string]interface{}{"ipAddressOrRange": "10.20.0.0/16"},
+	}
+	if !reflect.DeepEqual(properties["ipRules"], expectedIpRules) {
+		t.Fatalf("Expected the `ipRules` to be %+v but got %+v", expectedIpRules, properties["ipRules"])
+	}
+}
+
+func TestExpandAzureRmCosmosDBAccountBackupPolicy(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected *cosmosDBAccountBackupPolicy
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":                "Periodic",
+					"interval_in_minutes": 240,
+					"retention_in_hours":  8,
+					"storage_redundancy":  "Geo",
+					"tier":                "",
+				},
+			},
+			Expected: &cosmosDBAccountBackupPolicy{
+				Type: utils.String("Periodic"),
+				PeriodicModeProperties: &cosmosDBAccountPeriodicModeProperties{
+					BackupIntervalInMinutes:        utils.Int32(240),
+					BackupRetentionIntervalInHours: utils.Int32(8),
+					BackupStorageRedundancy:        utils.String("Geo"),
+				},
+			},
+		},
+		{
+			// the Periodic fields are Computed, so may still be present in the state after migrating to Continuous
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":                "Continuous",
+					"interval_in_minutes": 240,
+					"retention_in_hours":  8,
+					"storage_redundancy":  "Geo",
+					"tier":                "Continuous30Days",
+				},
+			},
+			Expected: &cosmosDBAccountBackupPolicy{
+				Type: utils.String("Continuous"),
+				ContinuousModeProperties: &cosmosDBAccountContinuousModeProperties{
+					Tier: utils.String("Continuous30Days"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := expandAzureRmCosmosDBAccountBackupPolicy(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
 func testCheckAzureRMCosmosDBAccountDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -948,3 +1139,56 @@ func checkAccAzureRMCosmosDBAccount_basic(resourceName string, location string,
 		resource.TestCheckResourceAttrSet(resourceName, "secondary_readonly_master_key"),
 	)
 }
+
+func testAccAzureRMCosmosDBAccount_backupPeriodic(rInt int, location string, interval int, retention int) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", fmt.Sprintf(`
+        backup {
+            type                = "Periodic"
+            interval_in_minutes = %d
+            retention_in_hours  = %d
+            storage_redundancy  = "Local"
+        }
+    `, interval, retention))
+}
+
+func testAccAzureRMCosmosDBAccount_backupContinuous(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+        backup {
+            type = "Continuous"
+            tier = "Continuous7Days"
+        }
+    `)
+}
+
+func testAccAzureRMCosmosDBAccount_restore(rInt int, location string, restoreTimestamp string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_account" "restored" {
+  name                = "acctest-%d-restored"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  offer_type          = "Standard"
+  create_mode         = "Restore"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = "${azurerm_resource_group.test.location}"
+    failover_priority = 0
+  }
+
+  backup {
+    type = "Continuous"
+    tier = "Continuous7Days"
+  }
+
+  restore {
+    source_cosmosdb_account_id = "${azurerm_cosmosdb_account.test.id}"
+    restore_timestamp_in_utc   = "%s"
+  }
+}
+`, testAccAzureRMCosmosDBAccount_backupContinuous(rInt, location), rInt, restoreTimestamp)
+}
@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Dev Center (Azure Deployment Environments) isn't present in the vendored SDK, so is managed using raw requests
+const devCenterApiVersion = "2023-04-01"
+
+type devCenter struct {
+	ID         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Location   *string              `json:"location,omitempty"`
+	Tags       map[string]*string   `json:"tags"`
+	Identity   *devCenterIdentity   `json:"identity,omitempty"`
+	Properties *devCenterProperties `json:"properties,omitempty"`
+}
+
+type devCenterIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type devCenterProperties struct {
+	DevCenterURI *string `json:"devCenterUri,omitempty"`
+}
+
+func resourceArmDevCenter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevCenterCreateUpdate,
+		Read:   resourceArmDevCenterRead,
+		Update: resourceArmDevCenterCreateUpdate,
+		Delete: resourceArmDevCenterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevCenterName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"dev_center_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevCenterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := devCenterID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing devCenter
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Dev Center %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_center", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := devCenter{
+		Location:   utils.String(location),
+		Identity:   expandArmDevCenterIdentity(d.Get("identity").([]interface{})),
+		Properties: &devCenterProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Dev Center %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmDevCenterRead(d, meta)
+}
+
+func resourceArmDevCenterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["devcenters"]
+
+	var resp devCenter
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Dev Center %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dev Center %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmDevCenterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("dev_center_uri", props.DevCenterURI)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDevCenterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["devcenters"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Dev Center %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devCenterID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/devcenters/%s", subscriptionId, resourceGroup, name)
+}
+
+// parseDevCenterID parses the ID of a Dev Center referenced by a child resource (such as a Catalog or Environment Type)
+func parseDevCenterID(input string) (*ResourceID, string, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := id.Path["devcenters"]
+	if name == "" {
+		return nil, "", fmt.Errorf("Expected %q to be the ID of a Dev Center", input)
+	}
+
+	return id, name, nil
+}
+
+func expandArmDevCenterIdentity(input []interface{}) *devCenterIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &devCenterIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &devCenterIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmDevCenterIdentity(input *devCenterIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
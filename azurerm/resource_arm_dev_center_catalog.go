@@ -0,0 +1,278 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type devCenterCatalog struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *devCenterCatalogProperties `json:"properties,omitempty"`
+}
+
+type devCenterCatalogProperties struct {
+	GitHub *devCenterGitCatalog `json:"gitHub,omitempty"`
+	AdoGit *devCenterGitCatalog `json:"adoGit,omitempty"`
+}
+
+type devCenterGitCatalog struct {
+	URI              *string `json:"uri,omitempty"`
+	Branch           *string `json:"branch,omitempty"`
+	Path             *string `json:"path,omitempty"`
+	SecretIdentifier *string `json:"secretIdentifier,omitempty"`
+}
+
+func resourceArmDevCenterCatalog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevCenterCatalogCreateUpdate,
+		Read:   resourceArmDevCenterCatalogRead,
+		Update: resourceArmDevCenterCatalogCreateUpdate,
+		Delete: resourceArmDevCenterCatalogDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevCenterProjectName,
+			},
+
+			"dev_center_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"catalog_github": devCenterGitCatalogSchema("catalog_adogit"),
+
+			"catalog_adogit": devCenterGitCatalogSchema("catalog_github"),
+		},
+	}
+}
+
+func devCenterGitCatalogSchema(conflictsWith string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{conflictsWith},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"branch": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// the folder within the repository containing the Environment Definitions
+				"path": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// the Key Vault Secret containing the Personal Access Token, which the Dev Center's
+				// Managed Identity needs to be able to read - not required for public repositories
+				"key_vault_key_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDevCenterCatalogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	devCenterId := d.Get("dev_center_id").(string)
+
+	parsed, devCenterName, err := parseDevCenterID(devCenterId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `dev_center_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+
+	id := devCenterCatalogID(devCenterID(parsed.SubscriptionID, resourceGroup, devCenterName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing devCenterCatalog
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Catalog %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_center_catalog", *existing.ID)
+		}
+	}
+
+	github := expandArmDevCenterGitCatalog(d.Get("catalog_github").([]interface{}))
+	adoGit := expandArmDevCenterGitCatalog(d.Get("catalog_adogit").([]interface{}))
+	if github == nil && adoGit == nil {
+		return fmt.Errorf("One of `catalog_github` or `catalog_adogit` must be specified")
+	}
+
+	parameters := devCenterCatalog{
+		Properties: &devCenterCatalogProperties{
+			GitHub: github,
+			AdoGit: adoGit,
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Catalog %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmDevCenterCatalogRead(d, meta)
+}
+
+func resourceArmDevCenterCatalogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	devCenterName := id.Path["devcenters"]
+	name := id.Path["catalogs"]
+
+	var resp devCenterCatalog
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Catalog %q was not found in Dev Center %q (Resource Group %q) - removing from state", name, devCenterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Catalog %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("dev_center_id", devCenterID(id.SubscriptionID, resourceGroup, devCenterName))
+
+	if props := resp.Properties; props != nil {
+		if err := d.Set("catalog_github", flattenArmDevCenterGitCatalog(props.GitHub)); err != nil {
+			return fmt.Errorf("Error setting `catalog_github`: %+v", err)
+		}
+
+		if err := d.Set("catalog_adogit", flattenArmDevCenterGitCatalog(props.AdoGit)); err != nil {
+			return fmt.Errorf("Error setting `catalog_adogit`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDevCenterCatalogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	devCenterName := id.Path["devcenters"]
+	name := id.Path["catalogs"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Catalog %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devCenterCatalogID(devCenterId, name string) string {
+	return fmt.Sprintf("%s/catalogs/%s", devCenterId, name)
+}
+
+func expandArmDevCenterGitCatalog(input []interface{}) *devCenterGitCatalog {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	catalog := devCenterGitCatalog{
+		URI:    utils.String(v["uri"].(string)),
+		Branch: utils.String(v["branch"].(string)),
+		Path:   utils.String(v["path"].(string)),
+	}
+
+	if secretIdentifier := v["key_vault_key_url"].(string); secretIdentifier != "" {
+		catalog.SecretIdentifier = utils.String(secretIdentifier)
+	}
+
+	return &catalog
+}
+
+func flattenArmDevCenterGitCatalog(input *devCenterGitCatalog) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	uri := ""
+	if input.URI != nil {
+		uri = *input.URI
+	}
+
+	branch := ""
+	if input.Branch != nil {
+		branch = *input.Branch
+	}
+
+	path := ""
+	if input.Path != nil {
+		path = *input.Path
+	}
+
+	secretIdentifier := ""
+	if input.SecretIdentifier != nil {
+		secretIdentifier = *input.SecretIdentifier
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uri":               uri,
+			"branch":            branch,
+			"path":              path,
+			"key_vault_key_url": secretIdentifier,
+		},
+	}
+}
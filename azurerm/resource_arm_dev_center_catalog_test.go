@@ -0,0 +1,113 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMDevCenterCatalog_gitHub(t *testing.T) {
+	resourceName := "azurerm_dev_center_catalog.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterCatalogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterCatalog_gitHub(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterCatalogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "catalog_github.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_github.0.path", "/Environments"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_adogit.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterCatalog_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_center_catalog.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterCatalogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterCatalog_gitHub(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterCatalogExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevCenterCatalog_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_dev_center_catalog"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevCenterCatalogExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMDevCenterResourceExists(resourceName, "Dev Center Catalog")
+}
+
+func testCheckAzureRMDevCenterCatalogDestroy(s *terraform.State) error {
+	return testCheckAzureRMDevCenterResourceDestroy(s, "azurerm_dev_center_catalog", "Dev Center Catalog")
+}
+
+func testAccAzureRMDevCenterCatalog_gitHub(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "test" {
+  name          = "acctestdcc-%d"
+  dev_center_id = "${azurerm_dev_center.test.id}"
+
+  catalog_github {
+    uri    = "https://github.com/Azure/deployment-environments.git"
+    branch = "main"
+    path   = "/Environments"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevCenterCatalog_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterCatalog_gitHub(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "import" {
+  name          = "${azurerm_dev_center_catalog.test.name}"
+  dev_center_id = "${azurerm_dev_center_catalog.test.dev_center_id}"
+
+  catalog_github {
+    uri    = "https://github.com/Azure/deployment-environments.git"
+    branch = "main"
+    path   = "/Environments"
+  }
+}
+`, template)
+}
@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type devCenterEnvironmentType struct {
+	ID         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Tags       map[string]*string                  `json:"tags"`
+	Properties *devCenterEnvironmentTypeProperties `json:"properties,omitempty"`
+}
+
+type devCenterEnvironmentTypeProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+func resourceArmDevCenterEnvironmentType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevCenterEnvironmentTypeCreateUpdate,
+		Read:   resourceArmDevCenterEnvironmentTypeRead,
+		Update: resourceArmDevCenterEnvironmentTypeCreateUpdate,
+		Delete: resourceArmDevCenterEnvironmentTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevCenterEnvironmentTypeName,
+			},
+
+			"dev_center_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevCenterEnvironmentTypeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	devCenterId := d.Get("dev_center_id").(string)
+
+	parsed, devCenterName, err := parseDevCenterID(devCenterId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `dev_center_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+
+	id := devCenterEnvironmentTypeID(devCenterID(parsed.SubscriptionID, resourceGroup, devCenterName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing devCenterEnvironmentType
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Environment Type %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_center_environment_type", *existing.ID)
+		}
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := devCenterEnvironmentType{
+		Properties: &devCenterEnvironmentTypeProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Environment Type %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmDevCenterEnvironmentTypeRead(d, meta)
+}
+
+func resourceArmDevCenterEnvironmentTypeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	devCenterName := id.Path["devcenters"]
+	name := id.Path["environmentTypes"]
+
+	var resp devCenterEnvironmentType
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Environment Type %q was not found in Dev Center %q (Resource Group %q) - removing from state", name, devCenterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Environment Type %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("dev_center_id", devCenterID(id.SubscriptionID, resourceGroup, devCenterName))
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDevCenterEnvironmentTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	devCenterName := id.Path["devcenters"]
+	name := id.Path["environmentTypes"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Environment Type %q (Dev Center %q / Resource Group %q): %+v", name, devCenterName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devCenterEnvironmentTypeID(devCenterId, name string) string {
+	return fmt.Sprintf("%s/environmentTypes/%s", devCenterId, name)
+}
@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMDevCenterEnvironmentType_basic(t *testing.T) {
+	resourceName := "azurerm_dev_center_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterEnvironmentType_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterEnvironmentTypeExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterEnvironmentType_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_center_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterEnvironmentType_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterEnvironmentTypeExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevCenterEnvironmentType_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_dev_center_environment_type"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterEnvironmentType_updateTags(t *testing.T) {
+	resourceName := "azurerm_dev_center_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterEnvironmentType_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterEnvironmentTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevCenterEnvironmentType_tags(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterEnvironmentTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Development"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevCenterEnvironmentTypeExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMDevCenterResourceExists(resourceName, "Dev Center Environment Type")
+}
+
+func testCheckAzureRMDevCenterEnvironmentTypeDestroy(s *terraform.State) error {
+	return testCheckAzureRMDevCenterResourceDestroy(s, "azurerm_dev_center_environment_type", "Dev Center Environment Type")
+}
+
+func testAccAzureRMDevCenterEnvironmentType_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctestdcet-%d"
+  dev_center_id = "${azurerm_dev_center.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevCenterEnvironmentType_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterEnvironmentType_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "import" {
+  name          = "${azurerm_dev_center_environment_type.test.name}"
+  dev_center_id = "${azurerm_dev_center_environment_type.test.dev_center_id}"
+}
+`, template)
+}
+
+func testAccAzureRMDevCenterEnvironmentType_tags(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctestdcet-%d"
+  dev_center_id = "${azurerm_dev_center.test.id}"
+
+  tags {
+    environment = "Development"
+  }
+}
+`, template, rInt)
+}
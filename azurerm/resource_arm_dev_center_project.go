@@ -0,0 +1,203 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type devCenterProject struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Location   *string                     `json:"location,omitempty"`
+	Tags       map[string]*string          `json:"tags"`
+	Properties *devCenterProjectProperties `json:"properties,omitempty"`
+}
+
+type devCenterProjectProperties struct {
+	DevCenterID        *string `json:"devCenterId,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	MaxDevBoxesPerUser *int32  `json:"maxDevBoxesPerUser,omitempty"`
+	DevCenterURI       *string `json:"devCenterUri,omitempty"`
+}
+
+func resourceArmDevCenterProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevCenterProjectCreateUpdate,
+		Read:   resourceArmDevCenterProjectRead,
+		Update: resourceArmDevCenterProjectCreateUpdate,
+		Delete: resourceArmDevCenterProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevCenterProjectName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"dev_center_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"maximum_dev_boxes_per_user": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"dev_center_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevCenterProjectCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := devCenterProjectID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing devCenterProject
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Dev Center Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_center_project", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := devCenterProject{
+		Location: utils.String(location),
+		Properties: &devCenterProjectProperties{
+			DevCenterID: utils.String(d.Get("dev_center_id").(string)),
+			Description: utils.String(d.Get("description").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	// when omitted there's no limit on the number of Dev Boxes per user
+	if v, ok := d.GetOk("maximum_dev_boxes_per_user"); ok {
+		parameters.Properties.MaxDevBoxesPerUser = utils.Int32(int32(v.(int)))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Dev Center Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmDevCenterProjectRead(d, meta)
+}
+
+func resourceArmDevCenterProjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["projects"]
+
+	var resp devCenterProject
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Dev Center Project %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dev Center Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("dev_center_id", props.DevCenterID)
+		d.Set("description", props.Description)
+		d.Set("dev_center_uri", props.DevCenterURI)
+
+		maxDevBoxesPerUser := 0
+		if props.MaxDevBoxesPerUser != nil {
+			maxDevBoxesPerUser = int(*props.MaxDevBoxesPerUser)
+		}
+		d.Set("maximum_dev_boxes_per_user", maxDevBoxesPerUser)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDevCenterProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["projects"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Dev Center Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devCenterProjectID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/projects/%s", subscriptionId, resourceGroup, name)
+}
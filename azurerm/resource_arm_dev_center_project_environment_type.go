@@ -0,0 +1,330 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type devCenterProjectEnvironmentType struct {
+	ID         *string                                    `json:"id,omitempty"`
+	Name       *string                                    `json:"name,omitempty"`
+	Location   *string                                    `json:"location,omitempty"`
+	Tags       map[string]*string                         `json:"tags"`
+	Identity   *devCenterIdentity                         `json:"identity,omitempty"`
+	Properties *devCenterProjectEnvironmentTypeProperties `json:"properties,omitempty"`
+}
+
+type devCenterProjectEnvironmentTypeProperties struct {
+	DeploymentTargetID    *string                             `json:"deploymentTargetId,omitempty"`
+	Status                *string                             `json:"status,omitempty"`
+	CreatorRoleAssignment *devCenterRoleAssignment            `json:"creatorRoleAssignment,omitempty"`
+	UserRoleAssignments   map[string]*devCenterRoleAssignment `json:"userRoleAssignments"`
+}
+
+// devCenterRoleAssignment is keyed on the ID of the Role Definition, with the name and description being computed
+type devCenterRoleAssignment struct {
+	Roles map[string]*devCenterRole `json:"roles"`
+}
+
+type devCenterRole struct {
+	RoleName    *string `json:"roleName,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func resourceArmDevCenterProjectEnvironmentType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevCenterProjectEnvironmentTypeCreateUpdate,
+		Read:   resourceArmDevCenterProjectEnvironmentTypeRead,
+		Update: resourceArmDevCenterProjectEnvironmentTypeCreateUpdate,
+		Delete: resourceArmDevCenterProjectEnvironmentTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// this must match the name of an Environment Type within the Dev Center
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevCenterEnvironmentTypeName,
+			},
+
+			"location": locationSchema(),
+
+			"dev_center_project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the Subscription in which Environments of this type are deployed
+			"deployment_target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// Environments are deployed using the Managed Identity of the Project Environment Type
+			"identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// the Role Definitions assigned to the creator of each Environment, on the Environment's Resource Group
+			"creator_role_assignment_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"user_role_assignment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.UUID,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevCenterProjectEnvironmentTypeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	projectId := d.Get("dev_center_project_id").(string)
+
+	parsed, err := parseAzureResourceID(projectId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `dev_center_project_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+	projectName := parsed.Path["projects"]
+	if projectName == "" {
+		return fmt.Errorf("Expected `dev_center_project_id` to be the ID of a Dev Center Project but got %q", projectId)
+	}
+
+	id := devCenterProjectEnvironmentTypeID(devCenterProjectID(parsed.SubscriptionID, resourceGroup, projectName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing devCenterProjectEnvironmentType
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Environment Type %q (Dev Center Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_center_project_environment_type", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := devCenterProjectEnvironmentType{
+		Location: utils.String(location),
+		Identity: expandArmDevCenterIdentity(d.Get("identity").([]interface{})),
+		Properties: &devCenterProjectEnvironmentTypeProperties{
+			DeploymentTargetID: utils.String(d.Get("deployment_target_id").(string)),
+			Status:             utils.String("Enabled"),
+			CreatorRoleAssignment: &devCenterRoleAssignment{
+				Roles: expandArmDevCenterRoles(d.Get("creator_role_assignment_roles").(*schema.Set).List()),
+			},
+			UserRoleAssignments: expandArmDevCenterUserRoleAssignments(d.Get("user_role_assignment").(*schema.Set).List()),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, devCenterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Environment Type %q (Dev Center Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmDevCenterProjectEnvironmentTypeRead(d, meta)
+}
+
+func resourceArmDevCenterProjectEnvironmentTypeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	name := id.Path["environmentTypes"]
+
+	var resp devCenterProjectEnvironmentType
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Environment Type %q was not found in Dev Center Project %q (Resource Group %q) - removing from state", name, projectName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Environment Type %q (Dev Center Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("dev_center_project_id", devCenterProjectID(id.SubscriptionID, resourceGroup, projectName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmDevCenterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_target_id", props.DeploymentTargetID)
+
+		creatorRoles := make([]interface{}, 0)
+		if props.CreatorRoleAssignment != nil {
+			creatorRoles = flattenArmDevCenterRoles(props.CreatorRoleAssignment.Roles)
+		}
+		if err := d.Set("creator_role_assignment_roles", schema.NewSet(schema.HashString, creatorRoles)); err != nil {
+			return fmt.Errorf("Error setting `creator_role_assignment_roles`: %+v", err)
+		}
+
+		if err := d.Set("user_role_assignment", flattenArmDevCenterUserRoleAssignments(props.UserRoleAssignments)); err != nil {
+			return fmt.Errorf("Error setting `user_role_assignment`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDevCenterProjectEnvironmentTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	name := id.Path["environmentTypes"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), devCenterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Environment Type %q (Dev Center Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devCenterProjectEnvironmentTypeID(projectId, name string) string {
+	return fmt.Sprintf("%s/environmentTypes/%s", projectId, name)
+}
+
+func expandArmDevCenterRoles(input []interface{}) map[string]*devCenterRole {
+	roles := make(map[string]*devCenterRole)
+	for _, v := range input {
+		roles[v.(string)] = &devCenterRole{}
+	}
+	return roles
+}
+
+func flattenArmDevCenterRoles(input map[string]*devCenterRole) []interface{} {
+	results := make([]interface{}, 0)
+	for roleId := range input {
+		results = append(results, roleId)
+	}
+	return results
+}
+
+func expandArmDevCenterUserRoleAssignments(input []interface{}) map[string]*devCenterRoleAssignment {
+	assignments := make(map[string]*devCenterRoleAssignment)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		assignments[v["user_id"].(string)] = &devCenterRoleAssignment{
+			Roles: expandArmDevCenterRoles(v["role_ids"].(*schema.Set).List()),
+		}
+	}
+	return assignments
+}
+
+func flattenArmDevCenterUserRoleAssignments(input map[string]*devCenterRoleAssignment) []interface{} {
+	results := make([]interface{}, 0)
+	for userId, assignment := range input {
+		roleIds := make([]interface{}, 0)
+		if assignment != nil {
+			roleIds = flattenArmDevCenterRoles(assignment.Roles)
+		}
+
+		results = append(results, map[string]interface{}{
+			"user_id":  userId,
+			"role_ids": schema.NewSet(schema.HashString, roleIds),
+		})
+	}
+	return results
+}
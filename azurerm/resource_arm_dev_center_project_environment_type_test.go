@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestExpandArmDevCenterUserRoleAssignments(t *testing.T) {
+	userId := "11111111-1111-1111-1111-111111111111"
+	roleId := "acdd72a7-3385-48ef-bd42-f606fba81ae7"
+
+	input := []interface{}{
+		map[string]interface{}{
+			"user_id":  userId,
+			"role_ids": schema.NewSet(schema.HashString, []interface{}{roleId}),
+		},
+	}
+
+	assignments := expandArmDevCenterUserRoleAssignments(input)
+	assignment, ok := assignments[userId]
+	if !ok {
+		t.Fatalf("Expected a Role Assignment for %q but got %+v", userId, assignments)
+	}
+	if _, ok := assignment.Roles[roleId]; !ok || len(assignment.Roles) != 1 {
+		t.Fatalf("Expected the Role Assignment to contain only %q but got %+v", roleId, assignment.Roles)
+	}
+
+	flattened := flattenArmDevCenterUserRoleAssignments(assignments)
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 Role Assignment but got %d", len(flattened))
+	}
+	v := flattened[0].(map[string]interface{})
+	if v["user_id"].(string) != userId || !v["role_ids"].(*schema.Set).Contains(roleId) {
+		t.Fatalf("Expected the Role Assignments to round-trip but got %+v", v)
+	}
+
+	if len(expandArmDevCenterUserRoleAssignments([]interface{}{})) != 0 {
+		t.Fatalf("Expected no Role Assignments when none are specified")
+	}
+}
+
+func TestAccAzureRMDevCenterProjectEnvironmentType_basic(t *testing.T) {
+	resourceName := "azurerm_dev_center_project_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProjectEnvironmentType_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterProjectEnvironmentType_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_center_project_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProjectEnvironmentType_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevCenterProjectEnvironmentType_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_dev_center_project_environment_type"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterProjectEnvironmentType_roleAssignments(t *testing.T) {
+	resourceName := "azurerm_dev_center_project_environment_type.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectEnvironmentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProjectEnvironmentType_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMDevCenterProjectEnvironmentType_roleAssignments(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "creator_role_assignment_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_role_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDevCenterProjectEnvironmentType_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "creator_role_assignment_roles.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_role_assignment.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevCenterProjectEnvironmentTypeExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMDevCenterResourceExists(resourceName, "Dev Center Project Environment Type")
+}
+
+func testCheckAzureRMDevCenterProjectEnvironmentTypeDestroy(s *terraform.State) error {
+	return testCheckAzureRMDevCenterResourceDestroy(s, "azurerm_dev_center_project_environment_type", "Dev Center Project Environment Type")
+}
+
+func testAccAzureRMDevCenterProjectEnvironmentType_template(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterEnvironmentType_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctestdcp-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  dev_center_id       = "${azurerm_dev_center.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevCenterProjectEnvironmentType_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterProjectEnvironmentType_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                  = "${azurerm_dev_center_environment_type.test.name}"
+  location              = "${azurerm_resource_group.test.location}"
+  dev_center_project_id = "${azurerm_dev_center_project.test.id}"
+  deployment_target_id  = "${data.azurerm_subscription.current.id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDevCenterProjectEnvironmentType_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterProjectEnvironmentType_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "import" {
+  name                  = "${azurerm_dev_center_project_environment_type.test.name}"
+  location              = "${azurerm_dev_center_project_environment_type.test.location}"
+  dev_center_project_id = "${azurerm_dev_center_project_environment_type.test.dev_center_project_id}"
+  deployment_target_id  = "${azurerm_dev_center_project_environment_type.test.deployment_target_id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDevCenterProjectEnvironmentType_roleAssignments(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterProjectEnvironmentType_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                          = "${azurerm_dev_center_environment_type.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  dev_center_project_id         = "${azurerm_dev_center_project.test.id}"
+  deployment_target_id          = "${data.azurerm_subscription.current.id}"
+  creator_role_assignment_roles = ["acdd72a7-3385-48ef-bd42-f606fba81ae7"]
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  user_role_assignment {
+    user_id  = "${data.azurerm_client_config.current.service_principal_object_id}"
+    role_ids = ["acdd72a7-3385-48ef-bd42-f606fba81ae7"]
+  }
+
+  tags {
+    environment = "Development"
+  }
+}
+`, template)
+}
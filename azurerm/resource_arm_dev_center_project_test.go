@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMDevCenterProject_basic(t *testing.T) {
+	resourceName := "azurerm_dev_center_project.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProject_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dev_center_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterProject_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_center_project.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProject_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevCenterProject_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_dev_center_project"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenterProject_update(t *testing.T) {
+	resourceName := "azurerm_dev_center_project.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenterProject_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMDevCenterProject_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Platform Engineering"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_dev_boxes_per_user", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDevCenterProject_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "maximum_dev_boxes_per_user", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevCenterProjectExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMDevCenterResourceExists(resourceName, "Dev Center Project")
+}
+
+func testCheckAzureRMDevCenterProjectDestroy(s *terraform.State) error {
+	return testCheckAzureRMDevCenterResourceDestroy(s, "azurerm_dev_center_project", "Dev Center Project")
+}
+
+func testAccAzureRMDevCenterProject_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctestdcp-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  dev_center_id       = "${azurerm_dev_center.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevCenterProject_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenterProject_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project" "import" {
+  name                = "${azurerm_dev_center_project.test.name}"
+  resource_group_name = "${azurerm_dev_center_project.test.resource_group_name}"
+  location            = "${azurerm_dev_center_project.test.location}"
+  dev_center_id       = "${azurerm_dev_center_project.test.dev_center_id}"
+}
+`, template)
+}
+
+func testAccAzureRMDevCenterProject_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project" "test" {
+  name                       = "acctestdcp-%d"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  dev_center_id              = "${azurerm_dev_center.test.id}"
+  description                = "Platform Engineering"
+  maximum_dev_boxes_per_user = 2
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,221 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDevCenter_basic(t *testing.T) {
+	resourceName := "azurerm_dev_center.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenter_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dev_center_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenter_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_center.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenter_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevCenter_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_dev_center"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevCenter_complete(t *testing.T) {
+	resourceName := "azurerm_dev_center.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevCenterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevCenter_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMDevCenter_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDevCenter_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevCenterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevCenterExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMDevCenterResourceExists(resourceName, "Dev Center")
+}
+
+func testCheckAzureRMDevCenterDestroy(s *terraform.State) error {
+	return testCheckAzureRMDevCenterResourceDestroy(s, "azurerm_dev_center", "Dev Center")
+}
+
+// the Dev Center resources share an API Version, so are checked using the same helpers
+func testCheckAzureRMDevCenterResourceExists(resourceName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, devCenterApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: %s %q does not exist", description, rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on %s %q: %+v", description, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevCenterResourceDestroy(s *terraform.State, resourceType string, description string) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, devCenterApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s still exists:\n%#v", description, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevCenter_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctestdc-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMDevCenter_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMDevCenter_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center" "import" {
+  name                = "${azurerm_dev_center.test.name}"
+  resource_group_name = "${azurerm_dev_center.test.resource_group_name}"
+  location            = "${azurerm_dev_center.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMDevCenter_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctestdc-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rString)
+}
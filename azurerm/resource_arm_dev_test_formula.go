@@ -0,0 +1,333 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestFormula() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestFormulaCreateUpdate,
+		Read:   resourceArmDevTestFormulaRead,
+		Update: resourceArmDevTestFormulaCreateUpdate,
+		Delete: resourceArmDevTestFormulaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevTestLabName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/3964
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"location": locationSchema(),
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard",
+					"Premium",
+				}, false),
+			},
+
+			"gallery_image_reference": azure.SchemaDevTestVirtualMachineGalleryImageReference(),
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"ssh_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"lab_virtual_network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"lab_subnet_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"allow_claim": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"disallow_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestFormulaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestFormulasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Formula creation")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, labName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing DevTest Formula %q (Lab %q / Resource Group %q): %s", name, labName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_test_formula", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	osType := d.Get("os_type").(string)
+	sshKey := d.Get("ssh_key").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if osType == "Windows" && sshKey != "" {
+		return fmt.Errorf("`ssh_key` can only be specified when `os_type` is `Linux`")
+	}
+
+	galleryImageReferenceRaw := d.Get("gallery_image_reference").([]interface{})
+	galleryImageReference := azure.ExpandDevTestLabVirtualMachineGalleryImageReference(galleryImageReferenceRaw, osType)
+
+	content := dtl.LabVirtualMachineCreationParameterProperties{
+		AllowClaim:                 utils.Bool(d.Get("allow_claim").(bool)),
+		DisallowPublicIPAddress:    utils.Bool(d.Get("disallow_public_ip_address").(bool)),
+		GalleryImageReference:      galleryImageReference,
+		IsAuthenticationWithSSHKey: utils.Bool(sshKey != ""),
+		Notes:                      utils.String(d.Get("notes").(string)),
+		OsType:                     utils.String(osType),
+		Size:                       utils.String(d.Get("size").(string)),
+		StorageType:                utils.String(d.Get("storage_type").(string)),
+		UserName:                   utils.String(d.Get("username").(string)),
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		content.Password = utils.String(v.(string))
+	}
+
+	if sshKey != "" {
+		content.SSHKey = utils.String(sshKey)
+	}
+
+	if v, ok := d.GetOk("lab_virtual_network_id"); ok {
+		content.LabVirtualNetworkID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lab_subnet_name"); ok {
+		content.LabSubnetName = utils.String(v.(string))
+	}
+
+	parameters := dtl.Formula{
+		Location: utils.String(location),
+		FormulaProperties: &dtl.FormulaProperties{
+			Description: utils.String(d.Get("description").(string)),
+			OsType:      utils.String(osType),
+			FormulaContent: &dtl.LabVirtualMachineCreationParameter{
+				LabVirtualMachineCreationParameterProperties: &content,
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Formula %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DevTest Formula %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Formula %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Formula %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestFormulaRead(d, meta)
+}
+
+func resourceArmDevTestFormulaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestFormulasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["formulas"]
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] DevTest Formula %q was not found in Lab %q / Resource Group %q - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Formula %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", read.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := read.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := read.FormulaProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("os_type", props.OsType)
+
+		if formula := props.FormulaContent; formula != nil {
+			if content := formula.LabVirtualMachineCreationParameterProperties; content != nil {
+				d.Set("size", content.Size)
+				d.Set("username", content.UserName)
+				d.Set("storage_type", content.StorageType)
+				d.Set("lab_virtual_network_id", content.LabVirtualNetworkID)
+				d.Set("lab_subnet_name", content.LabSubnetName)
+				d.Set("notes", content.Notes)
+
+				allowClaim := true
+				if content.AllowClaim != nil {
+					allowClaim = *content.AllowClaim
+				}
+				d.Set("allow_claim", allowClaim)
+
+				disallowPublicIPAddress := false
+				if content.DisallowPublicIPAddress != nil {
+					disallowPublicIPAddress = *content.DisallowPublicIPAddress
+				}
+				d.Set("disallow_public_ip_address", disallowPublicIPAddress)
+
+				flattenedImage := azure.FlattenDevTestVirtualMachineGalleryImage(content.GalleryImageReference)
+				if err := d.Set("gallery_image_reference", flattenedImage); err != nil {
+					return fmt.Errorf("Error setting `gallery_image_reference`: %+v", err)
+				}
+			}
+		}
+
+		// Computed fields
+		d.Set("author", props.Author)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetTags(d, read.Tags)
+
+	return nil
+}
+
+func resourceArmDevTestFormulaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestFormulasClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["formulas"]
+
+	resp, err := client.Delete(ctx, resourceGroup, labName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			// deleted outside of TF
+			log.Printf("[DEBUG] DevTest Formula %q was not found in Lab %q / Resource Group %q - assuming removed!", name, labName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Formula %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
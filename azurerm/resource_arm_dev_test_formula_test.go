@@ -0,0 +1,290 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMDevTestFormula_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_formula.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestFormulaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestFormula_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestFormulaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "gallery_image_reference.0.publisher", "Canonical"),
+					resource.TestCheckResourceAttr(resourceName, "allow_claim", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestFormula_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_test_formula.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestFormulaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestFormula_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestFormulaExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevTestFormula_requiresImport(rInt, location),
+				ExpectError: testRequiresImportError("azurerm_dev_test_formula"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestFormula_complete(t *testing.T) {
+	resourceName := "azurerm_dev_test_formula.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestFormulaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestFormula_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestFormulaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F2"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestFormula_complete(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestFormulaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F4"),
+					resource.TestCheckResourceAttr(resourceName, "allow_claim", "false"),
+					resource.TestCheckResourceAttr(resourceName, "disallow_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Ubuntu sandbox"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestFormulaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		formulaName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).devTestFormulasClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, formulaName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get devTestFormulasClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Formula %q (Lab %q / Resource Group: %q) does not exist", formulaName, labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestFormulaDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).devTestFormulasClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_formula" {
+			continue
+		}
+
+		formulaName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, formulaName, "")
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Formula still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestFormula_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    use_public_ip_address           = "Allow"
+    use_in_virtual_machine_creation = "Allow"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDevTestFormula_basic(rInt int, location string) string {
+	template := testAccAzureRMDevTestFormula_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "test" {
+  name                   = "acctestdtf%d"
+  lab_name               = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  os_type                = "Linux"
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "Pa$$w0rd1234!"
+  lab_virtual_network_id = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name        = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevTestFormula_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDevTestFormula_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "import" {
+  name                   = "${azurerm_dev_test_formula.test.name}"
+  lab_name               = "${azurerm_dev_test_formula.test.lab_name}"
+  resource_group_name    = "${azurerm_dev_test_formula.test.resource_group_name}"
+  location               = "${azurerm_dev_test_formula.test.location}"
+  os_type                = "${azurerm_dev_test_formula.test.os_type}"
+  size                   = "${azurerm_dev_test_formula.test.size}"
+  username               = "acct5stU5er"
+  password               = "Pa$$w0rd1234!"
+  lab_virtual_network_id = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name        = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDevTestFormula_complete(rInt int, location string) string {
+	template := testAccAzureRMDevTestFormula_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "test" {
+  name                       = "acctestdtf%d"
+  lab_name                   = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  os_type                    = "Linux"
+  size                       = "Standard_F4"
+  username                   = "acct5stU5er"
+  password                   = "Pa$$w0rd1234!"
+  lab_virtual_network_id     = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name            = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type               = "Premium"
+  allow_claim                = false
+  disallow_public_ip_address = true
+  description                = "Ubuntu sandbox"
+  notes                      = "Created by Terraform"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+
+  tags {
+    "Acceptance" = "Test"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestNotificationChannelCreateUpdate,
+		Read:   resourceArmDevTestNotificationChannelRead,
+		Update: resourceArmDevTestNotificationChannelCreateUpdate,
+		Delete: resourceArmDevTestNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevTestLabName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/3964
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"location": locationSchema(),
+
+			"web_hook_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(dtl.AutoShutdown),
+						string(dtl.Cost),
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestNotificationChannelCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestNotificationChannelsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Notification Channel creation")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, labName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing DevTest Notification Channel %q (Lab %q / Resource Group %q): %s", name, labName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_test_notification_channel", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	events := make([]dtl.Event, 0)
+	for _, v := range d.Get("events").(*schema.Set).List() {
+		events = append(events, dtl.Event{
+			EventName: dtl.NotificationChannelEventType(v.(string)),
+		})
+	}
+
+	parameters := dtl.NotificationChannel{
+		Location: utils.String(location),
+		NotificationChannelProperties: &dtl.NotificationChannelProperties{
+			WebHookURL:  utils.String(d.Get("web_hook_url").(string)),
+			Description: utils.String(d.Get("description").(string)),
+			Events:      &events,
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Notification Channel %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Notification Channel %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Notification Channel %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestNotificationChannelRead(d, meta)
+}
+
+func resourceArmDevTestNotificationChannelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestNotificationChannelsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["notificationchannels"]
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] DevTest Notification Channel %q was not found in Lab %q / Resource Group %q - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Notification Channel %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", read.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := read.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := read.NotificationChannelProperties; props != nil {
+		d.Set("web_hook_url", props.WebHookURL)
+		d.Set("description", props.Description)
+
+		events := make([]interface{}, 0)
+		if props.Events != nil {
+			for _, v := range *props.Events {
+				events = append(events, string(v.EventName))
+			}
+		}
+		if err := d.Set("events", schema.NewSet(schema.HashString, events)); err != nil {
+			return fmt.Errorf("Error setting `events`: %+v", err)
+		}
+
+		// Computed fields
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetTags(d, read.Tags)
+
+	return nil
+}
+
+func resourceArmDevTestNotificationChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestNotificationChannelsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["notificationchannels"]
+
+	resp, err := client.Delete(ctx, resourceGroup, labName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			// deleted outside of TF
+			log.Printf("[DEBUG] DevTest Notification Channel %q was not found in Lab %q / Resource Group %q - assuming removed!", name, labName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Notification Channel %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
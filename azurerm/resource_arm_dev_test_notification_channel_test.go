@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMDevTestNotificationChannel_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_notification_channel.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestNotificationChannel_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestNotificationChannel_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_test_notification_channel.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestNotificationChannel_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestNotificationChannelExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevTestNotificationChannel_requiresImport(rInt, location),
+				ExpectError: testRequiresImportError("azurerm_dev_test_notification_channel"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestNotificationChannel_update(t *testing.T) {
+	resourceName := "azurerm_dev_test_notification_channel.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestNotificationChannel_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestNotificationChannel_complete(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestNotificationChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Sandbox alerts"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestNotificationChannelExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		channelName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).devTestNotificationChannelsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, channelName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get devTestNotificationChannelsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DevTest Notification Channel %q (Lab %q / Resource Group: %q) does not exist", channelName, labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestNotificationChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).devTestNotificationChannelsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_notification_channel" {
+			continue
+		}
+
+		channelName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, channelName, "")
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Notification Channel still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestNotificationChannel_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_notification_channel" "test" {
+  name                = "acctestdtnc%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  web_hook_url        = "https://example.com/hooks/devtest"
+  events              = ["Cost"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDevTestNotificationChannel_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDevTestNotificationChannel_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_notification_channel" "import" {
+  name                = "${azurerm_dev_test_notification_channel.test.name}"
+  lab_name            = "${azurerm_dev_test_notification_channel.test.lab_name}"
+  resource_group_name = "${azurerm_dev_test_notification_channel.test.resource_group_name}"
+  location            = "${azurerm_dev_test_notification_channel.test.location}"
+  web_hook_url        = "${azurerm_dev_test_notification_channel.test.web_hook_url}"
+  events              = ["Cost"]
+}
+`, template)
+}
+
+func testAccAzureRMDevTestNotificationChannel_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_notification_channel" "test" {
+  name                = "acctestdtnc%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  web_hook_url        = "https://example.com/hooks/devtest-updated"
+  events              = ["AutoShutdown", "Cost"]
+  description         = "Sandbox alerts"
+
+  tags {
+    "Hello" = "World"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -1,11 +1,14 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -56,10 +59,12 @@ func resourceArmDevTestPolicy() *schema.Resource {
 			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/3964
 			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
 
+			// a JSON array of values (e.g. `["Standard_DS1_v2"]`) for an `AllowedValuesPolicy`, or a number for a `MaxValuePolicy`
 			"threshold": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validate.NoEmptyStrings,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 
 			"evaluator_type": {
@@ -84,6 +89,19 @@ func resourceArmDevTestPolicy() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			name := diff.Get("name").(string)
+			evaluatorType := diff.Get("evaluator_type").(string)
+			threshold := diff.Get("threshold").(string)
+
+			// the threshold may not be known until apply time if it's interpolated
+			if threshold == "" {
+				return nil
+			}
+
+			return validateDevTestPolicyThreshold(name, evaluatorType, threshold)
+		},
 	}
 }
 
@@ -201,7 +219,7 @@ func resourceArmDevTestPolicyDelete(d *schema.ResourceData, meta interface{}) er
 	policySetName := id.Path["policysets"]
 	name := id.Path["policies"]
 
-	read, err := client.Get(ctx, resourceGroup, policySetName, labName, name, "")
+	read, err := client.Get(ctx, resourceGroup, labName, policySetName, name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
 			// deleted outside of TF
@@ -219,3 +237,45 @@ func resourceArmDevTestPolicyDelete(d *schema.ResourceData, meta interface{}) er
 
 	return err
 }
+
+// validateDevTestPolicyThreshold ensures the threshold is in the format the Evaluator Type requires, since the
+// API accepts (but then fails to enforce) a Policy with a threshold in the wrong format
+func validateDevTestPolicyThreshold(name string, evaluatorType string, threshold string) error {
+	// policies which restrict the values which can be used, rather than how many resources can be created
+	allowedValuesPolicies := map[string]bool{
+		string(dtl.PolicyFactNameGalleryImage): true,
+		string(dtl.PolicyFactNameLabVMSize):    true,
+	}
+
+	switch dtl.PolicyEvaluatorType(evaluatorType) {
+	case dtl.AllowedValuesPolicy:
+		if name != "" && !allowedValuesPolicies[name] {
+			return fmt.Errorf("`evaluator_type` must be `%s` for the Policy %q", string(dtl.MaxValuePolicy), name)
+		}
+
+		var values []string
+		if err := json.Unmarshal([]byte(threshold), &values); err != nil {
+			return fmt.Errorf("`threshold` must be a JSON array of strings (e.g. `[\"Standard_DS1_v2\"]`) when `evaluator_type` is `%s`: %+v", evaluatorType, err)
+		}
+
+		if len(values) == 0 {
+			return fmt.Errorf("`threshold` must contain at least one value when `evaluator_type` is `%s`", evaluatorType)
+		}
+
+	case dtl.MaxValuePolicy:
+		if allowedValuesPolicies[name] {
+			return fmt.Errorf("`evaluator_type` must be `%s` for the Policy %q", string(dtl.AllowedValuesPolicy), name)
+		}
+
+		value, err := strconv.Atoi(threshold)
+		if err != nil {
+			return fmt.Errorf("`threshold` must be a whole number when `evaluator_type` is `%s`: %+v", evaluatorType, err)
+		}
+
+		if value < 0 {
+			return fmt.Errorf("`threshold` must be at least 0 when `evaluator_type` is `%s`", evaluatorType)
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,107 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestValidateDevTestPolicyThreshold(t *testing.T) {
+	testData := []struct {
+		name          string
+		policyName    string
+		evaluatorType string
+		threshold     string
+		shouldError   bool
+	}{
+		{
+			name:          "VM Count",
+			policyName:    "LabVmCount",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "999",
+			shouldError:   false,
+		},
+		{
+			name:          "VM Count Per User",
+			policyName:    "UserOwnedLabVmCount",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "2",
+			shouldError:   false,
+		},
+		{
+			name:          "VM Count as a Decimal",
+			policyName:    "LabVmCount",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "2.5",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Count as a Negative Number",
+			policyName:    "UserOwnedLabVmCount",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "-1",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Count as a List",
+			policyName:    "UserOwnedLabVmCount",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "[\"2\"]",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Count with Allowed Values",
+			policyName:    "LabVmCount",
+			evaluatorType: "AllowedValuesPolicy",
+			threshold:     "[\"2\"]",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Sizes",
+			policyName:    "LabVmSize",
+			evaluatorType: "AllowedValuesPolicy",
+			threshold:     "[\"Standard_DS1_v2\",\"Standard_DS2_v2\"]",
+			shouldError:   false,
+		},
+		{
+			name:          "VM Sizes as a String",
+			policyName:    "LabVmSize",
+			evaluatorType: "AllowedValuesPolicy",
+			threshold:     "Standard_DS1_v2",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Sizes as an Empty List",
+			policyName:    "LabVmSize",
+			evaluatorType: "AllowedValuesPolicy",
+			threshold:     "[]",
+			shouldError:   true,
+		},
+		{
+			name:          "VM Sizes with a Max Value",
+			policyName:    "LabVmSize",
+			evaluatorType: "MaxValuePolicy",
+			threshold:     "2",
+			shouldError:   true,
+		},
+		{
+			name:          "Gallery Images",
+			policyName:    "GalleryImage",
+			evaluatorType: "AllowedValuesPolicy",
+			threshold:     "[\"{\\\"offer\\\":\\\"UbuntuServer\\\"}\"]",
+			shouldError:   false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateDevTestPolicyThreshold(v.policyName, v.evaluatorType, v.threshold)
+		if v.shouldError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.name)
+		}
+
+		if !v.shouldError && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.name, err)
+		}
+	}
+}
+
 func TestAccAzureRMDevTestPolicy_basic(t *testing.T) {
 	resourceName := "azurerm_dev_test_policy.test"
 	rInt := tf.AccRandTimeInt()
@@ -93,6 +194,71 @@ func TestAccAzureRMDevTestPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDevTestPolicy_allowedVMSizes(t *testing.T) {
+	resourceName := "azurerm_dev_test_policy.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestPolicy_allowedVMSizes(rInt, location, `[\"Standard_DS1_v2\"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "evaluator_type", "AllowedValuesPolicy"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestPolicy_allowedVMSizes(rInt, location, `[\"Standard_DS1_v2\", \"Standard_DS2_v2\"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestPolicy_userOwnedVMCount(t *testing.T) {
+	resourceName := "azurerm_dev_test_policy.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestPolicy_userOwnedVMCount(rInt, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestPolicy_userOwnedVMCount(rInt, location, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDevTestPolicyExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -183,7 +349,7 @@ func testAccAzureRMDevTestPolicy_requiresImport(rInt int, location string) strin
 
 resource "azurerm_dev_test_policy" "import" {
   name                = "${azurerm_dev_test_policy.test.name}"
-  policy_set_name     = "$[azurerm_dev_test_policy.test.policy_set_name}"
+  policy_set_name     = "${azurerm_dev_test_policy.test.policy_set_name}"
   lab_name            = "${azurerm_dev_test_policy.test.lab_name}"
   resource_group_name = "${azurerm_dev_test_policy.test.resource_group_name}"
   threshold           = "999"
@@ -220,3 +386,51 @@ resource "azurerm_dev_test_policy" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMDevTestPolicy_allowedVMSizes(rInt int, location string, threshold string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_policy" "test" {
+  name                = "LabVmSize"
+  policy_set_name     = "default"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  threshold           = "%s"
+  evaluator_type      = "AllowedValuesPolicy"
+}
+`, rInt, location, rInt, threshold)
+}
+
+func testAccAzureRMDevTestPolicy_userOwnedVMCount(rInt int, location string, threshold int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_policy" "test" {
+  name                = "UserOwnedLabVmCount"
+  policy_set_name     = "default"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  threshold           = "%d"
+  evaluator_type      = "MaxValuePolicy"
+}
+`, rInt, location, rInt, threshold)
+}
@@ -0,0 +1,372 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// a DevTest Lab only ever has a single Target Cost, which is always named `targetCost`
+const devTestTargetCostName = "targetCost"
+
+func resourceArmDevTestTargetCost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestTargetCostCreateUpdate,
+		Read:   resourceArmDevTestTargetCostRead,
+		Update: resourceArmDevTestTargetCostCreateUpdate,
+		Delete: resourceArmDevTestTargetCostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevTestLabName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/3964
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"target": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"cycle_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(dtl.CalendarMonth),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dtl.CalendarMonth),
+					string(dtl.Custom),
+				}, false),
+			},
+
+			"cycle_start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"cycle_end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"threshold": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.IntInSlice([]int{25, 50, 75, 100, 125}),
+						},
+
+						"display_on_chart": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"send_notification_when_exceeded": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Get("cycle_type").(string) != string(dtl.Custom) {
+				return nil
+			}
+
+			if diff.Get("cycle_start_date").(string) == "" || diff.Get("cycle_end_date").(string) == "" {
+				return fmt.Errorf("`cycle_start_date` and `cycle_end_date` must be specified when `cycle_type` is `Custom`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmDevTestTargetCostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestCostsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DevTest Target Cost creation")
+
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	// the Target Cost always exists for a Lab, so the existing thresholds are retrieved to reuse their ID's
+	existing, err := client.Get(ctx, resourceGroup, labName, devTestTargetCostName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing DevTest Target Cost (Lab %q / Resource Group %q): %s", labName, resourceGroup, err)
+		}
+	}
+
+	// since the Target Cost can't be deleted, it's only considered to exist once it's been enabled
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" && devTestTargetCostIsEnabled(existing) {
+			return tf.ImportAsExistsError("azurerm_dev_test_target_cost", *existing.ID)
+		}
+	}
+
+	status := dtl.TargetCostStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = dtl.TargetCostStatusEnabled
+	}
+
+	thresholds, err := expandArmDevTestTargetCostThresholds(d.Get("threshold").(*schema.Set).List(), existing)
+	if err != nil {
+		return err
+	}
+
+	targetCost := dtl.TargetCostProperties{
+		Status:         status,
+		Target:         utils.Int32(int32(d.Get("target").(int))),
+		CycleType:      dtl.ReportingCycleType(d.Get("cycle_type").(string)),
+		CostThresholds: thresholds,
+	}
+
+	if targetCost.CycleType == dtl.Custom {
+		// these are validated by the schema
+		start, _ := time.Parse(time.RFC3339, d.Get("cycle_start_date").(string))
+		targetCost.CycleStartDateTime = &date.Time{Time: start}
+
+		end, _ := time.Parse(time.RFC3339, d.Get("cycle_end_date").(string))
+		targetCost.CycleEndDateTime = &date.Time{Time: end}
+	}
+
+	parameters := dtl.LabCost{
+		LabCostProperties: &dtl.LabCostProperties{
+			TargetCost: &targetCost,
+		},
+	}
+	if existing.Location != nil {
+		parameters.Location = existing.Location
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, labName, devTestTargetCostName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Target Cost (Lab %q / Resource Group %q): %+v", labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, labName, devTestTargetCostName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Target Cost (Lab %q / Resource Group %q): %+v", labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Target Cost (Lab %q / Resource Group %q) ID", labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestTargetCostRead(d, meta)
+}
+
+func resourceArmDevTestTargetCostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestCostsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["costs"]
+
+	read, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] DevTest Target Cost was not found in Lab %q / Resource Group %q - removing from state!", labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Target Cost (Lab %q / Resource Group %q): %+v", labName, resourceGroup, err)
+	}
+
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := read.LabCostProperties; props != nil {
+		if targetCost := props.TargetCost; targetCost != nil {
+			d.Set("enabled", targetCost.Status == dtl.TargetCostStatusEnabled)
+			d.Set("cycle_type", string(targetCost.CycleType))
+
+			if target := targetCost.Target; target != nil {
+				d.Set("target", int(*target))
+			}
+
+			if v := targetCost.CycleStartDateTime; v != nil {
+				d.Set("cycle_start_date", v.Format(time.RFC3339))
+			}
+
+			if v := targetCost.CycleEndDateTime; v != nil {
+				d.Set("cycle_end_date", v.Format(time.RFC3339))
+			}
+
+			if err := d.Set("threshold", flattenArmDevTestTargetCostThresholds(targetCost.CostThresholds)); err != nil {
+				return fmt.Errorf("Error setting `threshold`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDevTestTargetCostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestCostsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["costs"]
+
+	existing, err := client.Get(ctx, resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			// deleted outside of TF
+			log.Printf("[DEBUG] DevTest Target Cost was not found in Lab %q / Resource Group %q - assuming removed!", labName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DevTest Target Cost (Lab %q / Resource Group %q): %+v", labName, resourceGroup, err)
+	}
+
+	// the Target Cost can't be deleted, so instead it's disabled and the thresholds are removed
+	thresholds := make([]dtl.CostThresholdProperties, 0)
+	parameters := dtl.LabCost{
+		Location: existing.Location,
+		LabCostProperties: &dtl.LabCostProperties{
+			TargetCost: &dtl.TargetCostProperties{
+				Status:         dtl.TargetCostStatusDisabled,
+				CycleType:      dtl.CalendarMonth,
+				CostThresholds: &thresholds,
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters); err != nil {
+		return fmt.Errorf("Error disabling DevTest Target Cost (Lab %q / Resource Group %q): %+v", labName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devTestTargetCostIsEnabled(cost dtl.LabCost) bool {
+	if props := cost.LabCostProperties; props != nil {
+		if targetCost := props.TargetCost; targetCost != nil {
+			return targetCost.Status == dtl.TargetCostStatusEnabled
+		}
+	}
+
+	return false
+}
+
+func expandArmDevTestTargetCostThresholds(input []interface{}, existing dtl.LabCost) (*[]dtl.CostThresholdProperties, error) {
+	// each threshold is identified by an ID, which we reuse where the percentage already exists
+	existingIDs := make(map[float64]string)
+	if props := existing.LabCostProperties; props != nil && props.TargetCost != nil && props.TargetCost.CostThresholds != nil {
+		for _, v := range *props.TargetCost.CostThresholds {
+			if v.ThresholdID == nil || v.PercentageThreshold == nil || v.PercentageThreshold.ThresholdValue == nil {
+				continue
+			}
+
+			existingIDs[*v.PercentageThreshold.ThresholdValue] = *v.ThresholdID
+		}
+	}
+
+	thresholds := make([]dtl.CostThresholdProperties, 0)
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		percentage := float64(v["percentage"].(int))
+
+		thresholdID, ok := existingIDs[percentage]
+		if !ok {
+			id, err := uuid.GenerateUUID()
+			if err != nil {
+				return nil, fmt.Errorf("Error generating ID for DevTest Target Cost Threshold: %+v", err)
+			}
+			thresholdID = id
+		}
+
+		displayOnChart := dtl.Disabled
+		if v["display_on_chart"].(bool) {
+			displayOnChart = dtl.Enabled
+		}
+
+		sendNotification := dtl.Disabled
+		if v["send_notification_when_exceeded"].(bool) {
+			sendNotification = dtl.Enabled
+		}
+
+		thresholds = append(thresholds, dtl.CostThresholdProperties{
+			ThresholdID: utils.String(thresholdID),
+			PercentageThreshold: &dtl.PercentageCostThresholdProperties{
+				ThresholdValue: utils.Float(percentage),
+			},
+			DisplayOnChart:               displayOnChart,
+			SendNotificationWhenExceeded: sendNotification,
+		})
+	}
+
+	return &thresholds, nil
+}
+
+func flattenArmDevTestTargetCostThresholds(input *[]dtl.CostThresholdProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.PercentageThreshold == nil || v.PercentageThreshold.ThresholdValue == nil {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"percentage":                      int(*v.PercentageThreshold.ThresholdValue),
+			"display_on_chart":                v.DisplayOnChart == dtl.Enabled,
+			"send_notification_when_exceeded": v.SendNotificationWhenExceeded == dtl.Enabled,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,300 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandArmDevTestTargetCostThresholds(t *testing.T) {
+	existing := dtl.LabCost{
+		LabCostProperties: &dtl.LabCostProperties{
+			TargetCost: &dtl.TargetCostProperties{
+				CostThresholds: &[]dtl.CostThresholdProperties{
+					{
+						ThresholdID: utils.String("existing-75"),
+						PercentageThreshold: &dtl.PercentageCostThresholdProperties{
+							ThresholdValue: utils.Float(75),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"percentage":                      75,
+			"display_on_chart":                true,
+			"send_notification_when_exceeded": true,
+		},
+		map[string]interface{}{
+			"percentage":                      100,
+			"display_on_chart":                false,
+			"send_notification_when_exceeded": false,
+		},
+	}
+
+	result, err := expandArmDevTestTargetCostThresholds(input, existing)
+	if err != nil {
+		t.Fatalf("Error expanding thresholds: %+v", err)
+	}
+
+	thresholds := *result
+	if len(thresholds) != 2 {
+		t.Fatalf("Expected 2 thresholds but got %d", len(thresholds))
+	}
+
+	if *thresholds[0].ThresholdID != "existing-75" {
+		t.Fatalf("Expected the existing Threshold ID to be reused but got %q", *thresholds[0].ThresholdID)
+	}
+	if thresholds[0].DisplayOnChart != dtl.Enabled || thresholds[0].SendNotificationWhenExceeded != dtl.Enabled {
+		t.Fatalf("Expected the 75%% threshold to be displayed and notify")
+	}
+
+	if thresholds[1].ThresholdID == nil || *thresholds[1].ThresholdID == "" || *thresholds[1].ThresholdID == "existing-75" {
+		t.Fatalf("Expected a new Threshold ID to be generated for the 100%% threshold")
+	}
+	if thresholds[1].DisplayOnChart != dtl.Disabled || thresholds[1].SendNotificationWhenExceeded != dtl.Disabled {
+		t.Fatalf("Expected the 100%% threshold to be hidden and not notify")
+	}
+
+	flattened := flattenArmDevTestTargetCostThresholds(result)
+	if len(flattened) != 2 {
+		t.Fatalf("Expected 2 flattened thresholds but got %d", len(flattened))
+	}
+	if v := flattened[1].(map[string]interface{})["percentage"].(int); v != 100 {
+		t.Fatalf("Expected the percentage to be 100 but got %d", v)
+	}
+}
+
+func TestAccAzureRMDevTestTargetCost_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_target_cost.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestTargetCostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestTargetCost_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestTargetCostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target", "100"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cycle_type", "CalendarMonth"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestTargetCost_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dev_test_target_cost.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestTargetCostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestTargetCost_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestTargetCostExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDevTestTargetCost_requiresImport(rInt, location),
+				ExpectError: testRequiresImportError("azurerm_dev_test_target_cost"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestTargetCost_thresholds(t *testing.T) {
+	resourceName := "azurerm_dev_test_target_cost.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestTargetCostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestTargetCost_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestTargetCostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestTargetCost_thresholds(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestTargetCostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target", "250"),
+					resource.TestCheckResourceAttr(resourceName, "threshold.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestTargetCostExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).devTestCostsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, devTestTargetCostName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get devTestCostsClient: %+v", err)
+		}
+
+		if !devTestTargetCostIsEnabled(resp) {
+			return fmt.Errorf("Bad: DevTest Target Cost (Lab %q / Resource Group: %q) is not enabled", labName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestTargetCostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).devTestCostsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_target_cost" {
+			continue
+		}
+
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(ctx, resourceGroup, labName, devTestTargetCostName, "")
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		// the Target Cost can't be deleted, only disabled
+		if devTestTargetCostIsEnabled(resp) {
+			return fmt.Errorf("DevTest Target Cost is still enabled:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestTargetCost_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_target_cost" "test" {
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  target              = 100
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDevTestTargetCost_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDevTestTargetCost_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_target_cost" "import" {
+  lab_name            = "${azurerm_dev_test_target_cost.test.lab_name}"
+  resource_group_name = "${azurerm_dev_test_target_cost.test.resource_group_name}"
+  target              = "${azurerm_dev_test_target_cost.test.target}"
+}
+`, template)
+}
+
+func testAccAzureRMDevTestTargetCost_thresholds(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dev_test_notification_channel" "test" {
+  name                = "acctestdtnc%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  web_hook_url        = "https://example.com/hooks/devtest"
+  events              = ["Cost"]
+}
+
+resource "azurerm_dev_test_target_cost" "test" {
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  target              = 250
+
+  threshold {
+    percentage                      = 75
+    send_notification_when_exceeded = true
+  }
+
+  threshold {
+    percentage                      = 100
+    display_on_chart                = true
+    send_notification_when_exceeded = true
+  }
+
+  depends_on = ["azurerm_dev_test_notification_channel.test"]
+}
+`, rInt, location, rInt, rInt)
+}
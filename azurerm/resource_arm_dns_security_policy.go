@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// DNS Security Policies (DNS Resolver Policies) aren't present in the vendored SDK, so are managed using raw requests
+const dnsSecurityPolicyApiVersion = "2025-05-01"
+
+type dnsSecurityPolicy struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Tags       map[string]*string           `json:"tags"`
+	Properties *dnsSecurityPolicyProperties `json:"properties,omitempty"`
+}
+
+type dnsSecurityPolicyProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type dnsSecurityPolicySubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+func resourceArmDnsSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsSecurityPolicyCreateUpdate,
+		Read:   resourceArmDnsSecurityPolicyRead,
+		Update: resourceArmDnsSecurityPolicyCreateUpdate,
+		Delete: resourceArmDnsSecurityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsSecurityPolicyName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsSecurityPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := dnsSecurityPolicyID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing dnsSecurityPolicy
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing DNS Security Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_security_policy", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := dnsSecurityPolicy{
+		Location:   utils.String(location),
+		Properties: &dnsSecurityPolicyProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DNS Security Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read dnsSecurityPolicy
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of DNS Security Policy %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDnsSecurityPolicyRead(d, meta)
+}
+
+func resourceArmDnsSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dnsResolverPolicies"]
+
+	var policy dnsSecurityPolicy
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion, &policy)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] DNS Security Policy %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DNS Security Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := policy.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceArmDnsSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dnsResolverPolicies"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DNS Security Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func dnsSecurityPolicyID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverPolicies/%s", subscriptionId, resourceGroup, name)
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type dnsSecurityPolicyDomainList struct {
+	ID         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Location   *string                                `json:"location,omitempty"`
+	Tags       map[string]*string                     `json:"tags"`
+	Properties *dnsSecurityPolicyDomainListProperties `json:"properties,omitempty"`
+}
+
+type dnsSecurityPolicyDomainListProperties struct {
+	Domains *[]string `json:"domains,omitempty"`
+}
+
+func resourceArmDnsSecurityPolicyDomainList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsSecurityPolicyDomainListCreateUpdate,
+		Read:   resourceArmDnsSecurityPolicyDomainListRead,
+		Update: resourceArmDnsSecurityPolicyDomainListCreateUpdate,
+		Delete: resourceArmDnsSecurityPolicyDomainListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsSecurityPolicyName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"domains": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.DnsSecurityPolicyDomain,
+				},
+				Set: schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsSecurityPolicyDomainListCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := dnsSecurityPolicyDomainListID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing dnsSecurityPolicyDomainList
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing DNS Security Policy Domain List %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_security_policy_domain_list", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	domains := d.Get("domains").(*schema.Set).List()
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := dnsSecurityPolicyDomainList{
+		Location: utils.String(location),
+		Properties: &dnsSecurityPolicyDomainListProperties{
+			Domains: utils.ExpandStringArray(domains),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DNS Security Policy Domain List %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read dnsSecurityPolicyDomainList
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy Domain List %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of DNS Security Policy Domain List %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDnsSecurityPolicyDomainListRead(d, meta)
+}
+
+func resourceArmDnsSecurityPolicyDomainListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dnsResolverDomainLists"]
+
+	var list dnsSecurityPolicyDomainList
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion, &list)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] DNS Security Policy Domain List %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DNS Security Policy Domain List %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", list.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := list.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := list.Properties; props != nil {
+		if err := d.Set("domains", schema.NewSet(schema.HashString, utils.FlattenStringArray(props.Domains))); err != nil {
+			return fmt.Errorf("Error setting `domains`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, list.Tags)
+
+	return nil
+}
+
+func resourceArmDnsSecurityPolicyDomainListDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["dnsResolverDomainLists"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DNS Security Policy Domain List %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func dnsSecurityPolicyDomainListID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverDomainLists/%s", subscriptionId, resourceGroup, name)
+}
@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDnsSecurityPolicyDomainList_basic(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy_domain_list.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyDomainList_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyDomainListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicyDomainList_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dns_security_policy_domain_list.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyDomainList_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyDomainListExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDnsSecurityPolicyDomainList_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dns_security_policy_domain_list"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicyDomainList_update(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy_domain_list.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyDomainList_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyDomainListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsSecurityPolicyDomainList_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyDomainListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDnsSecurityPolicyDomainListExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing dnsSecurityPolicyDomainList
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: DNS Security Policy Domain List %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on DNS Security Policy Domain List %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsSecurityPolicyDomainListDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_security_policy_domain_list" {
+			continue
+		}
+
+		var existing dnsSecurityPolicyDomainList
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS Security Policy Domain List %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsSecurityPolicyDomainList_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_security_policy_domain_list" "test" {
+  name                = "acctest-dnsdl-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  domains             = ["contoso.com."]
+}
+`, rInt, location)
+}
+
+func testAccAzureRMDnsSecurityPolicyDomainList_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy_domain_list" "import" {
+  name                = "${azurerm_dns_security_policy_domain_list.test.name}"
+  resource_group_name = "${azurerm_dns_security_policy_domain_list.test.resource_group_name}"
+  location            = "${azurerm_dns_security_policy_domain_list.test.location}"
+  domains             = ["contoso.com."]
+}
+`, testAccAzureRMDnsSecurityPolicyDomainList_basic(rInt, location))
+}
+
+func testAccAzureRMDnsSecurityPolicyDomainList_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_security_policy_domain_list" "test" {
+  name                = "acctest-dnsdl-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  domains             = ["contoso.com.", "fabrikam.com."]
+}
+`, rInt, location)
+}
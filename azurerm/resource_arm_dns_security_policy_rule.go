@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type dnsSecurityPolicyRule struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags"`
+	Properties *dnsSecurityPolicyRuleProperties `json:"properties,omitempty"`
+}
+
+type dnsSecurityPolicyRuleProperties struct {
+	Priority               *int32                          `json:"priority,omitempty"`
+	Action                 *dnsSecurityPolicyRuleAction    `json:"action,omitempty"`
+	DnsResolverDomainLists *[]dnsSecurityPolicySubResource `json:"dnsResolverDomainLists,omitempty"`
+	DnsSecurityRuleState   *string                         `json:"dnsSecurityRuleState,omitempty"`
+}
+
+type dnsSecurityPolicyRuleAction struct {
+	ActionType *string `json:"actionType,omitempty"`
+}
+
+func resourceArmDnsSecurityPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsSecurityPolicyRuleCreateUpdate,
+		Read:   resourceArmDnsSecurityPolicyRuleRead,
+		Update: resourceArmDnsSecurityPolicyRuleCreateUpdate,
+		Delete: resourceArmDnsSecurityPolicyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsSecurityPolicyName,
+			},
+
+			"dns_security_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Allow",
+					"Alert",
+					"Block",
+				}, false),
+			},
+
+			"domain_list_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsSecurityPolicyRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	policyId := d.Get("dns_security_policy_id").(string)
+	id := fmt.Sprintf("%s/dnsSecurityRules/%s", policyId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing dnsSecurityPolicyRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing DNS Security Policy Rule %q (Policy %q): %+v", name, policyId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_security_policy_rule", *existing.ID)
+		}
+	}
+
+	// Rules must be created in the same location as the DNS Security Policy
+	var policy dnsSecurityPolicy
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, policyId, dnsSecurityPolicyApiVersion, &policy); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy %q: %+v", policyId, err)
+	}
+
+	state := "Enabled"
+	if !d.Get("enabled").(bool) {
+		state = "Disabled"
+	}
+
+	domainLists := make([]dnsSecurityPolicySubResource, 0)
+	for _, v := range d.Get("domain_list_ids").(*schema.Set).List() {
+		domainLists = append(domainLists, dnsSecurityPolicySubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := dnsSecurityPolicyRule{
+		Location: policy.Location,
+		Properties: &dnsSecurityPolicyRuleProperties{
+			Priority: utils.Int32(int32(d.Get("priority").(int))),
+			Action: &dnsSecurityPolicyRuleAction{
+				ActionType: utils.String(d.Get("action").(string)),
+			},
+			DnsResolverDomainLists: &domainLists,
+			DnsSecurityRuleState:   utils.String(state),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DNS Security Policy Rule %q (Policy %q): %+v", name, policyId, err)
+	}
+
+	var read dnsSecurityPolicyRule
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy Rule %q (Policy %q): %+v", name, policyId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of DNS Security Policy Rule %q (Policy %q)", name, policyId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDnsSecurityPolicyRuleRead(d, meta)
+}
+
+func resourceArmDnsSecurityPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["dnsResolverPolicies"]
+	name := id.Path["dnsSecurityRules"]
+
+	var rule dnsSecurityPolicyRule
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion, &rule)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] DNS Security Policy Rule %q was not found in Policy %q - removing from state", name, policyName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DNS Security Policy Rule %q (Policy %q / Resource Group %q): %+v", name, policyName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("dns_security_policy_id", dnsSecurityPolicyID(id.SubscriptionID, id.ResourceGroup, policyName))
+
+	if props := rule.Properties; props != nil {
+		if props.Priority != nil {
+			d.Set("priority", int(*props.Priority))
+		}
+
+		if action := props.Action; action != nil {
+			d.Set("action", action.ActionType)
+		}
+
+		domainListIds := make([]interface{}, 0)
+		if props.DnsResolverDomainLists != nil {
+			for _, v := range *props.DnsResolverDomainLists {
+				if v.ID != nil {
+					domainListIds = append(domainListIds, *v.ID)
+				}
+			}
+		}
+		if err := d.Set("domain_list_ids", schema.NewSet(schema.HashString, domainListIds)); err != nil {
+			return fmt.Errorf("Error setting `domain_list_ids`: %+v", err)
+		}
+
+		enabled := true
+		if v := props.DnsSecurityRuleState; v != nil {
+			enabled = strings.EqualFold(*v, "Enabled")
+		}
+		d.Set("enabled", enabled)
+	}
+
+	flattenAndSetTags(d, rule.Tags)
+
+	return nil
+}
+
+func resourceArmDnsSecurityPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["dnsResolverPolicies"]
+	name := id.Path["dnsSecurityRules"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DNS Security Policy Rule %q (Policy %q / Resource Group %q): %+v", name, policyName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
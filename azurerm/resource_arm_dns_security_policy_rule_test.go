@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDnsSecurityPolicyRule_basic(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy_rule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "Block"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicyRule_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dns_security_policy_rule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyRuleExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDnsSecurityPolicyRule_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dns_security_policy_rule"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicyRule_update(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy_rule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsSecurityPolicyRule_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(resourceName, "action", "Alert"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDnsSecurityPolicyRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing dnsSecurityPolicyRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: DNS Security Policy Rule %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on DNS Security Policy Rule %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsSecurityPolicyRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_security_policy_rule" {
+			continue
+		}
+
+		var existing dnsSecurityPolicyRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS Security Policy Rule %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsSecurityPolicyRule_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_security_policy" "test" {
+  name                = "acctest-dnssp-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_dns_security_policy_domain_list" "test" {
+  name                = "acctest-dnsdl-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  domains             = ["contoso.com."]
+}
+`, rInt, location)
+}
+
+func testAccAzureRMDnsSecurityPolicyRule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy_rule" "test" {
+  name                   = "acctest-dnsrule-%d"
+  dns_security_policy_id = "${azurerm_dns_security_policy.test.id}"
+  priority               = 100
+  action                 = "Block"
+  domain_list_ids        = ["${azurerm_dns_security_policy_domain_list.test.id}"]
+}
+`, testAccAzureRMDnsSecurityPolicyRule_template(rInt, location), rInt)
+}
+
+func testAccAzureRMDnsSecurityPolicyRule_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy_rule" "import" {
+  name                   = "${azurerm_dns_security_policy_rule.test.name}"
+  dns_security_policy_id = "${azurerm_dns_security_policy_rule.test.dns_security_policy_id}"
+  priority               = "${azurerm_dns_security_policy_rule.test.priority}"
+  action                 = "${azurerm_dns_security_policy_rule.test.action}"
+  domain_list_ids        = ["${azurerm_dns_security_policy_domain_list.test.id}"]
+}
+`, testAccAzureRMDnsSecurityPolicyRule_basic(rInt, location))
+}
+
+func testAccAzureRMDnsSecurityPolicyRule_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy_rule" "test" {
+  name                   = "acctest-dnsrule-%d"
+  dns_security_policy_id = "${azurerm_dns_security_policy.test.id}"
+  priority               = 200
+  action                 = "Alert"
+  domain_list_ids        = ["${azurerm_dns_security_policy_domain_list.test.id}"]
+  enabled                = false
+}
+`, testAccAzureRMDnsSecurityPolicyRule_template(rInt, location), rInt)
+}
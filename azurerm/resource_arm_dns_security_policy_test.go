@@ -0,0 +1,194 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDnsSecurityPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicy_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dns_security_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDnsSecurityPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dns_security_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicy_update(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsSecurityPolicy_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDnsSecurityPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing dnsSecurityPolicy
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: DNS Security Policy %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on DNS Security Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsSecurityPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_security_policy" {
+			continue
+		}
+
+		var existing dnsSecurityPolicy
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS Security Policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsSecurityPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_security_policy" "test" {
+  name                = "acctest-dnssp-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMDnsSecurityPolicy_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy" "import" {
+  name                = "${azurerm_dns_security_policy.test.name}"
+  resource_group_name = "${azurerm_dns_security_policy.test.resource_group_name}"
+  location            = "${azurerm_dns_security_policy.test.location}"
+}
+`, testAccAzureRMDnsSecurityPolicy_basic(rInt, location))
+}
+
+func testAccAzureRMDnsSecurityPolicy_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_security_policy" "test" {
+  name                = "acctest-dnssp-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location)
+}
@@ -0,0 +1,184 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type dnsSecurityPolicyVirtualNetworkLink struct {
+	ID         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Location   *string                                        `json:"location,omitempty"`
+	Tags       map[string]*string                             `json:"tags"`
+	Properties *dnsSecurityPolicyVirtualNetworkLinkProperties `json:"properties,omitempty"`
+}
+
+type dnsSecurityPolicyVirtualNetworkLinkProperties struct {
+	VirtualNetwork *dnsSecurityPolicySubResource `json:"virtualNetwork,omitempty"`
+}
+
+func resourceArmDnsSecurityPolicyVirtualNetworkLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsSecurityPolicyVirtualNetworkLinkCreateUpdate,
+		Read:   resourceArmDnsSecurityPolicyVirtualNetworkLinkRead,
+		Update: resourceArmDnsSecurityPolicyVirtualNetworkLinkCreateUpdate,
+		Delete: resourceArmDnsSecurityPolicyVirtualNetworkLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsSecurityPolicyName,
+			},
+
+			"dns_security_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// a Virtual Network can only be linked to a single DNS Security Policy
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDnsSecurityPolicyVirtualNetworkLinkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	policyId := d.Get("dns_security_policy_id").(string)
+	id := fmt.Sprintf("%s/virtualNetworkLinks/%s", policyId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing dnsSecurityPolicyVirtualNetworkLink
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing DNS Security Policy Virtual Network Link %q (Policy %q): %+v", name, policyId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_security_policy_virtual_network_link", *existing.ID)
+		}
+	}
+
+	// Virtual Network Links must be created in the same location as the DNS Security Policy
+	var policy dnsSecurityPolicy
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, policyId, dnsSecurityPolicyApiVersion, &policy); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy %q: %+v", policyId, err)
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := dnsSecurityPolicyVirtualNetworkLink{
+		Location: policy.Location,
+		Properties: &dnsSecurityPolicyVirtualNetworkLinkProperties{
+			VirtualNetwork: &dnsSecurityPolicySubResource{
+				ID: utils.String(d.Get("virtual_network_id").(string)),
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DNS Security Policy Virtual Network Link %q (Policy %q): %+v", name, policyId, err)
+	}
+
+	var read dnsSecurityPolicyVirtualNetworkLink
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, dnsSecurityPolicyApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving DNS Security Policy Virtual Network Link %q (Policy %q): %+v", name, policyId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of DNS Security Policy Virtual Network Link %q (Policy %q)", name, policyId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDnsSecurityPolicyVirtualNetworkLinkRead(d, meta)
+}
+
+func resourceArmDnsSecurityPolicyVirtualNetworkLinkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["dnsResolverPolicies"]
+	name := id.Path["virtualNetworkLinks"]
+
+	var link dnsSecurityPolicyVirtualNetworkLink
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion, &link)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] DNS Security Policy Virtual Network Link %q was not found in Policy %q - removing from state", name, policyName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DNS Security Policy Virtual Network Link %q (Policy %q / Resource Group %q): %+v", name, policyName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", link.Name)
+	d.Set("dns_security_policy_id", dnsSecurityPolicyID(id.SubscriptionID, id.ResourceGroup, policyName))
+
+	if props := link.Properties; props != nil {
+		if vnet := props.VirtualNetwork; vnet != nil {
+			d.Set("virtual_network_id", vnet.ID)
+		}
+	}
+
+	flattenAndSetTags(d, link.Tags)
+
+	return nil
+}
+
+func resourceArmDnsSecurityPolicyVirtualNetworkLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["dnsResolverPolicies"]
+	name := id.Path["virtualNetworkLinks"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), dnsSecurityPolicyApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DNS Security Policy Virtual Network Link %q (Policy %q / Resource Group %q): %+v", name, policyName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
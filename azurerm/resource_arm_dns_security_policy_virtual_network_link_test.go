@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDnsSecurityPolicyVirtualNetworkLink_basic(t *testing.T) {
+	resourceName := "azurerm_dns_security_policy_virtual_network_link.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsSecurityPolicyVirtualNetworkLink_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dns_security_policy_virtual_network_link.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dns_security_policy_virtual_network_link"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing dnsSecurityPolicyVirtualNetworkLink
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: DNS Security Policy Virtual Network Link %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on DNS Security Policy Virtual Network Link %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsSecurityPolicyVirtualNetworkLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_security_policy_virtual_network_link" {
+			continue
+		}
+
+		var existing dnsSecurityPolicyVirtualNetworkLink
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, dnsSecurityPolicyApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DNS Security Policy Virtual Network Link %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_security_policy" "test" {
+  name                = "acctest-dnssp-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_dns_security_policy_virtual_network_link" "test" {
+  name                   = "acctest-dnslink-%[1]d"
+  dns_security_policy_id = "${azurerm_dns_security_policy.test.id}"
+  virtual_network_id     = "${azurerm_virtual_network.test.id}"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_security_policy_virtual_network_link" "import" {
+  name                   = "${azurerm_dns_security_policy_virtual_network_link.test.name}"
+  dns_security_policy_id = "${azurerm_dns_security_policy_virtual_network_link.test.dns_security_policy_id}"
+  virtual_network_id     = "${azurerm_dns_security_policy_virtual_network_link.test.virtual_network_id}"
+}
+`, testAccAzureRMDnsSecurityPolicyVirtualNetworkLink_basic(rInt, location))
+}
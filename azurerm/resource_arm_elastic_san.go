@@ -0,0 +1,269 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Elastic SAN isn't present in the vendored SDK, so is managed using raw requests
+const elasticSanApiVersion = "2023-01-01"
+
+type elasticSan struct {
+	ID         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Location   *string               `json:"location,omitempty"`
+	Tags       map[string]*string    `json:"tags"`
+	Properties *elasticSanProperties `json:"properties,omitempty"`
+}
+
+type elasticSanProperties struct {
+	Sku                     *elasticSanSku `json:"sku,omitempty"`
+	AvailabilityZones       *[]string      `json:"availabilityZones,omitempty"`
+	BaseSizeTiB             *int64         `json:"baseSizeTiB,omitempty"`
+	ExtendedCapacitySizeTiB *int64         `json:"extendedCapacitySizeTiB,omitempty"`
+	TotalVolumeSizeGiB      *int64         `json:"totalVolumeSizeGiB,omitempty"`
+	VolumeGroupCount        *int64         `json:"volumeGroupCount,omitempty"`
+	TotalIops               *int64         `json:"totalIops,omitempty"`
+	TotalMBps               *int64         `json:"totalMBps,omitempty"`
+	TotalSizeTiB            *int64         `json:"totalSizeTiB,omitempty"`
+}
+
+type elasticSanSku struct {
+	Name *string `json:"name,omitempty"`
+	Tier *string `json:"tier,omitempty"`
+}
+
+func resourceArmElasticSan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmElasticSanCreateUpdate,
+		Read:   resourceArmElasticSanRead,
+		Update: resourceArmElasticSanCreateUpdate,
+		Delete: resourceArmElasticSanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ElasticSanName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Premium_LRS",
+					"Premium_ZRS",
+				}, false),
+			},
+
+			"zones": zonesSchema(),
+
+			// the Base Size determines the provisioned IOPS and Throughput of the Elastic SAN
+			"base_size_in_tib": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			// the Extended Capacity adds storage without adding IOPS or Throughput
+			"extended_size_in_tib": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+
+			"total_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_mbps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_size_in_tib": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_volume_size_in_gib": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"volume_group_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Get("sku").(string) == "Premium_ZRS" && len(diff.Get("zones").([]interface{})) > 0 {
+				return fmt.Errorf("`zones` cannot be specified when `sku` is `Premium_ZRS`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmElasticSanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := elasticSanID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing elasticSan
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Elastic SAN %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_elastic_san", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := elasticSan{
+		Location: utils.String(location),
+		Properties: &elasticSanProperties{
+			Sku: &elasticSanSku{
+				Name: utils.String(d.Get("sku").(string)),
+				Tier: utils.String("Premium"),
+			},
+			BaseSizeTiB:             utils.Int64(int64(d.Get("base_size_in_tib").(int))),
+			ExtendedCapacitySizeTiB: utils.Int64(int64(d.Get("extended_size_in_tib").(int))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("zones"); ok {
+		parameters.Properties.AvailabilityZones = expandZones(v.([]interface{}))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Elastic SAN %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmElasticSanRead(d, meta)
+}
+
+func resourceArmElasticSanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["elasticSans"]
+
+	var resp elasticSan
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Elastic SAN %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Elastic SAN %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		if sku := props.Sku; sku != nil {
+			d.Set("sku", sku.Name)
+		}
+
+		if err := d.Set("zones", utils.FlattenStringArray(props.AvailabilityZones)); err != nil {
+			return fmt.Errorf("Error setting `zones`: %+v", err)
+		}
+
+		d.Set("base_size_in_tib", elasticSanFlattenInt64(props.BaseSizeTiB))
+		d.Set("extended_size_in_tib", elasticSanFlattenInt64(props.ExtendedCapacitySizeTiB))
+		d.Set("total_iops", elasticSanFlattenInt64(props.TotalIops))
+		d.Set("total_mbps", elasticSanFlattenInt64(props.TotalMBps))
+		d.Set("total_size_in_tib", elasticSanFlattenInt64(props.TotalSizeTiB))
+		d.Set("total_volume_size_in_gib", elasticSanFlattenInt64(props.TotalVolumeSizeGiB))
+		d.Set("volume_group_count", elasticSanFlattenInt64(props.VolumeGroupCount))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmElasticSanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["elasticSans"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Elastic SAN %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func elasticSanID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ElasticSan/elasticSans/%s", subscriptionId, resourceGroup, name)
+}
+
+func elasticSanFlattenInt64(input *int64) int {
+	if input == nil {
+		return 0
+	}
+
+	return int(*input)
+}
@@ -0,0 +1,267 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMElasticSan_basic(t *testing.T) {
+	resourceName := "azurerm_elastic_san.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSan_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "base_size_in_tib", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extended_size_in_tib", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_iops"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_mbps"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSan_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_elastic_san.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSan_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMElasticSan_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_elastic_san"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSan_update(t *testing.T) {
+	resourceName := "azurerm_elastic_san.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSan_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMElasticSan_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "base_size_in_tib", "2"),
+					resource.TestCheckResourceAttr(resourceName, "extended_size_in_tib", "4"),
+					resource.TestCheckResourceAttr(resourceName, "total_size_in_tib", "6"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSan_zoneRedundant(t *testing.T) {
+	resourceName := "azurerm_elastic_san.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSan_zoneRedundant(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Premium_ZRS"),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMElasticSanExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMElasticSanResourceExists(resourceName, "Elastic SAN")
+}
+
+func testCheckAzureRMElasticSanDestroy(s *terraform.State) error {
+	return testCheckAzureRMElasticSanResourceDestroy(s, "azurerm_elastic_san", "Elastic SAN")
+}
+
+// the Elastic SAN resources share an API Version, so are checked using the same helpers
+func testCheckAzureRMElasticSanResourceExists(resourceName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, elasticSanApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: %s %q does not exist", description, rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on %s %q: %+v", description, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMElasticSanResourceDestroy(s *terraform.State, resourceType string, description string) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, elasticSanApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s still exists:\n%#v", description, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMElasticSan_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestes-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Premium_LRS"
+  zones               = ["1"]
+  base_size_in_tib    = 1
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMElasticSan_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSan_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san" "import" {
+  name                = "${azurerm_elastic_san.test.name}"
+  resource_group_name = "${azurerm_elastic_san.test.resource_group_name}"
+  location            = "${azurerm_elastic_san.test.location}"
+  sku                 = "${azurerm_elastic_san.test.sku}"
+  zones               = ["1"]
+  base_size_in_tib    = "${azurerm_elastic_san.test.base_size_in_tib}"
+}
+`, template)
+}
+
+func testAccAzureRMElasticSan_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                 = "acctestes-%s"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  sku                  = "Premium_LRS"
+  zones                = ["1"]
+  base_size_in_tib     = 2
+  extended_size_in_tib = 4
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMElasticSan_zoneRedundant(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestes-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Premium_ZRS"
+  base_size_in_tib    = 1
+}
+`, rInt, location, rString)
+}
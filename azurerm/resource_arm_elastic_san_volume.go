@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type elasticSanVolume struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *elasticSanVolumeProperties `json:"properties,omitempty"`
+}
+
+type elasticSanVolumeProperties struct {
+	VolumeID      *string                    `json:"volumeId,omitempty"`
+	SizeGiB       *int64                     `json:"sizeGiB,omitempty"`
+	CreationData  *elasticSanCreationData    `json:"creationData,omitempty"`
+	StorageTarget *elasticSanIscsiTargetInfo `json:"storageTarget,omitempty"`
+}
+
+type elasticSanCreationData struct {
+	CreateSource *string `json:"createSource,omitempty"`
+}
+
+type elasticSanIscsiTargetInfo struct {
+	TargetIqn            *string `json:"targetIqn,omitempty"`
+	TargetPortalHostname *string `json:"targetPortalHostname,omitempty"`
+	TargetPortalPort     *int32  `json:"targetPortalPort,omitempty"`
+}
+
+func resourceArmElasticSanVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmElasticSanVolumeCreateUpdate,
+		Read:   resourceArmElasticSanVolumeRead,
+		Update: resourceArmElasticSanVolumeCreateUpdate,
+		Delete: resourceArmElasticSanVolumeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ElasticSanVolumeName,
+			},
+
+			"volume_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"size_in_gib": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_iqn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_portal_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_portal_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Id() == "" {
+				return nil
+			}
+
+			// Volumes can be expanded, but not shrunk
+			oldSize, newSize := diff.GetChange("size_in_gib")
+			if newSize.(int) < oldSize.(int) {
+				return fmt.Errorf("`size_in_gib` can only be increased (from %d to %d)", oldSize.(int), newSize.(int))
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmElasticSanVolumeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	volumeGroupId := d.Get("volume_group_id").(string)
+
+	parsed, err := parseAzureResourceID(volumeGroupId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `volume_group_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+	elasticSanName := parsed.Path["elasticSans"]
+	volumeGroupName := parsed.Path["volumegroups"]
+	if elasticSanName == "" || volumeGroupName == "" {
+		return fmt.Errorf("Expected `volume_group_id` to be the ID of an Elastic SAN Volume Group but got %q", volumeGroupId)
+	}
+
+	id := elasticSanVolumeID(elasticSanVolumeGroupID(elasticSanID(parsed.SubscriptionID, resourceGroup, elasticSanName), volumeGroupName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing elasticSanVolume
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Volume %q (Volume Group %q / Elastic SAN %q / Resource Group %q): %+v", name, volumeGroupName, elasticSanName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_elastic_san_volume", *existing.ID)
+		}
+	}
+
+	parameters := elasticSanVolume{
+		Properties: &elasticSanVolumeProperties{
+			SizeGiB: utils.Int64(int64(d.Get("size_in_gib").(int))),
+		},
+	}
+
+	// the Creation Data can only be specified when the Volume is created
+	if d.IsNewResource() {
+		parameters.Properties.CreationData = &elasticSanCreationData{
+			CreateSource: utils.String("None"),
+		}
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Volume %q (Volume Group %q / Elastic SAN %q / Resource Group %q): %+v", name, volumeGroupName, elasticSanName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmElasticSanVolumeRead(d, meta)
+}
+
+func resourceArmElasticSanVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	elasticSanName := id.Path["elasticSans"]
+	volumeGroupName := id.Path["volumegroups"]
+	name := id.Path["volumes"]
+
+	var resp elasticSanVolume
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Volume %q was not found in Volume Group %q (Elastic SAN %q / Resource Group %q) - removing from state", name, volumeGroupName, elasticSanName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Volume %q (Volume Group %q / Elastic SAN %q / Resource Group %q): %+v", name, volumeGroupName, elasticSanName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("volume_group_id", elasticSanVolumeGroupID(elasticSanID(id.SubscriptionID, resourceGroup, elasticSanName), volumeGroupName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("size_in_gib", elasticSanFlattenInt64(props.SizeGiB))
+		d.Set("volume_id", props.VolumeID)
+
+		if target := props.StorageTarget; target != nil {
+			d.Set("target_iqn", target.TargetIqn)
+			d.Set("target_portal_hostname", target.TargetPortalHostname)
+
+			port := 0
+			if target.TargetPortalPort != nil {
+				port = int(*target.TargetPortalPort)
+			}
+			d.Set("target_portal_port", port)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmElasticSanVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	elasticSanName := id.Path["elasticSans"]
+	volumeGroupName := id.Path["volumegroups"]
+	name := id.Path["volumes"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Volume %q (Volume Group %q / Elastic SAN %q / Resource Group %q): %+v", name, volumeGroupName, elasticSanName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func elasticSanVolumeID(volumeGroupId, name string) string {
+	return fmt.Sprintf("%s/volumes/%s", volumeGroupId, name)
+}
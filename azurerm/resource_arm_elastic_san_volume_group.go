@@ -0,0 +1,271 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type elasticSanVolumeGroup struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *elasticSanVolumeGroupProperties `json:"properties,omitempty"`
+}
+
+type elasticSanVolumeGroupProperties struct {
+	ProtocolType *string                   `json:"protocolType,omitempty"`
+	Encryption   *string                   `json:"encryption,omitempty"`
+	NetworkAcls  *elasticSanNetworkRuleSet `json:"networkAcls,omitempty"`
+}
+
+type elasticSanNetworkRuleSet struct {
+	VirtualNetworkRules *[]elasticSanVirtualNetworkRule `json:"virtualNetworkRules,omitempty"`
+}
+
+type elasticSanVirtualNetworkRule struct {
+	ID     *string `json:"id,omitempty"`
+	Action *string `json:"action,omitempty"`
+}
+
+func resourceArmElasticSanVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmElasticSanVolumeGroupCreateUpdate,
+		Read:   resourceArmElasticSanVolumeGroupRead,
+		Update: resourceArmElasticSanVolumeGroupCreateUpdate,
+		Delete: resourceArmElasticSanVolumeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ElasticSanVolumeName,
+			},
+
+			"elastic_san_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protocol_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Iscsi",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Iscsi",
+				}, false),
+			},
+
+			"encryption_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "EncryptionAtRestWithPlatformKey",
+				ValidateFunc: validation.StringInSlice([]string{
+					"EncryptionAtRestWithPlatformKey",
+				}, false),
+			},
+
+			// the Subnets must have the `Microsoft.Storage.Global` Service Endpoint enabled
+			"network_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Allow",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmElasticSanVolumeGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	elasticSanId := d.Get("elastic_san_id").(string)
+
+	parsed, err := parseAzureResourceID(elasticSanId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `elastic_san_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+	elasticSanName := parsed.Path["elasticSans"]
+	if elasticSanName == "" {
+		return fmt.Errorf("Expected `elastic_san_id` to be the ID of an Elastic SAN but got %q", elasticSanId)
+	}
+
+	id := elasticSanVolumeGroupID(elasticSanID(parsed.SubscriptionID, resourceGroup, elasticSanName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing elasticSanVolumeGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Volume Group %q (Elastic SAN %q / Resource Group %q): %+v", name, elasticSanName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_elastic_san_volume_group", *existing.ID)
+		}
+	}
+
+	parameters := elasticSanVolumeGroup{
+		Properties: &elasticSanVolumeGroupProperties{
+			ProtocolType: utils.String(d.Get("protocol_type").(string)),
+			Encryption:   utils.String(d.Get("encryption_type").(string)),
+			NetworkAcls: &elasticSanNetworkRuleSet{
+				VirtualNetworkRules: expandArmElasticSanNetworkRules(d.Get("network_rule").([]interface{})),
+			},
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, elasticSanApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Volume Group %q (Elastic SAN %q / Resource Group %q): %+v", name, elasticSanName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmElasticSanVolumeGroupRead(d, meta)
+}
+
+func resourceArmElasticSanVolumeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	elasticSanName := id.Path["elasticSans"]
+	name := id.Path["volumegroups"]
+
+	var resp elasticSanVolumeGroup
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Volume Group %q was not found in Elastic SAN %q (Resource Group %q) - removing from state", name, elasticSanName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Volume Group %q (Elastic SAN %q / Resource Group %q): %+v", name, elasticSanName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("elastic_san_id", elasticSanID(id.SubscriptionID, resourceGroup, elasticSanName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("protocol_type", props.ProtocolType)
+		d.Set("encryption_type", props.Encryption)
+
+		var rules *[]elasticSanVirtualNetworkRule
+		if props.NetworkAcls != nil {
+			rules = props.NetworkAcls.VirtualNetworkRules
+		}
+		if err := d.Set("network_rule", flattenArmElasticSanNetworkRules(rules)); err != nil {
+			return fmt.Errorf("Error setting `network_rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmElasticSanVolumeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	elasticSanName := id.Path["elasticSans"]
+	name := id.Path["volumegroups"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), elasticSanApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Volume Group %q (Elastic SAN %q / Resource Group %q): %+v", name, elasticSanName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func elasticSanVolumeGroupID(elasticSanId, name string) string {
+	return fmt.Sprintf("%s/volumegroups/%s", elasticSanId, name)
+}
+
+func expandArmElasticSanNetworkRules(input []interface{}) *[]elasticSanVirtualNetworkRule {
+	results := make([]elasticSanVirtualNetworkRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, elasticSanVirtualNetworkRule{
+			ID:     utils.String(v["subnet_id"].(string)),
+			Action: utils.String(v["action"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmElasticSanNetworkRules(input *[]elasticSanVirtualNetworkRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		subnetId := ""
+		if rule.ID != nil {
+			subnetId = *rule.ID
+		}
+
+		action := "Allow"
+		if rule.Action != nil {
+			action = *rule.Action
+		}
+
+		results = append(results, map[string]interface{}{
+			"subnet_id": subnetId,
+			"action":    action,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestExpandArmElasticSanNetworkRules(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+
+	rules := *expandArmElasticSanNetworkRules([]interface{}{})
+	if len(rules) != 0 {
+		t.Fatalf("Expected an empty list of Network Rules so that existing rules are removed but got %+v", rules)
+	}
+
+	rules = *expandArmElasticSanNetworkRules([]interface{}{
+		map[string]interface{}{
+			"subnet_id": subnetId,
+			"action":    "Allow",
+		},
+	})
+	if len(rules) != 1 || *rules[0].ID != subnetId || *rules[0].Action != "Allow" {
+		t.Fatalf("Expected a single Network Rule for %q but got %+v", subnetId, rules)
+	}
+
+	flattened := flattenArmElasticSanNetworkRules(&rules)
+	if len(flattened) != 1 || flattened[0].(map[string]interface{})["subnet_id"] != subnetId {
+		t.Fatalf("Expected the Network Rules to round-trip but got %+v", flattened)
+	}
+}
+
+func TestAccAzureRMElasticSanVolumeGroup_basic(t *testing.T) {
+	resourceName := "azurerm_elastic_san_volume_group.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolumeGroup_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol_type", "Iscsi"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSanVolumeGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_elastic_san_volume_group.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolumeGroup_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMElasticSanVolumeGroup_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_elastic_san_volume_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSanVolumeGroup_networkRules(t *testing.T) {
+	resourceName := "azurerm_elastic_san_volume_group.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolumeGroup_networkRules(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule.0.action", "Allow"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMElasticSanVolumeGroup_networkRulesRemoved(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMElasticSanVolumeGroupExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMElasticSanResourceExists(resourceName, "Elastic SAN Volume Group")
+}
+
+func testCheckAzureRMElasticSanVolumeGroupDestroy(s *terraform.State) error {
+	return testCheckAzureRMElasticSanResourceDestroy(s, "azurerm_elastic_san_volume_group", "Elastic SAN Volume Group")
+}
+
+func testAccAzureRMElasticSanVolumeGroup_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSan_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestvg-%d"
+  elastic_san_id = "${azurerm_elastic_san.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMElasticSanVolumeGroup_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSanVolumeGroup_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "import" {
+  name           = "${azurerm_elastic_san_volume_group.test.name}"
+  elastic_san_id = "${azurerm_elastic_san_volume_group.test.elastic_san_id}"
+}
+`, template)
+}
+
+func testAccAzureRMElasticSanVolumeGroup_networkTemplate(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSan_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.Storage.Global"]
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMElasticSanVolumeGroup_networkRules(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSanVolumeGroup_networkTemplate(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestvg-%d"
+  elastic_san_id = "${azurerm_elastic_san.test.id}"
+
+  network_rule {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMElasticSanVolumeGroup_networkRulesRemoved(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSanVolumeGroup_networkTemplate(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestvg-%d"
+  elastic_san_id = "${azurerm_elastic_san.test.id}"
+}
+`, template, rInt)
+}
@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMElasticSanVolume_basic(t *testing.T) {
+	resourceName := "azurerm_elastic_san_volume.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolume_basic(ri, rs, testLocation(), 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size_in_gib", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_iqn"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_portal_hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_portal_port"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSanVolume_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_elastic_san_volume.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolume_basic(ri, rs, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMElasticSanVolume_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_elastic_san_volume"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMElasticSanVolume_resize(t *testing.T) {
+	resourceName := "azurerm_elastic_san_volume.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMElasticSanVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMElasticSanVolume_basic(ri, rs, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMElasticSanVolume_basic(ri, rs, location, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMElasticSanVolumeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size_in_gib", "10"),
+				),
+			},
+			{
+				Config:      testAccAzureRMElasticSanVolume_basic(ri, rs, location, 5),
+				ExpectError: regexp.MustCompile("`size_in_gib` can only be increased"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMElasticSanVolumeExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMElasticSanResourceExists(resourceName, "Elastic SAN Volume")
+}
+
+func testCheckAzureRMElasticSanVolumeDestroy(s *terraform.State) error {
+	return testCheckAzureRMElasticSanResourceDestroy(s, "azurerm_elastic_san_volume", "Elastic SAN Volume")
+}
+
+func testAccAzureRMElasticSanVolume_basic(rInt int, rString string, location string, size int) string {
+	template := testAccAzureRMElasticSanVolumeGroup_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestvol-%d"
+  volume_group_id = "${azurerm_elastic_san_volume_group.test.id}"
+  size_in_gib     = %d
+}
+`, template, rInt, size)
+}
+
+func testAccAzureRMElasticSanVolume_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMElasticSanVolume_basic(rInt, rString, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "import" {
+  name            = "${azurerm_elastic_san_volume.test.name}"
+  volume_group_id = "${azurerm_elastic_san_volume.test.volume_group_id}"
+  size_in_gib     = "${azurerm_elastic_san_volume.test.size_in_gib}"
+}
+`, template)
+}
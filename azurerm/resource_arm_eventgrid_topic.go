@@ -34,6 +34,18 @@ func resourceArmEventGridTopic() *schema.Resource {
 
 			"tags": tagsSchema(),
 
+			"local_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -85,13 +97,11 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Topic creation with Properties: %+v.", properties)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
-	if err != nil {
-		return err
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/topics/%s", meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	localAuthenticationEnabled := d.Get("local_authentication_enabled").(bool)
+	publicNetworkAccessEnabled := d.Get("public_network_access_enabled").(bool)
+	if err := createUpdateArmResourceWithDataPlaneAccess(ctx, client.Client, client.BaseURI, id, eventGridTopicDataPlaneAccessApiVersion, properties, localAuthenticationEnabled, publicNetworkAccessEnabled); err != nil {
+		return fmt.Errorf("Error creating/updating EventGrid Topic %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
@@ -129,9 +139,20 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error making Read request on EventGrid Topic '%s': %+v", name, err)
 	}
 
-	keys, err := client.ListSharedAccessKeys(ctx, resourceGroup, name)
+	localAuthenticationEnabled, publicNetworkAccessEnabled, err := retrieveArmDataPlaneAccess(ctx, client.Client, client.BaseURI, d.Id(), eventGridTopicDataPlaneAccessApiVersion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Shared Access Keys for EventGrid Topic '%s': %+v", name, err)
+		return fmt.Errorf("Error retrieving the Local Authentication/Public Network Access for EventGrid Topic '%s': %+v", name, err)
+	}
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	// the access keys can't be used when Local Authentication is disabled, so aren't exported
+	keys := eventgrid.TopicSharedAccessKeys{}
+	if localAuthenticationEnabled {
+		keys, err = client.ListSharedAccessKeys(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Shared Access Keys for EventGrid Topic '%s': %+v", name, err)
+		}
 	}
 
 	d.Set("name", resp.Name)
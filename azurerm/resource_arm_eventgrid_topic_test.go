@@ -95,6 +95,42 @@ func TestAccAzureRMEventGridTopic_basicWithTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMEventGridTopic_dataPlaneAccess(t *testing.T) {
+	resourceName := "azurerm_eventgrid_topic.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventGridTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventGridTopic_dataPlaneAccess(ri, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "primary_access_key", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMEventGridTopic_dataPlaneAccess(ri, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMEventGridTopicDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).eventGridTopicsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -204,3 +240,22 @@ resource "azurerm_eventgrid_topic" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMEventGridTopic_dataPlaneAccess(rInt int, enabled bool) string {
+	// currently only supported in "West Central US" & "West US 2"
+	location := "westus2"
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_topic" "test" {
+  name                          = "acctesteg-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  local_authentication_enabled  = %t
+  public_network_access_enabled = %t
+}
+`, rInt, location, rInt, enabled, enabled)
+}
@@ -80,6 +80,14 @@ func resourceArmEventHubNamespace() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 20),
 			},
 
+			// the default keys & connection strings can be omitted from the state for compliance reasons, in which
+			// case they should be retrieved using the `azurerm_eventhub_namespace_authorization_rule` resource
+			"export_default_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -151,17 +159,26 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 		Tags: expandTags(tags),
 	}
 
-	if v, ok := d.GetOk("maximum_throughput_units"); ok {
-		parameters.EHNamespaceProperties.MaximumThroughputUnits = utils.Int32(int32(v.(int)))
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	maximumThroughputUnits, err := eventHubNamespaceMaximumThroughputUnits(d, autoInflateEnabled, int(capacity))
 	if err != nil {
 		return err
 	}
+	parameters.EHNamespaceProperties.MaximumThroughputUnits = maximumThroughputUnits
+
+	if d.IsNewResource() {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		}
+	} else {
+		// the auto-inflate settings, capacity & tags can be updated in-place without re-provisioning the Namespace
+		if _, err := client.Update(ctx, resGroup, name, parameters); err != nil {
+			return fmt.Errorf("Error updating EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -207,20 +224,23 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 	d.Set("sku", string(resp.Sku.Name))
 	d.Set("capacity", resp.Sku.Capacity)
 
-	keys, err := client.ListKeys(ctx, resGroup, name, eventHubNamespaceDefaultAuthorizationRule)
-	if err != nil {
-		log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q: %+v", name, err)
-	} else {
-		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
-		d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
-		d.Set("default_primary_key", keys.PrimaryKey)
-		d.Set("default_secondary_key", keys.SecondaryKey)
+	exportDefaultKeys := true
+	if v, ok := d.GetOkExists("export_default_keys"); ok {
+		exportDefaultKeys = v.(bool)
 	}
+	d.Set("export_default_keys", exportDefaultKeys)
+
+	setEventHubNamespaceDefaultKeys(ctx, d, client, resGroup, name, exportDefaultKeys)
 
 	if props := resp.EHNamespaceProperties; props != nil {
 		d.Set("auto_inflate_enabled", props.IsAutoInflateEnabled)
 		d.Set("kafka_enabled", props.KafkaEnabled)
-		d.Set("maximum_throughput_units", int(*props.MaximumThroughputUnits))
+
+		maximumThroughputUnits := 0
+		if v := props.MaximumThroughputUnits; v != nil {
+			maximumThroughputUnits = int(*v)
+		}
+		d.Set("maximum_throughput_units", maximumThroughputUnits)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -250,6 +270,52 @@ func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}
 	return waitForEventHubNamespaceToBeDeleted(ctx, client, resGroup, name)
 }
 
+func eventHubNamespaceMaximumThroughputUnits(d *schema.ResourceData, autoInflateEnabled bool, capacity int) (*int32, error) {
+	v, ok := d.GetOk("maximum_throughput_units")
+
+	// when Auto-Inflate is disabled the API requires the Maximum Throughput Units to be 0, rather than retaining
+	// the value previously computed when Auto-Inflate was enabled
+	if !autoInflateEnabled {
+		if ok && d.HasChange("maximum_throughput_units") && v.(int) > 0 {
+			return nil, fmt.Errorf("`maximum_throughput_units` can only be set when `auto_inflate_enabled` is true")
+		}
+
+		return utils.Int32(0), nil
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	maximumThroughputUnits := v.(int)
+	if maximumThroughputUnits < capacity {
+		return nil, fmt.Errorf("`maximum_throughput_units` (%d) must be greater than or equal to `capacity` (%d) when `auto_inflate_enabled` is true", maximumThroughputUnits, capacity)
+	}
+
+	return utils.Int32(int32(maximumThroughputUnits)), nil
+}
+
+func setEventHubNamespaceDefaultKeys(ctx context.Context, d *schema.ResourceData, client eventhub.NamespacesClient, resourceGroup, name string, exportDefaultKeys bool) {
+	if !exportDefaultKeys {
+		d.Set("default_primary_connection_string", "")
+		d.Set("default_secondary_connection_string", "")
+		d.Set("default_primary_key", "")
+		d.Set("default_secondary_key", "")
+		return
+	}
+
+	keys, err := client.ListKeys(ctx, resourceGroup, name, eventHubNamespaceDefaultAuthorizationRule)
+	if err != nil {
+		log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return
+	}
+
+	d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("default_secondary_connection_string", keys.SecondaryConnectionString)
+	d.Set("default_primary_key", keys.PrimaryKey)
+	d.Set("default_secondary_key", keys.SecondaryKey)
+}
+
 func waitForEventHubNamespaceToBeDeleted(ctx context.Context, client eventhub.NamespacesClient, resourceGroup, name string) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for EventHub Namespace (%q in Resource Group %q) to be deleted", name, resourceGroup)
@@ -327,6 +327,59 @@ func TestAccAzureRMEventHubNamespace_maximumThroughputUnitsUpdate(t *testing.T)
 	})
 }
 
+func TestAccAzureRMEventHubNamespace_autoInflateDisabledUpdate(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace.test"
+	ri := tf.AccRandTimeInt()
+	preConfig := testAccAzureRMEventHubNamespace_maximumThroughputUnits(ri, testLocation())
+	postConfig := testAccAzureRMEventHubNamespace_autoInfalteDisabledWithAutoInflateUnits(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_inflate_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_throughput_units", "20"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_inflate_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_throughput_units", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHubNamespace_exportDefaultKeysDisabled(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventHubNamespace_exportDefaultKeysDisabled(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "export_default_keys", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_primary_connection_string", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_primary_key", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMEventHubNamespace_autoInfalteDisabledWithAutoInflateUnits(t *testing.T) {
 	resourceName := "azurerm_eventhub_namespace.test"
 	ri := tf.AccRandTimeInt()
@@ -574,3 +627,20 @@ resource "azurerm_eventhub_namespace" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMEventHubNamespace_exportDefaultKeysDisabled(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Basic"
+  export_default_keys = false
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,337 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure Fluid Relay isn't present in the vendored SDK, so is managed using raw requests
+const fluidRelayApiVersion = "2022-06-01"
+
+type fluidRelay struct {
+	ID         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Location   *string               `json:"location,omitempty"`
+	Tags       map[string]*string    `json:"tags"`
+	Identity   *fluidRelayIdentity   `json:"identity,omitempty"`
+	Properties *fluidRelayProperties `json:"properties,omitempty"`
+}
+
+type fluidRelayIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type fluidRelayProperties struct {
+	FrsTenantID         *string              `json:"frsTenantId,omitempty"`
+	FluidRelayEndpoints *fluidRelayEndpoints `json:"fluidRelayEndpoints,omitempty"`
+	StorageSku          *string              `json:"storagesku,omitempty"`
+}
+
+type fluidRelayEndpoints struct {
+	OrdererEndpoints *[]string `json:"ordererEndpoints,omitempty"`
+	StorageEndpoints *[]string `json:"storageEndpoints,omitempty"`
+	ServiceEndpoints *[]string `json:"serviceEndpoints,omitempty"`
+}
+
+type fluidRelayKeys struct {
+	Key1 *string `json:"key1,omitempty"`
+	Key2 *string `json:"key2,omitempty"`
+}
+
+func resourceArmFluidRelay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFluidRelayCreateUpdate,
+		Read:   resourceArmFluidRelayRead,
+		Update: resourceArmFluidRelayCreateUpdate,
+		Delete: resourceArmFluidRelayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FluidRelayName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"storage_sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "standard",
+				ValidateFunc: validation.StringInSlice([]string{
+					"basic",
+					"standard",
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// the Tenant ID used by the Fluid Framework clients, which differs from the Azure AD Tenant ID
+			"frs_tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"orderer_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"storage_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"service_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmFluidRelayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := fluidRelayID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing fluidRelay
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, fluidRelayApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Fluid Relay %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_fluid_relay", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := fluidRelay{
+		Location: utils.String(location),
+		Identity: expandArmFluidRelayIdentity(d.Get("identity").([]interface{})),
+		Properties: &fluidRelayProperties{
+			StorageSku: utils.String(d.Get("storage_sku").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, fluidRelayApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Fluid Relay %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmFluidRelayRead(d, meta)
+}
+
+func resourceArmFluidRelayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["fluidRelayServers"]
+
+	var resp fluidRelay
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), fluidRelayApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Fluid Relay %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Fluid Relay %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmFluidRelayIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		// the Storage SKU isn't returned for Fluid Relays created with the default
+		if props.StorageSku != nil {
+			d.Set("storage_sku", props.StorageSku)
+		}
+		d.Set("frs_tenant_id", props.FrsTenantID)
+
+		ordererEndpoints := make([]interface{}, 0)
+		storageEndpoints := make([]interface{}, 0)
+		serviceEndpoints := make([]interface{}, 0)
+		if endpoints := props.FluidRelayEndpoints; endpoints != nil {
+			ordererEndpoints = utils.FlattenStringArray(endpoints.OrdererEndpoints)
+			storageEndpoints = utils.FlattenStringArray(endpoints.StorageEndpoints)
+			serviceEndpoints = utils.FlattenStringArray(endpoints.ServiceEndpoints)
+		}
+
+		if err := d.Set("orderer_endpoints", ordererEndpoints); err != nil {
+			return fmt.Errorf("Error setting `orderer_endpoints`: %+v", err)
+		}
+		if err := d.Set("storage_endpoints", storageEndpoints); err != nil {
+			return fmt.Errorf("Error setting `storage_endpoints`: %+v", err)
+		}
+		if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
+			return fmt.Errorf("Error setting `service_endpoints`: %+v", err)
+		}
+	}
+
+	var keys fluidRelayKeys
+	if err := armRawPostWithResult(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/listKeys", d.Id()), fluidRelayApiVersion, struct{}{}, &keys); err != nil {
+		return fmt.Errorf("Error retrieving the Keys for Fluid Relay %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	d.Set("primary_key", keys.Key1)
+	d.Set("secondary_key", keys.Key2)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFluidRelayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["fluidRelayServers"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), fluidRelayApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Fluid Relay %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func fluidRelayID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.FluidRelay/fluidRelayServers/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmFluidRelayIdentity(input []interface{}) *fluidRelayIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &fluidRelayIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &fluidRelayIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmFluidRelayIdentity(input *fluidRelayIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFluidRelay_basic(t *testing.T) {
+	resourceName := "azurerm_fluid_relay.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFluidRelayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFluidRelay_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFluidRelayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_sku", "standard"),
+					resource.TestCheckResourceAttrSet(resourceName, "frs_tenant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "orderer_endpoints.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFluidRelay_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_fluid_relay.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFluidRelayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFluidRelay_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFluidRelayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFluidRelay_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_fluid_relay"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFluidRelay_complete(t *testing.T) {
+	resourceName := "azurerm_fluid_relay.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFluidRelayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFluidRelay_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFluidRelayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFluidRelay_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFluidRelayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMFluidRelay_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFluidRelayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFluidRelayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp fluidRelay
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, fluidRelayApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Fluid Relay %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Fluid Relay %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFluidRelayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_fluid_relay" {
+			continue
+		}
+
+		var resp fluidRelay
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, fluidRelayApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Fluid Relay still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFluidRelay_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_fluid_relay" "test" {
+  name                = "acctestfr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMFluidRelay_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFluidRelay_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_fluid_relay" "import" {
+  name                = "${azurerm_fluid_relay.test.name}"
+  resource_group_name = "${azurerm_fluid_relay.test.resource_group_name}"
+  location            = "${azurerm_fluid_relay.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMFluidRelay_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_fluid_relay" "test" {
+  name                = "acctestfr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -13,6 +13,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the vendored SDK predates the VNet Route All & Runtime Scale Monitoring properties within the Site Config,
+// so these are read & updated using a newer API Version
+const functionAppSiteConfigApiVersion = "2021-02-01"
+
+type functionAppSiteConfigExtended struct {
+	Properties *functionAppSiteConfigExtendedProperties `json:"properties,omitempty"`
+}
+
+type functionAppSiteConfigExtendedProperties struct {
+	VnetRouteAllEnabled                    *bool `json:"vnetRouteAllEnabled,omitempty"`
+	FunctionsRuntimeScaleMonitoringEnabled *bool `json:"functionsRuntimeScaleMonitoringEnabled,omitempty"`
+}
+
 // Azure Function App shares the same infrastructure with Azure App Service.
 // So this resource will reuse most of the App Service code, but remove the configurations which are not applicable for Function App.
 func resourceArmFunctionApp() *schema.Resource {
@@ -78,6 +91,18 @@ func resourceArmFunctionApp() *schema.Resource {
 				Default:  true,
 			},
 
+			"content_share_force_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"content_share_over_vnet_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"connection_string": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -193,6 +218,16 @@ func resourceArmFunctionApp() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"vnet_route_all_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"runtime_scale_monitoring_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -390,6 +425,12 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// since the Site Config is replaced above (using the older API Version) these are always re-applied
+	siteConfigExtended := expandFunctionAppSiteConfigExtended(d)
+	if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id()+"/config/web", functionAppSiteConfigApiVersion, siteConfigExtended); err != nil {
+		return fmt.Errorf("Error updating the VNet Route All/Runtime Scale Monitoring Configuration for Function App %q: %+v", name, err)
+	}
+
 	if d.HasChange("connection_string") {
 		// update the ConnectionStrings
 		connectionStrings := expandFunctionAppConnectionStrings(d)
@@ -487,8 +528,15 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	delete(appSettings, "AzureWebJobsDashboard")
 	delete(appSettings, "AzureWebJobsStorage")
 	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
-	delete(appSettings, "WEBSITE_CONTENTSHARE")
-	delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
+	// when the Content Share is disabled these are managed by the user within `app_settings`
+	if !d.Get("content_share_force_disabled").(bool) {
+		delete(appSettings, "WEBSITE_CONTENTSHARE")
+		delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
+	}
+
+	contentOverVnet, ok := appSettings["WEBSITE_CONTENTOVERVNET"]
+	d.Set("content_share_over_vnet_enabled", ok && contentOverVnet == "1")
+	delete(appSettings, "WEBSITE_CONTENTOVERVNET")
 
 	if err = d.Set("app_settings", appSettings); err != nil {
 		return err
@@ -502,7 +550,12 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error making Read request on AzureRM Function App Configuration %q: %+v", name, err)
 	}
 
-	siteConfig := flattenFunctionAppSiteConfig(configResp.SiteConfig)
+	var siteConfigExtended functionAppSiteConfigExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id()+"/config/web", functionAppSiteConfigApiVersion, &siteConfigExtended); err != nil {
+		return fmt.Errorf("Error retrieving the VNet Route All/Runtime Scale Monitoring Configuration for Function App %q: %+v", name, err)
+	}
+
+	siteConfig := flattenFunctionAppSiteConfig(configResp.SiteConfig, siteConfigExtended.Properties)
 	if err = d.Set("site_config", siteConfig); err != nil {
 		return err
 	}
@@ -550,6 +603,7 @@ func getBasicFunctionAppAppSettings(d *schema.ResourceData, appServiceTier strin
 	functionVersionPropName := "FUNCTIONS_EXTENSION_VERSION"
 	contentSharePropName := "WEBSITE_CONTENTSHARE"
 	contentFileConnStringPropName := "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"
+	contentOverVnetPropName := "WEBSITE_CONTENTOVERVNET"
 
 	storageConnection := d.Get("storage_connection_string").(string)
 	functionVersion := d.Get("version").(string)
@@ -567,15 +621,29 @@ func getBasicFunctionAppAppSettings(d *schema.ResourceData, appServiceTier strin
 		})
 	}
 
+	// allows the Content Share to be created when the Storage Account is only accessible via a VNet/Private Endpoint
+	if d.Get("content_share_over_vnet_enabled").(bool) {
+		basicSettings = append(basicSettings, web.NameValuePair{
+			Name:  &contentOverVnetPropName,
+			Value: utils.String("1"),
+		})
+	}
+
 	consumptionSettings := []web.NameValuePair{
 		{Name: &contentSharePropName, Value: &contentShare},
 		{Name: &contentFileConnStringPropName, Value: &storageConnection},
 	}
 
-	// If the application plan is NOT dynamic (consumption plan), we do NOT want to include WEBSITE_CONTENT components
-	if !strings.EqualFold(appServiceTier, "dynamic") {
+	// If the application plan is NOT dynamic (consumption plan) or elastic premium, we do NOT want to include WEBSITE_CONTENT components
+	if !strings.EqualFold(appServiceTier, "dynamic") && !strings.EqualFold(appServiceTier, "elasticpremium") {
+		return basicSettings
+	}
+
+	// when the Content Share is managed outside of Terraform (e.g. pre-created on a VNet restricted Storage Account)
+	if d.Get("content_share_force_disabled").(bool) {
 		return basicSettings
 	}
+
 	return append(basicSettings, consumptionSettings...)
 }
 
@@ -641,7 +709,25 @@ func expandFunctionAppSiteConfig(d *schema.ResourceData) web.SiteConfig {
 	return siteConfig
 }
 
-func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
+func expandFunctionAppSiteConfigExtended(d *schema.ResourceData) functionAppSiteConfigExtended {
+	vnetRouteAllEnabled := false
+	runtimeScaleMonitoringEnabled := false
+
+	if configs := d.Get("site_config").([]interface{}); len(configs) > 0 && configs[0] != nil {
+		config := configs[0].(map[string]interface{})
+		vnetRouteAllEnabled = config["vnet_route_all_enabled"].(bool)
+		runtimeScaleMonitoringEnabled = config["runtime_scale_monitoring_enabled"].(bool)
+	}
+
+	return functionAppSiteConfigExtended{
+		Properties: &functionAppSiteConfigExtendedProperties{
+			VnetRouteAllEnabled:                    utils.Bool(vnetRouteAllEnabled),
+			FunctionsRuntimeScaleMonitoringEnabled: utils.Bool(runtimeScaleMonitoringEnabled),
+		},
+	}
+}
+
+func flattenFunctionAppSiteConfig(input *web.SiteConfig, extended *functionAppSiteConfigExtendedProperties) []interface{} {
 	results := make([]interface{}, 0)
 	result := make(map[string]interface{})
 
@@ -666,6 +752,19 @@ func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
 		result["linux_fx_version"] = *input.LinuxFxVersion
 	}
 
+	vnetRouteAllEnabled := false
+	runtimeScaleMonitoringEnabled := false
+	if extended != nil {
+		if extended.VnetRouteAllEnabled != nil {
+			vnetRouteAllEnabled = *extended.VnetRouteAllEnabled
+		}
+		if extended.FunctionsRuntimeScaleMonitoringEnabled != nil {
+			runtimeScaleMonitoringEnabled = *extended.FunctionsRuntimeScaleMonitoringEnabled
+		}
+	}
+	result["vnet_route_all_enabled"] = vnetRouteAllEnabled
+	result["runtime_scale_monitoring_enabled"] = runtimeScaleMonitoringEnabled
+
 	results = append(results, result)
 	return results
 }
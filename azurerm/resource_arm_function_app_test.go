@@ -468,6 +468,46 @@ func TestAccAzureRMFunctionApp_consumptionPlanUppercaseName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFunctionApp_elasticPremiumVnet(t *testing.T) {
+	resourceName := "azurerm_function_app.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFunctionApp_elasticPremiumVnet(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppExists(resourceName),
+					testCheckAzureRMFunctionAppHasContentShare(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_share_over_vnet_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.vnet_route_all_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.runtime_scale_monitoring_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMFunctionApp_elasticPremiumVnet(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppExists(resourceName),
+					testCheckAzureRMFunctionAppHasContentShare(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_share_over_vnet_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.vnet_route_all_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.runtime_scale_monitoring_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFunctionApp_createIdentity(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := tf.AccRandTimeInt()
@@ -1353,6 +1393,50 @@ resource "azurerm_function_app" "test" {
 `, rInt, location, rString, rInt, rInt)
 }
 
+func testAccAzureRMFunctionApp_elasticPremiumVnet(rInt int, rString string, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  kind                = "elastic"
+
+  sku {
+    tier = "ElasticPremium"
+    size = "EP1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                            = "acctest-%[1]d-func"
+  location                        = "${azurerm_resource_group.test.location}"
+  resource_group_name             = "${azurerm_resource_group.test.name}"
+  app_service_plan_id             = "${azurerm_app_service_plan.test.id}"
+  storage_connection_string       = "${azurerm_storage_account.test.primary_connection_string}"
+  version                         = "~2"
+  content_share_over_vnet_enabled = %[4]t
+
+  site_config {
+    vnet_route_all_enabled           = %[4]t
+    runtime_scale_monitoring_enabled = %[4]t
+  }
+}
+`, rInt, location, rString, enabled)
+}
+
 func testAccAzureRMFunctionApp_basicIdentity(rInt int, storage string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
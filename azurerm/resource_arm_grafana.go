@@ -0,0 +1,543 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure Managed Grafana isn't present in the vendored SDK, so is managed using raw requests
+const grafanaApiVersion = "2023-09-01"
+
+const (
+	// the built-in `Grafana Admin` role, which Managed Grafana syncs to the Admin role within Grafana
+	grafanaAdminRoleDefinitionID = "22926164-76b3-42b3-bc55-97df8dab3e41"
+
+	// the built-in `Monitoring Reader` role, which allows Grafana to query Azure Monitor
+	grafanaMonitoringReaderRoleDefinitionID = "43d0d8ad-25c7-4714-9337-8ba259a9fe05"
+)
+
+type grafana struct {
+	ID         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Tags       map[string]*string `json:"tags"`
+	Sku        *grafanaSku        `json:"sku,omitempty"`
+	Identity   *grafanaIdentity   `json:"identity,omitempty"`
+	Properties *grafanaProperties `json:"properties,omitempty"`
+}
+
+type grafanaSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type grafanaIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type grafanaProperties struct {
+	ZoneRedundancy          *string              `json:"zoneRedundancy,omitempty"`
+	APIKey                  *string              `json:"apiKey,omitempty"`
+	DeterministicOutboundIP *string              `json:"deterministicOutboundIP,omitempty"`
+	PublicNetworkAccess     *string              `json:"publicNetworkAccess,omitempty"`
+	GrafanaIntegrations     *grafanaIntegrations `json:"grafanaIntegrations,omitempty"`
+	Endpoint                *string              `json:"endpoint,omitempty"`
+	GrafanaVersion          *string              `json:"grafanaVersion,omitempty"`
+	OutboundIPs             *[]string            `json:"outboundIPs,omitempty"`
+}
+
+type grafanaIntegrations struct {
+	AzureMonitorWorkspaceIntegrations *[]grafanaAzureMonitorWorkspaceIntegration `json:"azureMonitorWorkspaceIntegrations,omitempty"`
+}
+
+type grafanaAzureMonitorWorkspaceIntegration struct {
+	AzureMonitorWorkspaceResourceID *string `json:"azureMonitorWorkspaceResourceId,omitempty"`
+}
+
+func resourceArmGrafana() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmGrafanaCreateUpdate,
+		Read:   resourceArmGrafanaRead,
+		Update: resourceArmGrafanaCreateUpdate,
+		Delete: resourceArmGrafanaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GrafanaName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Standard",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Essential",
+					"Standard",
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"zone_redundancy_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"api_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deterministic_outbound_ip_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"azure_monitor_workspace_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			// the Object IDs of the Azure AD Users/Groups which are assigned the `Grafana Admin` role on this Grafana
+			"grafana_admin_object_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			// the Scopes (e.g. Subscriptions) at which the Managed Identity is assigned the `Monitoring Reader` role
+			"monitoring_reader_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"grafana_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			scopes := diff.Get("monitoring_reader_scopes").(*schema.Set)
+			identity := diff.Get("identity").([]interface{})
+			if scopes.Len() > 0 && len(identity) == 0 {
+				return fmt.Errorf("an `identity` block must be specified when `monitoring_reader_scopes` is specified")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmGrafanaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := grafanaID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing grafana
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, grafanaApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_grafana", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	integrations := make([]grafanaAzureMonitorWorkspaceIntegration, 0)
+	for _, v := range d.Get("azure_monitor_workspace_ids").(*schema.Set).List() {
+		integrations = append(integrations, grafanaAzureMonitorWorkspaceIntegration{
+			AzureMonitorWorkspaceResourceID: utils.String(v.(string)),
+		})
+	}
+
+	parameters := grafana{
+		Location: utils.String(location),
+		Sku: &grafanaSku{
+			Name: utils.String(d.Get("sku").(string)),
+		},
+		Identity: expandArmGrafanaIdentity(d.Get("identity").([]interface{})),
+		Properties: &grafanaProperties{
+			ZoneRedundancy:          grafanaEnabledString(d.Get("zone_redundancy_enabled").(bool)),
+			APIKey:                  grafanaEnabledString(d.Get("api_key_enabled").(bool)),
+			DeterministicOutboundIP: grafanaEnabledString(d.Get("deterministic_outbound_ip_enabled").(bool)),
+			PublicNetworkAccess:     grafanaEnabledString(d.Get("public_network_access_enabled").(bool)),
+			GrafanaIntegrations: &grafanaIntegrations{
+				AzureMonitorWorkspaceIntegrations: &integrations,
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, grafanaApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read grafana
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, grafanaApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Grafana %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	// the Role Assignments are keyed on the Principal, so are recreated when the Managed Identity changes
+	principalId := ""
+	if read.Identity != nil && read.Identity.PrincipalID != nil {
+		principalId = *read.Identity.PrincipalID
+	}
+
+	oldAdmins, newAdmins := d.GetChange("grafana_admin_object_ids")
+	oldScopes, newScopes := d.GetChange("monitoring_reader_scopes")
+	oldPrincipalId, _ := d.GetChange("identity.0.principal_id")
+
+	removed := make([]grafanaRoleAssignment, 0)
+	for _, v := range oldAdmins.(*schema.Set).Difference(newAdmins.(*schema.Set)).List() {
+		removed = append(removed, grafanaRoleAssignment{scope: *read.ID, roleDefinitionId: grafanaAdminRoleDefinitionID, principalId: v.(string)})
+	}
+	for _, v := range oldScopes.(*schema.Set).List() {
+		if oldPrincipalId.(string) != "" && (oldPrincipalId.(string) != principalId || !newScopes.(*schema.Set).Contains(v)) {
+			removed = append(removed, grafanaRoleAssignment{scope: v.(string), roleDefinitionId: grafanaMonitoringReaderRoleDefinitionID, principalId: oldPrincipalId.(string)})
+		}
+	}
+
+	added := make([]grafanaRoleAssignment, 0)
+	for _, v := range newAdmins.(*schema.Set).List() {
+		added = append(added, grafanaRoleAssignment{scope: *read.ID, roleDefinitionId: grafanaAdminRoleDefinitionID, principalId: v.(string)})
+	}
+	for _, v := range newScopes.(*schema.Set).List() {
+		added = append(added, grafanaRoleAssignment{scope: v.(string), roleDefinitionId: grafanaMonitoringReaderRoleDefinitionID, principalId: principalId})
+	}
+
+	if err := deleteGrafanaRoleAssignments(d.Id(), removed, meta); err != nil {
+		return fmt.Errorf("Error removing Role Assignments for Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// since the Role Assignment names are deterministic, re-creating an existing Role Assignment is a no-op
+	if err := createGrafanaRoleAssignments(d.Id(), added, meta); err != nil {
+		return fmt.Errorf("Error creating Role Assignments for Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmGrafanaRead(d, meta)
+}
+
+func resourceArmGrafanaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["grafana"]
+
+	var resp grafana
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), grafanaApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Grafana %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	if err := d.Set("identity", flattenArmGrafanaIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("zone_redundancy_enabled", grafanaIsEnabled(props.ZoneRedundancy, false))
+		d.Set("api_key_enabled", grafanaIsEnabled(props.APIKey, false))
+		d.Set("deterministic_outbound_ip_enabled", grafanaIsEnabled(props.DeterministicOutboundIP, false))
+		d.Set("public_network_access_enabled", grafanaIsEnabled(props.PublicNetworkAccess, true))
+		d.Set("endpoint", props.Endpoint)
+		d.Set("grafana_version", props.GrafanaVersion)
+
+		if err := d.Set("outbound_ips", utils.FlattenStringArray(props.OutboundIPs)); err != nil {
+			return fmt.Errorf("Error setting `outbound_ips`: %+v", err)
+		}
+
+		workspaceIds := make([]interface{}, 0)
+		if integrations := props.GrafanaIntegrations; integrations != nil && integrations.AzureMonitorWorkspaceIntegrations != nil {
+			for _, v := range *integrations.AzureMonitorWorkspaceIntegrations {
+				if v.AzureMonitorWorkspaceResourceID != nil {
+					workspaceIds = append(workspaceIds, *v.AzureMonitorWorkspaceResourceID)
+				}
+			}
+		}
+		if err := d.Set("azure_monitor_workspace_ids", schema.NewSet(schema.HashString, workspaceIds)); err != nil {
+			return fmt.Errorf("Error setting `azure_monitor_workspace_ids`: %+v", err)
+		}
+	}
+
+	// `grafana_admin_object_ids` and `monitoring_reader_scopes` are managed as Role Assignments, which are
+	// created by this resource, so we leave them as-is
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmGrafanaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["grafana"]
+
+	// the Monitoring Reader Role Assignments are at other scopes, so need to be removed explicitly
+	assignments := make([]grafanaRoleAssignment, 0)
+	if principalId := d.Get("identity.0.principal_id").(string); principalId != "" {
+		for _, v := range d.Get("monitoring_reader_scopes").(*schema.Set).List() {
+			assignments = append(assignments, grafanaRoleAssignment{scope: v.(string), roleDefinitionId: grafanaMonitoringReaderRoleDefinitionID, principalId: principalId})
+		}
+	}
+	for _, v := range d.Get("grafana_admin_object_ids").(*schema.Set).List() {
+		assignments = append(assignments, grafanaRoleAssignment{scope: d.Id(), roleDefinitionId: grafanaAdminRoleDefinitionID, principalId: v.(string)})
+	}
+
+	if err := deleteGrafanaRoleAssignments(d.Id(), assignments, meta); err != nil {
+		return fmt.Errorf("Error removing Role Assignments for Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), grafanaApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Grafana %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func grafanaID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Dashboard/grafana/%s", subscriptionId, resourceGroup, name)
+}
+
+func grafanaEnabledString(enabled bool) *string {
+	if enabled {
+		return utils.String("Enabled")
+	}
+
+	return utils.String("Disabled")
+}
+
+func grafanaIsEnabled(input *string, defaultValue bool) bool {
+	if input == nil {
+		return defaultValue
+	}
+
+	return strings.EqualFold(*input, "Enabled")
+}
+
+type grafanaRoleAssignment struct {
+	scope            string
+	roleDefinitionId string
+	principalId      string
+}
+
+// name returns a deterministic name for the Role Assignment, so that it can be removed without being tracked in the state
+func (a grafanaRoleAssignment) name(grafanaId string) string {
+	key := strings.ToLower(strings.Join([]string{grafanaId, a.scope, a.roleDefinitionId, a.principalId}, "|"))
+	return uuid.NewV5(uuid.NamespaceURL, key).String()
+}
+
+func createGrafanaRoleAssignments(grafanaId string, assignments []grafanaRoleAssignment, meta interface{}) error {
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	for _, assignment := range assignments {
+		name := assignment.name(grafanaId)
+		properties := authorization.RoleAssignmentCreateParameters{
+			RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+				RoleDefinitionID: utils.String(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", subscriptionId, assignment.roleDefinitionId)),
+				PrincipalID:      utils.String(assignment.principalId),
+			},
+		}
+
+		log.Printf("[DEBUG] Creating Role Assignment %q for Principal %q (Scope %q)..", name, assignment.principalId, assignment.scope)
+		// the Managed Identity can take a few minutes to replicate, which `retryRoleAssignmentsClient` handles
+		if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(assignment.scope, name, properties, meta)); err != nil {
+			return fmt.Errorf("Error creating Role Assignment for Principal %q (Scope %q): %+v", assignment.principalId, assignment.scope, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteGrafanaRoleAssignments(grafanaId string, assignments []grafanaRoleAssignment, meta interface{}) error {
+	client := meta.(*ArmClient).roleAssignmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	for _, assignment := range assignments {
+		name := assignment.name(grafanaId)
+
+		log.Printf("[DEBUG] Deleting Role Assignment %q for Principal %q (Scope %q)..", name, assignment.principalId, assignment.scope)
+		resp, err := client.Delete(ctx, assignment.scope, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error deleting Role Assignment for Principal %q (Scope %q): %+v", assignment.principalId, assignment.scope, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandArmGrafanaIdentity(input []interface{}) *grafanaIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &grafanaIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &grafanaIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmGrafanaIdentity(input *grafanaIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
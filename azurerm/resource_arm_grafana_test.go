@@ -0,0 +1,266 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestGrafanaRoleAssignmentName(t *testing.T) {
+	grafanaId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1"
+	assignment := grafanaRoleAssignment{
+		scope:            "/subscriptions/00000000-0000-0000-0000-000000000000",
+		roleDefinitionId: grafanaMonitoringReaderRoleDefinitionID,
+		principalId:      "11111111-1111-1111-1111-111111111111",
+	}
+
+	name := assignment.name(grafanaId)
+	if name != assignment.name(grafanaId) {
+		t.Fatalf("Expected the Role Assignment name to be deterministic")
+	}
+
+	casing := assignment
+	casing.scope = "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000"
+	if name != casing.name(grafanaId) {
+		t.Fatalf("Expected the Role Assignment name to be case-insensitive")
+	}
+
+	otherRole := assignment
+	otherRole.roleDefinitionId = grafanaAdminRoleDefinitionID
+	if name == otherRole.name(grafanaId) {
+		t.Fatalf("Expected the Role Assignment name to differ for a different Role Definition")
+	}
+
+	otherPrincipal := assignment
+	otherPrincipal.principalId = "22222222-2222-2222-2222-222222222222"
+	if name == otherPrincipal.name(grafanaId) {
+		t.Fatalf("Expected the Role Assignment name to differ for a different Principal")
+	}
+
+	if name == assignment.name(grafanaId+"2") {
+		t.Fatalf("Expected the Role Assignment name to differ for a different Grafana")
+	}
+}
+
+func TestAccAzureRMGrafana_basic(t *testing.T) {
+	resourceName := "azurerm_grafana.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMGrafanaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMGrafana_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGrafanaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "grafana_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMGrafana_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_grafana.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMGrafanaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMGrafana_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGrafanaExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMGrafana_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_grafana"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMGrafana_complete(t *testing.T) {
+	resourceName := "azurerm_grafana.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMGrafanaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMGrafana_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGrafanaExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMGrafana_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGrafanaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "api_key_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deterministic_outbound_ip_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_ips.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "azure_monitor_workspace_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grafana_admin_object_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_reader_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grafana_admin_object_ids", "monitoring_reader_scopes"},
+			},
+			{
+				Config: testAccAzureRMGrafana_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGrafanaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grafana_admin_object_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_reader_scopes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMGrafanaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp grafana
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, grafanaApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Grafana %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Grafana %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMGrafanaDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_grafana" {
+			continue
+		}
+
+		var resp grafana
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, grafanaApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Grafana still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMGrafana_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_grafana" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt%1000000)
+}
+
+func testAccAzureRMGrafana_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_grafana" "import" {
+  name                = "${azurerm_grafana.test.name}"
+  resource_group_name = "${azurerm_grafana.test.resource_group_name}"
+  location            = "${azurerm_grafana.test.location}"
+}
+`, testAccAzureRMGrafana_basic(rInt, location))
+}
+
+func testAccAzureRMGrafana_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-mw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_grafana" "test" {
+  name                              = "acctest-%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  location                          = "${azurerm_resource_group.test.location}"
+  api_key_enabled                   = true
+  deterministic_outbound_ip_enabled = true
+  azure_monitor_workspace_ids       = ["${azurerm_monitor_workspace.test.id}"]
+  grafana_admin_object_ids          = ["${data.azurerm_client_config.current.service_principal_object_id}"]
+  monitoring_reader_scopes          = ["${azurerm_resource_group.test.id}"]
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, rInt, location, rInt, rInt%1000000)
+}
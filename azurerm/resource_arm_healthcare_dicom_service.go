@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type healthcareDicomService struct {
+	ID         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Location   *string                           `json:"location,omitempty"`
+	Tags       map[string]*string                `json:"tags"`
+	Identity   *healthcareIdentity               `json:"identity,omitempty"`
+	Properties *healthcareDicomServiceProperties `json:"properties,omitempty"`
+}
+
+type healthcareDicomServiceProperties struct {
+	CorsConfiguration   *healthcareCorsConfiguration `json:"corsConfiguration,omitempty"`
+	PublicNetworkAccess *string                      `json:"publicNetworkAccess,omitempty"`
+	ServiceURL          *string                      `json:"serviceUrl,omitempty"`
+}
+
+func resourceArmHealthcareDicomService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareDicomServiceCreateUpdate,
+		Read:   resourceArmHealthcareDicomServiceRead,
+		Update: resourceArmHealthcareDicomServiceCreateUpdate,
+		Delete: resourceArmHealthcareDicomServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.HealthcareServiceName,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"identity": healthcareIdentitySchema(),
+
+			"cors": healthcareCorsSchema(),
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareDicomServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	workspaceId := d.Get("workspace_id").(string)
+	id := fmt.Sprintf("%s/dicomservices/%s", workspaceId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing healthcareDicomService
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare DICOM Service %q (Workspace %q): %+v", name, workspaceId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_dicom_service", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := healthcareDicomService{
+		Location: utils.String(location),
+		Identity: expandArmHealthcareIdentity(d.Get("identity").([]interface{})),
+		Properties: &healthcareDicomServiceProperties{
+			CorsConfiguration:   expandArmHealthcareCors(d.Get("cors").([]interface{})),
+			PublicNetworkAccess: healthcarePublicNetworkAccess(d.Get("public_network_access_enabled").(bool)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Healthcare DICOM Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	var read healthcareDicomService
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Healthcare DICOM Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Healthcare DICOM Service %q (Workspace %q)", name, workspaceId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareDicomServiceRead(d, meta)
+}
+
+func resourceArmHealthcareDicomServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := healthcareWorkspaceID(id.SubscriptionID, id.ResourceGroup, id.Path["workspaces"])
+	name := id.Path["dicomservices"]
+
+	var service healthcareDicomService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion, &service)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Healthcare DICOM Service %q was not found in Workspace %q - removing from state", name, workspaceId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Healthcare DICOM Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceId)
+	if location := service.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmHealthcareIdentity(service.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := service.Properties; props != nil {
+		if err := d.Set("cors", flattenArmHealthcareCors(props.CorsConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `cors`: %+v", err)
+		}
+
+		d.Set("public_network_access_enabled", healthcarePublicNetworkAccessEnabled(props.PublicNetworkAccess))
+		d.Set("service_url", props.ServiceURL)
+	}
+
+	flattenAndSetTags(d, service.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareDicomServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["dicomservices"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Healthcare DICOM Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
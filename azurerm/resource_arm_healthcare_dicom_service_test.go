@@ -0,0 +1,199 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMHealthcareDicomService_basic(t *testing.T) {
+	resourceName := "azurerm_healthcare_dicom_service.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareDicomServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareDicomService_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareDicomServiceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareDicomService_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_healthcare_dicom_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareDicomServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareDicomService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareDicomServiceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMHealthcareDicomService_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_healthcare_dicom_service"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareDicomService_complete(t *testing.T) {
+	resourceName := "azurerm_healthcare_dicom_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareDicomServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareDicomService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareDicomServiceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMHealthcareDicomService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareDicomServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_url"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMHealthcareDicomServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp healthcareDicomService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Healthcare DICOM Service %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Healthcare DICOM Service %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMHealthcareDicomServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_healthcare_dicom_service" {
+			continue
+		}
+
+		var resp healthcareDicomService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Healthcare DICOM Service still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMHealthcareDicomService_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name         = "acctest-dicom-%d"
+  workspace_id = "${azurerm_healthcare_workspace.test.id}"
+  location     = "${azurerm_resource_group.test.location}"
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location), rInt%1000000)
+}
+
+func testAccAzureRMHealthcareDicomService_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_dicom_service" "import" {
+  name         = "${azurerm_healthcare_dicom_service.test.name}"
+  workspace_id = "${azurerm_healthcare_dicom_service.test.workspace_id}"
+  location     = "${azurerm_healthcare_dicom_service.test.location}"
+}
+`, testAccAzureRMHealthcareDicomService_basic(rInt, location))
+}
+
+func testAccAzureRMHealthcareDicomService_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name                          = "acctest-dicom-%d"
+  workspace_id                  = "${azurerm_healthcare_workspace.test.id}"
+  location                      = "${azurerm_resource_group.test.location}"
+  public_network_access_enabled = false
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  cors {
+    allowed_origins = ["https://example.com"]
+    allowed_headers = ["*"]
+    allowed_methods = ["GET"]
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location), rInt%1000000)
+}
@@ -0,0 +1,370 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type healthcareFhirService struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Kind       *string                          `json:"kind,omitempty"`
+	Tags       map[string]*string               `json:"tags"`
+	Identity   *healthcareIdentity              `json:"identity,omitempty"`
+	Properties *healthcareFhirServiceProperties `json:"properties,omitempty"`
+}
+
+type healthcareFhirServiceProperties struct {
+	AccessPolicies              *[]healthcareFhirServiceAccessPolicy `json:"accessPolicies,omitempty"`
+	AcrConfiguration            *healthcareFhirServiceAcr            `json:"acrConfiguration,omitempty"`
+	AuthenticationConfiguration *healthcareFhirServiceAuthentication `json:"authenticationConfiguration,omitempty"`
+	CorsConfiguration           *healthcareCorsConfiguration         `json:"corsConfiguration,omitempty"`
+	ExportConfiguration         *healthcareFhirServiceExport         `json:"exportConfiguration,omitempty"`
+	PublicNetworkAccess         *string                              `json:"publicNetworkAccess,omitempty"`
+}
+
+type healthcareFhirServiceAccessPolicy struct {
+	ObjectID *string `json:"objectId,omitempty"`
+}
+
+type healthcareFhirServiceAcr struct {
+	LoginServers *[]string `json:"loginServers,omitempty"`
+}
+
+type healthcareFhirServiceAuthentication struct {
+	Authority         *string `json:"authority,omitempty"`
+	Audience          *string `json:"audience,omitempty"`
+	SmartProxyEnabled *bool   `json:"smartProxyEnabled,omitempty"`
+}
+
+type healthcareFhirServiceExport struct {
+	StorageAccountName *string `json:"storageAccountName,omitempty"`
+}
+
+func resourceArmHealthcareFhirService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareFhirServiceCreateUpdate,
+		Read:   resourceArmHealthcareFhirServiceRead,
+		Update: resourceArmHealthcareFhirServiceCreateUpdate,
+		Delete: resourceArmHealthcareFhirServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.HealthcareServiceName,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "fhir-R4",
+				ValidateFunc: validation.StringInSlice([]string{
+					"fhir-R4",
+					"fhir-Stu3",
+				}, false),
+			},
+
+			"authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authority": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
+						"audience": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"smart_proxy_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"identity": healthcareIdentitySchema(),
+
+			"access_policy_object_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
+			"container_registry_login_server_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"cors": healthcareCorsSchema(),
+
+			// the Managed Identity must be granted the `Storage Blob Data Contributor` role on this Storage Account
+			"configuration_export_storage_account_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareFhirServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	workspaceId := d.Get("workspace_id").(string)
+	id := fmt.Sprintf("%s/fhirservices/%s", workspaceId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing healthcareFhirService
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare FHIR Service %q (Workspace %q): %+v", name, workspaceId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_fhir_service", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	accessPolicies := make([]healthcareFhirServiceAccessPolicy, 0)
+	for _, v := range d.Get("access_policy_object_ids").(*schema.Set).List() {
+		accessPolicies = append(accessPolicies, healthcareFhirServiceAccessPolicy{
+			ObjectID: utils.String(v.(string)),
+		})
+	}
+
+	parameters := healthcareFhirService{
+		Location: utils.String(location),
+		Kind:     utils.String(d.Get("kind").(string)),
+		Identity: expandArmHealthcareIdentity(d.Get("identity").([]interface{})),
+		Properties: &healthcareFhirServiceProperties{
+			AccessPolicies: &accessPolicies,
+			AcrConfiguration: &healthcareFhirServiceAcr{
+				LoginServers: expandArmHealthcareStringSet(d.Get("container_registry_login_server_urls").(*schema.Set)),
+			},
+			AuthenticationConfiguration: expandArmHealthcareFhirServiceAuthentication(d.Get("authentication").([]interface{})),
+			CorsConfiguration:           expandArmHealthcareCors(d.Get("cors").([]interface{})),
+			ExportConfiguration: &healthcareFhirServiceExport{
+				StorageAccountName: utils.String(d.Get("configuration_export_storage_account_name").(string)),
+			},
+			PublicNetworkAccess: healthcarePublicNetworkAccess(d.Get("public_network_access_enabled").(bool)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Healthcare FHIR Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	var read healthcareFhirService
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Healthcare FHIR Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Healthcare FHIR Service %q (Workspace %q)", name, workspaceId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareFhirServiceRead(d, meta)
+}
+
+func resourceArmHealthcareFhirServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := healthcareWorkspaceID(id.SubscriptionID, id.ResourceGroup, id.Path["workspaces"])
+	name := id.Path["fhirservices"]
+
+	var service healthcareFhirService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion, &service)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Healthcare FHIR Service %q was not found in Workspace %q - removing from state", name, workspaceId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Healthcare FHIR Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceId)
+	if location := service.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("kind", service.Kind)
+
+	if err := d.Set("identity", flattenArmHealthcareIdentity(service.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := service.Properties; props != nil {
+		objectIds := make([]interface{}, 0)
+		if props.AccessPolicies != nil {
+			for _, policy := range *props.AccessPolicies {
+				if policy.ObjectID != nil {
+					objectIds = append(objectIds, *policy.ObjectID)
+				}
+			}
+		}
+		if err := d.Set("access_policy_object_ids", schema.NewSet(schema.HashString, objectIds)); err != nil {
+			return fmt.Errorf("Error setting `access_policy_object_ids`: %+v", err)
+		}
+
+		var loginServers *[]string
+		if acr := props.AcrConfiguration; acr != nil {
+			loginServers = acr.LoginServers
+		}
+		if err := d.Set("container_registry_login_server_urls", flattenArmHealthcareStringSet(loginServers)); err != nil {
+			return fmt.Errorf("Error setting `container_registry_login_server_urls`: %+v", err)
+		}
+
+		if err := d.Set("authentication", flattenArmHealthcareFhirServiceAuthentication(props.AuthenticationConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `authentication`: %+v", err)
+		}
+
+		if err := d.Set("cors", flattenArmHealthcareCors(props.CorsConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `cors`: %+v", err)
+		}
+
+		storageAccountName := ""
+		if export := props.ExportConfiguration; export != nil && export.StorageAccountName != nil {
+			storageAccountName = *export.StorageAccountName
+		}
+		d.Set("configuration_export_storage_account_name", storageAccountName)
+
+		d.Set("public_network_access_enabled", healthcarePublicNetworkAccessEnabled(props.PublicNetworkAccess))
+	}
+
+	flattenAndSetTags(d, service.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareFhirServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["fhirservices"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Healthcare FHIR Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmHealthcareFhirServiceAuthentication(input []interface{}) *healthcareFhirServiceAuthentication {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &healthcareFhirServiceAuthentication{
+		Authority:         utils.String(v["authority"].(string)),
+		Audience:          utils.String(v["audience"].(string)),
+		SmartProxyEnabled: utils.Bool(v["smart_proxy_enabled"].(bool)),
+	}
+}
+
+func flattenArmHealthcareFhirServiceAuthentication(input *healthcareFhirServiceAuthentication) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	authority := ""
+	if input.Authority != nil {
+		authority = *input.Authority
+	}
+
+	audience := ""
+	if input.Audience != nil {
+		audience = *input.Audience
+	}
+
+	smartProxyEnabled := false
+	if input.SmartProxyEnabled != nil {
+		smartProxyEnabled = *input.SmartProxyEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"authority":           authority,
+			"audience":            audience,
+			"smart_proxy_enabled": smartProxyEnabled,
+		},
+	}
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMHealthcareFhirService_basic(t *testing.T) {
+	resourceName := "azurerm_healthcare_fhir_service.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareFhirServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareFhirService_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareFhirServiceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareFhirService_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_healthcare_fhir_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareFhirServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareFhirService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareFhirServiceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMHealthcareFhirService_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_healthcare_fhir_service"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareFhirService_complete(t *testing.T) {
+	resourceName := "azurerm_healthcare_fhir_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareFhirServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareFhirService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareFhirServiceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMHealthcareFhirService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareFhirServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy_object_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_export_storage_account_name", fmt.Sprintf("acctestsa%d", ri%1000000)),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMHealthcareFhirServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp healthcareFhirService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Healthcare FHIR Service %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Healthcare FHIR Service %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMHealthcareFhirServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_healthcare_fhir_service" {
+			continue
+		}
+
+		var resp healthcareFhirService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Healthcare FHIR Service still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMHealthcareFhirService_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_healthcare_fhir_service" "test" {
+  name         = "acctest-fhir-%d"
+  workspace_id = "${azurerm_healthcare_workspace.test.id}"
+  location     = "${azurerm_resource_group.test.location}"
+
+  authentication {
+    authority = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}"
+    audience  = "https://acctestws%d-acctest-fhir-%d.fhir.azurehealthcareapis.com"
+  }
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location), rInt%1000000, rInt%1000000, rInt%1000000)
+}
+
+func testAccAzureRMHealthcareFhirService_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_fhir_service" "import" {
+  name         = "${azurerm_healthcare_fhir_service.test.name}"
+  workspace_id = "${azurerm_healthcare_fhir_service.test.workspace_id}"
+  location     = "${azurerm_healthcare_fhir_service.test.location}"
+
+  authentication {
+    authority = "${azurerm_healthcare_fhir_service.test.authentication.0.authority}"
+    audience  = "${azurerm_healthcare_fhir_service.test.authentication.0.audience}"
+  }
+}
+`, testAccAzureRMHealthcareFhirService_basic(rInt, location))
+}
+
+func testAccAzureRMHealthcareFhirService_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_healthcare_fhir_service" "test" {
+  name                                      = "acctest-fhir-%[2]d"
+  workspace_id                              = "${azurerm_healthcare_workspace.test.id}"
+  location                                  = "${azurerm_resource_group.test.location}"
+  access_policy_object_ids                  = ["${data.azurerm_client_config.current.service_principal_object_id}"]
+  configuration_export_storage_account_name = "${azurerm_storage_account.test.name}"
+  public_network_access_enabled             = false
+
+  authentication {
+    authority           = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}"
+    audience            = "https://acctestws%[2]d-acctest-fhir-%[2]d.fhir.azurehealthcareapis.com"
+    smart_proxy_enabled = true
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  cors {
+    allowed_origins     = ["https://example.com"]
+    allowed_headers     = ["*"]
+    allowed_methods     = ["GET", "POST"]
+    max_age_in_seconds  = 3600
+    credentials_allowed = true
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location), rInt%1000000)
+}
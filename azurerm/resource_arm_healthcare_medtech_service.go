@@ -0,0 +1,250 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// MedTech Services are exposed by the API as IoT Connectors
+type healthcareMedTechService struct {
+	ID         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Location   *string                             `json:"location,omitempty"`
+	Tags       map[string]*string                  `json:"tags"`
+	Identity   *healthcareIdentity                 `json:"identity,omitempty"`
+	Properties *healthcareMedTechServiceProperties `json:"properties,omitempty"`
+}
+
+type healthcareMedTechServiceProperties struct {
+	IngestionEndpointConfiguration *healthcareMedTechServiceIngestion     `json:"ingestionEndpointConfiguration,omitempty"`
+	DeviceMapping                  *healthcareMedTechServiceDeviceMapping `json:"deviceMapping,omitempty"`
+}
+
+type healthcareMedTechServiceIngestion struct {
+	EventHubName                    *string `json:"eventHubName,omitempty"`
+	ConsumerGroup                   *string `json:"consumerGroup,omitempty"`
+	FullyQualifiedEventHubNamespace *string `json:"fullyQualifiedEventHubNamespace,omitempty"`
+}
+
+type healthcareMedTechServiceDeviceMapping struct {
+	Content interface{} `json:"content,omitempty"`
+}
+
+func resourceArmHealthcareMedTechService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareMedTechServiceCreateUpdate,
+		Read:   resourceArmHealthcareMedTechServiceRead,
+		Update: resourceArmHealthcareMedTechServiceCreateUpdate,
+		Delete: resourceArmHealthcareMedTechServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.HealthcareServiceName,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"identity": healthcareIdentitySchema(),
+
+			"eventhub_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"eventhub_consumer_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"device_mapping_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareMedTechServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	workspaceId := d.Get("workspace_id").(string)
+	id := fmt.Sprintf("%s/iotconnectors/%s", workspaceId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing healthcareMedTechService
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare MedTech Service %q (Workspace %q): %+v", name, workspaceId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_medtech_service", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	var deviceMapping interface{}
+	if err := json.Unmarshal([]byte(d.Get("device_mapping_json").(string)), &deviceMapping); err != nil {
+		return fmt.Errorf("Error parsing `device_mapping_json`: %+v", err)
+	}
+
+	namespace := fmt.Sprintf("%s.%s", d.Get("eventhub_namespace_name").(string), meta.(*ArmClient).environment.ServiceBusEndpointSuffix)
+
+	parameters := healthcareMedTechService{
+		Location: utils.String(location),
+		Identity: expandArmHealthcareIdentity(d.Get("identity").([]interface{})),
+		Properties: &healthcareMedTechServiceProperties{
+			IngestionEndpointConfiguration: &healthcareMedTechServiceIngestion{
+				EventHubName:                    utils.String(d.Get("eventhub_name").(string)),
+				ConsumerGroup:                   utils.String(d.Get("eventhub_consumer_group_name").(string)),
+				FullyQualifiedEventHubNamespace: utils.String(namespace),
+			},
+			DeviceMapping: &healthcareMedTechServiceDeviceMapping{
+				Content: deviceMapping,
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Healthcare MedTech Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	var read healthcareMedTechService
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Healthcare MedTech Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Healthcare MedTech Service %q (Workspace %q)", name, workspaceId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareMedTechServiceRead(d, meta)
+}
+
+func resourceArmHealthcareMedTechServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := healthcareWorkspaceID(id.SubscriptionID, id.ResourceGroup, id.Path["workspaces"])
+	name := id.Path["iotconnectors"]
+
+	var service healthcareMedTechService
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion, &service)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Healthcare MedTech Service %q was not found in Workspace %q - removing from state", name, workspaceId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Healthcare MedTech Service %q (Workspace %q): %+v", name, workspaceId, err)
+	}
+
+	d.Set("name", name)
+	d.Set("workspace_id", workspaceId)
+	if location := service.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmHealthcareIdentity(service.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := service.Properties; props != nil {
+		if ingestion := props.IngestionEndpointConfiguration; ingestion != nil {
+			d.Set("eventhub_name", ingestion.EventHubName)
+			d.Set("eventhub_consumer_group_name", ingestion.ConsumerGroup)
+
+			if v := ingestion.FullyQualifiedEventHubNamespace; v != nil {
+				d.Set("eventhub_namespace_name", strings.TrimSuffix(*v, fmt.Sprintf(".%s", meta.(*ArmClient).environment.ServiceBusEndpointSuffix)))
+			}
+		}
+
+		if mapping := props.DeviceMapping; mapping != nil && mapping.Content != nil {
+			deviceMapping, err := json.Marshal(mapping.Content)
+			if err != nil {
+				return fmt.Errorf("Error serializing `device_mapping_json`: %+v", err)
+			}
+			d.Set("device_mapping_json", string(deviceMapping))
+		}
+	}
+
+	flattenAndSetTags(d, service.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareMedTechServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["iotconnectors"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Healthcare MedTech Service %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
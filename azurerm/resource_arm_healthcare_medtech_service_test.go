@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMHealthcareMedTechService_basic(t *testing.T) {
+	resourceName := "azurerm_healthcare_medtech_service.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareMedTechServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareMedTechService_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareMedTechServiceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareMedTechService_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_healthcare_medtech_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareMedTechServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareMedTechService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareMedTechServiceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMHealthcareMedTechService_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_healthcare_medtech_service"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareMedTechService_complete(t *testing.T) {
+	resourceName := "azurerm_healthcare_medtech_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareMedTechServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareMedTechService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareMedTechServiceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMHealthcareMedTechService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareMedTechServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eventhub_consumer_group_name", fmt.Sprintf("acctesteventhubcg-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMHealthcareMedTechServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp healthcareMedTechService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Healthcare MedTech Service %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Healthcare MedTech Service %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMHealthcareMedTechServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_healthcare_medtech_service" {
+			continue
+		}
+
+		var resp healthcareMedTechService
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Healthcare MedTech Service still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMHealthcareMedTechService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[2]d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%[2]d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name       = "${azurerm_eventhub.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMHealthcareMedTechService_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_medtech_service" "test" {
+  name                         = "acctest-mt-%d"
+  workspace_id                 = "${azurerm_healthcare_workspace.test.id}"
+  location                     = "${azurerm_resource_group.test.location}"
+  eventhub_namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name                = "${azurerm_eventhub.test.name}"
+  eventhub_consumer_group_name = "${azurerm_eventhub_consumer_group.test.name}"
+
+  device_mapping_json = <<JSON
+{
+  "templateType": "CollectionContent",
+  "template": []
+}
+JSON
+}
+`, testAccAzureRMHealthcareMedTechService_template(rInt, location), rInt%1000000)
+}
+
+func testAccAzureRMHealthcareMedTechService_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_medtech_service" "import" {
+  name                         = "${azurerm_healthcare_medtech_service.test.name}"
+  workspace_id                 = "${azurerm_healthcare_medtech_service.test.workspace_id}"
+  location                     = "${azurerm_healthcare_medtech_service.test.location}"
+  eventhub_namespace_name      = "${azurerm_healthcare_medtech_service.test.eventhub_namespace_name}"
+  eventhub_name                = "${azurerm_healthcare_medtech_service.test.eventhub_name}"
+  eventhub_consumer_group_name = "${azurerm_healthcare_medtech_service.test.eventhub_consumer_group_name}"
+  device_mapping_json          = "${azurerm_healthcare_medtech_service.test.device_mapping_json}"
+}
+`, testAccAzureRMHealthcareMedTechService_basic(rInt, location))
+}
+
+func testAccAzureRMHealthcareMedTechService_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_medtech_service" "test" {
+  name                         = "acctest-mt-%d"
+  workspace_id                 = "${azurerm_healthcare_workspace.test.id}"
+  location                     = "${azurerm_resource_group.test.location}"
+  eventhub_namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name                = "${azurerm_eventhub.test.name}"
+  eventhub_consumer_group_name = "${azurerm_eventhub_consumer_group.test.name}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  device_mapping_json = <<JSON
+{
+  "templateType": "CollectionContent",
+  "template": [
+    {
+      "templateType": "JsonPathContent",
+      "template": {
+        "typeName": "heartrate",
+        "typeMatchExpression": "$..[?(@heartRate)]",
+        "deviceIdExpression": "$.deviceId",
+        "timestampExpression": "$.endDate",
+        "values": [
+          {
+            "required": "true",
+            "valueExpression": "$.heartRate",
+            "valueName": "hr"
+          }
+        ]
+      }
+    }
+  ]
+}
+JSON
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, testAccAzureRMHealthcareMedTechService_template(rInt, location), rInt%1000000)
+}
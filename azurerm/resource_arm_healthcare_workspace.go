@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type healthcareWorkspace struct {
+	ID       *string            `json:"id,omitempty"`
+	Name     *string            `json:"name,omitempty"`
+	Location *string            `json:"location,omitempty"`
+	Tags     map[string]*string `json:"tags"`
+}
+
+func resourceArmHealthcareWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHealthcareWorkspaceCreateUpdate,
+		Read:   resourceArmHealthcareWorkspaceRead,
+		Update: resourceArmHealthcareWorkspaceCreateUpdate,
+		Delete: resourceArmHealthcareWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.HealthcareWorkspaceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmHealthcareWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := healthcareWorkspaceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing healthcareWorkspace
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Healthcare Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_healthcare_workspace", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := healthcareWorkspace{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Healthcare Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read healthcareWorkspace
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, healthcareApisApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Healthcare Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Healthcare Workspace %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHealthcareWorkspaceRead(d, meta)
+}
+
+func resourceArmHealthcareWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	var workspace healthcareWorkspace
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion, &workspace)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Healthcare Workspace %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Healthcare Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", workspace.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := workspace.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, workspace.Tags)
+
+	return nil
+}
+
+func resourceArmHealthcareWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), healthcareApisApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Healthcare Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func healthcareWorkspaceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HealthcareApis/workspaces/%s", subscriptionId, resourceGroup, name)
+}
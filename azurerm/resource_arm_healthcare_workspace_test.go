@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMHealthcareWorkspace_basic(t *testing.T) {
+	resourceName := "azurerm_healthcare_workspace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareWorkspace_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareWorkspaceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareWorkspace_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_healthcare_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMHealthcareWorkspace_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_healthcare_workspace"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMHealthcareWorkspace_complete(t *testing.T) {
+	resourceName := "azurerm_healthcare_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMHealthcareWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMHealthcareWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMHealthcareWorkspace_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMHealthcareWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMHealthcareWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp healthcareWorkspace
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Healthcare Workspace %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Healthcare Workspace %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMHealthcareWorkspaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_healthcare_workspace" {
+			continue
+		}
+
+		var resp healthcareWorkspace
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, healthcareApisApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Healthcare Workspace still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMHealthcareWorkspace_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_healthcare_workspace" "test" {
+  name                = "acctestws%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt%1000000)
+}
+
+func testAccAzureRMHealthcareWorkspace_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_workspace" "import" {
+  name                = "${azurerm_healthcare_workspace.test.name}"
+  resource_group_name = "${azurerm_healthcare_workspace.test.resource_group_name}"
+  location            = "${azurerm_healthcare_workspace.test.location}"
+}
+`, testAccAzureRMHealthcareWorkspace_basic(rInt, location))
+}
+
+func testAccAzureRMHealthcareWorkspace_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_healthcare_workspace" "test" {
+  name                = "acctestws%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt%1000000)
+}
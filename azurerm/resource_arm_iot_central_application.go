@@ -0,0 +1,482 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/iotcentral/mgmt/2018-09-01/iotcentral"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the vendored SDK predates Managed Identities, Public Network Access & Network Rule Sets for IoT Central
+// Applications, so these are read & updated using a newer API Version
+const iotCentralApplicationExtendedApiVersion = "2021-11-01-preview"
+
+type iotCentralApplicationExtended struct {
+	Identity   *iotCentralApplicationIdentity           `json:"identity,omitempty"`
+	Properties *iotCentralApplicationExtendedProperties `json:"properties,omitempty"`
+}
+
+type iotCentralApplicationIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type iotCentralApplicationExtendedProperties struct {
+	PublicNetworkAccess *string                              `json:"publicNetworkAccess,omitempty"`
+	NetworkRuleSets     *iotCentralApplicationNetworkRuleSet `json:"networkRuleSets,omitempty"`
+}
+
+type iotCentralApplicationNetworkRuleSet struct {
+	ApplyToDevices    *bool                                        `json:"applyToDevices,omitempty"`
+	ApplyToIoTCentral *bool                                        `json:"applyToIoTCentral,omitempty"`
+	DefaultAction     *string                                      `json:"defaultAction,omitempty"`
+	IPRules           *[]iotCentralApplicationNetworkRuleSetIPRule `json:"ipRules,omitempty"`
+}
+
+type iotCentralApplicationNetworkRuleSetIPRule struct {
+	FilterName *string `json:"filterName,omitempty"`
+	IPMask     *string `json:"ipMask,omitempty"`
+}
+
+func resourceArmIotCentralApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIotCentralApplicationCreateUpdate,
+		Read:   resourceArmIotCentralApplicationRead,
+		Update: resourceArmIotCentralApplicationCreateUpdate,
+		Delete: resourceArmIotCentralApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IoTCentralApplicationName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sub_domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IoTCentralApplicationSubdomain,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ST1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ST0",
+					"ST1",
+					"ST2",
+				}, false),
+			},
+
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"network_rule_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_to_device": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"default_action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Deny",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Deny",
+							}, false),
+						},
+						"ip_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"ip_mask": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.CIDR,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIotCentralApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_iot_central_application", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	app := iotcentral.App{
+		Location: utils.String(location),
+		Sku: &iotcentral.AppSkuInfo{
+			Name: iotcentral.AppSku(d.Get("sku").(string)),
+		},
+		AppProperties: &iotcentral.AppProperties{
+			Subdomain: utils.String(d.Get("sub_domain").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		app.AppProperties.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template"); ok {
+		app.AppProperties.Template = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, app)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of IoT Central Application %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	// since the PUT uses the older API Version these are always sent, to ensure they're not reset
+	extended := iotCentralApplicationExtended{
+		Identity: expandArmIotCentralApplicationIdentity(d.Get("identity").([]interface{})),
+		Properties: &iotCentralApplicationExtendedProperties{
+			PublicNetworkAccess: utils.String("Enabled"),
+			NetworkRuleSets:     expandArmIotCentralApplicationNetworkRuleSet(d.Get("network_rule_set").([]interface{})),
+		},
+	}
+
+	if !d.Get("public_network_access_enabled").(bool) {
+		extended.Properties.PublicNetworkAccess = utils.String("Disabled")
+	}
+
+	if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id(), iotCentralApplicationExtendedApiVersion, extended); err != nil {
+		return fmt.Errorf("Error updating the Identity/Network Access for IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmIotCentralApplicationRead(d, meta)
+}
+
+func resourceArmIotCentralApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IoTApps"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] IoT Central Application %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	if props := resp.AppProperties; props != nil {
+		d.Set("sub_domain", props.Subdomain)
+		d.Set("display_name", props.DisplayName)
+		d.Set("template", props.Template)
+		d.Set("application_id", props.ApplicationID)
+	}
+
+	var extended iotCentralApplicationExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), iotCentralApplicationExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Identity/Network Access for IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("identity", flattenArmIotCentralApplicationIdentity(extended.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	publicNetworkAccessEnabled := true
+	var networkRuleSet *iotCentralApplicationNetworkRuleSet
+	if props := extended.Properties; props != nil {
+		if v := props.PublicNetworkAccess; v != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*v, "Enabled")
+		}
+		networkRuleSet = props.NetworkRuleSets
+	}
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	if err := d.Set("network_rule_set", flattenArmIotCentralApplicationNetworkRuleSet(networkRuleSet)); err != nil {
+		return fmt.Errorf("Error setting `network_rule_set`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmIotCentralApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IoTApps"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmIotCentralApplicationIdentity(input []interface{}) *iotCentralApplicationIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &iotCentralApplicationIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &iotCentralApplicationIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmIotCentralApplicationIdentity(input *iotCentralApplicationIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandArmIotCentralApplicationNetworkRuleSet(input []interface{}) *iotCentralApplicationNetworkRuleSet {
+	ipRules := make([]iotCentralApplicationNetworkRuleSetIPRule, 0)
+
+	// when the block isn't specified all traffic is allowed
+	if len(input) == 0 || input[0] == nil {
+		return &iotCentralApplicationNetworkRuleSet{
+			ApplyToDevices:    utils.Bool(false),
+			ApplyToIoTCentral: utils.Bool(false),
+			DefaultAction:     utils.String("Allow"),
+			IPRules:           &ipRules,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	for _, raw := range v["ip_rule"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		ipRules = append(ipRules, iotCentralApplicationNetworkRuleSetIPRule{
+			FilterName: utils.String(rule["name"].(string)),
+			IPMask:     utils.String(rule["ip_mask"].(string)),
+		})
+	}
+
+	return &iotCentralApplicationNetworkRuleSet{
+		ApplyToDevices:    utils.Bool(v["apply_to_device"].(bool)),
+		ApplyToIoTCentral: utils.Bool(false),
+		DefaultAction:     utils.String(v["default_action"].(string)),
+		IPRules:           &ipRules,
+	}
+}
+
+func flattenArmIotCentralApplicationNetworkRuleSet(input *iotCentralApplicationNetworkRuleSet) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	defaultAction := "Allow"
+	if input.DefaultAction != nil {
+		defaultAction = *input.DefaultAction
+	}
+
+	ipRules := make([]interface{}, 0)
+	if input.IPRules != nil {
+		for _, rule := range *input.IPRules {
+			name := ""
+			if rule.FilterName != nil {
+				name = *rule.FilterName
+			}
+
+			ipMask := ""
+			if rule.IPMask != nil {
+				ipMask = *rule.IPMask
+			}
+
+			ipRules = append(ipRules, map[string]interface{}{
+				"name":    name,
+				"ip_mask": ipMask,
+			})
+		}
+	}
+
+	// an unrestricted Rule Set is the same as not specifying the block
+	if strings.EqualFold(defaultAction, "Allow") && len(ipRules) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	applyToDevice := false
+	if input.ApplyToDevices != nil {
+		applyToDevice = *input.ApplyToDevices
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"apply_to_device": applyToDevice,
+			"default_action":  defaultAction,
+			"ip_rule":         ipRules,
+		},
+	}
+}
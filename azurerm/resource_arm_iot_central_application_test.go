@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMIotCentralApplication_basic(t *testing.T) {
+	resourceName := "azurerm_iot_central_application.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotCentralApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "ST1"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_set.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIotCentralApplication_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_iot_central_application.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotCentralApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMIotCentralApplication_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_iot_central_application"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIotCentralApplication_complete(t *testing.T) {
+	resourceName := "azurerm_iot_central_application.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotCentralApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMIotCentralApplication_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "ST2"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("acctest-iotcentral-app-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_set.0.default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_set.0.ip_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "network_rule_set.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMIotCentralApplicationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).iotCentralAppsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on iotCentralAppsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: IoT Central Application %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMIotCentralApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).iotCentralAppsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iot_central_application" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("IoT Central Application still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMIotCentralApplication_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_central_application" "test" {
+  name                = "acctest-iotcentral-app-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sub_domain          = "acctest-iotcentral-app-%d"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMIotCentralApplication_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_central_application" "import" {
+  name                = "${azurerm_iot_central_application.test.name}"
+  resource_group_name = "${azurerm_iot_central_application.test.resource_group_name}"
+  location            = "${azurerm_iot_central_application.test.location}"
+  sub_domain          = "${azurerm_iot_central_application.test.sub_domain}"
+}
+`, testAccAzureRMIotCentralApplication_basic(rInt, location))
+}
+
+func testAccAzureRMIotCentralApplication_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_central_application" "test" {
+  name                          = "acctest-iotcentral-app-%d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  sub_domain                    = "acctest-iotcentral-app-%d"
+  display_name                  = "acctest-iotcentral-app-%d"
+  sku                           = "ST2"
+  public_network_access_enabled = false
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_rule_set {
+    default_action = "Deny"
+
+    ip_rule {
+      name    = "rule1"
+      ip_mask = "10.0.1.0/24"
+    }
+
+    ip_rule {
+      name    = "rule2"
+      ip_mask = "10.0.2.0/24"
+    }
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -40,6 +41,8 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: customizeDiffNameAvailability("Key Vault", resourceArmKeyVaultNameAvailability),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -517,3 +520,17 @@ func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, [
 	}
 	return &ruleSet, subnetIds
 }
+
+func resourceArmKeyVaultNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	input := keyvault.VaultCheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.KeyVault/vaults"),
+	}
+	resp, err := client.keyVaultClient.CheckNameAvailability(ctx, input)
+	if err != nil {
+		return false, "", err
+	}
+
+	available := resp.NameAvailable != nil && *resp.NameAvailable
+	return available, nameAvailabilityReason(resp.Message, string(resp.Reason)), nil
+}
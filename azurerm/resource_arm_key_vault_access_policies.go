@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const keyVaultAccessPoliciesIDSuffix = "/accessPolicies"
+
+// resourceArmKeyVaultAccessPolicies manages the complete set of Access Policies for a Key Vault - unlike
+// `azurerm_key_vault_access_policy` (which manages a single policy) any Access Policies added outside of
+// Terraform are detected as drift and removed
+func resourceArmKeyVaultAccessPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultAccessPoliciesCreateUpdate,
+		Read:   resourceArmKeyVaultAccessPoliciesRead,
+		Update: resourceArmKeyVaultAccessPoliciesCreateUpdate,
+		Delete: resourceArmKeyVaultAccessPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyVaultName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"access_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateUUID,
+						},
+						"object_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateUUID,
+						},
+						"application_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateUUID,
+						},
+						"certificate_permissions": azure.SchemaKeyVaultCertificatePermissions(),
+						"key_permissions":         azure.SchemaKeyVaultKeyPermissions(),
+						"secret_permissions":      azure.SchemaKeyVaultSecretPermissions(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultAccessPoliciesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	vaultName := d.Get("vault_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	policies := d.Get("access_policy").([]interface{})
+	accessPolicies, err := azure.ExpandKeyVaultAccessPolicies(policies)
+	if err != nil {
+		return fmt.Errorf("Error expanding `access_policy`: %+v", err)
+	}
+
+	// Locking to prevent parallel changes causing issues
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	keyVault, err := client.Get(ctx, resGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	if keyVault.ID == nil {
+		return fmt.Errorf("Cannot read Key Vault %q (Resource Group %q) ID", vaultName, resGroup)
+	}
+
+	log.Printf("[DEBUG] Replacing the Access Policies for Key Vault %q (Resource Group %q) with %d Access Policies", vaultName, resGroup, len(*accessPolicies))
+
+	// PATCH'ing the Key Vault replaces the full list of Access Policies, rather than merging them
+	parameters := keyvault.VaultPatchParameters{
+		Properties: &keyvault.VaultPatchProperties{
+			AccessPolicies: accessPolicies,
+		},
+	}
+	if _, err := client.Update(ctx, resGroup, vaultName, parameters); err != nil {
+		return fmt.Errorf("Error updating Access Policies for Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s%s", *keyVault.ID, keyVaultAccessPoliciesIDSuffix))
+	}
+
+	return resourceArmKeyVaultAccessPoliciesRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(keyVaultIDFromAccessPoliciesID(d.Id()))
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	resp, err := client.Get(ctx, resGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Key Vault %q (Resource Group %q) was not found - removing Access Policies from state", vaultName, resGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	d.Set("vault_name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.Properties; props != nil {
+		if err := d.Set("access_policy", azure.FlattenKeyVaultAccessPolicies(props.AccessPolicies)); err != nil {
+			return fmt.Errorf("Error setting `access_policy` for Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultAccessPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(keyVaultIDFromAccessPoliciesID(d.Id()))
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	resp, err := client.Get(ctx, resGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	accessPolicies := make([]keyvault.AccessPolicyEntry, 0)
+	parameters := keyvault.VaultPatchParameters{
+		Properties: &keyvault.VaultPatchProperties{
+			AccessPolicies: &accessPolicies,
+		},
+	}
+	if _, err := client.Update(ctx, resGroup, vaultName, parameters); err != nil {
+		return fmt.Errorf("Error removing Access Policies from Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	return nil
+}
+
+// keyVaultIDFromAccessPoliciesID returns the ID of the Key Vault, since the `/accessPolicies` suffix
+// means the ID of this resource can't be parsed as a regular Resource ID
+func keyVaultIDFromAccessPoliciesID(id string) string {
+	return strings.TrimSuffix(id, keyVaultAccessPoliciesIDSuffix)
+}
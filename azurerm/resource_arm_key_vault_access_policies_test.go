@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestKeyVaultIDFromAccessPoliciesID(t *testing.T) {
+	vaultId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"
+
+	id, err := parseAzureResourceID(keyVaultIDFromAccessPoliciesID(vaultId + keyVaultAccessPoliciesIDSuffix))
+	if err != nil {
+		t.Fatalf("Expected the ID to be parsed but got: %+v", err)
+	}
+
+	if id.ResourceGroup != "group1" || id.Path["vaults"] != "vault1" {
+		t.Fatalf("Expected the Resource Group to be %q and the Vault to be %q but got %q and %q", "group1", "vault1", id.ResourceGroup, id.Path["vaults"])
+	}
+}
+
+func TestAccAzureRMKeyVaultAccessPolicies_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policies.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicies_basic(rs, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.0.key_permissions.0", "get"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicies_removesOutOfBandPolicies(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policies.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicies_basic(rs, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 1),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAddAzureRMKeyVaultAccessPolicyOutOfBand(fmt.Sprintf("acctestRG-%s", rs), fmt.Sprintf("acctestkv-%s", rs)); err != nil {
+						t.Fatalf("Error adding an out-of-band Access Policy: %+v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicies_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policies.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicies_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicies_multiple(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "access_policy.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicies_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultAccessPoliciesCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vaultName := rs.Primary.Attributes["vault_name"]
+		resGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resGroup, vaultName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault %q (resource group: %q) does not exist", vaultName, resGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
+		}
+
+		actual := 0
+		if props := resp.Properties; props != nil && props.AccessPolicies != nil {
+			actual = len(*props.AccessPolicies)
+		}
+
+		if actual != expected {
+			return fmt.Errorf("Bad: Expected Key Vault %q (resource group: %q) to have %d Access Policies but got %d", vaultName, resGroup, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAddAzureRMKeyVaultAccessPolicyOutOfBand(resGroup string, vaultName string) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	tenantId, err := uuid.FromString(testAccProvider.Meta().(*ArmClient).tenantId)
+	if err != nil {
+		return err
+	}
+
+	accessPolicies := []keyvault.AccessPolicyEntry{
+		{
+			TenantID: &tenantId,
+			ObjectID: utils.String(uuid.NewV4().String()),
+			Permissions: &keyvault.Permissions{
+				Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsGet},
+			},
+		},
+	}
+
+	parameters := keyvault.VaultAccessPolicyParameters{
+		Properties: &keyvault.VaultAccessPolicyProperties{
+			AccessPolicies: &accessPolicies,
+		},
+	}
+
+	_, err = client.UpdateAccessPolicy(ctx, resGroup, vaultName, keyvault.Add, parameters)
+	return err
+}
+
+func testAccAzureRMKeyVaultAccessPolicies_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policies" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+      "set",
+    ]
+  }
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicies_multiple(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policies" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+      "set",
+    ]
+  }
+
+  access_policy {
+    tenant_id      = "${data.azurerm_client_config.current.tenant_id}"
+    object_id      = "${data.azurerm_client_config.current.service_principal_object_id}"
+    application_id = "${data.azurerm_client_config.current.service_principal_application_id}"
+
+    secret_permissions = [
+      "get",
+    ]
+  }
+}
+`, template)
+}
@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
@@ -18,6 +20,26 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the vendored SDK doesn't support stopping/starting a Managed Kubernetes Cluster, so this is done using raw requests
+const kubernetesClusterPowerStateApiVersion = "2020-09-01"
+
+const (
+	kubernetesClusterPowerStateRunning = "Running"
+	kubernetesClusterPowerStateStopped = "Stopped"
+)
+
+type kubernetesClusterPowerStateResource struct {
+	Properties *kubernetesClusterPowerStateProperties `json:"properties,omitempty"`
+}
+
+type kubernetesClusterPowerStateProperties struct {
+	PowerState *kubernetesClusterPowerState `json:"powerState,omitempty"`
+}
+
+type kubernetesClusterPowerState struct {
+	Code *string `json:"code,omitempty"`
+}
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKubernetesClusterCreateUpdate,
@@ -89,6 +111,16 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kubernetesClusterPowerStateRunning,
+					kubernetesClusterPowerStateStopped,
+				}, false),
+			},
+
 			"agent_pool_profile": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -184,10 +216,18 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						"client_secret": {
 							Type:         schema.TypeString,
 							ForceNew:     true,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validate.NoEmptyStrings,
 						},
+
+						// the Client Secret is retrieved from the Key Vault at apply time, rather than being specified in the config
+						"client_secret_key_vault_secret_id": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
 					},
 				},
 				Set: resourceKubernetesClusterServicePrincipalProfileHash,
@@ -258,6 +298,45 @@ func resourceArmKubernetesCluster() *schema.Resource {
 								},
 							},
 						},
+
+						"azure_policy": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"azure_keyvault_secrets_provider": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"secret_rotation_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"secret_rotation_interval": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "2m",
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -533,7 +612,10 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 	linuxProfile := expandKubernetesClusterLinuxProfile(d)
 	agentProfiles := expandKubernetesClusterAgentPoolProfiles(d)
-	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
+	servicePrincipalProfile, err := expandAzureRmKubernetesClusterServicePrincipal(ctx, d, meta)
+	if err != nil {
+		return err
+	}
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandKubernetesClusterAddonProfiles(d)
 
@@ -554,6 +636,23 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	rbacRaw := d.Get("role_based_access_control").([]interface{})
 	rbacEnabled, azureADProfile := expandKubernetesClusterRoleBasedAccessControl(rbacRaw, tenantId)
 
+	powerState := d.Get("power_state").(string)
+	updateRequired := d.IsNewResource() || !kubernetesClusterOnlyPowerStateHasChanged(d)
+
+	if !d.IsNewResource() {
+		oldPowerState, _ := d.GetChange("power_state")
+
+		// a stopped cluster can't be updated, so it's started before any other changes are made
+		if d.HasChange("power_state") && powerState == kubernetesClusterPowerStateRunning {
+			log.Printf("[DEBUG] Starting Managed Kubernetes Cluster %q (Resource Group %q)..", name, resGroup)
+			if err := armRawPost(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/start", d.Id()), kubernetesClusterPowerStateApiVersion); err != nil {
+				return fmt.Errorf("Error starting Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		} else if updateRequired && oldPowerState.(string) == kubernetesClusterPowerStateStopped {
+			return fmt.Errorf("Managed Kubernetes Cluster %q (Resource Group %q) is stopped and must be started (by setting `power_state` to `Running`) before it can be updated", name, resGroup)
+		}
+	}
+
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
@@ -571,13 +670,15 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		Tags: expandTags(tags),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
-	if err != nil {
-		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	if updateRequired {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+		if err != nil {
+			return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -591,6 +692,14 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 	d.SetId(*read.ID)
 
+	// clusters are always created running, so are stopped once any other changes have been made
+	if d.HasChange("power_state") && powerState == kubernetesClusterPowerStateStopped {
+		log.Printf("[DEBUG] Stopping Managed Kubernetes Cluster %q (Resource Group %q)..", name, resGroup)
+		if err := armRawPost(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/stop", *read.ID), kubernetesClusterPowerStateApiVersion); err != nil {
+			return fmt.Errorf("Error stopping Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceArmKubernetesClusterRead(d, meta)
 }
 
@@ -621,11 +730,17 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	powerState, err := retrieveKubernetesClusterPowerState(ctx, client, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Power State for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
+	d.Set("power_state", powerState)
 
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
@@ -715,6 +830,36 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// kubernetesClusterOnlyPowerStateHasChanged returns whether the cluster is only being stopped/started, in which case
+// it mustn't be updated - since a stopped cluster can't be updated
+func kubernetesClusterOnlyPowerStateHasChanged(d *schema.ResourceData) bool {
+	if !d.HasChange("power_state") {
+		return false
+	}
+
+	for k := range resourceArmKubernetesCluster().Schema {
+		if k != "power_state" && d.HasChange(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// retrieveKubernetesClusterPowerState returns whether the Managed Kubernetes Cluster is `Running` or `Stopped`
+func retrieveKubernetesClusterPowerState(ctx context.Context, client containerservice.ManagedClustersClient, id string) (string, error) {
+	var cluster kubernetesClusterPowerStateResource
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, kubernetesClusterPowerStateApiVersion, &cluster); err != nil {
+		return "", err
+	}
+
+	if props := cluster.Properties; props != nil && props.PowerState != nil && props.PowerState.Code != nil {
+		return *props.PowerState.Code, nil
+	}
+
+	return kubernetesClusterPowerStateRunning, nil
+}
+
 func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClusterAccessProfile) (*string, []interface{}) {
 	if accessProfile := profile.AccessProfile; accessProfile != nil {
 		if kubeConfigRaw := accessProfile.KubeConfig; kubeConfigRaw != nil {
@@ -793,6 +938,31 @@ func expandKubernetesClusterAddonProfiles(d *schema.ResourceData) map[string]*co
 		}
 	}
 
+	azurePolicy := profile["azure_policy"].([]interface{})
+	if len(azurePolicy) > 0 {
+		value := azurePolicy[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		addonProfiles["azurepolicy"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+		}
+	}
+
+	secretsProvider := profile["azure_keyvault_secrets_provider"].([]interface{})
+	if len(secretsProvider) > 0 {
+		value := secretsProvider[0].(map[string]interface{})
+		config := make(map[string]*string)
+		enabled := value["enabled"].(bool)
+
+		config["enableSecretRotation"] = utils.String(strconv.FormatBool(value["secret_rotation_enabled"].(bool)))
+		config["rotationPollInterval"] = utils.String(value["secret_rotation_interval"].(string))
+
+		addonProfiles["azureKeyvaultSecretsProvider"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  config,
+		}
+	}
+
 	return addonProfiles
 }
 
@@ -859,6 +1029,46 @@ func flattenKubernetesClusterAddonProfiles(profile map[string]*containerservice.
 	}
 	values["aci_connector_linux"] = aciConnectors
 
+	policies := make([]interface{}, 0)
+	if azurePolicy := profile["azurepolicy"]; azurePolicy != nil {
+		enabled := false
+		if enabledVal := azurePolicy.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		policies = append(policies, output)
+	}
+	values["azure_policy"] = policies
+
+	secretsProviders := make([]interface{}, 0)
+	if secretsProvider := profile["azureKeyvaultSecretsProvider"]; secretsProvider != nil {
+		enabled := false
+		if enabledVal := secretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		rotationEnabled := false
+		if v := secretsProvider.Config["enableSecretRotation"]; v != nil {
+			rotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		rotationInterval := ""
+		if v := secretsProvider.Config["rotationPollInterval"]; v != nil {
+			rotationInterval = *v
+		}
+
+		output := map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  rotationEnabled,
+			"secret_rotation_interval": rotationInterval,
+		}
+		secretsProviders = append(secretsProviders, output)
+	}
+	values["azure_keyvault_secrets_provider"] = secretsProviders
+
 	return []interface{}{values}
 }
 
@@ -1151,10 +1361,10 @@ func flattenKubernetesClusterRoleBasedAccessControl(input *containerservice.Mana
 	}
 }
 
-func expandAzureRmKubernetesClusterServicePrincipal(d *schema.ResourceData) *containerservice.ManagedClusterServicePrincipalProfile {
+func expandAzureRmKubernetesClusterServicePrincipal(ctx context.Context, d *schema.ResourceData, meta interface{}) (*containerservice.ManagedClusterServicePrincipalProfile, error) {
 	value, exists := d.GetOk("service_principal")
 	if !exists {
-		return nil
+		return nil, nil
 	}
 
 	configs := value.(*schema.Set).List()
@@ -1163,13 +1373,30 @@ func expandAzureRmKubernetesClusterServicePrincipal(d *schema.ResourceData) *con
 
 	clientId := config["client_id"].(string)
 	clientSecret := config["client_secret"].(string)
+	clientSecretKeyVaultSecretId := config["client_secret_key_vault_secret_id"].(string)
+
+	if clientSecret != "" && clientSecretKeyVaultSecretId != "" {
+		return nil, fmt.Errorf("only one of `client_secret` and `client_secret_key_vault_secret_id` can be specified within the `service_principal` block")
+	}
+
+	if clientSecretKeyVaultSecretId != "" {
+		secret, err := getKeyVaultSecretValue(ctx, meta.(*ArmClient).keyVaultManagementClient, clientSecretKeyVaultSecretId)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving the Client Secret for the Service Principal: %+v", err)
+		}
+		clientSecret = secret
+	}
+
+	if clientSecret == "" {
+		return nil, fmt.Errorf("either `client_secret` or `client_secret_key_vault_secret_id` must be specified within the `service_principal` block")
+	}
 
 	principal := containerservice.ManagedClusterServicePrincipalProfile{
 		ClientID: &clientId,
 		Secret:   &clientSecret,
 	}
 
-	return &principal
+	return &principal, nil
 }
 
 func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) *schema.Set {
@@ -0,0 +1,636 @@
+package azurerm
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Managed HSM isn't present in the vendored SDK, so is managed using raw requests
+const managedHsmApiVersion = "2021-10-01"
+
+// the Managed HSM Data Plane isn't available in the API Version used by the vendored Key Vault SDK, so is also managed
+// using raw requests - which are authorized using the Key Vault Data Plane Client, since both use the same challenge
+const managedHsmDataPlaneApiVersion = "7.4"
+
+type managedHsm struct {
+	ID         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Location   *string               `json:"location,omitempty"`
+	Tags       map[string]*string    `json:"tags"`
+	Sku        *managedHsmSku        `json:"sku,omitempty"`
+	Properties *managedHsmProperties `json:"properties,omitempty"`
+}
+
+type managedHsmSku struct {
+	Family *string `json:"family,omitempty"`
+	Name   *string `json:"name,omitempty"`
+}
+
+type managedHsmProperties struct {
+	TenantID                  *string                             `json:"tenantId,omitempty"`
+	InitialAdminObjectIds     *[]string                           `json:"initialAdminObjectIds,omitempty"`
+	HsmURI                    *string                             `json:"hsmUri,omitempty"`
+	EnableSoftDelete          *bool                               `json:"enableSoftDelete,omitempty"`
+	SoftDeleteRetentionInDays *int32                              `json:"softDeleteRetentionInDays,omitempty"`
+	EnablePurgeProtection     *bool                               `json:"enablePurgeProtection,omitempty"`
+	NetworkAcls               *managedHsmNetworkRuleSet           `json:"networkAcls,omitempty"`
+	PublicNetworkAccess       *string                             `json:"publicNetworkAccess,omitempty"`
+	SecurityDomainProperties  *managedHsmSecurityDomainProperties `json:"securityDomainProperties,omitempty"`
+}
+
+type managedHsmNetworkRuleSet struct {
+	Bypass        *string `json:"bypass,omitempty"`
+	DefaultAction *string `json:"defaultAction,omitempty"`
+}
+
+type managedHsmSecurityDomainProperties struct {
+	ActivationStatus        *string `json:"activationStatus,omitempty"`
+	ActivationStatusMessage *string `json:"activationStatusMessage,omitempty"`
+}
+
+type managedHsmSecurityDomainCertificates struct {
+	Certificates []managedHsmSecurityDomainJsonWebKey `json:"certificates"`
+	Required     int                                  `json:"required"`
+}
+
+type managedHsmSecurityDomainJsonWebKey struct {
+	Kty     string   `json:"kty"`
+	KeyOps  []string `json:"key_ops"`
+	Alg     string   `json:"alg"`
+	N       string   `json:"n"`
+	E       string   `json:"e"`
+	X5c     []string `json:"x5c"`
+	X5tS256 string   `json:"x5t#S256"`
+}
+
+type managedHsmSecurityDomainObject struct {
+	Value *string `json:"value,omitempty"`
+}
+
+type managedHsmSecurityDomainOperationStatus struct {
+	Status        *string `json:"status,omitempty"`
+	StatusDetails *string `json:"status_details,omitempty"`
+}
+
+func resourceArmManagedHsm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedHsmCreateUpdate,
+		Read:   resourceArmManagedHsmRead,
+		Update: resourceArmManagedHsmCreateUpdate,
+		Delete: resourceArmManagedHsmDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedHsmName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard_B1",
+					"Custom_B32",
+				}, false),
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"admin_object_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"soft_delete_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      90,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"network_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Deny",
+							}, false),
+						},
+						"bypass": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AzureServices",
+								"None",
+							}, false),
+						},
+					},
+				},
+			},
+
+			// the Managed HSM is activated by downloading the Security Domain, encrypted using these Certificates
+			"security_domain_key_vault_certificate_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 3,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateKeyVaultChildId,
+				},
+			},
+
+			// the number of Certificates required to decrypt the Security Domain
+			"security_domain_quorum": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(2, 10),
+			},
+
+			"hsm_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"security_domain_activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// the Security Domain can only be downloaded once, so this isn't available after an import
+			"security_domain_encrypted_data": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("security_domain_key_vault_certificate_ids") || !diff.NewValueKnown("security_domain_quorum") {
+				return nil
+			}
+
+			certificateIds := diff.Get("security_domain_key_vault_certificate_ids").([]interface{})
+			quorum := diff.Get("security_domain_quorum").(int)
+
+			if len(certificateIds) == 0 && quorum == 0 {
+				return nil
+			}
+
+			if len(certificateIds) == 0 || quorum == 0 {
+				return fmt.Errorf("`security_domain_key_vault_certificate_ids` and `security_domain_quorum` must be specified together")
+			}
+
+			if quorum > len(certificateIds) {
+				return fmt.Errorf("`security_domain_quorum` (%d) cannot be greater than the number of `security_domain_key_vault_certificate_ids` (%d)", quorum, len(certificateIds))
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmManagedHsmCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := managedHsmID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing managedHsm
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, managedHsmApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_managed_hsm", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	publicNetworkAccess := "Disabled"
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Enabled"
+	}
+
+	parameters := managedHsm{
+		Location: utils.String(location),
+		Sku: &managedHsmSku{
+			Family: utils.String("B"),
+			Name:   utils.String(d.Get("sku_name").(string)),
+		},
+		Properties: &managedHsmProperties{
+			TenantID:                  utils.String(d.Get("tenant_id").(string)),
+			InitialAdminObjectIds:     utils.ExpandStringArray(d.Get("admin_object_ids").(*schema.Set).List()),
+			EnableSoftDelete:          utils.Bool(true),
+			SoftDeleteRetentionInDays: utils.Int32(int32(d.Get("soft_delete_retention_days").(int))),
+			EnablePurgeProtection:     utils.Bool(d.Get("purge_protection_enabled").(bool)),
+			NetworkAcls:               expandArmManagedHsmNetworkAcls(d.Get("network_acls").([]interface{})),
+			PublicNetworkAccess:       utils.String(publicNetworkAccess),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, managedHsmApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	if d.IsNewResource() || d.HasChange("security_domain_key_vault_certificate_ids") || d.HasChange("security_domain_quorum") {
+		if err := activateArmManagedHsmSecurityDomain(d, meta, id); err != nil {
+			// clear the certificates so that the activation is retried during the next apply
+			d.Set("security_domain_key_vault_certificate_ids", []interface{}{})
+			return err
+		}
+	}
+
+	return resourceArmManagedHsmRead(d, meta)
+}
+
+func resourceArmManagedHsmRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedHSMs"]
+
+	var resp managedHsm
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), managedHsmApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Managed HSM %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("tenant_id", props.TenantID)
+		d.Set("hsm_uri", props.HsmURI)
+
+		if err := d.Set("admin_object_ids", schema.NewSet(schema.HashString, utils.FlattenStringArray(props.InitialAdminObjectIds))); err != nil {
+			return fmt.Errorf("Error setting `admin_object_ids`: %+v", err)
+		}
+
+		purgeProtectionEnabled := false
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
+		if props.SoftDeleteRetentionInDays != nil {
+			d.Set("soft_delete_retention_days", int(*props.SoftDeleteRetentionInDays))
+		}
+
+		publicNetworkAccessEnabled := true
+		if props.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*props.PublicNetworkAccess, "Enabled")
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		if err := d.Set("network_acls", flattenArmManagedHsmNetworkAcls(props.NetworkAcls)); err != nil {
+			return fmt.Errorf("Error setting `network_acls`: %+v", err)
+		}
+
+		activationStatus := ""
+		if sd := props.SecurityDomainProperties; sd != nil && sd.ActivationStatus != nil {
+			activationStatus = *sd.ActivationStatus
+		}
+		d.Set("security_domain_activation_status", activationStatus)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmManagedHsmDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedHSMs"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), managedHsmApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// Managed HSM's are always Soft Deleted, which reserves the name (and the HSM pool) until they're purged - which
+	// isn't possible when Purge Protection is enabled
+	if d.Get("purge_protection_enabled").(bool) {
+		log.Printf("[DEBUG] Purge Protection is enabled for Managed HSM %q (Resource Group %q) - not purging", name, resourceGroup)
+		return nil
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	purgePath := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.KeyVault/locations/%s/deletedManagedHSMs/%s/purge", id.SubscriptionID, location, name)
+	if err := armRawPost(ctx, client.Client, client.BaseURI, purgePath, managedHsmApiVersion); err != nil {
+		return fmt.Errorf("Error purging Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// activateArmManagedHsmSecurityDomain downloads the Security Domain (which activates the Managed HSM) when the
+// certificates are configured and the Managed HSM hasn't already been activated
+func activateArmManagedHsmSecurityDomain(d *schema.ResourceData, meta interface{}, id string) error {
+	client := meta.(*ArmClient).resourcesClient
+	dataPlaneClient := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{})
+	if len(certificateIds) == 0 {
+		return nil
+	}
+
+	var hsm managedHsm
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, managedHsmApiVersion, &hsm); err != nil {
+		return fmt.Errorf("Error retrieving Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if hsm.Properties == nil || hsm.Properties.HsmURI == nil {
+		return fmt.Errorf("Error retrieving Managed HSM %q (Resource Group %q): `hsmUri` was nil", name, resourceGroup)
+	}
+	hsmUri := *hsm.Properties.HsmURI
+
+	if sd := hsm.Properties.SecurityDomainProperties; sd != nil && sd.ActivationStatus != nil && strings.EqualFold(*sd.ActivationStatus, "Active") {
+		log.Printf("[DEBUG] The Security Domain for Managed HSM %q (Resource Group %q) has already been downloaded - skipping", name, resourceGroup)
+		return nil
+	}
+
+	parameters := managedHsmSecurityDomainCertificates{
+		Certificates: make([]managedHsmSecurityDomainJsonWebKey, 0),
+		Required:     d.Get("security_domain_quorum").(int),
+	}
+	for _, v := range certificateIds {
+		certificateId, err := azure.ParseKeyVaultChildID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		certificate, err := dataPlaneClient.GetCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, certificateId.Version)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+		}
+		if certificate.Cer == nil {
+			return fmt.Errorf("Error retrieving Certificate %q (Key Vault %q): `cer` was nil", certificateId.Name, certificateId.KeyVaultBaseUrl)
+		}
+
+		key, err := managedHsmSecurityDomainJsonWebKeyFromCertificate(*certificate.Cer)
+		if err != nil {
+			return fmt.Errorf("Error building the Security Domain key for Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+		}
+		parameters.Certificates = append(parameters.Certificates, *key)
+	}
+
+	log.Printf("[DEBUG] Downloading the Security Domain for Managed HSM %q (Resource Group %q)", name, resourceGroup)
+	encryptedData, err := managedHsmDownloadSecurityDomain(dataPlaneClient.Client, meta, hsmUri, parameters)
+	if err != nil {
+		return fmt.Errorf("Error downloading the Security Domain for Managed HSM %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("security_domain_encrypted_data", encryptedData)
+
+	return nil
+}
+
+func managedHsmDownloadSecurityDomain(client autorest.Client, meta interface{}, hsmUri string, parameters managedHsmSecurityDomainCertificates) (string, error) {
+	ctx := meta.(*ArmClient).StopContext
+	path := "/securitydomain/download"
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(hsmUri),
+		autorest.WithPath(path),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedHsmDataPlaneApiVersion,
+		}))
+	if err != nil {
+		return "", fmt.Errorf("Error preparing request for %q: %+v", path, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+	if err != nil {
+		return "", fmt.Errorf("Error sending request for %q: %+v", path, err)
+	}
+
+	// the encrypted Security Domain is returned in the initial response, whilst the activation continues asynchronously
+	var result managedHsmSecurityDomainObject
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return "", fmt.Errorf("Error invoking %q: %+v", path, err)
+	}
+
+	if result.Value == nil || *result.Value == "" {
+		return "", fmt.Errorf("the Security Domain was not returned")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Success"},
+		Refresh:    managedHsmSecurityDomainDownloadRefreshFunc(client, meta, hsmUri),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return "", fmt.Errorf("Error waiting for the Security Domain download to complete: %+v", err)
+	}
+
+	return *result.Value, nil
+}
+
+func managedHsmSecurityDomainDownloadRefreshFunc(client autorest.Client, meta interface{}, hsmUri string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ctx := meta.(*ArmClient).StopContext
+
+		var status managedHsmSecurityDomainOperationStatus
+		if _, err := armRawGet(ctx, client, hsmUri, "/securitydomain/download/pending", managedHsmDataPlaneApiVersion, &status); err != nil {
+			return nil, "", fmt.Errorf("Error polling the Security Domain download status: %+v", err)
+		}
+
+		if status.Status == nil {
+			return nil, "", fmt.Errorf("Error polling the Security Domain download status: `status` was nil")
+		}
+
+		if strings.EqualFold(*status.Status, "Failed") {
+			details := ""
+			if status.StatusDetails != nil {
+				details = *status.StatusDetails
+			}
+			return nil, "", fmt.Errorf("the Security Domain download failed: %s", details)
+		}
+
+		return status, *status.Status, nil
+	}
+}
+
+// managedHsmSecurityDomainJsonWebKeyFromCertificate builds the JSON Web Key used to encrypt the Security Domain from
+// the DER-encoded contents of an RSA Certificate
+func managedHsmSecurityDomainJsonWebKeyFromCertificate(input []byte) (*managedHsmSecurityDomainJsonWebKey, error) {
+	certificate, err := x509.ParseCertificate(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Certificate: %+v", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("the Certificate must use an RSA Key")
+	}
+
+	thumbprint := sha256.Sum256(input)
+
+	return &managedHsmSecurityDomainJsonWebKey{
+		Kty:     "RSA",
+		KeyOps:  []string{"verify", "encrypt", "wrapKey"},
+		Alg:     "RSA-OAEP-256",
+		N:       base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		X5c:     []string{base64.StdEncoding.EncodeToString(input)},
+		X5tS256: base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	}, nil
+}
+
+func managedHsmID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/managedHSMs/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmManagedHsmNetworkAcls(input []interface{}) *managedHsmNetworkRuleSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &managedHsmNetworkRuleSet{
+		Bypass:        utils.String(v["bypass"].(string)),
+		DefaultAction: utils.String(v["default_action"].(string)),
+	}
+}
+
+func flattenArmManagedHsmNetworkAcls(input *managedHsmNetworkRuleSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	bypass := ""
+	if input.Bypass != nil {
+		bypass = *input.Bypass
+	}
+
+	defaultAction := ""
+	if input.DefaultAction != nil {
+		defaultAction = *input.DefaultAction
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"bypass":         bypass,
+			"default_action": defaultAction,
+		},
+	}
+}
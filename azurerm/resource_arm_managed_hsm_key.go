@@ -0,0 +1,322 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagedHsmKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedHsmKeyCreate,
+		Read:   resourceArmManagedHsmKeyRead,
+		Update: resourceArmManagedHsmKeyUpdate,
+		Delete: resourceArmManagedHsmKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildName,
+			},
+
+			"hsm_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"key_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// Keys within a Managed HSM are always HSM-protected
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.ECHSM),
+					string(keyvault.RSAHSM),
+					"oct-HSM",
+				}, false),
+			},
+
+			// required for `RSA-HSM` and `oct-HSM` keys
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IntInSlice([]int{128, 192, 256, 2048, 3072, 4096}),
+			},
+
+			// required for `EC-HSM` keys
+			"curve": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.P256),
+					"P-256K",
+					string(keyvault.P384),
+					string(keyvault.P521),
+				}, false),
+			},
+
+			"key_opts": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(keyvault.Decrypt),
+						string(keyvault.Encrypt),
+						string(keyvault.Sign),
+						string(keyvault.UnwrapKey),
+						string(keyvault.Verify),
+						string(keyvault.WrapKey),
+					}, false),
+				},
+			},
+
+			// Computed
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"n": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"x": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"y": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			keyType := diff.Get("key_type").(string)
+			keySize := diff.Get("key_size").(int)
+			curve := diff.Get("curve").(string)
+
+			switch keyType {
+			case string(keyvault.ECHSM):
+				if curve == "" {
+					return fmt.Errorf("`curve` must be specified when `key_type` is %q", keyType)
+				}
+				if keySize != 0 {
+					return fmt.Errorf("`key_size` cannot be specified when `key_type` is %q", keyType)
+				}
+			case string(keyvault.RSAHSM):
+				if keySize < 2048 {
+					return fmt.Errorf("`key_size` must be one of 2048, 3072 or 4096 when `key_type` is %q", keyType)
+				}
+			case "oct-HSM":
+				if keySize == 0 || keySize > 256 {
+					return fmt.Errorf("`key_size` must be one of 128, 192 or 256 when `key_type` is %q", keyType)
+				}
+			}
+
+			if keyType != string(keyvault.ECHSM) && curve != "" {
+				return fmt.Errorf("`curve` can only be specified when `key_type` is %q", string(keyvault.ECHSM))
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmManagedHsmKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM Managed HSM Key creation.")
+	name := d.Get("name").(string)
+	hsmUri := d.Get("hsm_uri").(string)
+
+	if requireResourcesToBeImported {
+		var existing keyvault.KeyBundle
+		resp, err := armRawGet(ctx, client.Client, hsmUri, managedHsmKeyPath(name, ""), managedHsmDataPlaneApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Key %q (Managed HSM %q): %+v", name, hsmUri, err)
+			}
+		}
+
+		if existing.Key != nil && existing.Key.Kid != nil && *existing.Key.Kid != "" {
+			return tf.ImportAsExistsError("azurerm_managed_hsm_key", *existing.Key.Kid)
+		}
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := keyvault.KeyCreateParameters{
+		Kty:    keyvault.JSONWebKeyType(d.Get("key_type").(string)),
+		KeyOps: expandKeyVaultKeyOptions(d),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("key_size"); ok {
+		parameters.KeySize = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("curve"); ok {
+		parameters.Curve = keyvault.JSONWebKeyCurveName(v.(string))
+	}
+
+	var key keyvault.KeyBundle
+	if err := armRawPostWithResult(ctx, client.Client, hsmUri, fmt.Sprintf("%s/create", managedHsmKeyPath(name, "")), managedHsmDataPlaneApiVersion, parameters, &key); err != nil {
+		return fmt.Errorf("Error creating Key %q (Managed HSM %q): %+v", name, hsmUri, err)
+	}
+
+	if key.Key == nil || key.Key.Kid == nil {
+		return fmt.Errorf("Cannot read ID of Key %q (Managed HSM %q)", name, hsmUri)
+	}
+
+	d.SetId(*key.Key.Kid)
+
+	return resourceArmManagedHsmKeyRead(d, meta)
+}
+
+func resourceArmManagedHsmKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM Managed HSM Key update.")
+	id, err := azure.ParseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := keyvault.KeyUpdateParameters{
+		KeyOps: expandKeyVaultKeyOptions(d),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPatch(ctx, client.Client, id.KeyVaultBaseUrl, managedHsmKeyPath(id.Name, id.Version), managedHsmDataPlaneApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error updating Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceArmManagedHsmKeyRead(d, meta)
+}
+
+func resourceArmManagedHsmKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := azure.ParseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var resp keyvault.KeyBundle
+	httpResp, err := armRawGet(ctx, client.Client, id.KeyVaultBaseUrl, managedHsmKeyPath(id.Name, ""), managedHsmDataPlaneApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[DEBUG] Key %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("hsm_uri", id.KeyVaultBaseUrl)
+	if key := resp.Key; key != nil {
+		d.Set("key_type", string(key.Kty))
+		d.Set("curve", string(key.Crv))
+
+		// the Key Size isn't returned for EC Keys, which instead use the Curve
+		if key.N != nil && *key.N != "" {
+			modulus, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*key.N, "="))
+			if err != nil {
+				return fmt.Errorf("Error decoding the modulus of Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+			d.Set("key_size", len(modulus)*8)
+		}
+
+		options := make([]interface{}, 0)
+		if key.KeyOps != nil {
+			options = flattenKeyVaultKeyOptions(key.KeyOps)
+		}
+		if err := d.Set("key_opts", options); err != nil {
+			return fmt.Errorf("Error setting `key_opts`: %+v", err)
+		}
+
+		d.Set("n", key.N)
+		d.Set("e", key.E)
+		d.Set("x", key.X)
+		d.Set("y", key.Y)
+	}
+
+	// Computed
+	d.Set("version", id.Version)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmManagedHsmKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := azure.ParseKeyVaultChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, id.KeyVaultBaseUrl, managedHsmKeyPath(id.Name, ""), managedHsmDataPlaneApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func managedHsmKeyPath(name, version string) string {
+	if version == "" {
+		return fmt.Sprintf("/keys/%s", name)
+	}
+
+	return fmt.Sprintf("/keys/%s/%s", name, version)
+}
@@ -0,0 +1,296 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMManagedHsmKey_basicRSA(t *testing.T) {
+	resourceName := "azurerm_managed_hsm_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmKey_basicRSA(ri, rs, id, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "2048"),
+					resource.TestCheckResourceAttrSet(resourceName, "n"),
+					resource.TestCheckResourceAttrSet(resourceName, "e"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsmKey_basicEC(t *testing.T) {
+	resourceName := "azurerm_managed_hsm_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmKey_basicEC(ri, rs, id, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "curve", "P-256"),
+					resource.TestCheckResourceAttrSet(resourceName, "x"),
+					resource.TestCheckResourceAttrSet(resourceName, "y"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsmKey_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_managed_hsm_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmKey_basicRSA(ri, rs, id, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmKeyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedHsmKey_requiresImport(ri, rs, id, location),
+				ExpectError: testRequiresImportError("azurerm_managed_hsm_key"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsmKey_update(t *testing.T) {
+	resourceName := "azurerm_managed_hsm_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmKey_basicRSA(ri, rs, id, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_opts.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMManagedHsmKey_updated(ri, rs, id, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_opts.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagedHsmKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseKeyVaultChildID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp keyvault.KeyBundle
+		httpResp, err := armRawGet(ctx, client.Client, id.KeyVaultBaseUrl, managedHsmKeyPath(id.Name, ""), managedHsmDataPlaneApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Key %q (Managed HSM %q) does not exist", id.Name, id.KeyVaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedHsmKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_hsm_key" {
+			continue
+		}
+
+		id, err := azure.ParseKeyVaultChildID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var resp keyvault.KeyBundle
+		httpResp, err := armRawGet(ctx, client.Client, id.KeyVaultBaseUrl, managedHsmKeyPath(id.Name, ""), managedHsmDataPlaneApiVersion, &resp)
+		if err != nil {
+			// the Managed HSM itself is deleted at the end of the test, at which point it's no longer reachable
+			if httpResp == nil || utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Managed HSM Key still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagedHsmKey_basicRSA(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsmRoleAssignment_basic(rInt, rString, id, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_key" "test" {
+  name     = "acctestkey%s"
+  hsm_uri  = "${azurerm_managed_hsm.test.hsm_uri}"
+  key_type = "RSA-HSM"
+  key_size = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+
+  depends_on = ["azurerm_managed_hsm_role_assignment.test"]
+}
+`, template, rString)
+}
+
+func testAccAzureRMManagedHsmKey_basicEC(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsmRoleAssignment_basic(rInt, rString, id, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_key" "test" {
+  name     = "acctestkey%s"
+  hsm_uri  = "${azurerm_managed_hsm.test.hsm_uri}"
+  key_type = "EC-HSM"
+  curve    = "P-256"
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+
+  depends_on = ["azurerm_managed_hsm_role_assignment.test"]
+}
+`, template, rString)
+}
+
+func testAccAzureRMManagedHsmKey_requiresImport(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsmKey_basicRSA(rInt, rString, id, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_key" "import" {
+  name     = "${azurerm_managed_hsm_key.test.name}"
+  hsm_uri  = "${azurerm_managed_hsm_key.test.hsm_uri}"
+  key_type = "${azurerm_managed_hsm_key.test.key_type}"
+  key_size = "${azurerm_managed_hsm_key.test.key_size}"
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMManagedHsmKey_updated(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsmRoleAssignment_basic(rInt, rString, id, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_key" "test" {
+  name     = "acctestkey%s"
+  hsm_uri  = "${azurerm_managed_hsm.test.hsm_uri}"
+  key_type = "RSA-HSM"
+  key_size = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "unwrapKey",
+    "wrapKey",
+  ]
+
+  tags {
+    environment = "Production"
+  }
+
+  depends_on = ["azurerm_managed_hsm_role_assignment.test"]
+}
+`, template, rString)
+}
@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type managedHsmRoleAssignment struct {
+	ID         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *managedHsmRoleAssignmentProperties `json:"properties,omitempty"`
+}
+
+type managedHsmRoleAssignmentProperties struct {
+	Scope            *string `json:"scope,omitempty"`
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	PrincipalID      *string `json:"principalId,omitempty"`
+}
+
+type managedHsmRoleAssignmentID struct {
+	HsmUri string
+	Scope  string
+	Name   string
+}
+
+func resourceArmManagedHsmRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagedHsmRoleAssignmentCreate,
+		Read:   resourceArmManagedHsmRoleAssignmentRead,
+		Delete: resourceArmManagedHsmRoleAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"hsm_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			// either `/` for all Keys within the Managed HSM, or `/keys` / `/keys/{name}`
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`scope` must start with a `/`"),
+			},
+
+			// e.g. `/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/{id}`
+			"role_definition_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmManagedHsmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	hsmUri := d.Get("hsm_uri").(string)
+	scope := d.Get("scope").(string)
+	path := managedHsmRoleAssignmentPath(scope, name)
+
+	if requireResourcesToBeImported {
+		var existing managedHsmRoleAssignment
+		resp, err := armRawGet(ctx, client.Client, hsmUri, path, managedHsmDataPlaneApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Role Assignment %q (Scope %q / Managed HSM %q): %+v", name, scope, hsmUri, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_managed_hsm_role_assignment", managedHsmRoleAssignmentResourceID(hsmUri, scope, name))
+		}
+	}
+
+	parameters := managedHsmRoleAssignment{
+		Properties: &managedHsmRoleAssignmentProperties{
+			RoleDefinitionID: utils.String(d.Get("role_definition_id").(string)),
+			PrincipalID:      utils.String(d.Get("principal_id").(string)),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, hsmUri, path, managedHsmDataPlaneApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating Role Assignment %q (Scope %q / Managed HSM %q): %+v", name, scope, hsmUri, err)
+	}
+
+	d.SetId(managedHsmRoleAssignmentResourceID(hsmUri, scope, name))
+
+	return resourceArmManagedHsmRoleAssignmentRead(d, meta)
+}
+
+func resourceArmManagedHsmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseManagedHsmRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var resp managedHsmRoleAssignment
+	httpResp, err := armRawGet(ctx, client.Client, id.HsmUri, managedHsmRoleAssignmentPath(id.Scope, id.Name), managedHsmDataPlaneApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[DEBUG] Role Assignment %q (Scope %q) was not found in Managed HSM at URI %q - removing from state", id.Name, id.Scope, id.HsmUri)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Role Assignment %q (Scope %q / Managed HSM %q): %+v", id.Name, id.Scope, id.HsmUri, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("hsm_uri", id.HsmUri)
+	d.Set("scope", id.Scope)
+
+	if props := resp.Properties; props != nil {
+		d.Set("role_definition_id", props.RoleDefinitionID)
+		d.Set("principal_id", props.PrincipalID)
+	}
+
+	return nil
+}
+
+func resourceArmManagedHsmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseManagedHsmRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, id.HsmUri, managedHsmRoleAssignmentPath(id.Scope, id.Name), managedHsmDataPlaneApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Role Assignment %q (Scope %q / Managed HSM %q): %+v", id.Name, id.Scope, id.HsmUri, err)
+	}
+
+	return nil
+}
+
+// managedHsmRoleAssignmentPath returns the path of the Role Assignment within the Managed HSM - where the scope `/`
+// results in the path `//providers/...`, matching the behaviour of the Key Vault SDK
+func managedHsmRoleAssignmentPath(scope, name string) string {
+	return fmt.Sprintf("%s/providers/Microsoft.Authorization/roleAssignments/%s", scope, name)
+}
+
+func managedHsmRoleAssignmentResourceID(hsmUri, scope, name string) string {
+	return fmt.Sprintf("%s%s", strings.TrimSuffix(hsmUri, "/"), managedHsmRoleAssignmentPath(scope, name))
+}
+
+func parseManagedHsmRoleAssignmentID(input string) (*managedHsmRoleAssignmentID, error) {
+	// example: https://example.managedhsm.azure.net//providers/Microsoft.Authorization/roleAssignments/{name}
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Managed HSM Role Assignment ID: %s", err)
+	}
+
+	segments := strings.Split(idURL.Path, "/providers/Microsoft.Authorization/roleAssignments/")
+	if len(segments) != 2 || segments[1] == "" || strings.Contains(segments[1], "/") {
+		return nil, fmt.Errorf("Managed HSM Role Assignment ID should be in the format `{hsmUri}{scope}/providers/Microsoft.Authorization/roleAssignments/{name}`, got %q", input)
+	}
+
+	scope := segments[0]
+	if scope == "" {
+		scope = "/"
+	}
+
+	return &managedHsmRoleAssignmentID{
+		HsmUri: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Scope:  scope,
+		Name:   segments[1],
+	}, nil
+}
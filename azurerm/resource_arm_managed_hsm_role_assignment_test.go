@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseManagedHsmRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *managedHsmRoleAssignmentID
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "https://example.managedhsm.azure.net/",
+			Expected: nil,
+		},
+		{
+			Input:    "https://example.managedhsm.azure.net//providers/Microsoft.Authorization/roleAssignments/",
+			Expected: nil,
+		},
+		{
+			Input: "https://example.managedhsm.azure.net//providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &managedHsmRoleAssignmentID{
+				HsmUri: "https://example.managedhsm.azure.net/",
+				Scope:  "/",
+				Name:   "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input: "https://example.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &managedHsmRoleAssignmentID{
+				HsmUri: "https://example.managedhsm.azure.net/",
+				Scope:  "/keys",
+				Name:   "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input: "https://example.managedhsm.azure.net/keys/example/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &managedHsmRoleAssignmentID{
+				HsmUri: "https://example.managedhsm.azure.net/",
+				Scope:  "/keys/example",
+				Name:   "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseManagedHsmRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+
+		// the ID should round-trip
+		if id := managedHsmRoleAssignmentResourceID(actual.HsmUri, actual.Scope, actual.Name); id != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, id)
+		}
+	}
+}
+
+func TestAccAzureRMManagedHsmRoleAssignment_basic(t *testing.T) {
+	resourceName := "azurerm_managed_hsm_role_assignment.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmRoleAssignment_basic(ri, rs, id, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmRoleAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scope", "/keys"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsmRoleAssignment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_managed_hsm_role_assignment.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsmRoleAssignment_basic(ri, rs, id, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmRoleAssignmentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedHsmRoleAssignment_requiresImport(ri, rs, id, location),
+				ExpectError: testRequiresImportError("azurerm_managed_hsm_role_assignment"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagedHsmRoleAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseManagedHsmRoleAssignmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp managedHsmRoleAssignment
+		httpResp, err := armRawGet(ctx, client.Client, id.HsmUri, managedHsmRoleAssignmentPath(id.Scope, id.Name), managedHsmDataPlaneApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Role Assignment %q (Scope %q / Managed HSM %q) does not exist", id.Name, id.Scope, id.HsmUri)
+			}
+
+			return fmt.Errorf("Bad: Get on Role Assignment %q (Scope %q / Managed HSM %q): %+v", id.Name, id.Scope, id.HsmUri, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedHsmRoleAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_hsm_role_assignment" {
+			continue
+		}
+
+		id, err := parseManagedHsmRoleAssignmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var resp managedHsmRoleAssignment
+		httpResp, err := armRawGet(ctx, client.Client, id.HsmUri, managedHsmRoleAssignmentPath(id.Scope, id.Name), managedHsmDataPlaneApiVersion, &resp)
+		if err != nil {
+			// the Managed HSM itself is deleted at the end of the test, at which point it's no longer reachable
+			if httpResp == nil || utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Managed HSM Role Assignment still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagedHsmRoleAssignment_basic(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsm_activated(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_role_assignment" "test" {
+  name               = "%s"
+  hsm_uri            = "${azurerm_managed_hsm.test.hsm_uri}"
+  scope              = "/keys"
+  role_definition_id = "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+  principal_id       = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+`, template, id)
+}
+
+func testAccAzureRMManagedHsmRoleAssignment_requiresImport(rInt int, rString string, id string, location string) string {
+	template := testAccAzureRMManagedHsmRoleAssignment_basic(rInt, rString, id, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm_role_assignment" "import" {
+  name               = "${azurerm_managed_hsm_role_assignment.test.name}"
+  hsm_uri            = "${azurerm_managed_hsm_role_assignment.test.hsm_uri}"
+  scope              = "${azurerm_managed_hsm_role_assignment.test.scope}"
+  role_definition_id = "${azurerm_managed_hsm_role_assignment.test.role_definition_id}"
+  principal_id       = "${azurerm_managed_hsm_role_assignment.test.principal_id}"
+}
+`, template)
+}
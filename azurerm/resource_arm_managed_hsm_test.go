@@ -0,0 +1,442 @@
+package azurerm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestManagedHsmSecurityDomainJsonWebKeyFromCertificate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating RSA Key: %+v", err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating EC Key: %+v", err)
+	}
+
+	rsaCertificate := testManagedHsmGenerateCertificate(t, &rsaKey.PublicKey, rsaKey)
+	ecCertificate := testManagedHsmGenerateCertificate(t, &ecKey.PublicKey, ecKey)
+
+	if _, err := managedHsmSecurityDomainJsonWebKeyFromCertificate([]byte("not-a-certificate")); err == nil {
+		t.Fatalf("Expected an error parsing an invalid Certificate but didn't get one")
+	}
+
+	if _, err := managedHsmSecurityDomainJsonWebKeyFromCertificate(ecCertificate); err == nil {
+		t.Fatalf("Expected an error for a Certificate using an EC Key but didn't get one")
+	}
+
+	key, err := managedHsmSecurityDomainJsonWebKeyFromCertificate(rsaCertificate)
+	if err != nil {
+		t.Fatalf("Expected no error for a Certificate using an RSA Key but got: %+v", err)
+	}
+
+	if key.Kty != "RSA" || key.Alg != "RSA-OAEP-256" {
+		t.Fatalf("Expected `kty` to be `RSA` and `alg` to be `RSA-OAEP-256` but got %q and %q", key.Kty, key.Alg)
+	}
+
+	modulus, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		t.Fatalf("Error decoding `n`: %+v", err)
+	}
+	if new(big.Int).SetBytes(modulus).Cmp(rsaKey.N) != 0 {
+		t.Fatalf("Expected `n` to match the modulus of the RSA Key")
+	}
+
+	// the public exponent is almost always 65537, which is `AQAB`
+	if key.E != "AQAB" {
+		t.Fatalf("Expected `e` to be `AQAB` but got %q", key.E)
+	}
+
+	if len(key.X5c) != 1 || key.X5c[0] != base64.StdEncoding.EncodeToString(rsaCertificate) {
+		t.Fatalf("Expected `x5c` to contain the base64-encoded Certificate but got %+v", key.X5c)
+	}
+
+	thumbprint := sha256.Sum256(rsaCertificate)
+	if key.X5tS256 != base64.RawURLEncoding.EncodeToString(thumbprint[:]) {
+		t.Fatalf("Expected `x5t#S256` to be the SHA-256 thumbprint of the Certificate but got %q", key.X5tS256)
+	}
+}
+
+func testManagedHsmGenerateCertificate(t *testing.T, publicKey interface{}, privateKey interface{}) []byte {
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: "acctest",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, &template, &template, publicKey, privateKey)
+	if err != nil {
+		t.Fatalf("Error generating Certificate: %+v", err)
+	}
+
+	return certificate
+}
+
+func TestAccAzureRMManagedHsm_basic(t *testing.T) {
+	resourceName := "azurerm_managed_hsm.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsm_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_object_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "hsm_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsm_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_managed_hsm.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsm_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedHsm_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_managed_hsm"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsm_update(t *testing.T) {
+	resourceName := "azurerm_managed_hsm.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsm_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMManagedHsm_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.0.default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.0.bypass", "AzureServices"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedHsm_activated(t *testing.T) {
+	resourceName := "azurerm_managed_hsm.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedHsmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedHsm_activated(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedHsmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_domain_activation_status", "Active"),
+					resource.TestCheckResourceAttrSet(resourceName, "security_domain_encrypted_data"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Security Domain can only be downloaded once, so isn't available after an import
+				ImportStateVerifyIgnore: []string{
+					"security_domain_encrypted_data",
+					"security_domain_key_vault_certificate_ids",
+					"security_domain_quorum",
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagedHsmExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp managedHsm
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, managedHsmApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Managed HSM %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Managed HSM %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagedHsmDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_managed_hsm" {
+			continue
+		}
+
+		var resp managedHsm
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, managedHsmApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Managed HSM still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagedHsm_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_hsm" "test" {
+  name                       = "acctesthsm%s"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  sku_name                   = "Standard_B1"
+  tenant_id                  = "${data.azurerm_client_config.current.tenant_id}"
+  admin_object_ids           = ["${data.azurerm_client_config.current.service_principal_object_id}"]
+  soft_delete_retention_days = 7
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMManagedHsm_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMManagedHsm_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_hsm" "import" {
+  name                       = "${azurerm_managed_hsm.test.name}"
+  resource_group_name        = "${azurerm_managed_hsm.test.resource_group_name}"
+  location                   = "${azurerm_managed_hsm.test.location}"
+  sku_name                   = "${azurerm_managed_hsm.test.sku_name}"
+  tenant_id                  = "${azurerm_managed_hsm.test.tenant_id}"
+  admin_object_ids           = ["${azurerm_managed_hsm.test.admin_object_ids}"]
+  soft_delete_retention_days = "${azurerm_managed_hsm.test.soft_delete_retention_days}"
+}
+`, template)
+}
+
+func testAccAzureRMManagedHsm_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_hsm" "test" {
+  name                          = "acctesthsm%s"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  sku_name                      = "Standard_B1"
+  tenant_id                     = "${data.azurerm_client_config.current.tenant_id}"
+  admin_object_ids              = ["${data.azurerm_client_config.current.service_principal_object_id}"]
+  soft_delete_retention_days    = 7
+  public_network_access_enabled = false
+
+  network_acls {
+    default_action = "Deny"
+    bypass         = "AzureServices"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMManagedHsm_activated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "create",
+      "delete",
+      "get",
+      "update",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  count     = 3
+  name      = "acctestcert%s${count.index}"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+
+resource "azurerm_managed_hsm" "test" {
+  name                       = "acctesthsm%s"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  sku_name                   = "Standard_B1"
+  tenant_id                  = "${data.azurerm_client_config.current.tenant_id}"
+  admin_object_ids           = ["${data.azurerm_client_config.current.service_principal_object_id}"]
+  soft_delete_retention_days = 7
+
+  security_domain_key_vault_certificate_ids = ["${azurerm_key_vault_certificate.test.*.id}"]
+  security_domain_quorum                    = 2
+}
+`, rInt, location, rString, rString, rString)
+}
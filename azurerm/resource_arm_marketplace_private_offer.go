@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type marketplacePrivateOffer struct {
+	ID         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *marketplacePrivateOfferProperties `json:"properties,omitempty"`
+}
+
+type marketplacePrivateOfferProperties struct {
+	UniqueOfferID             *string   `json:"uniqueOfferId,omitempty"`
+	OfferDisplayName          *string   `json:"offerDisplayName,omitempty"`
+	PublisherDisplayName      *string   `json:"publisherDisplayName,omitempty"`
+	SpecificPlanIdsLimitation *[]string `json:"specificPlanIdsLimitation,omitempty"`
+	ETag                      *string   `json:"eTag,omitempty"`
+}
+
+type marketplacePrivateOfferID struct {
+	PrivateStoreID string
+	CollectionID   string
+	OfferID        string
+}
+
+func resourceArmMarketplacePrivateOffer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMarketplacePrivateOfferCreateUpdate,
+		Read:   resourceArmMarketplacePrivateOfferRead,
+		Update: resourceArmMarketplacePrivateOfferCreateUpdate,
+		Delete: resourceArmMarketplacePrivateOfferDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(i interface{}, k string) (warnings []string, errors []error) {
+					if _, err := parseArmMarketplacePrivateStoreCollectionID(i.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+					}
+					return
+				},
+			},
+
+			// in the format `{publisherId}.{offerId}`
+			"offer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[^./]+\.[^/]+$`),
+					"`offer_id` must be in the format `{publisherId}.{offerId}`",
+				),
+			},
+
+			// when omitted every Plan within the Offer is allowed
+			"plan_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+
+			"offer_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"publisher_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMarketplacePrivateOfferCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	collectionId := d.Get("collection_id").(string)
+	offerId := d.Get("offer_id").(string)
+	id := fmt.Sprintf("%s/offers/%s", collectionId, offerId)
+
+	// the eTag of the existing Offer must be sent when it's updated
+	var existing marketplacePrivateOffer
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, &existing)
+	if err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return fmt.Errorf("Error checking for presence of existing Private Marketplace Offer %q (Collection %q): %+v", offerId, collectionId, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_marketplace_private_offer", *existing.ID)
+		}
+	}
+
+	parameters := marketplacePrivateOffer{
+		Properties: &marketplacePrivateOfferProperties{
+			SpecificPlanIdsLimitation: utils.ExpandStringArray(d.Get("plan_ids").(*schema.Set).List()),
+		},
+	}
+	if props := existing.Properties; props != nil {
+		parameters.Properties.ETag = props.ETag
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Private Marketplace Offer %q (Collection %q): %+v", offerId, collectionId, err)
+	}
+
+	var read marketplacePrivateOffer
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Private Marketplace Offer %q (Collection %q): %+v", offerId, collectionId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Marketplace Offer %q (Collection %q)", offerId, collectionId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMarketplacePrivateOfferRead(d, meta)
+}
+
+func resourceArmMarketplacePrivateOfferRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseArmMarketplacePrivateOfferID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var offer marketplacePrivateOffer
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion, &offer)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Private Marketplace Offer %q was not found in Collection %q - removing from state", id.OfferID, id.CollectionID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Marketplace Offer %q (Collection %q): %+v", id.OfferID, id.CollectionID, err)
+	}
+
+	d.Set("collection_id", marketplacePrivateStoreCollectionResourceID(id.PrivateStoreID, id.CollectionID))
+	d.Set("offer_id", id.OfferID)
+
+	if props := offer.Properties; props != nil {
+		d.Set("offer_display_name", props.OfferDisplayName)
+		d.Set("publisher_display_name", props.PublisherDisplayName)
+
+		if err := d.Set("plan_ids", schema.NewSet(schema.HashString, utils.FlattenStringArray(props.SpecificPlanIdsLimitation))); err != nil {
+			return fmt.Errorf("Error setting `plan_ids`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMarketplacePrivateOfferDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseArmMarketplacePrivateOfferID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Private Marketplace Offer %q (Collection %q): %+v", id.OfferID, id.CollectionID, err)
+	}
+
+	return nil
+}
+
+func parseArmMarketplacePrivateOfferID(input string) (*marketplacePrivateOfferID, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[6], "offers") || segments[7] == "" {
+		return nil, fmt.Errorf("Expected a Private Marketplace Offer ID in the format `/providers/Microsoft.Marketplace/privateStores/{privateStoreId}/collections/{collectionId}/offers/{offerId}` but got %q", input)
+	}
+
+	collection, err := parseArmMarketplacePrivateStoreCollectionID("/" + strings.Join(segments[0:6], "/"))
+	if err != nil {
+		return nil, fmt.Errorf("Expected a Private Marketplace Offer ID in the format `/providers/Microsoft.Marketplace/privateStores/{privateStoreId}/collections/{collectionId}/offers/{offerId}` but got %q", input)
+	}
+
+	return &marketplacePrivateOfferID{
+		PrivateStoreID: collection.PrivateStoreID,
+		CollectionID:   collection.CollectionID,
+		OfferID:        segments[7],
+	}, nil
+}
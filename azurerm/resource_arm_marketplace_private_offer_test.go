@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMarketplacePrivateOffer_basic(t *testing.T) {
+	resourceName := "azurerm_marketplace_private_offer.test"
+	privateStoreId := testAccMarketplacePrivateStoreId(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMarketplacePrivateOfferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMarketplacePrivateOffer_basic(ri, privateStoreId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateOfferExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "plan_ids.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "offer_display_name"),
+				),
+			},
+			{
+				Config: testAccAzureRMMarketplacePrivateOffer_planIds(ri, privateStoreId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateOfferExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "plan_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseArmMarketplacePrivateOfferID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *marketplacePrivateOfferID
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111/collections/22222222-2222-2222-2222-222222222222",
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111/collections/22222222-2222-2222-2222-222222222222/offers/",
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111/subscriptions/22222222-2222-2222-2222-222222222222/offers/canonical.0001-com-ubuntu-server-jammy",
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111/collections/22222222-2222-2222-2222-222222222222/offers/canonical.0001-com-ubuntu-server-jammy",
+			Expected: &marketplacePrivateOfferID{
+				PrivateStoreID: "11111111-1111-1111-1111-111111111111",
+				CollectionID:   "22222222-2222-2222-2222-222222222222",
+				OfferID:        "canonical.0001-com-ubuntu-server-jammy",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseArmMarketplacePrivateOfferID(tc.Input)
+		if err != nil {
+			if tc.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected %q to parse but got: %+v", tc.Input, err)
+		}
+
+		if tc.Expected == nil {
+			t.Fatalf("Expected %q to fail parsing but got %+v", tc.Input, actual)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %q to be %+v but got %+v", tc.Input, *tc.Expected, *actual)
+		}
+	}
+}
+
+func testCheckAzureRMMarketplacePrivateOfferExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMarketplaceResourceExists(resourceName, "Private Marketplace Offer")
+}
+
+func testCheckAzureRMMarketplacePrivateOfferDestroy(s *terraform.State) error {
+	return testCheckAzureRMMarketplaceResourceDestroy(s, "azurerm_marketplace_private_offer", "Private Marketplace Offer")
+}
+
+func testAccAzureRMMarketplacePrivateOffer_basic(rInt int, privateStoreId string) string {
+	template := testAccAzureRMMarketplacePrivateStoreCollection_basic(rInt, privateStoreId)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_offer" "test" {
+  collection_id = "${azurerm_marketplace_private_store_collection.test.id}"
+  offer_id      = "canonical.0001-com-ubuntu-server-jammy"
+}
+`, template)
+}
+
+func testAccAzureRMMarketplacePrivateOffer_planIds(rInt int, privateStoreId string) string {
+	template := testAccAzureRMMarketplacePrivateStoreCollection_basic(rInt, privateStoreId)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_offer" "test" {
+  collection_id = "${azurerm_marketplace_private_store_collection.test.id}"
+  offer_id      = "canonical.0001-com-ubuntu-server-jammy"
+  plan_ids      = ["22_04-lts-gen2"]
+}
+`, template)
+}
@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Private Azure Marketplace isn't available in the vendored SDK, so it's managed using the raw API
+const marketplacePrivateStoreApiVersion = "2023-01-01"
+
+type marketplacePrivateStore struct {
+	ID         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *marketplacePrivateStoreProperties `json:"properties,omitempty"`
+}
+
+type marketplacePrivateStoreProperties struct {
+	Availability     *string `json:"availability,omitempty"`
+	PrivateStoreName *string `json:"privateStoreName,omitempty"`
+	TenantID         *string `json:"tenantId,omitempty"`
+	ETag             *string `json:"eTag,omitempty"`
+}
+
+func resourceArmMarketplacePrivateStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMarketplacePrivateStoreCreateUpdate,
+		Read:   resourceArmMarketplacePrivateStoreRead,
+		Update: resourceArmMarketplacePrivateStoreCreateUpdate,
+		Delete: resourceArmMarketplacePrivateStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMarketplacePrivateStoreCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	privateStoreId := d.Get("private_store_id").(string)
+	id := marketplacePrivateStoreID(privateStoreId)
+
+	// the eTag of the existing Private Store must be sent when it's updated
+	var existing marketplacePrivateStore
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, &existing)
+	if err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return fmt.Errorf("Error checking for presence of existing Private Marketplace Store %q: %+v", privateStoreId, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_marketplace_private_store", *existing.ID)
+		}
+	}
+
+	availability := "disabled"
+	if d.Get("enabled").(bool) {
+		availability = "enabled"
+	}
+
+	parameters := marketplacePrivateStore{
+		Properties: &marketplacePrivateStoreProperties{
+			Availability:     utils.String(availability),
+			PrivateStoreName: utils.String(d.Get("name").(string)),
+			TenantID:         utils.String(meta.(*ArmClient).tenantId),
+		},
+	}
+	if props := existing.Properties; props != nil {
+		parameters.Properties.ETag = props.ETag
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Private Marketplace Store %q: %+v", privateStoreId, err)
+	}
+
+	var read marketplacePrivateStore
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Private Marketplace Store %q: %+v", privateStoreId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Marketplace Store %q", privateStoreId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMarketplacePrivateStoreRead(d, meta)
+}
+
+func resourceArmMarketplacePrivateStoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	privateStoreId, err := parseArmMarketplacePrivateStoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var store marketplacePrivateStore
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion, &store)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Private Marketplace Store %q was not found - removing from state", privateStoreId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Marketplace Store %q: %+v", privateStoreId, err)
+	}
+
+	d.Set("private_store_id", privateStoreId)
+
+	if props := store.Properties; props != nil {
+		d.Set("name", props.PrivateStoreName)
+		d.Set("tenant_id", props.TenantID)
+
+		enabled := false
+		if props.Availability != nil {
+			enabled = strings.EqualFold(*props.Availability, "enabled")
+		}
+		d.Set("enabled", enabled)
+	}
+
+	return nil
+}
+
+func resourceArmMarketplacePrivateStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	privateStoreId, err := parseArmMarketplacePrivateStoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Private Marketplace Store %q: %+v", privateStoreId, err)
+	}
+
+	return nil
+}
+
+func marketplacePrivateStoreID(privateStoreId string) string {
+	return fmt.Sprintf("/providers/Microsoft.Marketplace/privateStores/%s", privateStoreId)
+}
+
+// parseArmMarketplacePrivateStoreID parses the ID of a Private Marketplace Store - these are scoped to the Tenant
+// rather than a Subscription, so can't be parsed using `parseAzureResourceID`
+func parseArmMarketplacePrivateStoreID(input string) (string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 4 || !strings.EqualFold(segments[0], "providers") || !strings.EqualFold(segments[1], "Microsoft.Marketplace") || !strings.EqualFold(segments[2], "privateStores") || segments[3] == "" {
+		return "", fmt.Errorf("Expected a Private Marketplace Store ID in the format `/providers/Microsoft.Marketplace/privateStores/{privateStoreId}` but got %q", input)
+	}
+
+	return segments[3], nil
+}
@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type marketplacePrivateStoreCollection struct {
+	ID         *string                                      `json:"id,omitempty"`
+	Name       *string                                      `json:"name,omitempty"`
+	Properties *marketplacePrivateStoreCollectionProperties `json:"properties,omitempty"`
+}
+
+type marketplacePrivateStoreCollectionProperties struct {
+	CollectionName    *string   `json:"collectionName,omitempty"`
+	AllSubscriptions  *bool     `json:"allSubscriptions,omitempty"`
+	SubscriptionsList *[]string `json:"subscriptionsList,omitempty"`
+	Enabled           *bool     `json:"enabled,omitempty"`
+	NumberOfOffers    *int64    `json:"numberOfOffers,omitempty"`
+}
+
+type marketplacePrivateStoreCollectionID struct {
+	PrivateStoreID string
+	CollectionID   string
+}
+
+func resourceArmMarketplacePrivateStoreCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMarketplacePrivateStoreCollectionCreateUpdate,
+		Read:   resourceArmMarketplacePrivateStoreCollectionRead,
+		Update: resourceArmMarketplacePrivateStoreCollectionCreateUpdate,
+		Delete: resourceArmMarketplacePrivateStoreCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"private_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"all_subscriptions_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"subscription_ids"},
+			},
+
+			"subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"all_subscriptions_enabled"},
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"collection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"number_of_offers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMarketplacePrivateStoreCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	privateStoreId := d.Get("private_store_id").(string)
+
+	// Collections are identified by a GUID, with the name only used for display - so there's nothing to import
+	id := d.Id()
+	if d.IsNewResource() {
+		collectionId, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("Error generating a Collection ID for Private Marketplace Store Collection %q: %+v", name, err)
+		}
+
+		id = marketplacePrivateStoreCollectionResourceID(privateStoreId, collectionId)
+	}
+
+	parameters := marketplacePrivateStoreCollection{
+		Properties: &marketplacePrivateStoreCollectionProperties{
+			CollectionName:    utils.String(name),
+			AllSubscriptions:  utils.Bool(d.Get("all_subscriptions_enabled").(bool)),
+			SubscriptionsList: utils.ExpandStringArray(d.Get("subscription_ids").(*schema.Set).List()),
+			Enabled:           utils.Bool(d.Get("enabled").(bool)),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Private Marketplace Store Collection %q (Private Store %q): %+v", name, privateStoreId, err)
+	}
+
+	var read marketplacePrivateStoreCollection
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, marketplacePrivateStoreApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Private Marketplace Store Collection %q (Private Store %q): %+v", name, privateStoreId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Marketplace Store Collection %q (Private Store %q)", name, privateStoreId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMarketplacePrivateStoreCollectionRead(d, meta)
+}
+
+func resourceArmMarketplacePrivateStoreCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseArmMarketplacePrivateStoreCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var collection marketplacePrivateStoreCollection
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion, &collection)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Private Marketplace Store Collection %q was not found in Private Store %q - removing from state", id.CollectionID, id.PrivateStoreID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Marketplace Store Collection %q (Private Store %q): %+v", id.CollectionID, id.PrivateStoreID, err)
+	}
+
+	d.Set("private_store_id", id.PrivateStoreID)
+	d.Set("collection_id", id.CollectionID)
+
+	if props := collection.Properties; props != nil {
+		d.Set("name", props.CollectionName)
+		d.Set("all_subscriptions_enabled", props.AllSubscriptions != nil && *props.AllSubscriptions)
+		d.Set("enabled", props.Enabled != nil && *props.Enabled)
+
+		numberOfOffers := 0
+		if props.NumberOfOffers != nil {
+			numberOfOffers = int(*props.NumberOfOffers)
+		}
+		d.Set("number_of_offers", numberOfOffers)
+
+		if err := d.Set("subscription_ids", schema.NewSet(schema.HashString, utils.FlattenStringArray(props.SubscriptionsList))); err != nil {
+			return fmt.Errorf("Error setting `subscription_ids`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMarketplacePrivateStoreCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseArmMarketplacePrivateStoreCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), marketplacePrivateStoreApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Private Marketplace Store Collection %q (Private Store %q): %+v", id.CollectionID, id.PrivateStoreID, err)
+	}
+
+	return nil
+}
+
+func marketplacePrivateStoreCollectionResourceID(privateStoreId, collectionId string) string {
+	return fmt.Sprintf("%s/collections/%s", marketplacePrivateStoreID(privateStoreId), collectionId)
+}
+
+func parseArmMarketplacePrivateStoreCollectionID(input string) (*marketplacePrivateStoreCollectionID, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 6 || !strings.EqualFold(segments[4], "collections") || segments[5] == "" {
+		return nil, fmt.Errorf("Expected a Private Marketplace Store Collection ID in the format `/providers/Microsoft.Marketplace/privateStores/{privateStoreId}/collections/{collectionId}` but got %q", input)
+	}
+
+	privateStoreId, err := parseArmMarketplacePrivateStoreID("/" + strings.Join(segments[0:4], "/"))
+	if err != nil {
+		return nil, fmt.Errorf("Expected a Private Marketplace Store Collection ID in the format `/providers/Microsoft.Marketplace/privateStores/{privateStoreId}/collections/{collectionId}` but got %q", input)
+	}
+
+	return &marketplacePrivateStoreCollectionID{
+		PrivateStoreID: privateStoreId,
+		CollectionID:   segments[5],
+	}, nil
+}
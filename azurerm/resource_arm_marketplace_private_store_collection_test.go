@@ -0,0 +1,83 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMarketplacePrivateStoreCollection_basic(t *testing.T) {
+	resourceName := "azurerm_marketplace_private_store_collection.test"
+	privateStoreId := testAccMarketplacePrivateStoreId(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMarketplacePrivateStoreCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMarketplacePrivateStoreCollection_basic(ri, privateStoreId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateStoreCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "all_subscriptions_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "collection_id"),
+				),
+			},
+			{
+				Config: testAccAzureRMMarketplacePrivateStoreCollection_subscriptions(ri, privateStoreId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateStoreCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "all_subscriptions_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMarketplacePrivateStoreCollectionExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMarketplaceResourceExists(resourceName, "Private Marketplace Store Collection")
+}
+
+func testCheckAzureRMMarketplacePrivateStoreCollectionDestroy(s *terraform.State) error {
+	return testCheckAzureRMMarketplaceResourceDestroy(s, "azurerm_marketplace_private_store_collection", "Private Marketplace Store Collection")
+}
+
+func testAccAzureRMMarketplacePrivateStoreCollection_basic(rInt int, privateStoreId string) string {
+	template := testAccAzureRMMarketplacePrivateStore_basic(rInt, privateStoreId, true)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name                      = "acctest-collection-%d"
+  private_store_id          = "${azurerm_marketplace_private_store.test.private_store_id}"
+  all_subscriptions_enabled = true
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMarketplacePrivateStoreCollection_subscriptions(rInt int, privateStoreId string) string {
+	template := testAccAzureRMMarketplacePrivateStore_basic(rInt, privateStoreId, true)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name             = "acctest-collection-%d"
+  private_store_id = "${azurerm_marketplace_private_store.test.private_store_id}"
+  subscription_ids = ["${data.azurerm_client_config.current.subscription_id}"]
+  enabled          = false
+}
+`, template, rInt)
+}
@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// a Tenant can only contain a single Private Marketplace Store, so these tests run against the specified Store
+// sequentially - which is deleted at the end of each test
+func testAccMarketplacePrivateStoreId(t *testing.T) string {
+	privateStoreId := os.Getenv("ARM_TEST_PRIVATE_STORE_ID")
+	if privateStoreId == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_PRIVATE_STORE_ID")
+	}
+
+	return privateStoreId
+}
+
+func TestAccAzureRMMarketplacePrivateStore_basic(t *testing.T) {
+	resourceName := "azurerm_marketplace_private_store.test"
+	privateStoreId := testAccMarketplacePrivateStoreId(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMarketplacePrivateStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMarketplacePrivateStore_basic(ri, privateStoreId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+				),
+			},
+			{
+				Config: testAccAzureRMMarketplacePrivateStore_basic(ri, privateStoreId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMarketplacePrivateStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseArmMarketplacePrivateStoreID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111/collections/22222222-2222-2222-2222-222222222222",
+			Error: true,
+		},
+		{
+			Input:    "/providers/Microsoft.Marketplace/privateStores/11111111-1111-1111-1111-111111111111",
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseArmMarketplacePrivateStoreID(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected %q to parse but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected %q to fail parsing but got %q", tc.Input, actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q to be %q but got %q", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
+func testCheckAzureRMMarketplacePrivateStoreExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMarketplaceResourceExists(resourceName, "Private Marketplace Store")
+}
+
+func testCheckAzureRMMarketplacePrivateStoreDestroy(s *terraform.State) error {
+	return testCheckAzureRMMarketplaceResourceDestroy(s, "azurerm_marketplace_private_store", "Private Marketplace Store")
+}
+
+// testCheckAzureRMMarketplaceResourceExists checks the Private Marketplace resource exists - since these share an API
+// Version and only the ID is needed, these are checked generically
+func testCheckAzureRMMarketplaceResourceExists(resourceName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing map[string]interface{}
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, marketplacePrivateStoreApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: %s %q does not exist", description, rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on %s %q: %+v", description, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMarketplaceResourceDestroy(s *terraform.State, resourceType string, description string) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var existing map[string]interface{}
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, marketplacePrivateStoreApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s %q still exists", description, rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMarketplacePrivateStore_basic(rInt int, privateStoreId string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_marketplace_private_store" "test" {
+  private_store_id = "%s"
+  name             = "acctest-store-%d"
+  enabled          = %t
+}
+`, privateStoreId, rInt, enabled)
+}
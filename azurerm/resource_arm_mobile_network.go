@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Private 5G Core (Mobile Network) isn't present in the vendored SDK, so is managed using raw requests
+const mobileNetworkApiVersion = "2022-11-01"
+
+type mobileNetwork struct {
+	ID         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Tags       map[string]*string       `json:"tags"`
+	Properties *mobileNetworkProperties `json:"properties,omitempty"`
+}
+
+type mobileNetworkProperties struct {
+	PublicLandMobileNetworkIdentifier *mobileNetworkPlmnID `json:"publicLandMobileNetworkIdentifier,omitempty"`
+	ServiceKey                        *string              `json:"serviceKey,omitempty"`
+}
+
+type mobileNetworkPlmnID struct {
+	Mcc *string `json:"mcc,omitempty"`
+	Mnc *string `json:"mnc,omitempty"`
+}
+
+// mobileNetworkSubResource is used to reference other resources, such as the Mobile Network a SIM Group belongs to
+type mobileNetworkSubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+func resourceArmMobileNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkCreateUpdate,
+		Read:   resourceArmMobileNetworkRead,
+		Update: resourceArmMobileNetworkCreateUpdate,
+		Delete: resourceArmMobileNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			// the Mobile Country Code and Mobile Network Code form the Public Land Mobile Network (PLMN) identifier
+			"mobile_country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.MobileCountryCode,
+			},
+
+			"mobile_network_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.MobileNetworkCode,
+			},
+
+			"service_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMobileNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := mobileNetworkID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetwork
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Mobile Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := mobileNetwork{
+		Location: utils.String(location),
+		Properties: &mobileNetworkProperties{
+			PublicLandMobileNetworkIdentifier: &mobileNetworkPlmnID{
+				Mcc: utils.String(d.Get("mobile_country_code").(string)),
+				Mnc: utils.String(d.Get("mobile_network_code").(string)),
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Mobile Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkRead(d, meta)
+}
+
+func resourceArmMobileNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["mobileNetworks"]
+
+	var resp mobileNetwork
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Mobile Network %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Mobile Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		if plmn := props.PublicLandMobileNetworkIdentifier; plmn != nil {
+			d.Set("mobile_country_code", plmn.Mcc)
+			d.Set("mobile_network_code", plmn.Mnc)
+		}
+
+		d.Set("service_key", props.ServiceKey)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMobileNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["mobileNetworks"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Mobile Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MobileNetwork/mobileNetworks/%s", subscriptionId, resourceGroup, name)
+}
+
+func parseMobileNetworkID(input string) (*ResourceID, string, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := id.Path["mobileNetworks"]
+	if name == "" {
+		return nil, "", fmt.Errorf("Expected %q to be the ID of a Mobile Network", input)
+	}
+
+	return id, name, nil
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type mobileNetworkDataNetwork struct {
+	ID         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Location   *string                             `json:"location,omitempty"`
+	Tags       map[string]*string                  `json:"tags"`
+	Properties *mobileNetworkDataNetworkProperties `json:"properties,omitempty"`
+}
+
+type mobileNetworkDataNetworkProperties struct {
+	Description *string `json:"description,omitempty"`
+}
+
+func resourceArmMobileNetworkDataNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkDataNetworkCreateUpdate,
+		Read:   resourceArmMobileNetworkDataNetworkRead,
+		Update: resourceArmMobileNetworkDataNetworkCreateUpdate,
+		Delete: resourceArmMobileNetworkDataNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"mobile_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMobileNetworkDataNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	mobileNetworkId := d.Get("mobile_network_id").(string)
+
+	parsed, mobileNetworkName, err := parseMobileNetworkID(mobileNetworkId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `mobile_network_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+
+	id := mobileNetworkDataNetworkID(mobileNetworkID(parsed.SubscriptionID, resourceGroup, mobileNetworkName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetworkDataNetwork
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Data Network %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network_data_network", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := mobileNetworkDataNetwork{
+		Location:   utils.String(location),
+		Properties: &mobileNetworkDataNetworkProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Data Network %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkDataNetworkRead(d, meta)
+}
+
+func resourceArmMobileNetworkDataNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	mobileNetworkName := id.Path["mobileNetworks"]
+	name := id.Path["dataNetworks"]
+
+	var resp mobileNetworkDataNetwork
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Data Network %q was not found in Mobile Network %q (Resource Group %q) - removing from state", name, mobileNetworkName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Network %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("mobile_network_id", mobileNetworkID(id.SubscriptionID, resourceGroup, mobileNetworkName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("description", props.Description)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMobileNetworkDataNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	mobileNetworkName := id.Path["mobileNetworks"]
+	name := id.Path["dataNetworks"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Data Network %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkDataNetworkID(mobileNetworkId, name string) string {
+	return fmt.Sprintf("%s/dataNetworks/%s", mobileNetworkId, name)
+}
@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMobileNetworkDataNetwork_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network_data_network.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDataNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkDataNetwork_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkDataNetworkExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkDataNetwork_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network_data_network.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDataNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkDataNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkDataNetworkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetworkDataNetwork_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mobile_network_data_network"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkDataNetwork_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network_data_network.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDataNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkDataNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkDataNetworkExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetworkDataNetwork_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkDataNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Industrial Edge"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkDataNetworkExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "Data Network")
+}
+
+func testCheckAzureRMMobileNetworkDataNetworkDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network_data_network", "Data Network")
+}
+
+func testAccAzureRMMobileNetworkDataNetwork_basic(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctestdn-%d"
+  mobile_network_id = "${azurerm_mobile_network.test.id}"
+  location          = "${azurerm_mobile_network.test.location}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMobileNetworkDataNetwork_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkDataNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_data_network" "import" {
+  name              = "${azurerm_mobile_network_data_network.test.name}"
+  mobile_network_id = "${azurerm_mobile_network_data_network.test.mobile_network_id}"
+  location          = "${azurerm_mobile_network_data_network.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMMobileNetworkDataNetwork_complete(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctestdn-%d"
+  mobile_network_id = "${azurerm_mobile_network.test.id}"
+  location          = "${azurerm_mobile_network.test.location}"
+  description       = "Industrial Edge"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,513 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type mobileNetworkPacketCoreControlPlane struct {
+	ID         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Location   *string                                        `json:"location,omitempty"`
+	Tags       map[string]*string                             `json:"tags"`
+	Properties *mobileNetworkPacketCoreControlPlaneProperties `json:"properties,omitempty"`
+}
+
+type mobileNetworkPacketCoreControlPlaneProperties struct {
+	Sites                       *[]mobileNetworkSubResource          `json:"sites,omitempty"`
+	Platform                    *mobileNetworkPlatformConfiguration  `json:"platform,omitempty"`
+	CoreNetworkTechnology       *string                              `json:"coreNetworkTechnology,omitempty"`
+	Version                     *string                              `json:"version,omitempty"`
+	ControlPlaneAccessInterface *mobileNetworkInterfaceProperties    `json:"controlPlaneAccessInterface,omitempty"`
+	Sku                         *string                              `json:"sku,omitempty"`
+	UeMtu                       *int32                               `json:"ueMtu,omitempty"`
+	LocalDiagnosticsAccess      *mobileNetworkLocalDiagnosticsAccess `json:"localDiagnosticsAccess,omitempty"`
+	InteropSettings             interface{}                          `json:"interopSettings,omitempty"`
+}
+
+type mobileNetworkPlatformConfiguration struct {
+	Type                 *string                   `json:"type,omitempty"`
+	AzureStackEdgeDevice *mobileNetworkSubResource `json:"azureStackEdgeDevice,omitempty"`
+	AzureStackHciCluster *mobileNetworkSubResource `json:"azureStackHciCluster,omitempty"`
+	ConnectedCluster     *mobileNetworkSubResource `json:"connectedCluster,omitempty"`
+	CustomLocation       *mobileNetworkSubResource `json:"customLocation,omitempty"`
+}
+
+type mobileNetworkInterfaceProperties struct {
+	Name        *string `json:"name,omitempty"`
+	Ipv4Address *string `json:"ipv4Address,omitempty"`
+	Ipv4Subnet  *string `json:"ipv4Subnet,omitempty"`
+	Ipv4Gateway *string `json:"ipv4Gateway,omitempty"`
+}
+
+type mobileNetworkLocalDiagnosticsAccess struct {
+	AuthenticationType     *string                              `json:"authenticationType,omitempty"`
+	HTTPSServerCertificate *mobileNetworkHTTPSServerCertificate `json:"httpsServerCertificate,omitempty"`
+}
+
+type mobileNetworkHTTPSServerCertificate struct {
+	CertificateURL *string `json:"certificateUrl,omitempty"`
+}
+
+func resourceArmMobileNetworkPacketCoreControlPlane() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkPacketCoreControlPlaneCreateUpdate,
+		Read:   resourceArmMobileNetworkPacketCoreControlPlaneRead,
+		Update: resourceArmMobileNetworkPacketCoreControlPlaneCreateUpdate,
+		Delete: resourceArmMobileNetworkPacketCoreControlPlaneDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"site_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"G0",
+					"G1",
+					"G2",
+					"G5",
+					"G10",
+				}, false),
+			},
+
+			"platform": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AKS-HCI",
+								"3P-AZURE-STACK-HCI",
+							}, false),
+						},
+
+						"edge_device_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"stack_hci_cluster_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"arc_kubernetes_cluster_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"custom_location_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"local_diagnostics_access": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AAD",
+								"Password",
+							}, false),
+						},
+
+						"https_server_certificate_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+					},
+				},
+			},
+
+			"control_plane_access_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"control_plane_access_ipv4_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"control_plane_access_ipv4_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"control_plane_access_ipv4_gateway": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"core_network_technology": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "5GC",
+				ValidateFunc: validation.StringInSlice([]string{
+					"5GC",
+					"EPC",
+				}, false),
+			},
+
+			// when not specified the latest version of the packet core software is installed
+			"software_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"user_equipment_mtu_in_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1440,
+				ValidateFunc: validation.IntBetween(1280, 1930),
+			},
+
+			"interoperability_settings_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMobileNetworkPacketCoreControlPlaneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := mobileNetworkPacketCoreControlPlaneID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetworkPacketCoreControlPlane
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Packet Core Control Plane %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network_packet_core_control_plane", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	sites := make([]mobileNetworkSubResource, 0)
+	for _, v := range d.Get("site_ids").([]interface{}) {
+		sites = append(sites, mobileNetworkSubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	parameters := mobileNetworkPacketCoreControlPlane{
+		Location: utils.String(location),
+		Properties: &mobileNetworkPacketCoreControlPlaneProperties{
+			Sites:                  &sites,
+			Sku:                    utils.String(d.Get("sku").(string)),
+			Platform:               expandArmMobileNetworkPlatform(d.Get("platform").([]interface{})),
+			LocalDiagnosticsAccess: expandArmMobileNetworkLocalDiagnosticsAccess(d.Get("local_diagnostics_access").([]interface{})),
+			ControlPlaneAccessInterface: &mobileNetworkInterfaceProperties{
+				Name:        utils.String(d.Get("control_plane_access_name").(string)),
+				Ipv4Address: utils.String(d.Get("control_plane_access_ipv4_address").(string)),
+				Ipv4Subnet:  utils.String(d.Get("control_plane_access_ipv4_subnet").(string)),
+				Ipv4Gateway: utils.String(d.Get("control_plane_access_ipv4_gateway").(string)),
+			},
+			CoreNetworkTechnology: utils.String(d.Get("core_network_technology").(string)),
+			UeMtu:                 utils.Int32(int32(d.Get("user_equipment_mtu_in_bytes").(int))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("software_version"); ok {
+		parameters.Properties.Version = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("interoperability_settings_json"); ok {
+		var interopSettings interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &interopSettings); err != nil {
+			return fmt.Errorf("Error parsing `interoperability_settings_json`: %+v", err)
+		}
+		parameters.Properties.InteropSettings = interopSettings
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Packet Core Control Plane %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkPacketCoreControlPlaneRead(d, meta)
+}
+
+func resourceArmMobileNetworkPacketCoreControlPlaneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["packetCoreControlPlanes"]
+
+	var resp mobileNetworkPacketCoreControlPlane
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Packet Core Control Plane %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Packet Core Control Plane %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		siteIds := make([]interface{}, 0)
+		if props.Sites != nil {
+			for _, v := range *props.Sites {
+				if v.ID != nil {
+					siteIds = append(siteIds, *v.ID)
+				}
+			}
+		}
+		if err := d.Set("site_ids", siteIds); err != nil {
+			return fmt.Errorf("Error setting `site_ids`: %+v", err)
+		}
+
+		d.Set("sku", props.Sku)
+
+		if err := d.Set("platform", flattenArmMobileNetworkPlatform(props.Platform)); err != nil {
+			return fmt.Errorf("Error setting `platform`: %+v", err)
+		}
+
+		if err := d.Set("local_diagnostics_access", flattenArmMobileNetworkLocalDiagnosticsAccess(props.LocalDiagnosticsAccess)); err != nil {
+			return fmt.Errorf("Error setting `local_diagnostics_access`: %+v", err)
+		}
+
+		if cpi := props.ControlPlaneAccessInterface; cpi != nil {
+			d.Set("control_plane_access_name", cpi.Name)
+			d.Set("control_plane_access_ipv4_address", cpi.Ipv4Address)
+			d.Set("control_plane_access_ipv4_subnet", cpi.Ipv4Subnet)
+			d.Set("control_plane_access_ipv4_gateway", cpi.Ipv4Gateway)
+		}
+
+		d.Set("core_network_technology", props.CoreNetworkTechnology)
+		d.Set("software_version", props.Version)
+
+		if props.UeMtu != nil {
+			d.Set("user_equipment_mtu_in_bytes", int(*props.UeMtu))
+		}
+
+		interopSettings := ""
+		if props.InteropSettings != nil {
+			settings, err := json.Marshal(props.InteropSettings)
+			if err != nil {
+				return fmt.Errorf("Error serializing `interoperability_settings_json`: %+v", err)
+			}
+			interopSettings = string(settings)
+		}
+		d.Set("interoperability_settings_json", interopSettings)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMobileNetworkPacketCoreControlPlaneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["packetCoreControlPlanes"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Packet Core Control Plane %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkPacketCoreControlPlaneID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MobileNetwork/packetCoreControlPlanes/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmMobileNetworkPlatform(input []interface{}) *mobileNetworkPlatformConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := mobileNetworkPlatformConfiguration{
+		Type: utils.String(v["type"].(string)),
+	}
+
+	if id := v["edge_device_id"].(string); id != "" {
+		output.AzureStackEdgeDevice = &mobileNetworkSubResource{ID: utils.String(id)}
+	}
+
+	if id := v["stack_hci_cluster_id"].(string); id != "" {
+		output.AzureStackHciCluster = &mobileNetworkSubResource{ID: utils.String(id)}
+	}
+
+	if id := v["arc_kubernetes_cluster_id"].(string); id != "" {
+		output.ConnectedCluster = &mobileNetworkSubResource{ID: utils.String(id)}
+	}
+
+	if id := v["custom_location_id"].(string); id != "" {
+		output.CustomLocation = &mobileNetworkSubResource{ID: utils.String(id)}
+	}
+
+	return &output
+}
+
+func flattenArmMobileNetworkPlatform(input *mobileNetworkPlatformConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	platformType := ""
+	if input.Type != nil {
+		platformType = *input.Type
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      platformType,
+			"edge_device_id":            flattenArmMobileNetworkSubResourceID(input.AzureStackEdgeDevice),
+			"stack_hci_cluster_id":      flattenArmMobileNetworkSubResourceID(input.AzureStackHciCluster),
+			"arc_kubernetes_cluster_id": flattenArmMobileNetworkSubResourceID(input.ConnectedCluster),
+			"custom_location_id":        flattenArmMobileNetworkSubResourceID(input.CustomLocation),
+		},
+	}
+}
+
+func expandArmMobileNetworkLocalDiagnosticsAccess(input []interface{}) *mobileNetworkLocalDiagnosticsAccess {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := mobileNetworkLocalDiagnosticsAccess{
+		AuthenticationType: utils.String(v["authentication_type"].(string)),
+	}
+
+	if url := v["https_server_certificate_url"].(string); url != "" {
+		output.HTTPSServerCertificate = &mobileNetworkHTTPSServerCertificate{
+			CertificateURL: utils.String(url),
+		}
+	}
+
+	return &output
+}
+
+func flattenArmMobileNetworkLocalDiagnosticsAccess(input *mobileNetworkLocalDiagnosticsAccess) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	authenticationType := ""
+	if input.AuthenticationType != nil {
+		authenticationType = *input.AuthenticationType
+	}
+
+	certificateUrl := ""
+	if cert := input.HTTPSServerCertificate; cert != nil && cert.CertificateURL != nil {
+		certificateUrl = *cert.CertificateURL
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"authentication_type":          authenticationType,
+			"https_server_certificate_url": certificateUrl,
+		},
+	}
+}
+
+func flattenArmMobileNetworkSubResourceID(input *mobileNetworkSubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
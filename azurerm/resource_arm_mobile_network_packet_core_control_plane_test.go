@@ -0,0 +1,252 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestExpandArmMobileNetworkPlatform(t *testing.T) {
+	edgeDeviceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataBoxEdge/dataBoxEdgeDevices/device1"
+
+	input := []interface{}{
+		map[string]interface{}{
+			"type":                      "AKS-HCI",
+			"edge_device_id":            edgeDeviceId,
+			"stack_hci_cluster_id":      "",
+			"arc_kubernetes_cluster_id": "",
+			"custom_location_id":        "",
+		},
+	}
+
+	output := expandArmMobileNetworkPlatform(input)
+	if output == nil {
+		t.Fatalf("Expected a Platform but got nil")
+	}
+
+	if *output.Type != "AKS-HCI" {
+		t.Fatalf("Expected the Type to be %q but got %q", "AKS-HCI", *output.Type)
+	}
+
+	if output.AzureStackEdgeDevice == nil || *output.AzureStackEdgeDevice.ID != edgeDeviceId {
+		t.Fatalf("Expected the Edge Device to be %q", edgeDeviceId)
+	}
+
+	if output.AzureStackHciCluster != nil || output.ConnectedCluster != nil || output.CustomLocation != nil {
+		t.Fatalf("Expected the unset references to be omitted")
+	}
+
+	flattened := flattenArmMobileNetworkPlatform(output)
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 flattened Platform but got %d", len(flattened))
+	}
+
+	v := flattened[0].(map[string]interface{})
+	for key, expected := range input[0].(map[string]interface{}) {
+		if v[key] != expected {
+			t.Fatalf("Expected %q to be %q but got %q", key, expected, v[key])
+		}
+	}
+}
+
+// a Packet Core Control Plane needs to be deployed onto an Azure Stack Edge device, which can't be provisioned on-demand
+func testAccMobileNetworkEdgeDeviceId(t *testing.T) string {
+	edgeDeviceId := os.Getenv("ARM_TEST_DATABOX_EDGE_DEVICE_ID")
+	if edgeDeviceId == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_DATABOX_EDGE_DEVICE_ID")
+	}
+
+	return edgeDeviceId
+}
+
+func TestAccAzureRMMobileNetworkPacketCoreControlPlane_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network_packet_core_control_plane.test"
+	edgeDeviceId := testAccMobileNetworkEdgeDeviceId(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkPacketCoreControlPlaneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkPacketCoreControlPlane_basic(ri, testLocation(), edgeDeviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkPacketCoreControlPlaneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "core_network_technology", "5GC"),
+					resource.TestCheckResourceAttr(resourceName, "user_equipment_mtu_in_bytes", "1440"),
+					resource.TestCheckResourceAttrSet(resourceName, "software_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkPacketCoreControlPlane_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network_packet_core_control_plane.test"
+	edgeDeviceId := testAccMobileNetworkEdgeDeviceId(t)
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkPacketCoreControlPlaneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkPacketCoreControlPlane_basic(ri, location, edgeDeviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkPacketCoreControlPlaneExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetworkPacketCoreControlPlane_requiresImport(ri, location, edgeDeviceId),
+				ExpectError: testRequiresImportError("azurerm_mobile_network_packet_core_control_plane"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkPacketCoreControlPlane_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network_packet_core_control_plane.test"
+	edgeDeviceId := testAccMobileNetworkEdgeDeviceId(t)
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkPacketCoreControlPlaneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkPacketCoreControlPlane_basic(ri, location, edgeDeviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkPacketCoreControlPlaneExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetworkPacketCoreControlPlane_complete(ri, location, edgeDeviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkPacketCoreControlPlaneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_equipment_mtu_in_bytes", "1600"),
+					resource.TestCheckResourceAttr(resourceName, "control_plane_access_ipv4_address", "192.168.1.199"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkPacketCoreControlPlaneExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "Packet Core Control Plane")
+}
+
+func testCheckAzureRMMobileNetworkPacketCoreControlPlaneDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network_packet_core_control_plane", "Packet Core Control Plane")
+}
+
+func testAccAzureRMMobileNetworkPacketCoreControlPlane_basic(rInt int, location string, edgeDeviceId string) string {
+	template := testAccAzureRMMobileNetworkSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_packet_core_control_plane" "test" {
+  name                = "acctestpccp-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "G0"
+  site_ids            = ["${azurerm_mobile_network_site.test.id}"]
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = "%s"
+  }
+
+  local_diagnostics_access {
+    authentication_type = "AAD"
+  }
+}
+`, template, rInt, edgeDeviceId)
+}
+
+func testAccAzureRMMobileNetworkPacketCoreControlPlane_requiresImport(rInt int, location string, edgeDeviceId string) string {
+	template := testAccAzureRMMobileNetworkPacketCoreControlPlane_basic(rInt, location, edgeDeviceId)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_packet_core_control_plane" "import" {
+  name                = "${azurerm_mobile_network_packet_core_control_plane.test.name}"
+  resource_group_name = "${azurerm_mobile_network_packet_core_control_plane.test.resource_group_name}"
+  location            = "${azurerm_mobile_network_packet_core_control_plane.test.location}"
+  sku                 = "${azurerm_mobile_network_packet_core_control_plane.test.sku}"
+  site_ids            = ["${azurerm_mobile_network_site.test.id}"]
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = "%s"
+  }
+
+  local_diagnostics_access {
+    authentication_type = "AAD"
+  }
+}
+`, template, edgeDeviceId)
+}
+
+func testAccAzureRMMobileNetworkPacketCoreControlPlane_complete(rInt int, location string, edgeDeviceId string) string {
+	template := testAccAzureRMMobileNetworkSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_packet_core_control_plane" "test" {
+  name                              = "acctestpccp-%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  location                          = "${azurerm_resource_group.test.location}"
+  sku                               = "G0"
+  site_ids                          = ["${azurerm_mobile_network_site.test.id}"]
+  control_plane_access_name         = "default-interface"
+  control_plane_access_ipv4_address = "192.168.1.199"
+  control_plane_access_ipv4_subnet  = "192.168.1.0/25"
+  control_plane_access_ipv4_gateway = "192.168.1.1"
+  user_equipment_mtu_in_bytes       = 1600
+
+  interoperability_settings_json = <<JSON
+{
+  "mtu": 1440
+}
+JSON
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = "%s"
+  }
+
+  local_diagnostics_access {
+    authentication_type = "Password"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt, edgeDeviceId)
+}
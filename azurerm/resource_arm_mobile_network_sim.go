@@ -0,0 +1,237 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type mobileNetworkSim struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *mobileNetworkSimProperties `json:"properties,omitempty"`
+}
+
+// the Authentication Key and Operator Key Code are write-only and aren't returned by the API
+type mobileNetworkSimProperties struct {
+	IntegratedCircuitCardIdentifier       *string                   `json:"integratedCircuitCardIdentifier,omitempty"`
+	InternationalMobileSubscriberIdentity *string                   `json:"internationalMobileSubscriberIdentity,omitempty"`
+	AuthenticationKey                     *string                   `json:"authenticationKey,omitempty"`
+	OperatorKeyCode                       *string                   `json:"operatorKeyCode,omitempty"`
+	DeviceType                            *string                   `json:"deviceType,omitempty"`
+	SimPolicy                             *mobileNetworkSubResource `json:"simPolicy,omitempty"`
+	SimState                              *string                   `json:"simState,omitempty"`
+}
+
+func resourceArmMobileNetworkSim() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkSimCreateUpdate,
+		Read:   resourceArmMobileNetworkSimRead,
+		Update: resourceArmMobileNetworkSimCreateUpdate,
+		Delete: resourceArmMobileNetworkSimDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"mobile_network_sim_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"integrated_circuit_card_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkSimIccid,
+			},
+
+			"international_mobile_subscriber_identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkSimImsi,
+			},
+
+			"authentication_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.MobileNetworkSimKey,
+			},
+
+			"operator_key_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.MobileNetworkSimKey,
+			},
+
+			"device_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"sim_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sim_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMobileNetworkSimCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	simGroupId := d.Get("mobile_network_sim_group_id").(string)
+
+	parsed, simGroupName, err := parseMobileNetworkSimGroupID(simGroupId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `mobile_network_sim_group_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+
+	id := mobileNetworkSimID(mobileNetworkSimGroupID(parsed.SubscriptionID, resourceGroup, simGroupName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetworkSim
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing SIM %q (SIM Group %q / Resource Group %q): %+v", name, simGroupName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network_sim", *existing.ID)
+		}
+	}
+
+	parameters := mobileNetworkSim{
+		Properties: &mobileNetworkSimProperties{
+			IntegratedCircuitCardIdentifier:       utils.String(d.Get("integrated_circuit_card_identifier").(string)),
+			InternationalMobileSubscriberIdentity: utils.String(d.Get("international_mobile_subscriber_identity").(string)),
+			AuthenticationKey:                     utils.String(d.Get("authentication_key").(string)),
+			OperatorKeyCode:                       utils.String(d.Get("operator_key_code").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("device_type"); ok {
+		parameters.Properties.DeviceType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sim_policy_id"); ok {
+		parameters.Properties.SimPolicy = &mobileNetworkSubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating SIM %q (SIM Group %q / Resource Group %q): %+v", name, simGroupName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkSimRead(d, meta)
+}
+
+func resourceArmMobileNetworkSimRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	simGroupName := id.Path["simGroups"]
+	name := id.Path["sims"]
+
+	var resp mobileNetworkSim
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] SIM %q was not found in SIM Group %q (Resource Group %q) - removing from state", name, simGroupName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SIM %q (SIM Group %q / Resource Group %q): %+v", name, simGroupName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("mobile_network_sim_group_id", mobileNetworkSimGroupID(id.SubscriptionID, resourceGroup, simGroupName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("integrated_circuit_card_identifier", props.IntegratedCircuitCardIdentifier)
+		d.Set("international_mobile_subscriber_identity", props.InternationalMobileSubscriberIdentity)
+		d.Set("device_type", props.DeviceType)
+		d.Set("sim_state", props.SimState)
+
+		simPolicyId := ""
+		if props.SimPolicy != nil && props.SimPolicy.ID != nil {
+			simPolicyId = *props.SimPolicy.ID
+		}
+		d.Set("sim_policy_id", simPolicyId)
+	}
+
+	// `authentication_key` and `operator_key_code` aren't returned by the API, so are left as-is in the state
+
+	return nil
+}
+
+func resourceArmMobileNetworkSimDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	simGroupName := id.Path["simGroups"]
+	name := id.Path["sims"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SIM %q (SIM Group %q / Resource Group %q): %+v", name, simGroupName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkSimID(simGroupId, name string) string {
+	return fmt.Sprintf("%s/sims/%s", simGroupId, name)
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type mobileNetworkSimGroup struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags"`
+	Properties *mobileNetworkSimGroupProperties `json:"properties,omitempty"`
+}
+
+type mobileNetworkSimGroupProperties struct {
+	MobileNetwork *mobileNetworkSubResource `json:"mobileNetwork,omitempty"`
+}
+
+func resourceArmMobileNetworkSimGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkSimGroupCreateUpdate,
+		Read:   resourceArmMobileNetworkSimGroupRead,
+		Update: resourceArmMobileNetworkSimGroupCreateUpdate,
+		Delete: resourceArmMobileNetworkSimGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"mobile_network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMobileNetworkSimGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := mobileNetworkSimGroupID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetworkSimGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing SIM Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network_sim_group", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := mobileNetworkSimGroup{
+		Location:   utils.String(location),
+		Properties: &mobileNetworkSimGroupProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("mobile_network_id"); ok {
+		parameters.Properties.MobileNetwork = &mobileNetworkSubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating SIM Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkSimGroupRead(d, meta)
+}
+
+func resourceArmMobileNetworkSimGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["simGroups"]
+
+	var resp mobileNetworkSimGroup
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] SIM Group %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SIM Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	mobileNetworkId := ""
+	if props := resp.Properties; props != nil && props.MobileNetwork != nil && props.MobileNetwork.ID != nil {
+		mobileNetworkId = *props.MobileNetwork.ID
+	}
+	d.Set("mobile_network_id", mobileNetworkId)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMobileNetworkSimGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["simGroups"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SIM Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkSimGroupID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MobileNetwork/simGroups/%s", subscriptionId, resourceGroup, name)
+}
+
+func parseMobileNetworkSimGroupID(input string) (*ResourceID, string, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := id.Path["simGroups"]
+	if name == "" {
+		return nil, "", fmt.Errorf("Expected %q to be the ID of a SIM Group", input)
+	}
+
+	return id, name, nil
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMobileNetworkSimGroup_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network_sim_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSimGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimGroupExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSimGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network_sim_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSimGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetworkSimGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mobile_network_sim_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSimGroup_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network_sim_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSimGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetworkSimGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimGroupExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "mobile_network_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkSimGroupExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "SIM Group")
+}
+
+func testCheckAzureRMMobileNetworkSimGroupDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network_sim_group", "SIM Group")
+}
+
+func testAccAzureRMMobileNetworkSimGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mobile_network_sim_group" "test" {
+  name                = "acctestsg-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMobileNetworkSimGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkSimGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim_group" "import" {
+  name                = "${azurerm_mobile_network_sim_group.test.name}"
+  resource_group_name = "${azurerm_mobile_network_sim_group.test.resource_group_name}"
+  location            = "${azurerm_mobile_network_sim_group.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMMobileNetworkSimGroup_complete(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim_group" "test" {
+  name                = "acctestsg-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  mobile_network_id   = "${azurerm_mobile_network.test.id}"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,156 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMobileNetworkSim_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network_sim.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSim_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "sim_state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// these aren't returned by the API
+				ImportStateVerifyIgnore: []string{"authentication_key", "operator_key_code"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSim_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network_sim.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSim_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetworkSim_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mobile_network_sim"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSim_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network_sim.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSim_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetworkSim_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSimExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "device_type", "Sensor"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authentication_key", "operator_key_code"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkSimExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "SIM")
+}
+
+func testCheckAzureRMMobileNetworkSimDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network_sim", "SIM")
+}
+
+func testAccAzureRMMobileNetworkSim_basic(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkSimGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim" "test" {
+  name                                     = "acctestsim-%d"
+  mobile_network_sim_group_id              = "${azurerm_mobile_network_sim_group.test.id}"
+  integrated_circuit_card_identifier       = "8900000000000000000"
+  international_mobile_subscriber_identity = "001010000000001"
+  authentication_key                       = "00000000000000000000000000000000"
+  operator_key_code                        = "00000000000000000000000000000000"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMobileNetworkSim_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkSim_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim" "import" {
+  name                                     = "${azurerm_mobile_network_sim.test.name}"
+  mobile_network_sim_group_id              = "${azurerm_mobile_network_sim.test.mobile_network_sim_group_id}"
+  integrated_circuit_card_identifier       = "${azurerm_mobile_network_sim.test.integrated_circuit_card_identifier}"
+  international_mobile_subscriber_identity = "${azurerm_mobile_network_sim.test.international_mobile_subscriber_identity}"
+  authentication_key                       = "${azurerm_mobile_network_sim.test.authentication_key}"
+  operator_key_code                        = "${azurerm_mobile_network_sim.test.operator_key_code}"
+}
+`, template)
+}
+
+func testAccAzureRMMobileNetworkSim_complete(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkSimGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim" "test" {
+  name                                     = "acctestsim-%d"
+  mobile_network_sim_group_id              = "${azurerm_mobile_network_sim_group.test.id}"
+  integrated_circuit_card_identifier       = "8900000000000000000"
+  international_mobile_subscriber_identity = "001010000000001"
+  authentication_key                       = "00000000000000000000000000000000"
+  operator_key_code                        = "00000000000000000000000000000000"
+  device_type                              = "Sensor"
+}
+`, template, rInt)
+}
@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type mobileNetworkSite struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Tags       map[string]*string           `json:"tags"`
+	Properties *mobileNetworkSiteProperties `json:"properties,omitempty"`
+}
+
+// the Network Functions (such as the Packet Core Control Plane) are associated with the Site from their side
+type mobileNetworkSiteProperties struct {
+	NetworkFunctions *[]mobileNetworkSubResource `json:"networkFunctions,omitempty"`
+}
+
+func resourceArmMobileNetworkSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMobileNetworkSiteCreateUpdate,
+		Read:   resourceArmMobileNetworkSiteRead,
+		Update: resourceArmMobileNetworkSiteCreateUpdate,
+		Delete: resourceArmMobileNetworkSiteDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MobileNetworkName,
+			},
+
+			"mobile_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"network_function_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMobileNetworkSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	mobileNetworkId := d.Get("mobile_network_id").(string)
+
+	parsed, mobileNetworkName, err := parseMobileNetworkID(mobileNetworkId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `mobile_network_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+
+	id := mobileNetworkSiteID(mobileNetworkID(parsed.SubscriptionID, resourceGroup, mobileNetworkName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing mobileNetworkSite
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Site %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mobile_network_site", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := mobileNetworkSite{
+		Location:   utils.String(location),
+		Properties: &mobileNetworkSiteProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, mobileNetworkApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Site %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMobileNetworkSiteRead(d, meta)
+}
+
+func resourceArmMobileNetworkSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	mobileNetworkName := id.Path["mobileNetworks"]
+	name := id.Path["sites"]
+
+	var resp mobileNetworkSite
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Site %q was not found in Mobile Network %q (Resource Group %q) - removing from state", name, mobileNetworkName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Site %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("mobile_network_id", mobileNetworkID(id.SubscriptionID, resourceGroup, mobileNetworkName))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	networkFunctionIds := make([]interface{}, 0)
+	if props := resp.Properties; props != nil && props.NetworkFunctions != nil {
+		for _, v := range *props.NetworkFunctions {
+			if v.ID != nil {
+				networkFunctionIds = append(networkFunctionIds, *v.ID)
+			}
+		}
+	}
+	if err := d.Set("network_function_ids", networkFunctionIds); err != nil {
+		return fmt.Errorf("Error setting `network_function_ids`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMobileNetworkSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	mobileNetworkName := id.Path["mobileNetworks"]
+	name := id.Path["sites"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), mobileNetworkApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Site %q (Mobile Network %q / Resource Group %q): %+v", name, mobileNetworkName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func mobileNetworkSiteID(mobileNetworkId, name string) string {
+	return fmt.Sprintf("%s/sites/%s", mobileNetworkId, name)
+}
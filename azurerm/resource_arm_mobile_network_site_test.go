@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMobileNetworkSite_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network_site.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSite_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSiteExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSite_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSiteExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetworkSite_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mobile_network_site"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetworkSite_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetworkSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSiteExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetworkSite_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkSiteExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "Site")
+}
+
+func testCheckAzureRMMobileNetworkSiteDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network_site", "Site")
+}
+
+func testAccAzureRMMobileNetworkSite_basic(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_site" "test" {
+  name              = "acctestsite-%d"
+  mobile_network_id = "${azurerm_mobile_network.test.id}"
+  location          = "${azurerm_mobile_network.test.location}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMobileNetworkSite_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMobileNetworkSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_site" "import" {
+  name              = "${azurerm_mobile_network_site.test.name}"
+  mobile_network_id = "${azurerm_mobile_network_site.test.mobile_network_id}"
+  location          = "${azurerm_mobile_network_site.test.location}"
+}
+`, template)
+}
+
+func testAccAzureRMMobileNetworkSite_complete(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_site" "test" {
+  name              = "acctestsite-%d"
+  mobile_network_id = "${azurerm_mobile_network.test.id}"
+  location          = "${azurerm_mobile_network.test.location}"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMobileNetwork_basic(t *testing.T) {
+	resourceName := "azurerm_mobile_network.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetwork_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mobile_country_code", "001"),
+					resource.TestCheckResourceAttr(resourceName, "mobile_network_code", "01"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetwork_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mobile_network.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMobileNetwork_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mobile_network"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMobileNetwork_update(t *testing.T) {
+	resourceName := "azurerm_mobile_network.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMobileNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMobileNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMobileNetwork_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMobileNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mobile_country_code", "001"),
+					resource.TestCheckResourceAttr(resourceName, "mobile_network_code", "001"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMobileNetworkExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMMobileNetworkResourceExists(resourceName, "Mobile Network")
+}
+
+func testCheckAzureRMMobileNetworkDestroy(s *terraform.State) error {
+	return testCheckAzureRMMobileNetworkResourceDestroy(s, "azurerm_mobile_network", "Mobile Network")
+}
+
+// the Mobile Network resources share an API Version, so are checked using the same helpers
+func testCheckAzureRMMobileNetworkResourceExists(resourceName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, mobileNetworkApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: %s %q does not exist", description, rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on %s %q: %+v", description, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMobileNetworkResourceDestroy(s *terraform.State, resourceType string, description string) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		var resp map[string]interface{}
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, mobileNetworkApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%s still exists:\n%#v", description, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMobileNetwork_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mobile_network" "test" {
+  name                = "acctestmn-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  mobile_country_code = "001"
+  mobile_network_code = "01"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMobileNetwork_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMobileNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network" "import" {
+  name                = "${azurerm_mobile_network.test.name}"
+  resource_group_name = "${azurerm_mobile_network.test.resource_group_name}"
+  location            = "${azurerm_mobile_network.test.location}"
+  mobile_country_code = "${azurerm_mobile_network.test.mobile_country_code}"
+  mobile_network_code = "${azurerm_mobile_network.test.mobile_network_code}"
+}
+`, template)
+}
+
+func testAccAzureRMMobileNetwork_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mobile_network" "test" {
+  name                = "acctestmn-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  mobile_country_code = "001"
+  mobile_network_code = "001"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
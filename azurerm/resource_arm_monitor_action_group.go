@@ -4,14 +4,84 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the Event Hub receivers, the Azure AD authentication of (secure) Webhook receivers and the Common Alert Schema
+// aren't present in the vendored SDK, so Action Groups are managed using raw requests against a newer API version
+const monitorActionGroupApiVersion = "2023-01-01"
+
+type monitorActionGroup struct {
+	ID         *string                       `json:"id,omitempty"`
+	Location   *string                       `json:"location,omitempty"`
+	Properties *monitorActionGroupProperties `json:"properties,omitempty"`
+	Tags       map[string]*string            `json:"tags"`
+}
+
+type monitorActionGroupProperties struct {
+	GroupShortName             *string                                        `json:"groupShortName,omitempty"`
+	Enabled                    *bool                                          `json:"enabled,omitempty"`
+	EmailReceivers             *[]insights.EmailReceiver                      `json:"emailReceivers,omitempty"`
+	SmsReceivers               *[]insights.SmsReceiver                        `json:"smsReceivers,omitempty"`
+	WebhookReceivers           *[]monitorActionGroupWebhookReceiver           `json:"webhookReceivers,omitempty"`
+	EventHubReceivers          *[]monitorActionGroupEventHubReceiver          `json:"eventHubReceivers,omitempty"`
+	LogicAppReceivers          *[]monitorActionGroupLogicAppReceiver          `json:"logicAppReceivers,omitempty"`
+	AutomationRunbookReceivers *[]monitorActionGroupAutomationRunbookReceiver `json:"automationRunbookReceivers,omitempty"`
+	ArmRoleReceivers           *[]monitorActionGroupArmRoleReceiver           `json:"armRoleReceivers,omitempty"`
+}
+
+type monitorActionGroupWebhookReceiver struct {
+	Name                 *string `json:"name,omitempty"`
+	ServiceURI           *string `json:"serviceUri,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+	UseAadAuth           *bool   `json:"useAadAuth,omitempty"`
+	ObjectID             *string `json:"objectId,omitempty"`
+	IdentifierURI        *string `json:"identifierUri,omitempty"`
+	TenantID             *string `json:"tenantId,omitempty"`
+}
+
+type monitorActionGroupEventHubReceiver struct {
+	Name                 *string `json:"name,omitempty"`
+	EventHubNameSpace    *string `json:"eventHubNameSpace,omitempty"`
+	EventHubName         *string `json:"eventHubName,omitempty"`
+	SubscriptionID       *string `json:"subscriptionId,omitempty"`
+	TenantID             *string `json:"tenantId,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
+
+type monitorActionGroupLogicAppReceiver struct {
+	Name                 *string `json:"name,omitempty"`
+	ResourceID           *string `json:"resourceId,omitempty"`
+	CallbackURL          *string `json:"callbackUrl,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
+
+type monitorActionGroupAutomationRunbookReceiver struct {
+	Name                 *string `json:"name,omitempty"`
+	AutomationAccountID  *string `json:"automationAccountId,omitempty"`
+	RunbookName          *string `json:"runbookName,omitempty"`
+	WebhookResourceID    *string `json:"webhookResourceId,omitempty"`
+	IsGlobalRunbook      *bool   `json:"isGlobalRunbook,omitempty"`
+	ServiceURI           *string `json:"serviceUri,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
+
+type monitorActionGroupArmRoleReceiver struct {
+	Name                 *string `json:"name,omitempty"`
+	RoleID               *string `json:"roleId,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
+
+func monitorActionGroupID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/actionGroups/%s", subscriptionId, resourceGroup, name)
+}
+
 func resourceArmMonitorActionGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmMonitorActionGroupCreateUpdate,
@@ -102,6 +172,171 @@ func resourceArmMonitorActionGroup() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validate.NoEmptyStrings,
 						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"aad_auth": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.UUID,
+									},
+									"identifier_uri": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"tenant_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validate.UUID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"event_hub_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"event_hub_namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"event_hub_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"subscription_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"logic_app_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"callback_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"automation_runbook_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"automation_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"runbook_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"webhook_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"is_global_runbook": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"service_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"arm_role_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"role_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"use_common_alert_schema": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -114,15 +349,18 @@ func resourceArmMonitorActionGroup() *schema.Resource {
 func resourceArmMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorActionGroupsClient
 	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
+	id := monitorActionGroupID(subscriptionId, resGroup, name)
 
 	if requireResourcesToBeImported && d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+		var existing monitorActionGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorActionGroupApiVersion, &existing)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Monitor Action Group Service Plan %q (Resource Group %q): %s", name, resGroup, err)
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Monitor Action Group %q (Resource Group %q): %s", name, resGroup, err)
 			}
 		}
 
@@ -137,28 +375,36 @@ func resourceArmMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta inte
 	emailReceiversRaw := d.Get("email_receiver").([]interface{})
 	smsReceiversRaw := d.Get("sms_receiver").([]interface{})
 	webhookReceiversRaw := d.Get("webhook_receiver").([]interface{})
+	eventHubReceiversRaw := d.Get("event_hub_receiver").([]interface{})
+	logicAppReceiversRaw := d.Get("logic_app_receiver").([]interface{})
+	automationRunbookReceiversRaw := d.Get("automation_runbook_receiver").([]interface{})
+	armRoleReceiversRaw := d.Get("arm_role_receiver").([]interface{})
 
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
-	parameters := insights.ActionGroupResource{
+	parameters := monitorActionGroup{
 		Location: utils.String(azureRMNormalizeLocation("Global")),
-		ActionGroup: &insights.ActionGroup{
-			GroupShortName:   utils.String(shortName),
-			Enabled:          utils.Bool(enabled),
-			EmailReceivers:   expandMonitorActionGroupEmailReceiver(emailReceiversRaw),
-			SmsReceivers:     expandMonitorActionGroupSmsReceiver(smsReceiversRaw),
-			WebhookReceivers: expandMonitorActionGroupWebHookReceiver(webhookReceiversRaw),
+		Properties: &monitorActionGroupProperties{
+			GroupShortName:             utils.String(shortName),
+			Enabled:                    utils.Bool(enabled),
+			EmailReceivers:             expandMonitorActionGroupEmailReceiver(emailReceiversRaw),
+			SmsReceivers:               expandMonitorActionGroupSmsReceiver(smsReceiversRaw),
+			WebhookReceivers:           expandMonitorActionGroupWebHookReceiver(webhookReceiversRaw),
+			EventHubReceivers:          expandMonitorActionGroupEventHubReceiver(eventHubReceiversRaw, subscriptionId),
+			LogicAppReceivers:          expandMonitorActionGroupLogicAppReceiver(logicAppReceiversRaw),
+			AutomationRunbookReceivers: expandMonitorActionGroupAutomationRunbookReceiver(automationRunbookReceiversRaw),
+			ArmRoleReceivers:           expandMonitorActionGroupArmRoleReceiver(armRoleReceiversRaw),
 		},
 		Tags: expandedTags,
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, monitorActionGroupApiVersion, parameters); err != nil {
 		return fmt.Errorf("Error creating or updating action group %q (resource group %q): %+v", name, resGroup, err)
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
-	if err != nil {
+	var read monitorActionGroup
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorActionGroupApiVersion, &read); err != nil {
 		return fmt.Errorf("Error getting action group %q (resource group %q) after creation: %+v", name, resGroup, err)
 	}
 	if read.ID == nil {
@@ -181,9 +427,10 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["actionGroups"]
 
-	resp, err := client.Get(ctx, resGroup, name)
+	var group monitorActionGroup
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), monitorActionGroupApiVersion, &group)
 	if err != nil {
-		if response.WasNotFound(resp.Response.Response) {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
 			d.SetId("")
 			return nil
 		}
@@ -193,24 +440,40 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
 
-	if group := resp.ActionGroup; group != nil {
-		d.Set("short_name", group.GroupShortName)
-		d.Set("enabled", group.Enabled)
+	if props := group.Properties; props != nil {
+		d.Set("short_name", props.GroupShortName)
+		d.Set("enabled", props.Enabled)
 
-		if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(group.EmailReceivers)); err != nil {
+		if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(props.EmailReceivers)); err != nil {
 			return fmt.Errorf("Error setting `email_receiver`: %+v", err)
 		}
 
-		if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(group.SmsReceivers)); err != nil {
+		if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(props.SmsReceivers)); err != nil {
 			return fmt.Errorf("Error setting `sms_receiver`: %+v", err)
 		}
 
-		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
+		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(props.WebhookReceivers)); err != nil {
 			return fmt.Errorf("Error setting `webhook_receiver`: %+v", err)
 		}
+
+		if err = d.Set("event_hub_receiver", flattenMonitorActionGroupEventHubReceiver(props.EventHubReceivers)); err != nil {
+			return fmt.Errorf("Error setting `event_hub_receiver`: %+v", err)
+		}
+
+		if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(props.LogicAppReceivers)); err != nil {
+			return fmt.Errorf("Error setting `logic_app_receiver`: %+v", err)
+		}
+
+		if err = d.Set("automation_runbook_receiver", flattenMonitorActionGroupAutomationRunbookReceiver(props.AutomationRunbookReceivers)); err != nil {
+			return fmt.Errorf("Error setting `automation_runbook_receiver`: %+v", err)
+		}
+
+		if err = d.Set("arm_role_receiver", flattenMonitorActionGroupArmRoleReceiver(props.ArmRoleReceivers)); err != nil {
+			return fmt.Errorf("Error setting `arm_role_receiver`: %+v", err)
+		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, group.Tags)
 
 	return nil
 }
@@ -228,7 +491,7 @@ func resourceArmMonitorActionGroupDelete(d *schema.ResourceData, meta interface{
 
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("Error deleting action group %q (resource group %q): %+v", name, resGroup, err)
 		}
 	}
@@ -263,13 +526,101 @@ func expandMonitorActionGroupSmsReceiver(v []interface{}) *[]insights.SmsReceive
 	return &receivers
 }
 
-func expandMonitorActionGroupWebHookReceiver(v []interface{}) *[]insights.WebhookReceiver {
-	receivers := make([]insights.WebhookReceiver, 0)
+func expandMonitorActionGroupWebHookReceiver(v []interface{}) *[]monitorActionGroupWebhookReceiver {
+	receivers := make([]monitorActionGroupWebhookReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := monitorActionGroupWebhookReceiver{
+			Name:                 utils.String(val["name"].(string)),
+			ServiceURI:           utils.String(val["service_uri"].(string)),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
+			UseAadAuth:           utils.Bool(false),
+		}
+
+		if authRaw := val["aad_auth"].([]interface{}); len(authRaw) > 0 && authRaw[0] != nil {
+			auth := authRaw[0].(map[string]interface{})
+			receiver.UseAadAuth = utils.Bool(true)
+			receiver.ObjectID = utils.String(auth["object_id"].(string))
+
+			if v := auth["identifier_uri"].(string); v != "" {
+				receiver.IdentifierURI = utils.String(v)
+			}
+			if v := auth["tenant_id"].(string); v != "" {
+				receiver.TenantID = utils.String(v)
+			}
+		}
+
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupEventHubReceiver(v []interface{}, subscriptionId string) *[]monitorActionGroupEventHubReceiver {
+	receivers := make([]monitorActionGroupEventHubReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.WebhookReceiver{
-			Name:       utils.String(val["name"].(string)),
-			ServiceURI: utils.String(val["service_uri"].(string)),
+		receiver := monitorActionGroupEventHubReceiver{
+			Name:                 utils.String(val["name"].(string)),
+			EventHubNameSpace:    utils.String(val["event_hub_namespace"].(string)),
+			EventHubName:         utils.String(val["event_hub_name"].(string)),
+			SubscriptionID:       utils.String(subscriptionId),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
+		}
+
+		// the Event Hub defaults to being within the same Subscription (and Tenant) as the Action Group
+		if v := val["subscription_id"].(string); v != "" {
+			receiver.SubscriptionID = utils.String(v)
+		}
+		if v := val["tenant_id"].(string); v != "" {
+			receiver.TenantID = utils.String(v)
+		}
+
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupLogicAppReceiver(v []interface{}) *[]monitorActionGroupLogicAppReceiver {
+	receivers := make([]monitorActionGroupLogicAppReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := monitorActionGroupLogicAppReceiver{
+			Name:                 utils.String(val["name"].(string)),
+			ResourceID:           utils.String(val["resource_id"].(string)),
+			CallbackURL:          utils.String(val["callback_url"].(string)),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]monitorActionGroupAutomationRunbookReceiver {
+	receivers := make([]monitorActionGroupAutomationRunbookReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := monitorActionGroupAutomationRunbookReceiver{
+			Name:                 utils.String(val["name"].(string)),
+			AutomationAccountID:  utils.String(val["automation_account_id"].(string)),
+			RunbookName:          utils.String(val["runbook_name"].(string)),
+			WebhookResourceID:    utils.String(val["webhook_resource_id"].(string)),
+			IsGlobalRunbook:      utils.Bool(val["is_global_runbook"].(bool)),
+			ServiceURI:           utils.String(val["service_uri"].(string)),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupArmRoleReceiver(v []interface{}) *[]monitorActionGroupArmRoleReceiver {
+	receivers := make([]monitorActionGroupArmRoleReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := monitorActionGroupArmRoleReceiver{
+			Name:                 utils.String(val["name"].(string)),
+			RoleID:               utils.String(val["role_id"].(string)),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		receivers = append(receivers, receiver)
 	}
@@ -313,7 +664,73 @@ func flattenMonitorActionGroupSmsReceiver(receivers *[]insights.SmsReceiver) []i
 	return result
 }
 
-func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookReceiver) []interface{} {
+func flattenMonitorActionGroupWebHookReceiver(receivers *[]monitorActionGroupWebhookReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{})
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.ServiceURI != nil {
+				val["service_uri"] = *receiver.ServiceURI
+			}
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
+			}
+
+			auth := make([]interface{}, 0)
+			if receiver.UseAadAuth != nil && *receiver.UseAadAuth {
+				authVal := make(map[string]interface{})
+				if receiver.ObjectID != nil {
+					authVal["object_id"] = *receiver.ObjectID
+				}
+				if receiver.IdentifierURI != nil {
+					authVal["identifier_uri"] = *receiver.IdentifierURI
+				}
+				if receiver.TenantID != nil {
+					authVal["tenant_id"] = *receiver.TenantID
+				}
+				auth = append(auth, authVal)
+			}
+			val["aad_auth"] = auth
+
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupEventHubReceiver(receivers *[]monitorActionGroupEventHubReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{})
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.EventHubNameSpace != nil {
+				val["event_hub_namespace"] = *receiver.EventHubNameSpace
+			}
+			if receiver.EventHubName != nil {
+				val["event_hub_name"] = *receiver.EventHubName
+			}
+			if receiver.SubscriptionID != nil {
+				val["subscription_id"] = *receiver.SubscriptionID
+			}
+			if receiver.TenantID != nil {
+				val["tenant_id"] = *receiver.TenantID
+			}
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupLogicAppReceiver(receivers *[]monitorActionGroupLogicAppReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
@@ -321,9 +738,67 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookRecei
 			if receiver.Name != nil {
 				val["name"] = *receiver.Name
 			}
+			if receiver.ResourceID != nil {
+				val["resource_id"] = *receiver.ResourceID
+			}
+			if receiver.CallbackURL != nil {
+				val["callback_url"] = *receiver.CallbackURL
+			}
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]monitorActionGroupAutomationRunbookReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{})
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.AutomationAccountID != nil {
+				val["automation_account_id"] = *receiver.AutomationAccountID
+			}
+			if receiver.RunbookName != nil {
+				val["runbook_name"] = *receiver.RunbookName
+			}
+			if receiver.WebhookResourceID != nil {
+				val["webhook_resource_id"] = *receiver.WebhookResourceID
+			}
+			if receiver.IsGlobalRunbook != nil {
+				val["is_global_runbook"] = *receiver.IsGlobalRunbook
+			}
 			if receiver.ServiceURI != nil {
 				val["service_uri"] = *receiver.ServiceURI
 			}
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupArmRoleReceiver(receivers *[]monitorActionGroupArmRoleReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{})
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.RoleID != nil {
+				val["role_id"] = *receiver.RoleID
+			}
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
+			}
 			result = append(result, val)
 		}
 	}
@@ -159,6 +159,146 @@ func TestAccAzureRMMonitorActionGroup_webhookReceiver(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMonitorActionGroup_secureWebhookReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMonitorActionGroup_secureWebhookReceiver(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "webhook_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_receiver.0.use_common_alert_schema", "true"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_receiver.0.aad_auth.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "webhook_receiver.0.aad_auth.0.identifier_uri"),
+					resource.TestCheckResourceAttrSet(resourceName, "webhook_receiver.0.aad_auth.0.tenant_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_eventHubReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMonitorActionGroup_eventHubReceiver(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_hub_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_hub_receiver.0.use_common_alert_schema", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "event_hub_receiver.0.subscription_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "event_hub_receiver.0.tenant_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_logicAppReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMonitorActionGroup_logicAppReceiver(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logic_app_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logic_app_receiver.0.use_common_alert_schema", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_automationRunbookReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMonitorActionGroup_automationRunbookReceiver(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automation_runbook_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "automation_runbook_receiver.0.is_global_runbook", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_armRoleReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMonitorActionGroup_armRoleReceiver(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "arm_role_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "arm_role_receiver.0.role_id", "43d0d8ad-25c7-4714-9337-8ba259a9fe05"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMonitorActionGroup_complete(t *testing.T) {
 	resourceName := "azurerm_monitor_action_group.test"
 	ri := tf.AccRandTimeInt()
@@ -427,6 +567,151 @@ resource "azurerm_monitor_action_group" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMMonitorActionGroup_secureWebhookReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  webhook_receiver {
+    name                    = "callmysecureapi"
+    service_uri             = "https://example.com/alert"
+    use_common_alert_schema = true
+
+    aad_auth {
+      object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+    }
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_eventHubReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Basic"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  event_hub_receiver {
+    name                = "sendtoeventhub"
+    event_hub_namespace = "${azurerm_eventhub_namespace.test.name}"
+    event_hub_name      = "${azurerm_eventhub.test.name}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_logicAppReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  logic_app_receiver {
+    name                    = "logicappaction"
+    resource_id             = "${azurerm_logic_app_workflow.test.id}"
+    callback_url            = "https://logicapptriggerurl/..."
+    use_common_alert_schema = true
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_automationRunbookReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  automation_runbook_receiver {
+    name                  = "runbookaction"
+    automation_account_id = "${azurerm_automation_account.test.id}"
+    runbook_name          = "my runbook"
+    webhook_resource_id   = "${azurerm_automation_account.test.id}/webhooks/webhook_alert"
+    is_global_runbook     = true
+    service_uri           = "https://s13events.azure-automation.net/webhooks?token=randomtoken"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_armRoleReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  arm_role_receiver {
+    name    = "Monitoring Reader"
+    role_id = "43d0d8ad-25c7-4714-9337-8ba259a9fe05"
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMMonitorActionGroup_complete(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -17,6 +17,35 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const monitorActivityLogAlertApiVersion = "2017-04-01"
+
+// monitorActivityLogAlertExtended is an Activity Log Alert including the `anyOf` and `containsAny` conditions,
+// which aren't present in the vendored SDK
+type monitorActivityLogAlertExtended struct {
+	Location   *string                                    `json:"location,omitempty"`
+	Tags       map[string]*string                         `json:"tags"`
+	Properties *monitorActivityLogAlertExtendedProperties `json:"properties,omitempty"`
+}
+
+type monitorActivityLogAlertExtendedProperties struct {
+	Scopes      *[]string                                 `json:"scopes,omitempty"`
+	Enabled     *bool                                     `json:"enabled,omitempty"`
+	Condition   *monitorActivityLogAlertExtendedCondition `json:"condition,omitempty"`
+	Actions     *insights.ActivityLogAlertActionList      `json:"actions,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+}
+
+type monitorActivityLogAlertExtendedCondition struct {
+	AllOf *[]monitorActivityLogAlertExtendedLeafCondition `json:"allOf,omitempty"`
+}
+
+type monitorActivityLogAlertExtendedLeafCondition struct {
+	Field       *string                                         `json:"field,omitempty"`
+	Equals      *string                                         `json:"equals,omitempty"`
+	ContainsAny *[]string                                       `json:"containsAny,omitempty"`
+	AnyOf       *[]monitorActivityLogAlertExtendedLeafCondition `json:"anyOf,omitempty"`
+}
+
 func resourceArmMonitorActivityLogAlert() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmMonitorActivityLogAlertCreateUpdate,
@@ -63,8 +92,10 @@ func resourceArmMonitorActivityLogAlert() *schema.Resource {
 								"Autoscale",
 								"Policy",
 								"Recommendation",
+								"ResourceHealth",
 								"Security",
 								"Service Health",
+								"ServiceHealth",
 							}, false),
 						},
 						"operation_name": {
@@ -111,6 +142,98 @@ func resourceArmMonitorActivityLogAlert() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"service_health": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"events": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"ActionRequired",
+												"Incident",
+												"Informational",
+												"Maintenance",
+												"Security",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+									"services": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+										Set: schema.HashString,
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+						"resource_health": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"current": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Available",
+												"Degraded",
+												"Unavailable",
+												"Unknown",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+									"previous": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Available",
+												"Degraded",
+												"Unavailable",
+												"Unknown",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+									"reason": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"PlatformInitiated",
+												"Unknown",
+												"UserInitiated",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -182,19 +305,28 @@ func resourceArmMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
 
-	parameters := insights.ActivityLogAlertResource{
+	condition, err := expandMonitorActivityLogAlertCriteria(criteriaRaw)
+	if err != nil {
+		return err
+	}
+
+	parameters := monitorActivityLogAlertExtended{
 		Location: utils.String(azureRMNormalizeLocation("Global")),
-		ActivityLogAlert: &insights.ActivityLogAlert{
+		Properties: &monitorActivityLogAlertExtendedProperties{
 			Enabled:     utils.Bool(enabled),
 			Description: utils.String(description),
 			Scopes:      utils.ExpandStringArray(scopesRaw),
-			Condition:   expandMonitorActivityLogAlertCriteria(criteriaRaw),
+			Condition:   condition,
 			Actions:     expandMonitorActivityLogAlertAction(actionRaw),
 		},
 		Tags: expandedTags,
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+	// the Service Health & Resource Health criteria need `anyOf` and `containsAny` conditions, which the API supports
+	// but the vendored SDK doesn't - as such the Activity Log Alert is PUT directly
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	alertId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/activityLogAlerts/%s", subscriptionId, resourceGroup, name)
+	if err := armRawPut(ctx, client.Client, client.BaseURI, alertId, monitorActivityLogAlertApiVersion, parameters); err != nil {
 		return fmt.Errorf("Error creating or updating activity log alert %q (resource group %q): %+v", name, resourceGroup, err)
 	}
 
@@ -239,13 +371,21 @@ func resourceArmMonitorActivityLogAlertRead(d *schema.ResourceData, meta interfa
 		if err := d.Set("scopes", utils.FlattenStringArray(alert.Scopes)); err != nil {
 			return fmt.Errorf("Error setting `scopes`: %+v", err)
 		}
-		if err := d.Set("criteria", flattenMonitorActivityLogAlertCriteria(alert.Condition)); err != nil {
-			return fmt.Errorf("Error setting `criteria`: %+v", err)
-		}
 		if err := d.Set("action", flattenMonitorActivityLogAlertAction(alert.Actions)); err != nil {
 			return fmt.Errorf("Error setting `action`: %+v", err)
 		}
 	}
+
+	// the `anyOf` and `containsAny` conditions aren't present in the vendored SDK, so the criteria are read directly
+	var extended monitorActivityLogAlertExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), monitorActivityLogAlertApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving criteria for activity log alert %q (resource group %q): %+v", name, resourceGroup, err)
+	}
+	if props := extended.Properties; props != nil {
+		if err := d.Set("criteria", flattenMonitorActivityLogAlertCriteria(props.Condition)); err != nil {
+			return fmt.Errorf("Error setting `criteria`: %+v", err)
+		}
+	}
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -271,73 +411,134 @@ func resourceArmMonitorActivityLogAlertDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.ActivityLogAlertAllOfCondition {
-	conditions := make([]insights.ActivityLogAlertLeafCondition, 0)
+func expandMonitorActivityLogAlertCriteria(input []interface{}) (*monitorActivityLogAlertExtendedCondition, error) {
+	conditions := make([]monitorActivityLogAlertExtendedLeafCondition, 0)
 	v := input[0].(map[string]interface{})
 
 	if category := v["category"].(string); category != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("category"),
 			Equals: utils.String(category),
 		})
 	}
 	if op := v["operation_name"].(string); op != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("operationName"),
 			Equals: utils.String(op),
 		})
 	}
 	if caller := v["caller"].(string); caller != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("caller"),
 			Equals: utils.String(caller),
 		})
 	}
 	if level := v["level"].(string); level != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("level"),
 			Equals: utils.String(level),
 		})
 	}
 	if resourceProvider := v["resource_provider"].(string); resourceProvider != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("resourceProvider"),
 			Equals: utils.String(resourceProvider),
 		})
 	}
 	if resourceType := v["resource_type"].(string); resourceType != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("resourceType"),
 			Equals: utils.String(resourceType),
 		})
 	}
 	if resourceGroup := v["resource_group"].(string); resourceGroup != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("resourceGroup"),
 			Equals: utils.String(resourceGroup),
 		})
 	}
 	if id := v["resource_id"].(string); id != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("resourceId"),
 			Equals: utils.String(id),
 		})
 	}
 	if status := v["status"].(string); status != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("status"),
 			Equals: utils.String(status),
 		})
 	}
 	if subStatus := v["sub_status"].(string); subStatus != "" {
-		conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
 			Field:  utils.String("subStatus"),
 			Equals: utils.String(subStatus),
 		})
 	}
 
-	return &insights.ActivityLogAlertAllOfCondition{
+	category := v["category"].(string)
+
+	if serviceHealth := v["service_health"].([]interface{}); len(serviceHealth) > 0 {
+		if category != "ServiceHealth" {
+			return nil, fmt.Errorf("`service_health` can only be specified when the `category` is `ServiceHealth`")
+		}
+
+		if serviceHealth[0] != nil {
+			health := serviceHealth[0].(map[string]interface{})
+			if events := health["events"].(*schema.Set).List(); len(events) > 0 {
+				conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("properties.incidentType", events))
+			}
+			if services := health["services"].(*schema.Set).List(); len(services) > 0 {
+				conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
+					Field:       utils.String("properties.impactedServices[*].ServiceName"),
+					ContainsAny: utils.ExpandStringArray(services),
+				})
+			}
+			if regions := health["regions"].(*schema.Set).List(); len(regions) > 0 {
+				conditions = append(conditions, monitorActivityLogAlertExtendedLeafCondition{
+					Field:       utils.String("properties.impactedServices[*].ImpactedRegions[*].RegionName"),
+					ContainsAny: utils.ExpandStringArray(regions),
+				})
+			}
+		}
+	}
+
+	if resourceHealth := v["resource_health"].([]interface{}); len(resourceHealth) > 0 {
+		if category != "ResourceHealth" {
+			return nil, fmt.Errorf("`resource_health` can only be specified when the `category` is `ResourceHealth`")
+		}
+
+		if resourceHealth[0] != nil {
+			health := resourceHealth[0].(map[string]interface{})
+			if current := health["current"].(*schema.Set).List(); len(current) > 0 {
+				conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("properties.currentHealthStatus", current))
+			}
+			if previous := health["previous"].(*schema.Set).List(); len(previous) > 0 {
+				conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("properties.previousHealthStatus", previous))
+			}
+			if reason := health["reason"].(*schema.Set).List(); len(reason) > 0 {
+				conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("properties.cause", reason))
+			}
+		}
+	}
+
+	return &monitorActivityLogAlertExtendedCondition{
 		AllOf: &conditions,
+	}, nil
+}
+
+// expandMonitorActivityLogAlertAnyOfCondition returns a condition which matches when the field equals any of the values
+func expandMonitorActivityLogAlertAnyOfCondition(field string, values []interface{}) monitorActivityLogAlertExtendedLeafCondition {
+	anyOf := make([]monitorActivityLogAlertExtendedLeafCondition, 0)
+	for _, value := range values {
+		anyOf = append(anyOf, monitorActivityLogAlertExtendedLeafCondition{
+			Field:  utils.String(field),
+			Equals: utils.String(value.(string)),
+		})
+	}
+
+	return monitorActivityLogAlertExtendedLeafCondition{
+		AnyOf: &anyOf,
 	}
 }
 
@@ -364,31 +565,103 @@ func expandMonitorActivityLogAlertAction(input []interface{}) *insights.Activity
 	}
 }
 
-func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllOfCondition) []interface{} {
+func flattenMonitorActivityLogAlertCriteria(input *monitorActivityLogAlertExtendedCondition) []interface{} {
 	result := make(map[string]interface{})
 	if input == nil || input.AllOf == nil {
 		return []interface{}{result}
 	}
+
+	serviceHealth := map[string][]interface{}{
+		"events":   make([]interface{}, 0),
+		"services": make([]interface{}, 0),
+		"regions":  make([]interface{}, 0),
+	}
+	resourceHealth := map[string][]interface{}{
+		"current":  make([]interface{}, 0),
+		"previous": make([]interface{}, 0),
+		"reason":   make([]interface{}, 0),
+	}
+
+	// `anyOf` conditions are flattened into the leaf conditions they contain, since each of them checks the same field
+	leaves := make([]monitorActivityLogAlertExtendedLeafCondition, 0)
 	for _, condition := range *input.AllOf {
-		if condition.Field != nil && condition.Equals != nil {
+		if condition.AnyOf != nil {
+			leaves = append(leaves, *condition.AnyOf...)
+			continue
+		}
+		leaves = append(leaves, condition)
+	}
+
+	for _, condition := range leaves {
+		if condition.Field == nil {
+			continue
+		}
+
+		if condition.ContainsAny != nil {
+			values := make([]interface{}, 0)
+			for _, v := range *condition.ContainsAny {
+				values = append(values, v)
+			}
+
 			switch strings.ToLower(*condition.Field) {
-			case "operationname":
-				result["operation_name"] = *condition.Equals
-			case "resourceprovider":
-				result["resource_provider"] = *condition.Equals
-			case "resourcetype":
-				result["resource_type"] = *condition.Equals
-			case "resourcegroup":
-				result["resource_group"] = *condition.Equals
-			case "resourceid":
-				result["resource_id"] = *condition.Equals
-			case "substatus":
-				result["sub_status"] = *condition.Equals
-			case "caller", "category", "level", "status":
-				result[*condition.Field] = *condition.Equals
+			case "properties.impactedservices[*].servicename":
+				serviceHealth["services"] = append(serviceHealth["services"], values...)
+			case "properties.impactedservices[*].impactedregions[*].regionname":
+				serviceHealth["regions"] = append(serviceHealth["regions"], values...)
 			}
+			continue
+		}
+
+		if condition.Equals == nil {
+			continue
+		}
+
+		switch strings.ToLower(*condition.Field) {
+		case "operationname":
+			result["operation_name"] = *condition.Equals
+		case "resourceprovider":
+			result["resource_provider"] = *condition.Equals
+		case "resourcetype":
+			result["resource_type"] = *condition.Equals
+		case "resourcegroup":
+			result["resource_group"] = *condition.Equals
+		case "resourceid":
+			result["resource_id"] = *condition.Equals
+		case "substatus":
+			result["sub_status"] = *condition.Equals
+		case "caller", "category", "level", "status":
+			result[*condition.Field] = *condition.Equals
+		case "properties.incidenttype":
+			serviceHealth["events"] = append(serviceHealth["events"], *condition.Equals)
+		case "properties.currenthealthstatus":
+			resourceHealth["current"] = append(resourceHealth["current"], *condition.Equals)
+		case "properties.previoushealthstatus":
+			resourceHealth["previous"] = append(resourceHealth["previous"], *condition.Equals)
+		case "properties.cause":
+			resourceHealth["reason"] = append(resourceHealth["reason"], *condition.Equals)
 		}
 	}
+
+	if len(serviceHealth["events"]) > 0 || len(serviceHealth["services"]) > 0 || len(serviceHealth["regions"]) > 0 {
+		result["service_health"] = []interface{}{
+			map[string]interface{}{
+				"events":   schema.NewSet(schema.HashString, serviceHealth["events"]),
+				"services": schema.NewSet(schema.HashString, serviceHealth["services"]),
+				"regions":  schema.NewSet(schema.HashString, serviceHealth["regions"]),
+			},
+		}
+	}
+
+	if len(resourceHealth["current"]) > 0 || len(resourceHealth["previous"]) > 0 || len(resourceHealth["reason"]) > 0 {
+		result["resource_health"] = []interface{}{
+			map[string]interface{}{
+				"current":  schema.NewSet(schema.HashString, resourceHealth["current"]),
+				"previous": schema.NewSet(schema.HashString, resourceHealth["previous"]),
+				"reason":   schema.NewSet(schema.HashString, resourceHealth["reason"]),
+			},
+		}
+	}
+
 	return []interface{}{result}
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
@@ -213,6 +214,66 @@ func TestAccAzureRMMonitorActivityLogAlert_basicAndCompleteUpdate(t *testing.T)
 	})
 }
 
+func TestAccAzureRMMonitorActivityLogAlert_serviceHealth(t *testing.T) {
+	resourceName := "azurerm_monitor_activity_log_alert.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActivityLogAlert_serviceHealth(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.category", "ServiceHealth"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.service_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.service_health.0.events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.service_health.0.services.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.service_health.0.regions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActivityLogAlert_resourceHealth(t *testing.T) {
+	resourceName := "azurerm_monitor_activity_log_alert.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActivityLogAlert_resourceHealth(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.category", "ResourceHealth"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_health.0.current.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_health.0.previous.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_health.0.reason.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAzureRMMonitorActivityLogAlert_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -232,6 +293,58 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMMonitorActivityLogAlert_serviceHealth(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${data.azurerm_subscription.current.id}"]
+
+  criteria {
+    category = "ServiceHealth"
+
+    service_health {
+      events   = ["Incident", "Maintenance"]
+      services = ["Virtual Machines", "Storage"]
+      regions  = ["Global"]
+    }
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActivityLogAlert_resourceHealth(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_resource_group.test.id}"]
+
+  criteria {
+    category = "ResourceHealth"
+
+    resource_health {
+      current  = ["Degraded", "Unavailable"]
+      previous = ["Available"]
+      reason   = ["PlatformInitiated"]
+    }
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMMonitorActivityLogAlert_requiresImport(rInt int, location string) string {
 	template := testAccAzureRMMonitorActivityLogAlert_basic(rInt, location)
 	return fmt.Sprintf(`
@@ -409,3 +522,83 @@ func testCheckAzureRMMonitorActivityLogAlertExists(resourceName string) resource
 		return nil
 	}
 }
+
+func TestMonitorActivityLogAlertCriteria_serviceHealthRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"category":          "ServiceHealth",
+			"operation_name":    "",
+			"caller":            "",
+			"level":             "",
+			"resource_provider": "",
+			"resource_type":     "",
+			"resource_group":    "",
+			"resource_id":       "",
+			"status":            "",
+			"sub_status":        "",
+			"service_health": []interface{}{
+				map[string]interface{}{
+					"events":   schema.NewSet(schema.HashString, []interface{}{"Incident", "Maintenance"}),
+					"services": schema.NewSet(schema.HashString, []interface{}{"Storage"}),
+					"regions":  schema.NewSet(schema.HashString, []interface{}{"West Europe", "North Europe"}),
+				},
+			},
+			"resource_health": []interface{}{},
+		},
+	}
+
+	condition, err := expandMonitorActivityLogAlertCriteria(input)
+	if err != nil {
+		t.Fatalf("Error expanding criteria: %+v", err)
+	}
+
+	// category, the `anyOf` events and the `containsAny` services & regions
+	if len(*condition.AllOf) != 4 {
+		t.Fatalf("Expected 4 conditions but got %d", len(*condition.AllOf))
+	}
+
+	output := flattenMonitorActivityLogAlertCriteria(condition)[0].(map[string]interface{})
+	if output["category"] != "ServiceHealth" {
+		t.Fatalf("Expected the category to be `ServiceHealth` but got %q", output["category"])
+	}
+
+	if _, ok := output["resource_health"]; ok {
+		t.Fatalf("Expected no `resource_health` block")
+	}
+
+	serviceHealth := output["service_health"].([]interface{})[0].(map[string]interface{})
+	for key, expected := range map[string]int{"events": 2, "services": 1, "regions": 2} {
+		if actual := serviceHealth[key].(*schema.Set).Len(); actual != expected {
+			t.Fatalf("Expected %d %s but got %d", expected, key, actual)
+		}
+	}
+}
+
+func TestMonitorActivityLogAlertCriteria_healthRequiresCategory(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"category":          "Administrative",
+			"operation_name":    "",
+			"caller":            "",
+			"level":             "",
+			"resource_provider": "",
+			"resource_type":     "",
+			"resource_group":    "",
+			"resource_id":       "",
+			"status":            "",
+			"sub_status":        "",
+			"service_health":    []interface{}{},
+			"resource_health": []interface{}{
+				map[string]interface{}{
+					"current":  schema.NewSet(schema.HashString, []interface{}{"Degraded"}),
+					"previous": schema.NewSet(schema.HashString, []interface{}{}),
+					"reason":   schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+		},
+	}
+
+	if _, err := expandMonitorActivityLogAlertCriteria(input); err == nil {
+		t.Fatalf("Expected an error when `resource_health` is specified for the `Administrative` category")
+	}
+}
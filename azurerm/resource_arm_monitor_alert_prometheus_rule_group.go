@@ -0,0 +1,603 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Prometheus Rule Groups aren't present in the vendored SDK, so are managed using raw requests
+const monitorPrometheusRuleGroupApiVersion = "2023-03-01"
+
+type monitorPrometheusRuleGroup struct {
+	ID         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Location   *string                               `json:"location,omitempty"`
+	Tags       map[string]*string                    `json:"tags"`
+	Properties *monitorPrometheusRuleGroupProperties `json:"properties,omitempty"`
+}
+
+type monitorPrometheusRuleGroupProperties struct {
+	Description *string                  `json:"description,omitempty"`
+	Enabled     *bool                    `json:"enabled,omitempty"`
+	ClusterName *string                  `json:"clusterName,omitempty"`
+	Scopes      *[]string                `json:"scopes,omitempty"`
+	Interval    *string                  `json:"interval,omitempty"`
+	Rules       *[]monitorPrometheusRule `json:"rules,omitempty"`
+}
+
+type monitorPrometheusRule struct {
+	Record               *string                                    `json:"record,omitempty"`
+	Alert                *string                                    `json:"alert,omitempty"`
+	Enabled              *bool                                      `json:"enabled,omitempty"`
+	Expression           *string                                    `json:"expression,omitempty"`
+	Severity             *int32                                     `json:"severity,omitempty"`
+	For                  *string                                    `json:"for,omitempty"`
+	Labels               map[string]string                          `json:"labels,omitempty"`
+	Annotations          map[string]string                          `json:"annotations,omitempty"`
+	Actions              *[]monitorPrometheusRuleAction             `json:"actions,omitempty"`
+	ResolveConfiguration *monitorPrometheusRuleResolveConfiguration `json:"resolveConfiguration,omitempty"`
+}
+
+type monitorPrometheusRuleAction struct {
+	ActionGroupID    *string           `json:"actionGroupId,omitempty"`
+	ActionProperties map[string]string `json:"actionProperties,omitempty"`
+}
+
+type monitorPrometheusRuleResolveConfiguration struct {
+	AutoResolved  *bool   `json:"autoResolved,omitempty"`
+	TimeToResolve *string `json:"timeToResolve,omitempty"`
+}
+
+func resourceArmMonitorAlertPrometheusRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorAlertPrometheusRuleGroupCreateUpdate,
+		Read:   resourceArmMonitorAlertPrometheusRuleGroupRead,
+		Update: resourceArmMonitorAlertPrometheusRuleGroupCreateUpdate,
+		Delete: resourceArmMonitorAlertPrometheusRuleGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorPrometheusRuleGroupName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			// the IDs of the Monitor Workspaces (and optionally the Kubernetes Cluster) the rules apply to
+			"scopes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			// limits the rules to the metrics of a single Kubernetes Cluster
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"rule_group_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT1M",
+				ValidateFunc: validate.MonitorPrometheusRuleGroupInterval,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						// the name of the Recording Rule - conflicts with `alert`
+						"record": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						// the name of the Alerting Rule - conflicts with `record`
+						"alert": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"for": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIso8601Duration(),
+						},
+
+						"severity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 4),
+						},
+
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"annotations": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"alert_resolution": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_resolved": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"time_to_resolve": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateIso8601Duration(),
+									},
+								},
+							},
+						},
+
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_group_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"action_properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			for i, raw := range diff.Get("rule").([]interface{}) {
+				if raw == nil {
+					continue
+				}
+
+				if err := validateMonitorPrometheusRule(raw.(map[string]interface{})); err != nil {
+					return fmt.Errorf("`rule.%d`: %+v", i, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmMonitorAlertPrometheusRuleGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := monitorPrometheusRuleGroupID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing monitorPrometheusRuleGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorPrometheusRuleGroupApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Prometheus Rule Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_monitor_alert_prometheus_rule_group", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	props := monitorPrometheusRuleGroupProperties{
+		Enabled:  utils.Bool(d.Get("rule_group_enabled").(bool)),
+		Scopes:   utils.ExpandStringArray(d.Get("scopes").([]interface{})),
+		Interval: utils.String(d.Get("interval").(string)),
+		Rules:    expandMonitorPrometheusRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("cluster_name"); ok {
+		props.ClusterName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		props.Description = utils.String(v.(string))
+	}
+
+	parameters := monitorPrometheusRuleGroup{
+		Location:   utils.String(location),
+		Properties: &props,
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, monitorPrometheusRuleGroupApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Prometheus Rule Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read monitorPrometheusRuleGroup
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorPrometheusRuleGroupApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Prometheus Rule Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Prometheus Rule Group %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorAlertPrometheusRuleGroupRead(d, meta)
+}
+
+func resourceArmMonitorAlertPrometheusRuleGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["prometheusRuleGroups"]
+
+	var ruleGroup monitorPrometheusRuleGroup
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), monitorPrometheusRuleGroupApiVersion, &ruleGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Prometheus Rule Group %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Prometheus Rule Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", ruleGroup.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := ruleGroup.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := ruleGroup.Properties; props != nil {
+		d.Set("cluster_name", props.ClusterName)
+		d.Set("description", props.Description)
+		d.Set("interval", props.Interval)
+
+		enabled := true
+		if props.Enabled != nil {
+			enabled = *props.Enabled
+		}
+		d.Set("rule_group_enabled", enabled)
+
+		if err := d.Set("scopes", utils.FlattenStringArray(props.Scopes)); err != nil {
+			return fmt.Errorf("Error setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("rule", flattenMonitorPrometheusRules(props.Rules)); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, ruleGroup.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorAlertPrometheusRuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["prometheusRuleGroups"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), monitorPrometheusRuleGroupApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Prometheus Rule Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func monitorPrometheusRuleGroupID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AlertsManagement/prometheusRuleGroups/%s", subscriptionId, resourceGroup, name)
+}
+
+// validateMonitorPrometheusRule validates that a rule is either a Recording Rule or an Alerting Rule, and that
+// the fields which only apply to Alerting Rules aren't set on a Recording Rule
+func validateMonitorPrometheusRule(rule map[string]interface{}) error {
+	record := rule["record"].(string)
+	alert := rule["alert"].(string)
+
+	if (record == "") == (alert == "") {
+		return fmt.Errorf("exactly one of `record` or `alert` must be specified")
+	}
+
+	if record == "" {
+		return nil
+	}
+
+	if rule["for"].(string) != "" {
+		return fmt.Errorf("`for` can only be specified for an Alerting Rule")
+	}
+
+	if rule["severity"].(int) != 0 {
+		return fmt.Errorf("`severity` can only be specified for an Alerting Rule")
+	}
+
+	if len(rule["annotations"].(map[string]interface{})) > 0 {
+		return fmt.Errorf("`annotations` can only be specified for an Alerting Rule")
+	}
+
+	if len(rule["alert_resolution"].([]interface{})) > 0 {
+		return fmt.Errorf("`alert_resolution` can only be specified for an Alerting Rule")
+	}
+
+	if len(rule["action"].([]interface{})) > 0 {
+		return fmt.Errorf("`action` can only be specified for an Alerting Rule")
+	}
+
+	return nil
+}
+
+func expandMonitorPrometheusRules(input []interface{}) *[]monitorPrometheusRule {
+	rules := make([]monitorPrometheusRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rule := monitorPrometheusRule{
+			Enabled:    utils.Bool(v["enabled"].(bool)),
+			Expression: utils.String(v["expression"].(string)),
+			Labels:     expandMonitorPrometheusRuleMap(v["labels"].(map[string]interface{})),
+		}
+
+		if record := v["record"].(string); record != "" {
+			rule.Record = utils.String(record)
+			rules = append(rules, rule)
+			continue
+		}
+
+		rule.Alert = utils.String(v["alert"].(string))
+		rule.Severity = utils.Int32(int32(v["severity"].(int)))
+		rule.Annotations = expandMonitorPrometheusRuleMap(v["annotations"].(map[string]interface{}))
+		rule.Actions = expandMonitorPrometheusRuleActions(v["action"].([]interface{}))
+		rule.ResolveConfiguration = expandMonitorPrometheusRuleResolveConfiguration(v["alert_resolution"].([]interface{}))
+
+		if f := v["for"].(string); f != "" {
+			rule.For = utils.String(f)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &rules
+}
+
+func expandMonitorPrometheusRuleActions(input []interface{}) *[]monitorPrometheusRuleAction {
+	actions := make([]monitorPrometheusRuleAction, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		actions = append(actions, monitorPrometheusRuleAction{
+			ActionGroupID:    utils.String(v["action_group_id"].(string)),
+			ActionProperties: expandMonitorPrometheusRuleMap(v["action_properties"].(map[string]interface{})),
+		})
+	}
+
+	return &actions
+}
+
+func expandMonitorPrometheusRuleResolveConfiguration(input []interface{}) *monitorPrometheusRuleResolveConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	output := monitorPrometheusRuleResolveConfiguration{
+		AutoResolved: utils.Bool(v["auto_resolved"].(bool)),
+	}
+
+	if timeToResolve := v["time_to_resolve"].(string); timeToResolve != "" {
+		output.TimeToResolve = utils.String(timeToResolve)
+	}
+
+	return &output
+}
+
+func flattenMonitorPrometheusRules(input *[]monitorPrometheusRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		output := map[string]interface{}{
+			"labels":           flattenMonitorPrometheusRuleMap(rule.Labels),
+			"annotations":      flattenMonitorPrometheusRuleMap(rule.Annotations),
+			"action":           flattenMonitorPrometheusRuleActions(rule.Actions),
+			"alert_resolution": flattenMonitorPrometheusRuleResolveConfiguration(rule.ResolveConfiguration),
+		}
+
+		if v := rule.Expression; v != nil {
+			output["expression"] = *v
+		}
+
+		enabled := true
+		if v := rule.Enabled; v != nil {
+			enabled = *v
+		}
+		output["enabled"] = enabled
+
+		if v := rule.Record; v != nil {
+			output["record"] = *v
+		}
+
+		if v := rule.Alert; v != nil {
+			output["alert"] = *v
+		}
+
+		if v := rule.For; v != nil {
+			output["for"] = *v
+		}
+
+		if v := rule.Severity; v != nil {
+			output["severity"] = int(*v)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenMonitorPrometheusRuleActions(input *[]monitorPrometheusRuleAction) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, action := range *input {
+		output := map[string]interface{}{
+			"action_properties": flattenMonitorPrometheusRuleMap(action.ActionProperties),
+		}
+
+		if v := action.ActionGroupID; v != nil {
+			output["action_group_id"] = *v
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenMonitorPrometheusRuleResolveConfiguration(input *monitorPrometheusRuleResolveConfiguration) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	autoResolved := false
+	if input.AutoResolved != nil {
+		autoResolved = *input.AutoResolved
+	}
+
+	timeToResolve := ""
+	if input.TimeToResolve != nil {
+		timeToResolve = *input.TimeToResolve
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"auto_resolved":   autoResolved,
+			"time_to_resolve": timeToResolve,
+		},
+	}
+}
+
+func expandMonitorPrometheusRuleMap(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+func flattenMonitorPrometheusRuleMap(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
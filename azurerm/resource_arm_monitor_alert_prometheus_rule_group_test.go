@@ -0,0 +1,332 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestValidateMonitorPrometheusRule(t *testing.T) {
+	rule := func(record, alert string) map[string]interface{} {
+		return map[string]interface{}{
+			"record":           record,
+			"alert":            alert,
+			"for":              "",
+			"severity":         0,
+			"annotations":      map[string]interface{}{},
+			"alert_resolution": []interface{}{},
+			"action":           []interface{}{},
+		}
+	}
+
+	recordingWithSeverity := rule("job:requests:rate5m", "")
+	recordingWithSeverity["severity"] = 2
+
+	recordingWithFor := rule("job:requests:rate5m", "")
+	recordingWithFor["for"] = "PT5M"
+
+	recordingWithAction := rule("job:requests:rate5m", "")
+	recordingWithAction["action"] = []interface{}{map[string]interface{}{}}
+
+	alertingComplete := rule("", "HighErrorRate")
+	alertingComplete["for"] = "PT5M"
+	alertingComplete["severity"] = 2
+	alertingComplete["annotations"] = map[string]interface{}{"summary": "error rate is high"}
+	alertingComplete["action"] = []interface{}{map[string]interface{}{}}
+
+	cases := []struct {
+		Name   string
+		Rule   map[string]interface{}
+		Errors bool
+	}{
+		{Name: "recording", Rule: rule("job:requests:rate5m", "")},
+		{Name: "alerting", Rule: rule("", "HighErrorRate")},
+		{Name: "alerting complete", Rule: alertingComplete},
+		{Name: "neither", Rule: rule("", ""), Errors: true},
+		{Name: "both", Rule: rule("job:requests:rate5m", "HighErrorRate"), Errors: true},
+		{Name: "recording with severity", Rule: recordingWithSeverity, Errors: true},
+		{Name: "recording with for", Rule: recordingWithFor, Errors: true},
+		{Name: "recording with action", Rule: recordingWithAction, Errors: true},
+	}
+
+	for _, tc := range cases {
+		err := validateMonitorPrometheusRule(tc.Rule)
+		if tc.Errors && err == nil {
+			t.Fatalf("Expected %q to error but it didn't", tc.Name)
+		}
+
+		if !tc.Errors && err != nil {
+			t.Fatalf("Expected %q not to error but got: %+v", tc.Name, err)
+		}
+	}
+}
+
+func TestAccAzureRMMonitorAlertPrometheusRuleGroup_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_alert_prometheus_rule_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAlertPrometheusRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_group_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "PT1M"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.record", "job:requests:rate5m"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorAlertPrometheusRuleGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_monitor_alert_prometheus_rule_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAlertPrometheusRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMonitorAlertPrometheusRuleGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_monitor_alert_prometheus_rule_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorAlertPrometheusRuleGroup_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_alert_prometheus_rule_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAlertPrometheusRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorAlertPrometheusRuleGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", fmt.Sprintf("acctestaks%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "interval", "PT5M"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.alert", "HighErrorRate"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.alert_resolution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorAlertPrometheusRuleGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var ruleGroup monitorPrometheusRuleGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, monitorPrometheusRuleGroupApiVersion, &ruleGroup)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Prometheus Rule Group %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Prometheus Rule Group %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorAlertPrometheusRuleGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_alert_prometheus_rule_group" {
+			continue
+		}
+
+		var ruleGroup monitorPrometheusRuleGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, monitorPrometheusRuleGroupApiVersion, &ruleGroup)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Prometheus Rule Group still exists:\n%#v", ruleGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorAlertPrometheusRuleGroup_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-mw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorAlertPrometheusRuleGroup_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-prg-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  scopes              = ["${azurerm_monitor_workspace.test.id}"]
+
+  rule {
+    record     = "job:requests:rate5m"
+    expression = "sum by (job) (rate(http_requests_total[5m]))"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorAlertPrometheusRuleGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMonitorAlertPrometheusRuleGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "import" {
+  name                = "${azurerm_monitor_alert_prometheus_rule_group.test.name}"
+  resource_group_name = "${azurerm_monitor_alert_prometheus_rule_group.test.resource_group_name}"
+  location            = "${azurerm_monitor_alert_prometheus_rule_group.test.location}"
+  scopes              = ["${azurerm_monitor_workspace.test.id}"]
+
+  rule {
+    record     = "job:requests:rate5m"
+    expression = "sum by (job) (rate(http_requests_total[5m]))"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMMonitorAlertPrometheusRuleGroup_complete(rInt int, location string) string {
+	template := testAccAzureRMMonitorAlertPrometheusRuleGroup_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-prg-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  scopes              = ["${azurerm_monitor_workspace.test.id}"]
+  cluster_name        = "acctestaks%d"
+  description         = "Acceptance Test Rule Group"
+  interval            = "PT5M"
+
+  rule {
+    record     = "job:requests:rate5m"
+    expression = "sum by (job) (rate(http_requests_total[5m]))"
+
+    labels = {
+      team = "platform"
+    }
+  }
+
+  rule {
+    alert      = "HighErrorRate"
+    expression = "job:requests:rate5m > 100"
+    for        = "PT5M"
+    severity   = 2
+
+    annotations = {
+      summary = "the request rate is too high"
+    }
+
+    alert_resolution {
+      auto_resolved   = true
+      time_to_resolve = "PT10M"
+    }
+
+    action {
+      action_group_id = "${azurerm_monitor_action_group.test.id}"
+
+      action_properties = {
+        "IcM.Title" = "High Error Rate"
+      }
+    }
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, template, rInt, rInt, rInt)
+}
@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure Monitor Workspaces (managed Prometheus) aren't present in the vendored SDK, so are managed using raw requests
+const monitorWorkspaceApiVersion = "2023-04-03"
+
+type monitorWorkspace struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Location   *string                     `json:"location,omitempty"`
+	Tags       map[string]*string          `json:"tags"`
+	Properties *monitorWorkspaceProperties `json:"properties,omitempty"`
+}
+
+type monitorWorkspaceProperties struct {
+	PublicNetworkAccess      *string                                   `json:"publicNetworkAccess,omitempty"`
+	Metrics                  *monitorWorkspaceMetrics                  `json:"metrics,omitempty"`
+	DefaultIngestionSettings *monitorWorkspaceDefaultIngestionSettings `json:"defaultIngestionSettings,omitempty"`
+}
+
+type monitorWorkspaceMetrics struct {
+	PrometheusQueryEndpoint *string `json:"prometheusQueryEndpoint,omitempty"`
+}
+
+type monitorWorkspaceDefaultIngestionSettings struct {
+	DataCollectionEndpointResourceID *string `json:"dataCollectionEndpointResourceId,omitempty"`
+	DataCollectionRuleResourceID     *string `json:"dataCollectionRuleResourceId,omitempty"`
+}
+
+func resourceArmMonitorWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorWorkspaceCreateUpdate,
+		Read:   resourceArmMonitorWorkspaceRead,
+		Update: resourceArmMonitorWorkspaceCreateUpdate,
+		Delete: resourceArmMonitorWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorWorkspaceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"query_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_data_collection_endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_data_collection_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := monitorWorkspaceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing monitorWorkspace
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorWorkspaceApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Monitor Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_monitor_workspace", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	publicNetworkAccess := "Enabled"
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Disabled"
+	}
+
+	parameters := monitorWorkspace{
+		Location: utils.String(location),
+		Properties: &monitorWorkspaceProperties{
+			PublicNetworkAccess: utils.String(publicNetworkAccess),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, monitorWorkspaceApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Monitor Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read monitorWorkspace
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, monitorWorkspaceApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Monitor Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Monitor Workspace %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorWorkspaceRead(d, meta)
+}
+
+func resourceArmMonitorWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	var workspace monitorWorkspace
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), monitorWorkspaceApiVersion, &workspace)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Monitor Workspace %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Monitor Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", workspace.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := workspace.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := workspace.Properties; props != nil {
+		publicNetworkAccessEnabled := true
+		if v := props.PublicNetworkAccess; v != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*v, "Enabled")
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		if metrics := props.Metrics; metrics != nil {
+			d.Set("query_endpoint", metrics.PrometheusQueryEndpoint)
+		}
+
+		if settings := props.DefaultIngestionSettings; settings != nil {
+			d.Set("default_data_collection_endpoint_id", settings.DataCollectionEndpointResourceID)
+			d.Set("default_data_collection_rule_id", settings.DataCollectionRuleResourceID)
+		}
+	}
+
+	flattenAndSetTags(d, workspace.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), monitorWorkspaceApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Monitor Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func monitorWorkspaceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Monitor/accounts/%s", subscriptionId, resourceGroup, name)
+}
@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMonitorWorkspace_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_workspace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorWorkspace_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_data_collection_endpoint_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_data_collection_rule_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorWorkspace_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_monitor_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMonitorWorkspace_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_monitor_workspace"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorWorkspace_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorWorkspace_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var workspace monitorWorkspace
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, monitorWorkspaceApiVersion, &workspace)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Monitor Workspace %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Monitor Workspace %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorWorkspaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_workspace" {
+			continue
+		}
+
+		var workspace monitorWorkspace
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, monitorWorkspaceApiVersion, &workspace)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Monitor Workspace still exists:\n%#v", workspace)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorWorkspace_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-mw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorWorkspace_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_workspace" "import" {
+  name                = "${azurerm_monitor_workspace.test.name}"
+  resource_group_name = "${azurerm_monitor_workspace.test.resource_group_name}"
+  location            = "${azurerm_monitor_workspace.test.location}"
+}
+`, testAccAzureRMMonitorWorkspace_basic(rInt, location))
+}
+
+func testAccAzureRMMonitorWorkspace_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                          = "acctest-mw-%d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  public_network_access_enabled = false
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the vendored SDK only contains the legacy vault-backed Long Term Retention Policies, so these are managed using raw requests
+const msSqlDatabaseBackupLongTermRetentionPolicyApiVersion = "2021-11-01"
+
+// a retention of `PT0S` disables that retention, which is what the policy is reverted to when the resource is removed
+const msSqlDatabaseBackupLongTermRetentionDisabled = "PT0S"
+
+type msSqlDatabaseBackupLongTermRetentionPolicy struct {
+	ID         *string                                               `json:"id,omitempty"`
+	Properties *msSqlDatabaseBackupLongTermRetentionPolicyProperties `json:"properties,omitempty"`
+}
+
+type msSqlDatabaseBackupLongTermRetentionPolicyProperties struct {
+	WeeklyRetention  *string `json:"weeklyRetention,omitempty"`
+	MonthlyRetention *string `json:"monthlyRetention,omitempty"`
+	YearlyRetention  *string `json:"yearlyRetention,omitempty"`
+	WeekOfYear       *int32  `json:"weekOfYear,omitempty"`
+}
+
+func resourceArmMsSqlDatabaseBackupLongTermRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyCreateUpdate,
+		Read:   resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyRead,
+		Update: resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyCreateUpdate,
+		Delete: resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"weekly_retention": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      msSqlDatabaseBackupLongTermRetentionDisabled,
+				ValidateFunc: validate.MsSqlBackupLongTermRetentionDuration,
+			},
+
+			"monthly_retention": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      msSqlDatabaseBackupLongTermRetentionDisabled,
+				ValidateFunc: validate.MsSqlBackupLongTermRetentionDuration,
+			},
+
+			"yearly_retention": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      msSqlDatabaseBackupLongTermRetentionDisabled,
+				ValidateFunc: validate.MsSqlBackupLongTermRetentionDuration,
+			},
+
+			"week_of_year": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 52),
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupLongTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for MSSQL Database Backup Long Term Retention Policy creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	id := msSqlDatabaseBackupLongTermRetentionPolicyID(meta.(*ArmClient).subscriptionId, resGroup, serverName, databaseName)
+
+	parameters := msSqlDatabaseBackupLongTermRetentionPolicy{
+		Properties: &msSqlDatabaseBackupLongTermRetentionPolicyProperties{
+			WeeklyRetention:  utils.String(d.Get("weekly_retention").(string)),
+			MonthlyRetention: utils.String(d.Get("monthly_retention").(string)),
+			YearlyRetention:  utils.String(d.Get("yearly_retention").(string)),
+			WeekOfYear:       utils.Int32(int32(d.Get("week_of_year").(int))),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, msSqlDatabaseBackupLongTermRetentionPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Long Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupLongTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	var resp msSqlDatabaseBackupLongTermRetentionPolicy
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseBackupLongTermRetentionPolicyApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Backup Long Term Retention Policy %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Backup Long Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("weekly_retention", props.WeeklyRetention)
+		d.Set("monthly_retention", props.MonthlyRetention)
+		d.Set("yearly_retention", props.YearlyRetention)
+		if props.WeekOfYear != nil {
+			d.Set("week_of_year", int(*props.WeekOfYear))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlDatabaseBackupLongTermRetentionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupLongTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	// the policy can't be deleted, so instead each retention is disabled
+	parameters := msSqlDatabaseBackupLongTermRetentionPolicy{
+		Properties: &msSqlDatabaseBackupLongTermRetentionPolicyProperties{
+			WeeklyRetention:  utils.String(msSqlDatabaseBackupLongTermRetentionDisabled),
+			MonthlyRetention: utils.String(msSqlDatabaseBackupLongTermRetentionDisabled),
+			YearlyRetention:  utils.String(msSqlDatabaseBackupLongTermRetentionDisabled),
+			WeekOfYear:       utils.Int32(1),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseBackupLongTermRetentionPolicyApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error resetting Backup Long Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	return nil
+}
+
+func msSqlDatabaseBackupLongTermRetentionPolicyID(subscriptionId, resourceGroup, serverName, databaseName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/backupLongTermRetentionPolicies/default", subscriptionId, resourceGroup, serverName, databaseName)
+}
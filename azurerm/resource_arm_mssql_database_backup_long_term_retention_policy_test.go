@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database_backup_long_term_retention_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "weekly_retention", "P2W"),
+					resource.TestCheckResourceAttr(resourceName, "monthly_retention", "PT0S"),
+					resource.TestCheckResourceAttr(resourceName, "yearly_retention", "PT0S"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_update(t *testing.T) {
+	resourceName := "azurerm_mssql_database_backup_long_term_retention_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "weekly_retention", "P12W"),
+					resource.TestCheckResourceAttr(resourceName, "monthly_retention", "P12M"),
+					resource.TestCheckResourceAttr(resourceName, "yearly_retention", "P5Y"),
+					resource.TestCheckResourceAttr(resourceName, "week_of_year", "16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlBackupLongTermRetentionPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var policy msSqlDatabaseBackupLongTermRetentionPolicy
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseBackupLongTermRetentionPolicyApiVersion, &policy)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Backup Long Term Retention Policy %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Backup Long Term Retention Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseBackupLongTermRetentionPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).msSqlBackupLongTermRetentionPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database_backup_long_term_retention_policy" {
+			continue
+		}
+
+		var policy msSqlDatabaseBackupLongTermRetentionPolicy
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseBackupLongTermRetentionPolicyApiVersion, &policy)
+		if err != nil {
+			// the database is removed alongside the policy
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := policy.Properties; props != nil && props.WeeklyRetention != nil && *props.WeeklyRetention != msSqlDatabaseBackupLongTermRetentionDisabled {
+			return fmt.Errorf("Backup Long Term Retention Policy %q was not disabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_backup_long_term_retention_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.test.name}"
+  weekly_retention    = "P2W"
+}
+`, template)
+}
+
+func testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_complete(rInt int, location string) string {
+	template := testAccAzureRMMsSqlDatabaseBackupLongTermRetentionPolicy_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_backup_long_term_retention_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.test.name}"
+  weekly_retention    = "P12W"
+  monthly_retention   = "P12M"
+  yearly_retention    = "P5Y"
+  week_of_year        = 16
+}
+`, template)
+}
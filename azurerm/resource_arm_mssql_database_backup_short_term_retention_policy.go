@@ -0,0 +1,157 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the service default, which is what the policy is reverted to when the resource is removed
+const msSqlDatabaseBackupShortTermRetentionDefaultDays = 7
+
+func resourceArmMsSqlDatabaseBackupShortTermRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyCreateUpdate,
+		Read:   resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyRead,
+		Update: resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyCreateUpdate,
+		Delete: resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(7, 35),
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for MSSQL Database Backup Short Term Retention Policy creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	retentionDays := d.Get("retention_days").(int)
+
+	parameters := sql.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sql.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(retentionDays)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, databaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error issuing get request for Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q) ID", databaseName, serverName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	resp, err := client.Get(ctx, resGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Backup Short Term Retention Policy %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := resp.BackupShortTermRetentionPolicyProperties; props != nil {
+		d.Set("retention_days", props.RetentionDays)
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlDatabaseBackupShortTermRetentionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	// the policy can't be deleted, so instead we revert it to the service default
+	parameters := sql.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sql.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(msSqlDatabaseBackupShortTermRetentionDefaultDays)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, databaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error resetting Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for reset of Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlDatabaseBackupShortTermRetentionPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database_backup_short_term_retention_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseBackupShortTermRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseBackupShortTermRetentionPolicy_basic(ri, location, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseBackupShortTermRetentionPolicyExists(resourceName, 14),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "14"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseBackupShortTermRetentionPolicy_basic(ri, location, 35),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseBackupShortTermRetentionPolicyExists(resourceName, 35),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "35"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlDatabaseBackupShortTermRetentionPolicyExists(resourceName string, retentionDays int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q) does not exist", databaseName, serverName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on msSqlBackupShortTermRetentionPoliciesClient: %+v", err)
+		}
+
+		if props := resp.BackupShortTermRetentionPolicyProperties; props == nil || props.RetentionDays == nil || int(*props.RetentionDays) != retentionDays {
+			return fmt.Errorf("Bad: Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q) does not have a retention of %d days", databaseName, serverName, resourceGroup, retentionDays)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseBackupShortTermRetentionPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database_backup_short_term_retention_policy" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			// the database is removed alongside the policy
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := resp.BackupShortTermRetentionPolicyProperties; props != nil && props.RetentionDays != nil && *props.RetentionDays != msSqlDatabaseBackupShortTermRetentionDefaultDays {
+			return fmt.Errorf("Backup Short Term Retention Policy (MSSQL Database %q / Server %q / Resource Group %q) was not reset to the default", databaseName, serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabaseBackupShortTermRetentionPolicy_basic(rInt int, location string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_mssql_database_backup_short_term_retention_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.test.name}"
+  retention_days      = %[3]d
+}
+`, rInt, location, retentionDays)
+}
@@ -0,0 +1,376 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmMsSqlDatabaseReplicationLink manages a Geo-Replication Link by creating a Geo-Secondary of the Database
+// (with the same name) on the Partner Server. The Link is identified from the perspective of `database_id`, such that
+// after a Failover the Link is still read from the same Database - which allows `role` to be used to Failover.
+func resourceArmMsSqlDatabaseReplicationLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseReplicationLinkCreate,
+		Read:   resourceArmMsSqlDatabaseReplicationLinkRead,
+		Update: resourceArmMsSqlDatabaseReplicationLinkUpdate,
+		Delete: resourceArmMsSqlDatabaseReplicationLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"partner_server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"readable_secondary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			// the desired role of the Database specified in `database_id` - changing this triggers a Failover
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.ReplicationRolePrimary),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ReplicationRolePrimary),
+					string(sql.ReplicationRoleSecondary),
+				}, false),
+			},
+
+			"failover_allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"partner_database_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"partner_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"partner_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replication_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replication_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"percent_complete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseReplicationLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	databasesClient := meta.(*ArmClient).sqlDatabasesClient
+	serversClient := meta.(*ArmClient).sqlServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	databaseId := d.Get("database_id").(string)
+	database, err := parseAzureResourceID(databaseId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := database.ResourceGroup
+	serverName := database.Path["servers"]
+	databaseName := database.Path["databases"]
+
+	partnerServer, err := parseAzureResourceID(d.Get("partner_server_id").(string))
+	if err != nil {
+		return err
+	}
+	partnerResourceGroup := partnerServer.ResourceGroup
+	partnerServerName := partnerServer.Path["servers"]
+
+	if requireResourcesToBeImported {
+		existing, err := findArmMsSqlDatabaseReplicationLink(d, meta, resourceGroup, serverName, databaseName, partnerServerName)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil && existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_database_replication_link", *existing.ID)
+		}
+	}
+
+	server, err := serversClient.Get(ctx, partnerResourceGroup, partnerServerName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Partner SQL Server %q (Resource Group %q): %+v", partnerServerName, partnerResourceGroup, err)
+	}
+
+	createMode := sql.OnlineSecondary
+	if !d.Get("readable_secondary").(bool) {
+		createMode = sql.NonReadableSecondary
+	}
+
+	secondary := sql.Database{
+		Location: server.Location,
+		DatabaseProperties: &sql.DatabaseProperties{
+			CreateMode:       createMode,
+			SourceDatabaseID: utils.String(databaseId),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Geo-Secondary of SQL Database %q (Server %q / Resource Group %q) on Server %q (Resource Group %q)..", databaseName, serverName, resourceGroup, partnerServerName, partnerResourceGroup)
+	future, err := databasesClient.CreateOrUpdate(ctx, partnerResourceGroup, partnerServerName, databaseName, secondary)
+	if err != nil {
+		return fmt.Errorf("Error creating Geo-Secondary of SQL Database %q (Server %q / Resource Group %q) on Server %q (Resource Group %q): %+v", databaseName, serverName, resourceGroup, partnerServerName, partnerResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, databasesClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for Geo-Secondary of SQL Database %q (Server %q / Resource Group %q) to be created on Server %q (Resource Group %q): %+v", databaseName, serverName, resourceGroup, partnerServerName, partnerResourceGroup, err)
+	}
+
+	meta.(*ArmClient).sqlServerCache.invalidate(partnerResourceGroup, partnerServerName)
+
+	link, err := findArmMsSqlDatabaseReplicationLink(d, meta, resourceGroup, serverName, databaseName, partnerServerName)
+	if err != nil {
+		return err
+	}
+
+	if link == nil || link.ID == nil {
+		return fmt.Errorf("Cannot find the Replication Link between SQL Database %q (Server %q / Resource Group %q) and Server %q", databaseName, serverName, resourceGroup, partnerServerName)
+	}
+
+	d.SetId(*link.ID)
+
+	// a Failover can only be performed once the Link has been created, so the Database is always initially the Primary
+	if d.Get("role").(string) != string(sql.ReplicationRolePrimary) {
+		return resourceArmMsSqlDatabaseReplicationLinkUpdate(d, meta)
+	}
+
+	return resourceArmMsSqlDatabaseReplicationLinkRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseReplicationLinkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	linkId := id.Path["replicationLinks"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, linkId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Replication Link %q for SQL Database %q (Server %q / Resource Group %q) was not found - removing from state", linkId, databaseName, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Replication Link %q for SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("database_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s", id.SubscriptionID, resourceGroup, serverName, databaseName))
+
+	if props := resp.ReplicationLinkProperties; props != nil {
+		d.Set("role", string(props.Role))
+		d.Set("partner_role", string(props.PartnerRole))
+		if location := props.PartnerLocation; location != nil {
+			d.Set("partner_location", azureRMNormalizeLocation(*location))
+		}
+		d.Set("replication_mode", props.ReplicationMode)
+		d.Set("replication_state", string(props.ReplicationState))
+
+		percentComplete := 0
+		if props.PercentComplete != nil {
+			percentComplete = int(*props.PercentComplete)
+		}
+		d.Set("percent_complete", percentComplete)
+
+		startTime := ""
+		if props.StartTime != nil {
+			startTime = props.StartTime.String()
+		}
+		d.Set("start_time", startTime)
+
+		// the API only returns the name of the Partner Server, so the Resource Group is taken from the configuration
+		if partnerServerId := d.Get("partner_server_id").(string); partnerServerId != "" && props.PartnerDatabase != nil {
+			d.Set("partner_database_id", fmt.Sprintf("%s/databases/%s", partnerServerId, *props.PartnerDatabase))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlDatabaseReplicationLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	linkId := id.Path["replicationLinks"]
+
+	current, err := client.Get(ctx, resourceGroup, serverName, databaseName, linkId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Replication Link %q for SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+	}
+
+	role := d.Get("role").(string)
+	if props := current.ReplicationLinkProperties; props != nil && !strings.EqualFold(string(props.Role), role) {
+		// a Failover is performed against the Secondary, which then becomes the Primary - the Link ID is the same on both
+		failoverResourceGroup := resourceGroup
+		failoverServerName := serverName
+		failoverDatabaseName := databaseName
+		if role == string(sql.ReplicationRoleSecondary) {
+			partnerServer, err := parseAzureResourceID(d.Get("partner_server_id").(string))
+			if err != nil {
+				return err
+			}
+
+			failoverResourceGroup = partnerServer.ResourceGroup
+			failoverServerName = partnerServer.Path["servers"]
+			if props.PartnerDatabase != nil {
+				failoverDatabaseName = *props.PartnerDatabase
+			}
+		}
+
+		if err := failoverArmMsSqlDatabaseReplicationLink(d, meta, failoverResourceGroup, failoverServerName, failoverDatabaseName, linkId); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmMsSqlDatabaseReplicationLinkRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseReplicationLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	linkId := id.Path["replicationLinks"]
+
+	// terminating the Link leaves the Geo-Secondary in place as a standalone Database
+	resp, err := client.Delete(ctx, resourceGroup, serverName, databaseName, linkId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Replication Link %q for SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func failoverArmMsSqlDatabaseReplicationLink(d *schema.ResourceData, meta interface{}, resourceGroup, serverName, databaseName, linkId string) error {
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	if d.Get("failover_allow_data_loss").(bool) {
+		log.Printf("[DEBUG] Performing a Forced Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q)..", linkId, databaseName, serverName, resourceGroup)
+		future, err := client.FailoverAllowDataLoss(ctx, resourceGroup, serverName, databaseName, linkId)
+		if err != nil {
+			return fmt.Errorf("Error performing Forced Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Forced Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Performing a Planned Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q)..", linkId, databaseName, serverName, resourceGroup)
+	future, err := client.Failover(ctx, resourceGroup, serverName, databaseName, linkId)
+	if err != nil {
+		return fmt.Errorf("Error performing Planned Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Planned Failover of Replication Link %q to SQL Database %q (Server %q / Resource Group %q): %+v", linkId, databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// findArmMsSqlDatabaseReplicationLink returns the Replication Link between the specified Database and the
+// Database of the same name on the Partner Server, if one exists
+func findArmMsSqlDatabaseReplicationLink(d *schema.ResourceData, meta interface{}, resourceGroup, serverName, databaseName, partnerServerName string) (*sql.ReplicationLink, error) {
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.ListByDatabase(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Replication Links for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	if resp.Value == nil {
+		return nil, nil
+	}
+
+	for _, link := range *resp.Value {
+		props := link.ReplicationLinkProperties
+		if props == nil || props.PartnerServer == nil || props.PartnerDatabase == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.PartnerServer, partnerServerName) && strings.EqualFold(*props.PartnerDatabase, databaseName) {
+			return &link, nil
+		}
+	}
+
+	return nil, nil
+}
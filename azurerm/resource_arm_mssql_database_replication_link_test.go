@@ -0,0 +1,237 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlDatabaseReplicationLink_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database_replication_link.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseReplicationLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseReplicationLink_basic(ri, testLocation(), testAltLocation(), "Primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "partner_role", "Secondary"),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_state"),
+					resource.TestCheckResourceAttrSet(resourceName, "partner_database_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partner_server_id", "partner_database_id", "readable_secondary"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseReplicationLink_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_database_replication_link.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseReplicationLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseReplicationLink_basic(ri, location, altLocation, "Primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlDatabaseReplicationLink_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_mssql_database_replication_link"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseReplicationLink_failover(t *testing.T) {
+	resourceName := "azurerm_mssql_database_replication_link.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseReplicationLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseReplicationLink_basic(ri, location, altLocation, "Primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseReplicationLink_basic(ri, location, altLocation, "Secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Secondary"),
+					resource.TestCheckResourceAttr(resourceName, "partner_role", "Primary"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseReplicationLink_basic(ri, location, altLocation, "Primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlDatabaseReplicationLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		serverName := id.Path["servers"]
+		databaseName := id.Path["databases"]
+		linkId := id.Path["replicationLinks"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlReplicationLinksClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, linkId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Replication Link %q (SQL Database %q / Server %q / Resource Group %q) does not exist", linkId, databaseName, serverName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on sqlReplicationLinksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseReplicationLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlReplicationLinksClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database_replication_link" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		serverName := id.Path["servers"]
+		databaseName := id.Path["databases"]
+		linkId := id.Path["replicationLinks"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, linkId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Replication Link %q (SQL Database %q / Server %q / Resource Group %q) still exists", linkId, databaseName, serverName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabaseReplicationLink_template(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "primary" {
+  name                         = "acctestsqlserver%[1]d-primary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+  name                         = "acctestsqlserver%[1]d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "%[3]s"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.primary.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, altLocation)
+}
+
+func testAccAzureRMMsSqlDatabaseReplicationLink_basic(rInt int, location, altLocation, role string) string {
+	template := testAccAzureRMMsSqlDatabaseReplicationLink_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_replication_link" "test" {
+  database_id       = "${azurerm_sql_database.test.id}"
+  partner_server_id = "${azurerm_sql_server.secondary.id}"
+  role              = "%s"
+}
+`, template, role)
+}
+
+func testAccAzureRMMsSqlDatabaseReplicationLink_requiresImport(rInt int, location, altLocation string) string {
+	template := testAccAzureRMMsSqlDatabaseReplicationLink_basic(rInt, location, altLocation, "Primary")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_replication_link" "import" {
+  database_id       = "${azurerm_mssql_database_replication_link.test.database_id}"
+  partner_server_id = "${azurerm_mssql_database_replication_link.test.partner_server_id}"
+}
+`, template)
+}
@@ -0,0 +1,242 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type msSqlDatabaseWorkloadClassifier struct {
+	ID         *string                                    `json:"id,omitempty"`
+	Name       *string                                    `json:"name,omitempty"`
+	Properties *msSqlDatabaseWorkloadClassifierProperties `json:"properties,omitempty"`
+}
+
+type msSqlDatabaseWorkloadClassifierProperties struct {
+	MemberName *string `json:"memberName,omitempty"`
+	Label      *string `json:"label,omitempty"`
+	Context    *string `json:"context,omitempty"`
+	StartTime  *string `json:"startTime,omitempty"`
+	EndTime    *string `json:"endTime,omitempty"`
+	Importance *string `json:"importance,omitempty"`
+}
+
+func resourceArmMsSqlDatabaseWorkloadClassifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseWorkloadClassifierCreateUpdate,
+		Read:   resourceArmMsSqlDatabaseWorkloadClassifierRead,
+		Update: resourceArmMsSqlDatabaseWorkloadClassifierCreateUpdate,
+		Delete: resourceArmMsSqlDatabaseWorkloadClassifierDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"workload_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the Database User or Role the Classifier applies to
+			"member_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMsSqlDatabaseWorkloadClassifierTime,
+			},
+
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMsSqlDatabaseWorkloadClassifierTime,
+			},
+
+			"importance": msSqlDatabaseWorkloadImportanceSchema(),
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseWorkloadClassifierCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	workloadGroupId := d.Get("workload_group_id").(string)
+	id := fmt.Sprintf("%s/workloadClassifiers/%s", workloadGroupId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing msSqlDatabaseWorkloadClassifier
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Workload Classifier %q (Workload Group %q): %+v", name, workloadGroupId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_database_workload_classifier", *existing.ID)
+		}
+	}
+
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+	if (startTime == "") != (endTime == "") {
+		return fmt.Errorf("`start_time` and `end_time` must be specified together")
+	}
+
+	properties := msSqlDatabaseWorkloadClassifierProperties{
+		MemberName: utils.String(d.Get("member_name").(string)),
+		Importance: utils.String(d.Get("importance").(string)),
+	}
+
+	if v := d.Get("label").(string); v != "" {
+		properties.Label = utils.String(v)
+	}
+
+	if v := d.Get("context").(string); v != "" {
+		properties.Context = utils.String(v)
+	}
+
+	if startTime != "" {
+		properties.StartTime = utils.String(startTime)
+		properties.EndTime = utils.String(endTime)
+	}
+
+	parameters := msSqlDatabaseWorkloadClassifier{
+		Properties: &properties,
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Workload Classifier %q (Workload Group %q): %+v", name, workloadGroupId, err)
+	}
+
+	var read msSqlDatabaseWorkloadClassifier
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Workload Classifier %q (Workload Group %q): %+v", name, workloadGroupId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Workload Classifier %q (Workload Group %q)", name, workloadGroupId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlDatabaseWorkloadClassifierRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseWorkloadClassifierRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	workloadGroupName := id.Path["workloadGroups"]
+	name := id.Path["workloadClassifiers"]
+
+	var classifier msSqlDatabaseWorkloadClassifier
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseWorkloadApiVersion, &classifier)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Workload Classifier %q was not found in Workload Group %q (Database %q) - removing from state", name, workloadGroupName, databaseName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Workload Classifier %q (Workload Group %q / Database %q / Server %q): %+v", name, workloadGroupName, databaseName, serverName, err)
+	}
+
+	d.Set("name", classifier.Name)
+	d.Set("workload_group_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/workloadGroups/%s", id.SubscriptionID, id.ResourceGroup, serverName, databaseName, workloadGroupName))
+
+	if props := classifier.Properties; props != nil {
+		d.Set("member_name", props.MemberName)
+		d.Set("label", props.Label)
+		d.Set("context", props.Context)
+		d.Set("start_time", props.StartTime)
+		d.Set("end_time", props.EndTime)
+		d.Set("importance", props.Importance)
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlDatabaseWorkloadClassifierDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	workloadGroupName := id.Path["workloadGroups"]
+	name := id.Path["workloadClassifiers"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseWorkloadApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Workload Classifier %q (Workload Group %q / Database %q / Server %q): %+v", name, workloadGroupName, databaseName, serverName, err)
+	}
+
+	return nil
+}
+
+// validateMsSqlDatabaseWorkloadClassifierTime validates a time of day in the format `HH:MM` (UTC)
+func validateMsSqlDatabaseWorkloadClassifierTime(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a time in the format `HH:MM`, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database_workload_classifier.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadClassifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_name", "dbo"),
+					resource.TestCheckResourceAttr(resourceName, "importance", "normal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseWorkloadClassifier_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_database_workload_classifier.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadClassifierExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlDatabaseWorkloadClassifier_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mssql_database_workload_classifier"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseWorkloadClassifier_update(t *testing.T) {
+	resourceName := "azurerm_mssql_database_workload_classifier.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadClassifierExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadClassifier_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadClassifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "label", "dailyload"),
+					resource.TestCheckResourceAttr(resourceName, "context", "dashboard"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "09:00"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "17:00"),
+					resource.TestCheckResourceAttr(resourceName, "importance", "above_normal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateMsSqlDatabaseWorkloadClassifierTime(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "00:00",
+			Errors: 0,
+		},
+		{
+			Value:  "23:59",
+			Errors: 0,
+		},
+		{
+			Value:  "24:00",
+			Errors: 1,
+		},
+		{
+			Value:  "12:60",
+			Errors: 1,
+		},
+		{
+			Value:  "9:00",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMsSqlDatabaseWorkloadClassifierTime(tc.Value, "start_time")
+
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected validateMsSqlDatabaseWorkloadClassifierTime to return %d errors for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseWorkloadClassifierExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing msSqlDatabaseWorkloadClassifier
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Workload Classifier %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Workload Classifier %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseWorkloadClassifierDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database_workload_classifier" {
+			continue
+		}
+
+		var existing msSqlDatabaseWorkloadClassifier
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Workload Classifier %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_classifier" "test" {
+  name              = "acctestwc%d"
+  workload_group_id = "${azurerm_mssql_database_workload_group.test.id}"
+  member_name       = "dbo"
+}
+`, testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadClassifier_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_classifier" "import" {
+  name              = "${azurerm_mssql_database_workload_classifier.test.name}"
+  workload_group_id = "${azurerm_mssql_database_workload_classifier.test.workload_group_id}"
+  member_name       = "${azurerm_mssql_database_workload_classifier.test.member_name}"
+}
+`, testAccAzureRMMsSqlDatabaseWorkloadClassifier_basic(rInt, location))
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadClassifier_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_classifier" "test" {
+  name              = "acctestwc%d"
+  workload_group_id = "${azurerm_mssql_database_workload_group.test.id}"
+  member_name       = "dbo"
+  label             = "dailyload"
+  context           = "dashboard"
+  start_time        = "09:00"
+  end_time          = "17:00"
+  importance        = "above_normal"
+}
+`, testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(rInt, location), rInt)
+}
@@ -0,0 +1,242 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Workload Groups & Classifiers aren't present in the vendored SDK, so are managed using raw requests
+const msSqlDatabaseWorkloadApiVersion = "2019-06-01-preview"
+
+type msSqlDatabaseWorkloadGroup struct {
+	ID         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *msSqlDatabaseWorkloadGroupProperties `json:"properties,omitempty"`
+}
+
+type msSqlDatabaseWorkloadGroupProperties struct {
+	MinResourcePercent           *int32   `json:"minResourcePercent,omitempty"`
+	MaxResourcePercent           *int32   `json:"maxResourcePercent,omitempty"`
+	MinResourcePercentPerRequest *float64 `json:"minResourcePercentPerRequest,omitempty"`
+	MaxResourcePercentPerRequest *float64 `json:"maxResourcePercentPerRequest,omitempty"`
+	Importance                   *string  `json:"importance,omitempty"`
+	QueryExecutionTimeout        *int32   `json:"queryExecutionTimeout,omitempty"`
+}
+
+func msSqlDatabaseWorkloadImportanceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "normal",
+		ValidateFunc: validation.StringInSlice([]string{
+			"low",
+			"below_normal",
+			"normal",
+			"above_normal",
+			"high",
+		}, false),
+	}
+}
+
+func resourceArmMsSqlDatabaseWorkloadGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseWorkloadGroupCreateUpdate,
+		Read:   resourceArmMsSqlDatabaseWorkloadGroupRead,
+		Update: resourceArmMsSqlDatabaseWorkloadGroupCreateUpdate,
+		Delete: resourceArmMsSqlDatabaseWorkloadGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"min_resource_percent": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+
+			"max_resource_percent": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"min_resource_percent_per_request": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validate.FloatBetween(0, 100),
+			},
+
+			"max_resource_percent_per_request": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validate.FloatBetween(0, 100),
+			},
+
+			"importance": msSqlDatabaseWorkloadImportanceSchema(),
+
+			"query_execution_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseWorkloadGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	databaseId := d.Get("database_id").(string)
+	id := fmt.Sprintf("%s/workloadGroups/%s", databaseId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing msSqlDatabaseWorkloadGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Workload Group %q (Database %q): %+v", name, databaseId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_database_workload_group", *existing.ID)
+		}
+	}
+
+	minResourcePercent := d.Get("min_resource_percent").(int)
+	maxResourcePercent := d.Get("max_resource_percent").(int)
+	if minResourcePercent > maxResourcePercent {
+		return fmt.Errorf("`min_resource_percent` (%d) cannot be greater than `max_resource_percent` (%d)", minResourcePercent, maxResourcePercent)
+	}
+
+	parameters := msSqlDatabaseWorkloadGroup{
+		Properties: &msSqlDatabaseWorkloadGroupProperties{
+			MinResourcePercent:           utils.Int32(int32(minResourcePercent)),
+			MaxResourcePercent:           utils.Int32(int32(maxResourcePercent)),
+			MinResourcePercentPerRequest: utils.Float(d.Get("min_resource_percent_per_request").(float64)),
+			MaxResourcePercentPerRequest: utils.Float(d.Get("max_resource_percent_per_request").(float64)),
+			Importance:                   utils.String(d.Get("importance").(string)),
+			QueryExecutionTimeout:        utils.Int32(int32(d.Get("query_execution_timeout_in_seconds").(int))),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Workload Group %q (Database %q): %+v", name, databaseId, err)
+	}
+
+	var read msSqlDatabaseWorkloadGroup
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlDatabaseWorkloadApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Workload Group %q (Database %q): %+v", name, databaseId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Workload Group %q (Database %q)", name, databaseId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlDatabaseWorkloadGroupRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseWorkloadGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	name := id.Path["workloadGroups"]
+
+	var group msSqlDatabaseWorkloadGroup
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseWorkloadApiVersion, &group)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Workload Group %q was not found in Database %q (Server %q) - removing from state", name, databaseName, serverName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Workload Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", group.Name)
+	d.Set("database_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s", id.SubscriptionID, id.ResourceGroup, serverName, databaseName))
+
+	if props := group.Properties; props != nil {
+		if v := props.MinResourcePercent; v != nil {
+			d.Set("min_resource_percent", int(*v))
+		}
+
+		if v := props.MaxResourcePercent; v != nil {
+			d.Set("max_resource_percent", int(*v))
+		}
+
+		d.Set("min_resource_percent_per_request", props.MinResourcePercentPerRequest)
+		d.Set("max_resource_percent_per_request", props.MaxResourcePercentPerRequest)
+		d.Set("importance", props.Importance)
+
+		queryExecutionTimeout := 0
+		if v := props.QueryExecutionTimeout; v != nil {
+			queryExecutionTimeout = int(*v)
+		}
+		d.Set("query_execution_timeout_in_seconds", queryExecutionTimeout)
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlDatabaseWorkloadGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	name := id.Path["workloadGroups"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), msSqlDatabaseWorkloadApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Workload Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
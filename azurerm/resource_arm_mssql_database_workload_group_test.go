@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlDatabaseWorkloadGroup_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database_workload_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "min_resource_percent", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_resource_percent", "100"),
+					resource.TestCheckResourceAttr(resourceName, "importance", "normal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseWorkloadGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_database_workload_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlDatabaseWorkloadGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mssql_database_workload_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabaseWorkloadGroup_update(t *testing.T) {
+	resourceName := "azurerm_mssql_database_workload_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseWorkloadGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlDatabaseWorkloadGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseWorkloadGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "min_resource_percent", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_resource_percent", "50"),
+					resource.TestCheckResourceAttr(resourceName, "max_resource_percent_per_request", "25"),
+					resource.TestCheckResourceAttr(resourceName, "importance", "high"),
+					resource.TestCheckResourceAttr(resourceName, "query_execution_timeout_in_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlDatabaseWorkloadGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing msSqlDatabaseWorkloadGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Workload Group %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Workload Group %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseWorkloadGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database_workload_group" {
+			continue
+		}
+
+		var existing msSqlDatabaseWorkloadGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlDatabaseWorkloadApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Workload Group %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabaseWorkload_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "DataWarehouse"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  requested_service_objective_name = "DW100c"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_group" "test" {
+  name                             = "acctestwg%d"
+  database_id                      = "${azurerm_sql_database.test.id}"
+  min_resource_percent             = 0
+  max_resource_percent             = 100
+  min_resource_percent_per_request = 3
+}
+`, testAccAzureRMMsSqlDatabaseWorkload_template(rInt, location), rInt)
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadGroup_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_group" "import" {
+  name                             = "${azurerm_mssql_database_workload_group.test.name}"
+  database_id                      = "${azurerm_mssql_database_workload_group.test.database_id}"
+  min_resource_percent             = "${azurerm_mssql_database_workload_group.test.min_resource_percent}"
+  max_resource_percent             = "${azurerm_mssql_database_workload_group.test.max_resource_percent}"
+  min_resource_percent_per_request = "${azurerm_mssql_database_workload_group.test.min_resource_percent_per_request}"
+}
+`, testAccAzureRMMsSqlDatabaseWorkloadGroup_basic(rInt, location))
+}
+
+func testAccAzureRMMsSqlDatabaseWorkloadGroup_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_workload_group" "test" {
+  name                               = "acctestwg%d"
+  database_id                        = "${azurerm_sql_database.test.id}"
+  min_resource_percent               = 10
+  max_resource_percent               = 50
+  min_resource_percent_per_request   = 5
+  max_resource_percent_per_request   = 25
+  importance                         = "high"
+  query_execution_timeout_in_seconds = 3600
+}
+`, testAccAzureRMMsSqlDatabaseWorkload_template(rInt, location), rInt)
+}
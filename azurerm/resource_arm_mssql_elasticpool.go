@@ -1,21 +1,44 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/pricing"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the Preferred Enclave Type and High Availability Replica Count aren't available in the vendored SDK, so these are
+// read & updated using a newer API Version - which is also used for `response_export_values`, so that properties
+// added to the API since can be exported without waiting on the SDK
+const msSqlElasticPoolPreviewApiVersion = "2023-05-01-preview"
+
+// migrating the vCore family of an Elastic Pool moves each Database onto new hardware, which can take considerably
+// longer than other updates - so this is waited on for at least this long
+const msSqlElasticPoolFamilyMigrationTimeout = 6 * time.Hour
+
+type msSqlElasticPoolPreview struct {
+	Properties *msSqlElasticPoolPreviewProperties `json:"properties,omitempty"`
+}
+
+type msSqlElasticPoolPreviewProperties struct {
+	PreferredEnclaveType         *string `json:"preferredEnclaveType,omitempty"`
+	HighAvailabilityReplicaCount *int32  `json:"highAvailabilityReplicaCount,omitempty"`
+}
+
 func resourceArmMsSqlElasticPool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmMsSqlElasticPoolCreateUpdate,
@@ -26,6 +49,9 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		MigrateState:  resourceAzureRMMsSqlElasticPoolMigrateState,
+		SchemaVersion: 1,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -54,15 +80,8 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"BasicPool",
-								"StandardPool",
-								"PremiumPool",
-								"GP_Gen4",
-								"GP_Gen5",
-								"BC_Gen4",
-								"BC_Gen5",
-							}, true),
+							// validated against the known SKUs in the CustomizeDiff, so that this can be relaxed
+							ValidateFunc:     validate.NoEmptyStrings,
 							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
@@ -75,13 +94,8 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 						"tier": {
 							Type:     schema.TypeString,
 							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"Basic",
-								"Standard",
-								"Premium",
-								"GeneralPurpose",
-								"BusinessCritical",
-							}, true),
+							// validated against the known SKUs in the CustomizeDiff, so that this can be relaxed
+							ValidateFunc:     validate.NoEmptyStrings,
 							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
@@ -98,9 +112,23 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 				},
 			},
 
+			// changing the family migrates the Elastic Pool (and its Databases) onto the new hardware in-place
+			"vcore_family_upgrade_in_place": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"pending_family_migration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			// when omitted these default to `0` through to the largest per-database capacity supported by the SKU
 			"per_database_settings": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -160,95 +188,139 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 			},
 
 			"max_size_bytes": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"max_size_gb"},
+				ValidateFunc:     validation.IntAtLeast(0),
+				DiffSuppressFunc: msSqlElasticPoolBasicMaxSizeDiffSuppress,
+			},
+
+			"max_size_gb": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"max_size_bytes"},
+				ValidateFunc:     validate.FloatAtLeast(0),
+				DiffSuppressFunc: msSqlElasticPoolBasicMaxSizeDiffSuppress,
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enclave_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Default",
+					"VBS",
+				}, false),
+			},
+
+			"high_availability_replica_count": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.IntAtLeast(0),
+				ValidateFunc: validation.IntBetween(0, 4),
 			},
 
-			"zone_redundant": {
-				Type:     schema.TypeBool,
+			"estimated_monthly_cost": {
+				Type:     schema.TypeFloat,
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
-		},
+			"fully_qualified_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
-		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			"force_delete_replicated": forceDeleteReplicatedSchema(),
 
-			name, _ := diff.GetOk("sku.0.name")
-			capacity, _ := diff.GetOk("sku.0.capacity")
-			minCapacity, _ := diff.GetOk("per_database_settings.0.min_capacity")
-			maxCapacity, _ := diff.GetOk("per_database_settings.0.max_capacity")
+			"diagnostics": monitorDiagnosticsSchema(),
 
-			if strings.HasPrefix(strings.ToLower(name.(string)), "gp_") {
+			"tags": tagsSchema(),
 
-				if capacity.(int) > 24 {
-					return fmt.Errorf("GeneralPurpose pricing tier only supports upto 24 vCores")
-				}
+			"propagate_tags_to_databases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
-				if capacity.(int) < 1 {
-					return fmt.Errorf("GeneralPurpose pricing tier must have a minimum of 1 vCores")
-				}
+			// the Databases within the source Elastic Pool are copied into this Elastic Pool when it's created
+			"source_elastic_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
 
-				switch {
-				case capacity.(int) == 1:
-				case capacity.(int) == 2:
-				case capacity.(int) == 4:
-				case capacity.(int) == 8:
-				case capacity.(int) == 16:
-				case capacity.(int) == 24:
-				default:
-					return fmt.Errorf("GeneralPurpose pricing tier must have a capacity of 1, 2, 4, 8, 16, or 24 vCores")
-				}
+			"response_export_values": azure.SchemaResponseExportValues(),
+
+			"response_export": azure.SchemaResponseExport(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := recomputeMsSqlElasticPoolDefaultPerDatabaseSettings(diff); err != nil {
+				return err
 			}
 
-			if strings.HasPrefix(strings.ToLower(name.(string)), "bc_") {
-				if capacity.(int) > 80 {
-					return fmt.Errorf("BusinessCritical pricing tier only supports upto 80 vCores")
+			if err := validateMsSqlElasticPoolSku(diff); err != nil {
+				client, ok := v.(*ArmClient)
+				if !ok || !client.relaxedMsSqlSkuValidation {
+					return err
 				}
 
-				if capacity.(int) < 2 {
-					return fmt.Errorf("BusinessCritical pricing tier must have a minimum of 2 vCores")
-				}
+				// Azure regularly ships new SKU/capacity combinations before these checks are updated,
+				// so when requested the API is treated as the source of truth
+				log.Printf("[WARN] MsSQL ElasticPool SKU validation failed (continuing since `relaxed_sku_validation` is enabled): %+v", err)
+			}
+
+			if client, ok := v.(*ArmClient); ok {
+				if err := validateMsSqlElasticPoolLocationCapabilities(diff, client); err != nil {
+					if !client.relaxedMsSqlSkuValidation {
+						return err
+					}
 
-				switch {
-				case capacity.(int) == 1:
-				case capacity.(int) == 2:
-				case capacity.(int) == 4:
-				case capacity.(int) == 8:
-				case capacity.(int) == 16:
-				case capacity.(int) == 24:
-				case capacity.(int) == 32:
-				case capacity.(int) == 40:
-				case capacity.(int) == 80:
-				default:
-					return fmt.Errorf("BusinessCritical pricing tier must have a capacity of 2, 4, 8, 16, 24, 32, 40, or 80 vCores")
+					log.Printf("[WARN] MsSQL ElasticPool Location validation failed (continuing since `relaxed_sku_validation` is enabled): %+v", err)
 				}
 			}
 
-			// Additional checks based of SKU type...
-			if strings.HasPrefix(strings.ToLower(name.(string)), "gp_") || strings.HasPrefix(strings.ToLower(name.(string)), "bc_") {
-				// vCore based
-				if maxCapacity.(float64) > float64(capacity.(int)) {
-					return fmt.Errorf("BusinessCritical and GeneralPurpose pricing tiers perDatabaseSettings maxCapacity must not be higher than the SKUs capacity value")
-				}
+			if err := validateMsSqlElasticPoolFamilyMigration(diff); err != nil {
+				return err
+			}
 
-				if minCapacity.(float64) > maxCapacity.(float64) {
-					return fmt.Errorf("perDatabaseSettings maxCapacity must be greater than or equal to the perDatabaseSettings minCapacity value")
-				}
-			} else {
-				// DTU based
-				if maxCapacity.(float64) != math.Trunc(maxCapacity.(float64)) {
-					return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs must have whole numbers as their maxCapacity")
+			if client, ok := v.(*ArmClient); ok {
+				if err := validateMsSqlElasticPoolFamilyMigrationLocation(diff, client); err != nil {
+					return err
 				}
+			}
 
-				if minCapacity.(float64) != math.Trunc(minCapacity.(float64)) {
-					return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs must have whole numbers as their minCapacity")
-				}
+			if err := validateMsSqlElasticPoolHighAvailabilityReplicaCount(diff); err != nil {
+				return err
+			}
 
-				if minCapacity.(float64) < 0.0 {
-					return fmt.Errorf("BasicPool, StandardPool, and PremiumPool SKUs per_database_settings min_capacity must be equal to or greater than zero")
+			if client, ok := v.(*ArmClient); ok && client.enableCostEstimation {
+				if diff.Id() == "" || diff.HasChange("sku") || diff.HasChange("location") {
+					if !diff.NewValueKnown("location") || !diff.NewValueKnown("sku") {
+						return diff.SetNewComputed("estimated_monthly_cost")
+					}
+
+					location := azureRMNormalizeLocation(diff.Get("location").(string))
+					tier, _ := diff.GetOk("sku.0.tier")
+					family, _ := diff.GetOk("sku.0.family")
+					capacity, _ := diff.GetOk("sku.0.capacity")
+
+					cost, err := azureRmMsSqlElasticPoolEstimatedMonthlyCost(client.StopContext, client.retailPricesClient, location, tier.(string), family.(string), capacity.(int))
+					if err != nil {
+						// cost estimation is informational, so this shouldn't block the plan
+						log.Printf("[WARN] Unable to estimate the monthly cost of MsSQL ElasticPool: %+v", err)
+						return diff.SetNewComputed("estimated_monthly_cost")
+					}
+
+					return diff.SetNew("estimated_monthly_cost", cost)
 				}
 			}
 
@@ -257,6 +329,179 @@ func resourceArmMsSqlElasticPool() *schema.Resource {
 	}
 }
 
+func validateMsSqlElasticPoolSku(diff *schema.ResourceDiff) error {
+	name, _ := diff.GetOk("sku.0.name")
+	tier, _ := diff.GetOk("sku.0.tier")
+	capacity, _ := diff.GetOk("sku.0.capacity")
+	minCapacity, _ := diff.GetOk("per_database_settings.0.min_capacity")
+	maxCapacity, _ := diff.GetOk("per_database_settings.0.max_capacity")
+
+	// the SKU can only be validated once it's known
+	if !diff.NewValueKnown("sku.0.name") || !diff.NewValueKnown("sku.0.tier") || !diff.NewValueKnown("sku.0.capacity") {
+		return nil
+	}
+
+	sku, ok := azure.MsSqlElasticPoolSkuForName(name.(string))
+	if !ok {
+		return fmt.Errorf("`sku.0.name` %q isn't a known SKU - possible values are %s", name.(string), strings.Join(azure.MsSqlElasticPoolSkuNames(), ", "))
+	}
+
+	if !strings.EqualFold(tier.(string), sku.Tier) {
+		return fmt.Errorf("`sku.0.tier` must be %q when using the %q SKU but got %q", sku.Tier, sku.Name, tier.(string))
+	}
+
+	if err := sku.ValidateCapacity(capacity.(int)); err != nil {
+		return err
+	}
+
+	if !sku.SupportsMaxSize {
+		for _, field := range []string{"max_size_bytes", "max_size_gb"} {
+			if v, ok := diff.GetOk(field); ok && diff.HasChange(field) {
+				return fmt.Errorf("`%s` cannot be set to %v for %s pricing tier since the storage grows automatically", field, v, sku.Tier)
+			}
+		}
+	}
+
+	// where omitted the per-database settings are derived from the SKU, so are valid
+	if !diff.NewValueKnown("per_database_settings") || len(diff.Get("per_database_settings").([]interface{})) == 0 {
+		return nil
+	}
+
+	if err := sku.ValidatePerDatabaseCapacity(minCapacity.(float64), maxCapacity.(float64), capacity.(int)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recomputeMsSqlElasticPoolDefaultPerDatabaseSettings marks the per-database settings as computed when the SKU changes
+// and these are still the defaults derived from the previous SKU, so that they're derived from the new SKU rather than
+// the stale values in the state being sent
+func recomputeMsSqlElasticPoolDefaultPerDatabaseSettings(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("sku") || diff.HasChange("per_database_settings") {
+		return nil
+	}
+
+	oldName, _ := diff.GetChange("sku.0.name")
+	oldCapacity, _ := diff.GetChange("sku.0.capacity")
+	defaultMinCapacity, defaultMaxCapacity := msSqlElasticPoolDefaultPerDatabaseCapacity(oldName.(string), oldCapacity.(int))
+
+	minCapacity := diff.Get("per_database_settings.0.min_capacity").(float64)
+	maxCapacity := diff.Get("per_database_settings.0.max_capacity").(float64)
+	if minCapacity != defaultMinCapacity || maxCapacity != defaultMaxCapacity {
+		return nil
+	}
+
+	return diff.SetNewComputed("per_database_settings")
+}
+
+func msSqlElasticPoolDefaultPerDatabaseCapacity(skuName string, capacity int) (float64, float64) {
+	if sku, ok := azure.MsSqlElasticPoolSkuForName(skuName); ok {
+		return sku.DefaultPerDatabaseCapacity(capacity)
+	}
+
+	// SKUs unknown to the provider (when `relaxed_sku_validation` is enabled) can use the capacity of the pool
+	return 0, float64(capacity)
+}
+
+// validateMsSqlElasticPoolLocationCapabilities validates that the SKU is available within the Location (and for the
+// version of the Server, where it exists) using the Capabilities API - since some SKUs (e.g. Business Critical Gen4)
+// aren't available in every region, which otherwise would only fail during the apply
+func validateMsSqlElasticPoolLocationCapabilities(diff *schema.ResourceDiff, client *ArmClient) error {
+	if client.msSqlCapabilitiesCache == nil {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("sku") && !diff.HasChange("location") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("location") || !diff.NewValueKnown("sku.0.name") || !diff.NewValueKnown("sku.0.capacity") {
+		return nil
+	}
+
+	ctx := client.StopContext
+	location := diff.Get("location").(string)
+	skuName := diff.Get("sku.0.name").(string)
+	capacity := diff.Get("sku.0.capacity").(int)
+
+	// the Server may not exist yet, in which case the SKU is validated against every Server Version
+	serverVersion := ""
+	if diff.NewValueKnown("resource_group_name") && diff.NewValueKnown("server_name") {
+		server, err := client.sqlServersClient.Get(ctx, diff.Get("resource_group_name").(string), diff.Get("server_name").(string))
+		if err == nil && server.ServerProperties != nil && server.ServerProperties.Version != nil {
+			serverVersion = *server.ServerProperties.Version
+		}
+	}
+
+	return client.msSqlCapabilitiesCache.validateElasticPoolSku(ctx, client.msSqlCapabilitiesClient, location, serverVersion, skuName, capacity)
+}
+
+// validateMsSqlElasticPoolFamilyMigration validates that the family of an existing Elastic Pool is only changed through
+// the explicit Gen4 to Gen5 migration path - since updating the family otherwise can fail part-way through, leaving
+// some Databases within the Elastic Pool migrated and others not
+func validateMsSqlElasticPoolFamilyMigration(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("sku.0.family") || !diff.NewValueKnown("sku.0.family") {
+		return nil
+	}
+
+	old, new := diff.GetChange("sku.0.family")
+	oldFamily := old.(string)
+	newFamily := new.(string)
+
+	// moving between DTU and vCore based SKUs isn't a migration between hardware generations
+	if oldFamily == "" || newFamily == "" {
+		return nil
+	}
+
+	if !strings.EqualFold(oldFamily, "Gen4") || !strings.EqualFold(newFamily, "Gen5") {
+		return fmt.Errorf("the `sku.0.family` of an Elastic Pool can only be migrated from Gen4 to Gen5 but got %q to %q", oldFamily, newFamily)
+	}
+
+	if !diff.Get("vcore_family_upgrade_in_place").(bool) {
+		return fmt.Errorf("changing the `sku.0.family` from %q to %q migrates the Elastic Pool and its Databases onto new hardware - `vcore_family_upgrade_in_place` must be set to `true` to do so", oldFamily, newFamily)
+	}
+
+	if diff.NewValueKnown("sku.0.name") {
+		if name := diff.Get("sku.0.name").(string); !strings.HasSuffix(strings.ToLower(name), "_"+strings.ToLower(newFamily)) {
+			return fmt.Errorf("`sku.0.name` must be a %s SKU (e.g. `GP_%s`) when migrating the `sku.0.family` to %q but got %q", newFamily, newFamily, newFamily, name)
+		}
+	}
+
+	return nil
+}
+
+// validateMsSqlElasticPoolFamilyMigrationLocation validates that the family being migrated to is available within the
+// Location, since the migration can't be rolled back once started
+func validateMsSqlElasticPoolFamilyMigrationLocation(diff *schema.ResourceDiff, client *ArmClient) error {
+	if client.msSqlCapabilitiesCache == nil || diff.Id() == "" || !diff.HasChange("sku.0.family") {
+		return nil
+	}
+
+	if !diff.Get("vcore_family_upgrade_in_place").(bool) || !diff.NewValueKnown("location") || !diff.NewValueKnown("sku.0.family") {
+		return nil
+	}
+
+	location := diff.Get("location").(string)
+	family := diff.Get("sku.0.family").(string)
+
+	ctx := client.StopContext
+	return client.msSqlCapabilitiesCache.validateElasticPoolFamily(ctx, client.msSqlCapabilitiesClient, location, family)
+}
+
+func validateMsSqlElasticPoolHighAvailabilityReplicaCount(diff *schema.ResourceDiff) error {
+	count, ok := diff.GetOk("high_availability_replica_count")
+	if !ok || !diff.HasChange("high_availability_replica_count") || !diff.NewValueKnown("sku.0.tier") {
+		return nil
+	}
+
+	if tier := diff.Get("sku.0.tier").(string); !strings.EqualFold(tier, "Hyperscale") {
+		return fmt.Errorf("`high_availability_replica_count` can only be set to %d for Hyperscale Elastic Pools but got the %q tier", count.(int), tier)
+	}
+
+	return nil
+}
+
 func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).msSqlElasticPoolsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -280,9 +525,58 @@ func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	tags := d.Get("tags").(map[string]interface{})
+
+	// `response_export_values` is only used when reading the resource, so changing it alone requires no API call
+	if onlyResponseExportValuesHaveChanged(d, resourceArmMsSqlElasticPool().Schema) {
+		return resourceArmMsSqlElasticPoolRead(d, meta)
+	}
+
+	if onlyMetadataHasChanged(d, resourceArmMsSqlElasticPool().Schema, "force_delete_replicated", "vcore_family_upgrade_in_place", "propagate_tags_to_databases") {
+		log.Printf("[DEBUG] Only the tags of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q) have changed - updating them in-place", elasticPoolName, serverName, resGroup)
+
+		future, err := client.Update(ctx, resGroup, serverName, elasticPoolName, sql.ElasticPoolUpdate{Tags: expandTags(tags)})
+		if err != nil {
+			return fmt.Errorf("Error issuing update request for the tags of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on update future for the tags of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resGroup, err)
+		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+
+		if err := applyArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+			return fmt.Errorf("Error applying the diagnostics for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resGroup, err)
+		}
+
+		if d.Get("propagate_tags_to_databases").(bool) {
+			if err := propagateArmMsSqlElasticPoolTagsToDatabases(ctx, meta.(*ArmClient).msSqlDatabasesClient, meta.(*ArmClient).sqlServerCache, resGroup, serverName, elasticPoolName, expandTags(tags)); err != nil {
+				return err
+			}
+		}
+
+		return resourceArmMsSqlElasticPoolRead(d, meta)
+	}
+
+	sourceElasticPoolId := ""
+	if d.IsNewResource() {
+		sourceElasticPoolId = d.Get("source_elastic_pool_id").(string)
+	}
+	if sourceElasticPoolId != "" {
+		_, sourceServerName, _, err := parseArmMsSqlElasticPoolId(sourceElasticPoolId)
+		if err != nil {
+			return err
+		}
+
+		// the copied Databases keep their names, which must be unique within a Server
+		if strings.EqualFold(sourceServerName, serverName) {
+			return fmt.Errorf("the Elastic Pool specified in `source_elastic_pool_id` must be on a different MSSQL Server to MsSQL ElasticPool %q", elasticPoolName)
+		}
+	}
+
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	sku := expandAzureRmMsSqlElasticPoolSku(d)
-	tags := d.Get("tags").(map[string]interface{})
 
 	elasticPool := sql.ElasticPool{
 		Name:     &elasticPoolName,
@@ -294,8 +588,30 @@ func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interf
 		},
 	}
 
-	if v, ok := d.GetOk("max_size_bytes"); ok {
-		elasticPool.MaxSizeBytes = utils.Int64(int64(v.(int)))
+	// both are Computed, so only the value which has been changed (or specified) should be sent
+	if d.HasChange("max_size_gb") {
+		if v, ok := d.GetOk("max_size_gb"); ok {
+			elasticPool.MaxSizeBytes = utils.Int64(msSqlElasticPoolGBToBytes(v.(float64)))
+		}
+	} else if v, ok := d.GetOk("max_size_bytes"); ok {
+		// when moving to the Basic tier the size computed for the previous tier is stale, so is only sent when specified
+		if !msSqlElasticPoolIsBasicTier(d.Get("sku.0.tier").(string)) || d.HasChange("max_size_bytes") {
+			elasticPool.MaxSizeBytes = utils.Int64(int64(v.(int)))
+		}
+	}
+
+	familyMigration := !d.IsNewResource() && d.HasChange("sku.0.family") && d.Get("vcore_family_upgrade_in_place").(bool)
+	if familyMigration {
+		// a previous migration which timed out is waited on, rather than starting another part-way through
+		if err := waitForArmMsSqlElasticPoolOperations(ctx, client, d.Id(), msSqlElasticPoolFamilyMigrationTimeout); err != nil {
+			return fmt.Errorf("Error waiting for the in-progress operations on MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q) to complete: %+v", elasticPoolName, serverName, resGroup, err)
+		}
+
+		if client.PollingDuration < msSqlElasticPoolFamilyMigrationTimeout {
+			client.PollingDuration = msSqlElasticPoolFamilyMigrationTimeout
+		}
+
+		log.Printf("[DEBUG] Migrating the family of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q) to %q", elasticPoolName, serverName, resGroup, d.Get("sku.0.family").(string))
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, elasticPoolName, elasticPool)
@@ -304,9 +620,17 @@ func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if familyMigration {
+			// ensures the in-progress migration is looked up during the next refresh
+			d.Set("pending_family_migration", true)
+			return fmt.Errorf("Error waiting for the migration of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q) to the %q family - the migration may still be in progress and will be waited on during the next apply: %+v", elasticPoolName, serverName, resGroup, d.Get("sku.0.family").(string), err)
+		}
+
 		return err
 	}
 
+	meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+
 	read, err := client.Get(ctx, resGroup, serverName, elasticPoolName)
 	if err != nil {
 		return err
@@ -317,6 +641,44 @@ func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interf
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("enclave_type") || d.HasChange("high_availability_replica_count") {
+		preview := msSqlElasticPoolPreview{
+			Properties: &msSqlElasticPoolPreviewProperties{},
+		}
+		if v, ok := d.GetOk("enclave_type"); ok {
+			preview.Properties.PreferredEnclaveType = utils.String(v.(string))
+		}
+		if v, ok := d.GetOk("high_availability_replica_count"); ok {
+			preview.Properties.HighAvailabilityReplicaCount = utils.Int32(int32(v.(int)))
+		}
+
+		if preview.Properties.PreferredEnclaveType != nil || preview.Properties.HighAvailabilityReplicaCount != nil {
+			if err := armRawPatch(ctx, client.Client, client.BaseURI, *read.ID, msSqlElasticPoolPreviewApiVersion, preview); err != nil {
+				return fmt.Errorf("Error updating the Enclave Type/High Availability Replica Count for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resGroup, err)
+			}
+
+			meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+		}
+	}
+
+	if sourceElasticPoolId != "" {
+		if err := copyArmMsSqlElasticPoolDatabases(ctx, meta.(*ArmClient).msSqlDatabasesClient, sourceElasticPoolId, resGroup, serverName, location, *read.ID); err != nil {
+			return err
+		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+	}
+
+	if err := applyArmMonitorDiagnostics(d, meta, *read.ID); err != nil {
+		return fmt.Errorf("Error applying the diagnostics for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resGroup, err)
+	}
+
+	if d.Get("propagate_tags_to_databases").(bool) {
+		if err := propagateArmMsSqlElasticPoolTagsToDatabases(ctx, meta.(*ArmClient).msSqlDatabasesClient, meta.(*ArmClient).sqlServerCache, resGroup, serverName, elasticPoolName, expandTags(tags)); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmMsSqlElasticPoolRead(d, meta)
 }
 
@@ -329,7 +691,7 @@ func resourceArmMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	resp, err := client.Get(ctx, resGroup, serverName, name)
+	resp, err := readArmMsSqlElasticPool(ctx, client, meta.(*ArmClient).sqlServerCache, resGroup, serverName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -346,13 +708,53 @@ func resourceArmMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("server_name", serverName)
+	d.Set("fully_qualified_domain_name", sqlServerFullyQualifiedDomainName(meta.(*ArmClient), serverName))
+
+	// a migration can only be pending when one was previously detected, when a migration timed out during an
+	// Update, or when the Elastic Pool is being imported (in which case the `sku` isn't yet in the state)
+	pendingFamilyMigrationExpected := d.Get("pending_family_migration").(bool) || d.Get("sku.#").(int) == 0
 
 	if err := d.Set("sku", flattenAzureRmMsSqlElasticPoolSku(resp.Sku)); err != nil {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
+	// only Gen4 Elastic Pools can be part-way through a migration, since the family is updated once it completes
+	pendingFamilyMigration := false
+	if sku := resp.Sku; pendingFamilyMigrationExpected && sku != nil && sku.Family != nil && strings.EqualFold(*sku.Family, "Gen4") {
+		operations, err := listArmMsSqlElasticPoolOperationsInProgress(ctx, client, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error listing the operations for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+		}
+
+		pendingFamilyMigration = len(operations) > 0
+	}
+	d.Set("pending_family_migration", pendingFamilyMigration)
+
+	if client := meta.(*ArmClient); client.enableCostEstimation {
+		if sku := resp.Sku; sku != nil && sku.Tier != nil && sku.Capacity != nil && resp.Location != nil {
+			family := ""
+			if sku.Family != nil {
+				family = *sku.Family
+			}
+
+			cost, err := azureRmMsSqlElasticPoolEstimatedMonthlyCost(ctx, client.retailPricesClient, azureRMNormalizeLocation(*resp.Location), *sku.Tier, family, int(*sku.Capacity))
+			if err != nil {
+				log.Printf("[WARN] Unable to estimate the monthly cost of MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+			} else {
+				d.Set("estimated_monthly_cost", cost)
+			}
+		}
+	}
+
 	if properties := resp.ElasticPoolProperties; properties != nil {
-		d.Set("max_size_bytes", properties.MaxSizeBytes)
+		// the MaxSizeBytes can be omitted for Basic tier pools, in which case both sizes are explicitly reset rather
+		// than leaving the (stale) sizes from a previous tier in the state
+		maxSizeBytes := int64(0)
+		if v := properties.MaxSizeBytes; v != nil {
+			maxSizeBytes = *v
+		}
+		d.Set("max_size_bytes", maxSizeBytes)
+		d.Set("max_size_gb", msSqlElasticPoolBytesToGB(maxSizeBytes))
 		d.Set("zone_redundant", properties.ZoneRedundant)
 
 		//todo remove in 2.0
@@ -365,8 +767,34 @@ func resourceArmMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the preview API is only queried when these are used, since it's not available in every region
+	_, hasEnclaveType := d.GetOk("enclave_type")
+	_, hasReplicaCount := d.GetOk("high_availability_replica_count")
+	if hasEnclaveType || hasReplicaCount {
+		var preview msSqlElasticPoolPreview
+		if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), msSqlElasticPoolPreviewApiVersion, &preview); err != nil {
+			log.Printf("[WARN] Unable to retrieve the Enclave Type/High Availability Replica Count for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+		} else if props := preview.Properties; props != nil {
+			// these are omitted by the API when unsupported in the region/tier - in which case the existing value is retained
+			if v := props.PreferredEnclaveType; v != nil {
+				d.Set("enclave_type", *v)
+			}
+			if v := props.HighAvailabilityReplicaCount; v != nil {
+				d.Set("high_availability_replica_count", int(*v))
+			}
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
+	if err := readArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := setArmResponseExportValues(ctx, d, meta, msSqlElasticPoolPreviewApiVersion); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -379,10 +807,234 @@ func resourceArmMsSqlElasticPoolDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if err := ensureArmSqlElasticPoolHasNoGeoReplicas(d, meta, resGroup, serverName, name); err != nil {
+		return err
+	}
+
+	if err := deleteArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+		return err
+	}
+
 	_, err = client.Delete(ctx, resGroup, serverName, name)
+	meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+	return err
+}
+
+// propagateArmMsSqlElasticPoolTagsToDatabases merges the tags of the MsSQL Elastic Pool into the tags of each
+// Database within it - tags which only exist on the Database are left as-is
+func propagateArmMsSqlElasticPoolTagsToDatabases(ctx context.Context, client sql.DatabasesClient, cache *sqlServerCache, resourceGroup string, serverName string, elasticPoolName string, tags map[string]*string) error {
+	// the cached Databases are stale once any of them have been updated, including when a later update fails
+	defer cache.invalidate(resourceGroup, serverName)
+
+	iterator, err := client.ListByElasticPoolComplete(ctx, resourceGroup, serverName, elasticPoolName)
+	if err != nil {
+		return fmt.Errorf("Error listing Databases for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		database := iterator.Value()
+		if database.Name != nil {
+			databaseTags, changed := mergeArmMsSqlElasticPoolTags(database.Tags, tags)
+			if changed {
+				log.Printf("[DEBUG] Propagating the tags of MsSQL ElasticPool %q to Database %q (MSSQL Server %q / Resource Group %q)", elasticPoolName, *database.Name, serverName, resourceGroup)
+
+				future, err := client.Update(ctx, resourceGroup, serverName, *database.Name, sql.DatabaseUpdate{Tags: databaseTags})
+				if err != nil {
+					return fmt.Errorf("Error updating the tags of Database %q (MSSQL Server %q / Resource Group %q): %+v", *database.Name, serverName, resourceGroup, err)
+				}
+
+				if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("Error waiting for the tags of Database %q (MSSQL Server %q / Resource Group %q) to be updated: %+v", *database.Name, serverName, resourceGroup, err)
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Databases for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", elasticPoolName, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// copyArmMsSqlElasticPoolDatabases copies each of the Databases within the source Elastic Pool into the target Elastic
+// Pool - the copies are started in parallel and then waited on, since copying each Database in turn can take hours
+func copyArmMsSqlElasticPoolDatabases(ctx context.Context, client sql.DatabasesClient, sourceElasticPoolId string, resourceGroup string, serverName string, location string, elasticPoolId string) error {
+	sourceResourceGroup, sourceServerName, sourceElasticPoolName, err := parseArmMsSqlElasticPoolId(sourceElasticPoolId)
+	if err != nil {
+		return err
+	}
+
+	iterator, err := client.ListByElasticPoolComplete(ctx, sourceResourceGroup, sourceServerName, sourceElasticPoolName)
+	if err != nil {
+		return fmt.Errorf("Error listing Databases for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", sourceElasticPoolName, sourceServerName, sourceResourceGroup, err)
+	}
+
+	futures := make(map[string]sql.DatabasesCreateOrUpdateFuture)
+	for iterator.NotDone() {
+		database := iterator.Value()
+		if database.Name != nil && database.ID != nil {
+			log.Printf("[DEBUG] Copying Database %q from MsSQL ElasticPool %q into MSSQL Server %q (Resource Group %q)", *database.Name, sourceElasticPoolName, serverName, resourceGroup)
+
+			parameters := sql.Database{
+				Location: utils.String(location),
+				Tags:     database.Tags,
+				DatabaseProperties: &sql.DatabaseProperties{
+					CreateMode:       sql.CreateModeCopy,
+					SourceDatabaseID: database.ID,
+					ElasticPoolID:    utils.String(elasticPoolId),
+				},
+			}
+
+			future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, *database.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("Error copying Database %q (MSSQL Server %q / Resource Group %q): %+v", *database.Name, serverName, resourceGroup, err)
+			}
+
+			futures[*database.Name] = future
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Databases for MsSQL ElasticPool %q (MSSQL Server %q / Resource Group %q): %+v", sourceElasticPoolName, sourceServerName, sourceResourceGroup, err)
+		}
+	}
+
+	for name, future := range futures {
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the copy of Database %q (MSSQL Server %q / Resource Group %q) to complete: %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// mergeArmMsSqlElasticPoolTags overlays the Elastic Pool's tags onto the existing Database tags,
+// returning the merged tags and whether they differ from the existing tags
+func mergeArmMsSqlElasticPoolTags(existing map[string]*string, poolTags map[string]*string) (map[string]*string, bool) {
+	output := make(map[string]*string, len(existing)+len(poolTags))
+	for k, v := range existing {
+		output[k] = v
+	}
+
+	changed := false
+	for k, v := range poolTags {
+		if v == nil {
+			continue
+		}
+
+		if current, ok := output[k]; !ok || current == nil || *current != *v {
+			output[k] = utils.String(*v)
+			changed = true
+		}
+	}
+
+	return output, changed
+}
+
+// listArmMsSqlElasticPoolOperationsInProgress returns the update operations on the Elastic Pool which haven't completed
+func listArmMsSqlElasticPoolOperationsInProgress(ctx context.Context, client sql.ElasticPoolsClient, elasticPoolId string) ([]sql.ElasticPoolOperation, error) {
+	var result sql.ElasticPoolOperationListResult
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, elasticPoolId+"/operations", msSqlDefaultApiVersion, &result); err != nil {
+		return nil, err
+	}
+
+	operations := make([]sql.ElasticPoolOperation, 0)
+	if result.Value == nil {
+		return operations, nil
+	}
+
+	for _, v := range *result.Value {
+		props := v.ElasticPoolOperationProperties
+		if props == nil || props.Operation == nil || props.State == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.Operation, "UPDATE") && msSqlElasticPoolOperationIsInProgress(*props.State) {
+			operations = append(operations, v)
+		}
+	}
+
+	return operations, nil
+}
+
+func msSqlElasticPoolOperationIsInProgress(state string) bool {
+	switch strings.ToUpper(strings.Replace(state, "_", "", -1)) {
+	case "PENDING", "INPROGRESS":
+		return true
+	}
+
+	return false
+}
+
+func waitForArmMsSqlElasticPoolOperations(ctx context.Context, client sql.ElasticPoolsClient, elasticPoolId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"InProgress"},
+		Target:  []string{"Completed"},
+		Refresh: func() (interface{}, string, error) {
+			operations, err := listArmMsSqlElasticPoolOperationsInProgress(ctx, client, elasticPoolId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(operations) > 0 {
+				return operations, "InProgress", nil
+			}
+
+			return operations, "Completed", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
 	return err
 }
 
+// readArmMsSqlElasticPool retrieves the MsSQL Elastic Pool from the Server-level cache where possible,
+// falling back to retrieving it individually
+func readArmMsSqlElasticPool(ctx context.Context, client sql.ElasticPoolsClient, cache *sqlServerCache, resourceGroup string, serverName string, name string) (sql.ElasticPool, error) {
+	if pool := cache.msSqlElasticPool(ctx, client, resourceGroup, serverName, name); pool != nil {
+		return *pool, nil
+	}
+
+	return client.Get(ctx, resourceGroup, serverName, name)
+}
+
+// msSqlElasticPoolBasicMaxSizeDiffSuppress suppresses the diff for the size of Basic tier pools when either side is
+// unset, since the API omits the MaxSizeBytes for these - which otherwise shows as a perpetual diff
+func msSqlElasticPoolBasicMaxSizeDiffSuppress(_, old, new string, d *schema.ResourceData) bool {
+	if !msSqlElasticPoolIsBasicTier(d.Get("sku.0.tier").(string)) {
+		return false
+	}
+
+	return msSqlElasticPoolSizeIsUnset(old) || msSqlElasticPoolSizeIsUnset(new)
+}
+
+func msSqlElasticPoolSizeIsUnset(input string) bool {
+	if input == "" {
+		return true
+	}
+
+	v, err := strconv.ParseFloat(input, 64)
+	return err == nil && v == 0
+}
+
+func msSqlElasticPoolIsBasicTier(tier string) bool {
+	return strings.EqualFold(tier, "Basic")
+}
+
+const msSqlElasticPoolBytesPerGB = 1073741824
+
+func msSqlElasticPoolBytesToGB(input int64) float64 {
+	return float64(input) / msSqlElasticPoolBytesPerGB
+}
+
+// msSqlElasticPoolGBToBytes rounds to the nearest byte, since fractional sizes (e.g. 4.8828125) can't always be
+// represented exactly as a float
+func msSqlElasticPoolGBToBytes(input float64) int64 {
+	return int64(math.Round(input * msSqlElasticPoolBytesPerGB))
+}
+
 func parseArmMsSqlElasticPoolId(sqlElasticPoolId string) (string, string, string, error) {
 	id, err := parseAzureResourceID(sqlElasticPoolId)
 	if err != nil {
@@ -394,6 +1046,14 @@ func parseArmMsSqlElasticPoolId(sqlElasticPoolId string) (string, string, string
 
 func expandAzureRmMsSqlElasticPoolPerDatabaseSettings(d *schema.ResourceData) *sql.ElasticPoolPerDatabaseSettings {
 	perDatabaseSettings := d.Get("per_database_settings").([]interface{})
+	if len(perDatabaseSettings) == 0 || perDatabaseSettings[0] == nil {
+		minCapacity, maxCapacity := msSqlElasticPoolDefaultPerDatabaseCapacity(d.Get("sku.0.name").(string), d.Get("sku.0.capacity").(int))
+		return &sql.ElasticPoolPerDatabaseSettings{
+			MinCapacity: utils.Float(minCapacity),
+			MaxCapacity: utils.Float(maxCapacity),
+		}
+	}
+
 	perDatabaseSetting := perDatabaseSettings[0].(map[string]interface{})
 
 	minCapacity := perDatabaseSetting["min_capacity"].(float64)
@@ -462,6 +1122,10 @@ func flattenAzureRmMsSqlElasticPoolProperties(resp *sql.ElasticPoolProperties) [
 }
 
 func flattenAzureRmMsSqlElasticPoolPerDatabaseSettings(resp *sql.ElasticPoolPerDatabaseSettings) []interface{} {
+	if resp == nil {
+		return []interface{}{}
+	}
+
 	perDatabaseSettings := map[string]interface{}{}
 
 	if minCapacity := resp.MinCapacity; minCapacity != nil {
@@ -474,3 +1138,41 @@ func flattenAzureRmMsSqlElasticPoolPerDatabaseSettings(resp *sql.ElasticPoolPerD
 
 	return []interface{}{perDatabaseSettings}
 }
+
+// azureRmMsSqlElasticPoolEstimatedMonthlyCost estimates the monthly pay-as-you-go cost of an Elastic Pool
+// in USD from the Azure Retail Prices API. vCore pools are priced per vCore, DTU pools per eDTU.
+func azureRmMsSqlElasticPoolEstimatedMonthlyCost(ctx context.Context, client pricing.RetailPricesClient, location, tier, family string, capacity int) (float64, error) {
+	var productName, meterName string
+	switch strings.ToLower(tier) {
+	case "generalpurpose":
+		productName = fmt.Sprintf("SQL Database Elastic Pool - General Purpose - Compute %s", family)
+		meterName = "vCore"
+	case "businesscritical":
+		productName = fmt.Sprintf("SQL Database Elastic Pool - Business Critical - Compute %s", family)
+		meterName = "vCore"
+	case "basic":
+		productName = "SQL Database Elastic Pool - Basic"
+		meterName = "eDTUs"
+	case "standard":
+		productName = "SQL Database Elastic Pool - Standard"
+		meterName = "eDTUs"
+	case "premium":
+		productName = "SQL Database Elastic Pool - Premium"
+		meterName = "eDTUs"
+	default:
+		return 0, fmt.Errorf("Cost estimation isn't supported for the %q tier", tier)
+	}
+
+	filter := fmt.Sprintf("serviceName eq 'SQL Database' and armRegionName eq '%s' and productName eq '%s' and meterName eq '%s'", location, productName, meterName)
+	prices, err := client.List(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	prices = pricing.FilterByConsumption(prices)
+	if len(prices) == 0 {
+		return 0, fmt.Errorf("No Retail Prices were found for %q (Meter %q) in %q", productName, meterName, location)
+	}
+
+	return pricing.MonthlyPrice(prices[0], float64(capacity))
+}
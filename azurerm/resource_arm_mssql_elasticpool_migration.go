@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceAzureRMMsSqlElasticPoolMigrateState(v int, is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM MsSQL Elastic Pool State v0; migrating to v1")
+		return migrateAzureRMMsSqlElasticPoolStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateAzureRMMsSqlElasticPoolStateV0toV1 moves the values from the deprecated `elastic_pool_properties` block
+// to the top-level attributes (where they're not already set), and removes the block - so that the state remains
+// valid once the block is removed from the schema
+func migrateAzureRMMsSqlElasticPoolStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM MsSQL Elastic Pool Attributes before Migration: %#v", is.Attributes)
+
+	if v := is.Attributes["elastic_pool_properties.0.max_size_bytes"]; v != "" && is.Attributes["max_size_bytes"] == "" {
+		maxSizeBytes, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `elastic_pool_properties.0.max_size_bytes` %q: %+v", v, err)
+		}
+
+		is.Attributes["max_size_bytes"] = v
+		if is.Attributes["max_size_gb"] == "" {
+			is.Attributes["max_size_gb"] = strconv.FormatFloat(msSqlElasticPoolBytesToGB(maxSizeBytes), 'G', -1, 64)
+		}
+	}
+
+	if v := is.Attributes["elastic_pool_properties.0.zone_redundant"]; v != "" && is.Attributes["zone_redundant"] == "" {
+		is.Attributes["zone_redundant"] = v
+	}
+
+	for k := range is.Attributes {
+		if strings.HasPrefix(k, "elastic_pool_properties.") {
+			delete(is.Attributes, k)
+		}
+	}
+
+	log.Printf("[DEBUG] ARM MsSQL Elastic Pool Attributes after State Migration: %#v", is.Attributes)
+
+	return is, nil
+}
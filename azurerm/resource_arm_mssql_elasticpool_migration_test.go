@@ -0,0 +1,91 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMMsSqlElasticPoolMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		ID           string
+		Attributes   map[string]string
+		Expected     map[string]string
+		Meta         interface{}
+	}{
+		"v0_1_empty": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes:   map[string]string{},
+			Expected:     map[string]string{},
+		},
+		"v0_1_nested_only": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"name":                                     "example",
+				"elastic_pool_properties.#":                "1",
+				"elastic_pool_properties.0.state":          "Ready",
+				"elastic_pool_properties.0.creation_date":  "2019-01-01T00:00:00Z",
+				"elastic_pool_properties.0.max_size_bytes": "5368709120",
+				"elastic_pool_properties.0.zone_redundant": "true",
+				"elastic_pool_properties.0.license_type":   "",
+			},
+			Expected: map[string]string{
+				"name":           "example",
+				"max_size_bytes": "5368709120",
+				"max_size_gb":    "5",
+				"zone_redundant": "true",
+			},
+		},
+		"v0_1_top_level_retained": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"name":                      "example",
+				"max_size_bytes":            "10737418240",
+				"max_size_gb":               "10",
+				"zone_redundant":            "false",
+				"elastic_pool_properties.#": "1",
+				"elastic_pool_properties.0.max_size_bytes": "5368709120",
+				"elastic_pool_properties.0.zone_redundant": "true",
+			},
+			Expected: map[string]string{
+				"name":           "example",
+				"max_size_bytes": "10737418240",
+				"max_size_gb":    "10",
+				"zone_redundant": "false",
+			},
+		},
+		"v0_1_fractional_size": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"elastic_pool_properties.#":                "1",
+				"elastic_pool_properties.0.max_size_bytes": "5242880000",
+			},
+			Expected: map[string]string{
+				"max_size_bytes": "5242880000",
+				"max_size_gb":    "4.8828125",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceAzureRMMsSqlElasticPoolMigrateState(tc.StateVersion, is, tc.Meta)
+
+		if err != nil {
+			t.Fatalf("bad: %q, err: %+v", tn, err)
+		}
+
+		if !reflect.DeepEqual(tc.Expected, is.Attributes) {
+			t.Fatalf("Bad MsSQL Elastic Pool Migrate\n\n. Got: %+v\n\n expected: %+v", is.Attributes, tc.Expected)
+		}
+	}
+}
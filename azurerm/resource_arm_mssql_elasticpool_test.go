@@ -1,15 +1,68 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_mssql_elasticpool", &resource.Sweeper{
+		Name: "azurerm_mssql_elasticpool",
+		// Elastic Pools can only be deleted once they're empty
+		Dependencies: []string{"azurerm_sql_database"},
+		F:            testSweepMsSqlElasticPools,
+	})
+}
+
+func testSweepMsSqlElasticPools(region string) error {
+	return testSweepForEachAcceptanceTestSqlServer(region, func(client *ArmClient, resourceGroup string, serverName string) error {
+		ctx := client.StopContext
+		poolsClient := client.msSqlElasticPoolsClient
+
+		pools, err := poolsClient.ListByServerComplete(ctx, resourceGroup, serverName, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Elastic Pools (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+
+		for pools.NotDone() {
+			if pool := pools.Value(); pool.Name != nil {
+				name := *pool.Name
+
+				log.Printf("[DEBUG] Sweeping Elastic Pool %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+				future, err := poolsClient.Delete(ctx, resourceGroup, serverName, name)
+				if err != nil {
+					if !response.WasNotFound(future.Response()) {
+						return fmt.Errorf("Error deleting Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+					}
+				} else if err := future.WaitForCompletionRef(ctx, poolsClient.Client); err != nil {
+					return fmt.Errorf("Error waiting for deletion of Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+				}
+			}
+
+			if err := pools.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Elastic Pools (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+			}
+		}
+
+		return nil
+	})
+}
+
 // TODO: add import tests
 func TestAccAzureRMMsSqlElasticPool_basic_DTU(t *testing.T) {
 	resourceName := "azurerm_mssql_elasticpool.test"
@@ -26,18 +79,96 @@ func TestAccAzureRMMsSqlElasticPool_basic_DTU(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "BasicPool"),
+					resource.TestCheckResourceAttrSet(resourceName, "fully_qualified_domain_name"),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Basic"),
 					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "50"),
 					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0"),
 					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "5"),
 					resource.TestCheckResourceAttrSet(resourceName, "max_size_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "max_size_gb", "4.8828125"),
 					resource.TestCheckResourceAttrSet(resourceName, "zone_redundant"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_maxSizeGB(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_maxSizeGB(ri, location, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_size_gb", "50"),
+					resource.TestCheckResourceAttr(resourceName, "max_size_bytes", "53687091200"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_maxSizeGB(ri, location, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_size_gb", "100"),
+					resource.TestCheckResourceAttr(resourceName, "max_size_bytes", "107374182400"),
+				),
+			},
 		},
 	})
 }
 
+func TestMsSqlElasticPoolMaxSizeConversion(t *testing.T) {
+	cases := []struct {
+		Bytes int64
+		GB    float64
+	}{
+		{
+			Bytes: 0,
+			GB:    0,
+		},
+		{
+			Bytes: 5242880000,
+			GB:    4.8828125,
+		},
+		{
+			Bytes: 5368709120,
+			GB:    5,
+		},
+		{
+			Bytes: 805306368000,
+			GB:    750,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := msSqlElasticPoolBytesToGB(tc.Bytes); actual != tc.GB {
+			t.Fatalf("Expected %d bytes to be %f GB but got %f", tc.Bytes, tc.GB, actual)
+		}
+
+		if actual := msSqlElasticPoolGBToBytes(tc.GB); actual != tc.Bytes {
+			t.Fatalf("Expected %f GB to be %d bytes but got %d", tc.GB, tc.Bytes, actual)
+		}
+	}
+}
+
 func TestAccAzureRMMsSqlElasticPool_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -91,9 +222,10 @@ func TestAccAzureRMMsSqlElasticPool_standard_DTU(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
 			},
 		},
 	})
@@ -124,9 +256,183 @@ func TestAccAzureRMMsSqlElasticPool_basic_vCore(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_defaultPerDatabaseSettings(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_defaultPerDatabaseSettings(ri, location, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "4"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_defaultPerDatabaseSettings(ri, location, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "8"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_hyperscale(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the storage for Hyperscale pools grows automatically
+				Config:      testAccAzureRMMsSqlElasticPool_vCore_Template(ri, location, "HS_Gen5", "Hyperscale", 4, "Gen5", 0, 4),
+				ExpectError: regexp.MustCompile("`max_size_bytes` cannot be set"),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_hyperscale(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "HS_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Hyperscale"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "Gen5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_enclaveTypeAndHighAvailability(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMMsSqlElasticPool_highAvailabilityNonHyperscale(ri, location),
+				ExpectError: regexp.MustCompile("`high_availability_replica_count` can only be set"),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_enclaveTypeAndHighAvailability(ri, location, "VBS", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enclave_type", "VBS"),
+					resource.TestCheckResourceAttr(resourceName, "high_availability_replica_count", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_enclaveTypeAndHighAvailability(ri, location, "Default", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enclave_type", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "high_availability_replica_count", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_relaxedSkuValidation(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	// GeneralPurpose Pools with 6 vCores are supported by Azure, but not (yet) by the provider's SKU validation
+	config := testAccAzureRMMsSqlElasticPool_vCore_Template(ri, location, "GP_Gen5", "GeneralPurpose", 6, "Gen5", 0, 6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("GeneralPurpose pricing tier must have a capacity of"),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_relaxedSkuValidation(config),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_stableApiVersion(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMMsSqlElasticPool_basic_vCore(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+				),
+			},
+			{
+				// the existing state should be compatible with the newer API Version, so there should be no diff
+				Config:             testAccAzureRMMsSqlElasticPool_apiVersion(config, "2021-11-01"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_apiVersion(testAccAzureRMMsSqlElasticPool_resize_vCore(ri, location), "2021-11-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "8"),
+				),
 			},
 		},
 	})
@@ -197,6 +503,71 @@ func TestAccAzureRMMsSqlElasticPool_resize_DTU(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMsSqlElasticPool_standardToBasic_DTU(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMsSqlElasticPool_standard_DTU(ri, location)
+	postConfig := testAccAzureRMMsSqlElasticPool_basic_DTU(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "max_size_gb", "50"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Basic"),
+				),
+			},
+		},
+	})
+}
+
+func TestMsSqlElasticPoolSizeIsUnset(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: true,
+		},
+		{
+			Input:    "0",
+			Expected: true,
+		},
+		{
+			Input:    "0.0",
+			Expected: true,
+		},
+		{
+			Input:    "4.8828125",
+			Expected: false,
+		},
+		{
+			Input:    "5242880000",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := msSqlElasticPoolSizeIsUnset(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q to be %t but got %t", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMMsSqlElasticPool_resize_vCore(t *testing.T) {
 	resourceName := "azurerm_mssql_elasticpool.test"
 	ri := tf.AccRandTimeInt()
@@ -237,18 +608,367 @@ func TestAccAzureRMMsSqlElasticPool_resize_vCore(t *testing.T) {
 	})
 }
 
-func testCheckAzureRMMsSqlElasticPoolExists(resourceName string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[resourceName]
-		if !ok {
-			return fmt.Errorf("Not found: %s", resourceName)
-		}
+func TestAccAzureRMMsSqlElasticPool_vCoreFamilyUpgradeInPlace(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
 
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
-		serverName := rs.Primary.Attributes["server_name"]
-		poolName := rs.Primary.Attributes["name"]
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_vCoreFamilyUpgradeInPlace(ri, location, "Gen4", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "GP_Gen4"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "Gen4"),
+					resource.TestCheckResourceAttr(resourceName, "pending_family_migration", "false"),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlElasticPool_vCoreFamilyUpgradeInPlace(ri, location, "Gen5", false),
+				ExpectError: regexp.MustCompile("`vcore_family_upgrade_in_place` must be set to `true`"),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_vCoreFamilyUpgradeInPlace(ri, location, "Gen5", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "GP_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "pending_family_migration", "false"),
+				),
+			},
+		},
+	})
+}
 
-		client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
+func TestMsSqlElasticPoolOperationIsInProgress(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "PENDING",
+			Expected: true,
+		},
+		{
+			Input:    "IN_PROGRESS",
+			Expected: true,
+		},
+		{
+			Input:    "InProgress",
+			Expected: true,
+		},
+		{
+			Input:    "COMPLETED",
+			Expected: false,
+		},
+		{
+			Input:    "FAILED",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := msSqlElasticPoolOperationIsInProgress(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q to be %t but got %t", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMMsSqlElasticPool_withTags(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMsSqlElasticPool_withTags(ri, location)
+	postConfig := testAccAzureRMMsSqlElasticPool_withTagsUpdate(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "50"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_propagateTagsToDatabases(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	databaseResourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_propagateTagsToDatabases(ri, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_propagateTagsToDatabases(ri, location, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+					testCheckAzureRMMsSqlElasticPoolDatabaseHasTag(databaseResourceName, "environment", "production"),
+					testCheckAzureRMMsSqlElasticPoolDatabaseHasTag(databaseResourceName, "owner", "database-team"),
+				),
+			},
+		},
+	})
+}
+
+func TestMsSqlElasticPoolMergeTags(t *testing.T) {
+	cases := []struct {
+		Existing map[string]*string
+		Pool     map[string]*string
+		Expected map[string]string
+		Changed  bool
+	}{
+		{
+			Existing: map[string]*string{},
+			Pool:     map[string]*string{},
+			Expected: map[string]string{},
+			Changed:  false,
+		},
+		{
+			Existing: map[string]*string{"owner": utils.String("team")},
+			Pool:     map[string]*string{"environment": utils.String("production")},
+			Expected: map[string]string{"owner": "team", "environment": "production"},
+			Changed:  true,
+		},
+		{
+			Existing: map[string]*string{"environment": utils.String("staging")},
+			Pool:     map[string]*string{"environment": utils.String("production")},
+			Expected: map[string]string{"environment": "production"},
+			Changed:  true,
+		},
+		{
+			Existing: map[string]*string{"environment": utils.String("production"), "owner": utils.String("team")},
+			Pool:     map[string]*string{"environment": utils.String("production")},
+			Expected: map[string]string{"environment": "production", "owner": "team"},
+			Changed:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, changed := mergeArmMsSqlElasticPoolTags(tc.Existing, tc.Pool)
+		if changed != tc.Changed {
+			t.Fatalf("Expected changed to be %t but got %t", tc.Changed, changed)
+		}
+
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("Expected %d tags but got %d", len(tc.Expected), len(actual))
+		}
+
+		for k, v := range tc.Expected {
+			if actual[k] == nil || *actual[k] != v {
+				t.Fatalf("Expected tag %q to be %q but got %v", k, v, actual[k])
+			}
+		}
+	}
+}
+
+func TestMsSqlElasticPoolTagsOnlyUpdate(t *testing.T) {
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG/providers/Microsoft.Sql/servers/acctestserver/elasticPools/acctestpool"
+	pool := fmt.Sprintf(`{"id": %q, "name": "acctestpool", "location": "westeurope", "sku": {"name": "GP_Gen5", "tier": "GeneralPurpose", "family": "Gen5", "capacity": 4}, "tags": {"environment": "staging"}}`, poolId)
+
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/elasticPools") {
+			fmt.Fprintf(w, `{"value": [%s]}`, pool)
+			return
+		}
+		fmt.Fprint(w, pool)
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		StopContext:             context.Background(),
+		environment:             az.PublicCloud,
+		msSqlElasticPoolsClient: sql.NewElasticPoolsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000"),
+		sqlServerCache:          newSqlServerCache(),
+	}
+
+	state := &terraform.InstanceState{
+		ID: poolId,
+		Attributes: map[string]string{
+			"id":                          poolId,
+			"name":                        "acctestpool",
+			"resource_group_name":         "acctestRG",
+			"server_name":                 "acctestserver",
+			"location":                    "westeurope",
+			"sku.#":                       "1",
+			"sku.0.name":                  "GP_Gen5",
+			"sku.0.tier":                  "GeneralPurpose",
+			"sku.0.family":                "Gen5",
+			"sku.0.capacity":              "4",
+			"estimated_monthly_cost":      "1234.5",
+			"force_delete_replicated":     "false",
+			"propagate_tags_to_databases": "false",
+			"tags.%":                      "1",
+			"tags.environment":            "production",
+		},
+	}
+
+	// alongside the tags, the computed-only and local-only fields change - neither of which require a full update
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"tags.environment":        {Old: "production", New: "staging"},
+			"estimated_monthly_cost":  {Old: "1234.5", NewComputed: true},
+			"force_delete_replicated": {Old: "false", New: "true"},
+		},
+	}
+
+	if _, err := resourceArmMsSqlElasticPool().Apply(state, diff, client); err != nil {
+		t.Fatalf("Error applying the diff: %+v", err)
+	}
+
+	patches := 0
+	for _, method := range requests {
+		switch method {
+		case http.MethodPatch:
+			patches++
+		case http.MethodGet:
+		default:
+			t.Fatalf("Expected only PATCH and GET requests but got a %s request", method)
+		}
+	}
+
+	if patches != 1 {
+		t.Fatalf("Expected a single PATCH request but got %d", patches)
+	}
+}
+
+func TestAccAzureRMMsSqlElasticPool_diagnostics(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_diagnostics(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					testCheckAzureRMMonitorDiagnosticsExists(resourceName, "terraform-diagnostics", true),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.0.metrics_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_diagnosticsUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					testCheckAzureRMMonitorDiagnosticsExists(resourceName, "terraform-diagnostics", false),
+					testCheckAzureRMMonitorDiagnosticsExists(resourceName, "acctest-diagnostics", true),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.0.retention_days", "7"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlElasticPool_basic_DTU(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					testCheckAzureRMMonitorDiagnosticsExists(resourceName, "acctest-diagnostics", false),
+					resource.TestCheckResourceAttr(resourceName, "diagnostics.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_sourceElasticPool(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.copy"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_sourceElasticPool(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					testCheckAzureRMMsSqlElasticPoolDatabaseCount(resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "source_elastic_pool_id", "azurerm_mssql_elasticpool.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_replicated", "source_elastic_pool_id"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_responseExportValues(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlElasticPool_responseExportValues(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "response_export.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "response_export.sku.name", "BasicPool"),
+					resource.TestCheckResourceAttr(resourceName, "response_export.properties.state", "Ready"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlElasticPoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		poolName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, poolName)
@@ -264,6 +984,68 @@ func testCheckAzureRMMsSqlElasticPoolExists(resourceName string) resource.TestCh
 	}
 }
 
+func testCheckAzureRMMsSqlElasticPoolDatabaseCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		poolName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlDatabasesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		databases, err := client.ListByElasticPoolComplete(ctx, resourceGroup, serverName, poolName)
+		if err != nil {
+			return fmt.Errorf("Bad: ListByElasticPool on msSqlDatabasesClient: %+v", err)
+		}
+
+		count := 0
+		for databases.NotDone() {
+			count++
+			if err := databases.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Bad: ListByElasticPool on msSqlDatabasesClient: %+v", err)
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("Bad: Expected MsSql Elastic Pool %q on server: %q (resource group: %q) to contain %d Databases but got %d", poolName, serverName, resourceGroup, expected, count)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlElasticPoolDatabaseHasTag(resourceName string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlDatabasesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on msSqlDatabasesClient: %+v", err)
+		}
+
+		if v, ok := resp.Tags[key]; !ok || v == nil || *v != value {
+			return fmt.Errorf("Bad: Expected Database %q (MSSQL Server %q / Resource Group %q) to have the tag %q set to %q", name, serverName, resourceGroup, key, value)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMMsSqlElasticPoolDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -350,6 +1132,34 @@ func testAccAzureRMMsSqlElasticPool_resize_vCore(rInt int, location string) stri
 	return testAccAzureRMMsSqlElasticPool_vCore_Template(rInt, location, "GP_Gen5", "GeneralPurpose", 8, "Gen5", 0, 8)
 }
 
+func testAccAzureRMMsSqlElasticPool_relaxedSkuValidation(template string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    mssql {
+      relaxed_sku_validation = true
+    }
+  }
+}
+
+%s
+`, template)
+}
+
+func testAccAzureRMMsSqlElasticPool_apiVersion(template string, apiVersion string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    mssql {
+      api_version = "%s"
+    }
+  }
+}
+
+%s
+`, apiVersion, template)
+}
+
 func testAccAzureRMMsSqlElasticPool_vCore_Template(rInt int, location string, skuName string, skuTier string, skuCapacity int, skuFamily string, databaseSettingsMin float64, databaseSettingsMax float64) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -388,7 +1198,7 @@ resource "azurerm_mssql_elasticpool" "test" {
 `, rInt, location, skuName, skuTier, skuCapacity, skuFamily, databaseSettingsMin, databaseSettingsMax)
 }
 
-func testAccAzureRMMsSqlElasticPool_DTU_Template(rInt int, location string, skuName string, skuTier string, skuCapacity int, maxSizeBytes int, databaseSettingsMin int, databaseSettingsMax int) string {
+func testAccAzureRMMsSqlElasticPool_vCoreFamilyUpgradeInPlace(rInt int, location string, family string, upgradeInPlace bool) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
   name     = "acctestRG-%[1]d"
@@ -405,22 +1215,562 @@ resource "azurerm_sql_server" "test" {
 }
 
 resource "azurerm_mssql_elasticpool" "test" {
-  name                = "acctest-pool-dtu-%[1]d"
-  resource_group_name = "${azurerm_resource_group.test.name}"
-  location            = "${azurerm_resource_group.test.location}"
-  server_name         = "${azurerm_sql_server.test.name}"
-  max_size_bytes      = %[6]d
-  
+  name                          = "acctest-pool-vcore-%[1]d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  server_name                   = "${azurerm_sql_server.test.name}"
+  max_size_gb                   = 5
+  vcore_family_upgrade_in_place = %[4]t
+
   sku {
-    name     = "%[3]s"
-    tier     = "%[4]s"
-    capacity = %[5]d
+    name     = "GP_%[3]s"
+    tier     = "GeneralPurpose"
+    capacity = 4
+    family   = "%[3]s"
   }
+}
+`, rInt, location, family, upgradeInPlace)
+}
 
-  per_database_settings {
-    min_capacity = %[7]d
-    max_capacity = %[8]d
-  }
+func testAccAzureRMMsSqlElasticPool_defaultPerDatabaseSettings(rInt int, location string, skuCapacity int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%s"
 }
-`, rInt, location, skuName, skuTier, skuCapacity, maxSizeBytes, databaseSettingsMin, databaseSettingsMax)
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-vcore-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5368709120
+
+  sku {
+    name     = "GP_Gen5"
+    tier     = "GeneralPurpose"
+    capacity = %[3]d
+    family   = "Gen5"
+  }
+}
+`, rInt, location, skuCapacity)
+}
+
+func testAccAzureRMMsSqlElasticPool_hyperscale(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-hs-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+
+  sku {
+    name     = "HS_Gen5"
+    tier     = "Hyperscale"
+    capacity = 4
+    family   = "Gen5"
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 4
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_enclaveTypeAndHighAvailability(rInt int, location string, enclaveType string, replicaCount int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                            = "acctest-pool-hs-%[1]d"
+  resource_group_name             = "${azurerm_resource_group.test.name}"
+  location                        = "${azurerm_resource_group.test.location}"
+  server_name                     = "${azurerm_sql_server.test.name}"
+  enclave_type                    = "%[3]s"
+  high_availability_replica_count = %[4]d
+
+  sku {
+    name     = "HS_Gen5"
+    tier     = "Hyperscale"
+    capacity = 4
+    family   = "Gen5"
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 4
+  }
+}
+`, rInt, location, enclaveType, replicaCount)
+}
+
+func testAccAzureRMMsSqlElasticPool_highAvailabilityNonHyperscale(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                            = "acctest-pool-vcore-%[1]d"
+  resource_group_name             = "${azurerm_resource_group.test.name}"
+  location                        = "${azurerm_resource_group.test.location}"
+  server_name                     = "${azurerm_sql_server.test.name}"
+  high_availability_replica_count = 2
+
+  sku {
+    name     = "GP_Gen5"
+    tier     = "GeneralPurpose"
+    capacity = 4
+    family   = "Gen5"
+  }
+
+  per_database_settings {
+    min_capacity = 0.25
+    max_capacity = 4
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_DTU_Template(rInt int, location string, skuName string, skuTier string, skuCapacity int, maxSizeBytes int, databaseSettingsMin int, databaseSettingsMax int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = %[6]d
+  
+  sku {
+    name     = "%[3]s"
+    tier     = "%[4]s"
+    capacity = %[5]d
+  }
+
+  per_database_settings {
+    min_capacity = %[7]d
+    max_capacity = %[8]d
+  }
+}
+`, rInt, location, skuName, skuTier, skuCapacity, maxSizeBytes, databaseSettingsMin, databaseSettingsMax)
+}
+
+func testAccAzureRMMsSqlElasticPool_sourceElasticPool(rInt int, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_basic_DTU(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestdb%[2]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+}
+
+resource "azurerm_sql_server" "copy" {
+  name                         = "acctest%[2]d-copy"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "copy" {
+  name                   = "acctest-pool-copy-%[2]d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  server_name            = "${azurerm_sql_server.copy.name}"
+  max_size_gb            = 50
+  source_elastic_pool_id = "${azurerm_mssql_elasticpool.test.id}"
+
+  sku {
+    name     = "StandardPool"
+    tier     = "Standard"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 50
+  }
+
+  depends_on = ["azurerm_sql_database.test"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlElasticPool_maxSizeGB(rInt int, location string, maxSizeGB int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_gb         = %[3]d
+
+  sku {
+    name     = "StandardPool"
+    tier     = "Standard"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 50
+  }
+}
+`, rInt, location, maxSizeGB)
+}
+
+func testAccAzureRMMsSqlElasticPool_withTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  tags {
+    environment = "staging"
+    database    = "test"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_withTagsUpdate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  tags {
+    environment = "production"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_propagateTagsToDatabases(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                        = "acctest-pool-dtu-%[1]d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  server_name                 = "${azurerm_sql_server.test.name}"
+  max_size_gb                 = 4.8828125
+  propagate_tags_to_databases = true
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  tags {
+    environment = "%[3]s"
+  }
+}
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestdb%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  elastic_pool_name   = "${azurerm_mssql_elasticpool.test.name}"
+
+  tags {
+    owner = "database-team"
+  }
+
+  lifecycle {
+    ignore_changes = ["tags"]
+  }
+}
+`, rInt, location, environment)
+}
+
+func testAccAzureRMMsSqlElasticPool_responseExportValues(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  response_export_values = ["sku.name", "properties.state"]
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_diagnosticsTemplate(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMMsSqlElasticPool_diagnostics(rInt int, rString string, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_diagnosticsTemplate(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  diagnostics {
+    log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlElasticPool_diagnosticsUpdated(rInt int, rString string, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_diagnosticsTemplate(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-dtu-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+
+  diagnostics {
+    name               = "acctest-diagnostics"
+    storage_account_id = "${azurerm_storage_account.test.id}"
+    retention_days     = 7
+  }
+}
+`, template, rInt)
 }
@@ -0,0 +1,492 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlFailoverGroupCreateUpdate,
+		Read:   resourceArmMsSqlFailoverGroupRead,
+		Update: resourceArmMsSqlFailoverGroupCreateUpdate,
+		Delete: resourceArmMsSqlFailoverGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`),
+					"The Failover Group name must be between 1 and 63 characters long, contain only lowercase letters, numbers and hyphens and cannot start or end with a hyphen.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"partner_server": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"databases": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"include_all_databases"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			// when enabled every (user) Database on the Server is a member of the Failover Group - the membership is
+			// compared to the Databases on the Server during each plan, so new Databases are added on the next apply
+			"include_all_databases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: resourceArmMsSqlFailoverGroupCustomizeDiff,
+	}
+}
+
+func resourceArmMsSqlFailoverGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if policies := diff.Get("read_write_endpoint_failover_policy").([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		mode := policy["mode"].(string)
+		graceMinutes := policy["grace_minutes"].(int)
+
+		if mode == string(sql.Automatic) && graceMinutes == 0 {
+			return fmt.Errorf("`grace_minutes` must be specified when the `read_write_endpoint_failover_policy` `mode` is `Automatic`")
+		}
+		if mode == string(sql.Manual) && graceMinutes != 0 {
+			return fmt.Errorf("`grace_minutes` can only be specified when the `read_write_endpoint_failover_policy` `mode` is `Automatic`")
+		}
+	}
+
+	// the membership for new Failover Groups is determined at apply time, since the Server may not exist yet
+	if !diff.Get("include_all_databases").(bool) || diff.Id() == "" {
+		return nil
+	}
+
+	client := v.(*ArmClient).msSqlDatabasesClient
+	ctx := v.(*ArmClient).StopContext
+
+	resourceGroup := diff.Get("resource_group_name").(string)
+	serverName := diff.Get("server_name").(string)
+
+	databaseIds, err := msSqlFailoverGroupServerDatabaseIds(ctx, client, resourceGroup, serverName)
+	if err != nil {
+		return err
+	}
+
+	existing := make([]string, 0)
+	for _, id := range diff.Get("databases").(*schema.Set).List() {
+		existing = append(existing, id.(string))
+	}
+
+	if msSqlFailoverGroupDatabasesDiffer(existing, databaseIds) {
+		log.Printf("[DEBUG] The Databases on SQL Server %q (Resource Group %q) have changed - updating the membership of the Failover Group", serverName, resourceGroup)
+		if err := diff.SetNew("databases", databaseIds); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_failover_group", *existing.ID)
+		}
+	}
+
+	databases := make([]string, 0)
+	if d.Get("include_all_databases").(bool) {
+		databaseIds, err := msSqlFailoverGroupServerDatabaseIds(ctx, meta.(*ArmClient).msSqlDatabasesClient, resourceGroup, serverName)
+		if err != nil {
+			return err
+		}
+		databases = databaseIds
+	} else {
+		for _, id := range d.Get("databases").(*schema.Set).List() {
+			databases = append(databases, id.(string))
+		}
+	}
+
+	readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if d.Get("readonly_endpoint_failover_policy_enabled").(bool) {
+		readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := sql.FailoverGroup{
+		FailoverGroupProperties: &sql.FailoverGroupProperties{
+			ReadWriteEndpoint: expandArmMsSqlFailoverGroupReadWriteEndpoint(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			ReadOnlyEndpoint: &sql.FailoverGroupReadOnlyEndpoint{
+				FailoverPolicy: readOnlyFailoverPolicy,
+			},
+			PartnerServers: expandArmMsSqlFailoverGroupPartnerServers(d.Get("partner_server").([]interface{})),
+			Databases:      &databases,
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	invalidateArmMsSqlFailoverGroupServers(meta, resourceGroup, serverName, d.Get("partner_server").([]interface{}))
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Failover Group %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlFailoverGroupRead(d, meta)
+}
+
+func resourceArmMsSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Failover Group %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		d.Set("role", string(props.ReplicationRole))
+
+		if err := d.Set("read_write_endpoint_failover_policy", flattenArmMsSqlFailoverGroupReadWriteEndpoint(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		readOnlyFailoverPolicyEnabled := false
+		if endpoint := props.ReadOnlyEndpoint; endpoint != nil {
+			readOnlyFailoverPolicyEnabled = endpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+		}
+		d.Set("readonly_endpoint_failover_policy_enabled", readOnlyFailoverPolicyEnabled)
+
+		if err := d.Set("partner_server", flattenArmMsSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("Error setting `partner_server`: %+v", err)
+		}
+
+		databases := make([]interface{}, 0)
+		if props.Databases != nil {
+			for _, v := range *props.Databases {
+				databases = append(databases, v)
+			}
+		}
+		if err := d.Set("databases", schema.NewSet(schema.HashString, databases)); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMsSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	invalidateArmMsSqlFailoverGroupServers(meta, resourceGroup, serverName, d.Get("partner_server").([]interface{}))
+
+	return nil
+}
+
+// msSqlFailoverGroupServerDatabaseIds returns the (sorted) IDs of the user Databases on the specified Server
+func msSqlFailoverGroupServerDatabaseIds(ctx context.Context, client MsSql.DatabasesClient, resourceGroup string, serverName string) ([]string, error) {
+	iterator, err := client.ListByServerComplete(ctx, resourceGroup, serverName)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Databases for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	ids := make([]string, 0)
+	for iterator.NotDone() {
+		database := iterator.Value()
+		// the `master` database is a system database which can't be a member of a Failover Group
+		if database.ID != nil && database.Name != nil && !strings.EqualFold(*database.Name, "master") {
+			ids = append(ids, *database.ID)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Databases for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// msSqlFailoverGroupDatabasesDiffer compares the Database IDs case-insensitively, since the casing of the Resource
+// Group returned by the Databases and Failover Groups APIs can differ
+func msSqlFailoverGroupDatabasesDiffer(first []string, second []string) bool {
+	if len(first) != len(second) {
+		return true
+	}
+
+	ids := make(map[string]struct{})
+	for _, v := range first {
+		ids[strings.ToLower(v)] = struct{}{}
+	}
+
+	for _, v := range second {
+		if _, ok := ids[strings.ToLower(v)]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandArmMsSqlFailoverGroupReadWriteEndpoint(input []interface{}) *sql.FailoverGroupReadWriteEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	endpoint := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: sql.ReadWriteEndpointFailoverPolicy(v["mode"].(string)),
+	}
+
+	if graceMinutes := v["grace_minutes"].(int); graceMinutes != 0 {
+		endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(graceMinutes))
+	}
+
+	return &endpoint
+}
+
+func flattenArmMsSqlFailoverGroupReadWriteEndpoint(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+// invalidateArmMsSqlFailoverGroupServers invalidates the cached Databases of the Server and each Partner Server, since
+// adding Databases to a Failover Group creates their Geo-Secondaries on the Partner Servers
+func invalidateArmMsSqlFailoverGroupServers(meta interface{}, resourceGroup string, serverName string, partners []interface{}) {
+	cache := meta.(*ArmClient).sqlServerCache
+	cache.invalidate(resourceGroup, serverName)
+
+	for _, raw := range partners {
+		v := raw.(map[string]interface{})
+		id, err := parseAzureResourceID(v["id"].(string))
+		if err != nil {
+			continue
+		}
+
+		cache.invalidate(id.ResourceGroup, id.Path["servers"])
+	}
+}
+
+func expandArmMsSqlFailoverGroupPartnerServers(input []interface{}) *[]sql.PartnerInfo {
+	partners := make([]sql.PartnerInfo, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		partners = append(partners, sql.PartnerInfo{
+			ID: utils.String(v["id"].(string)),
+		})
+	}
+
+	return &partners
+}
+
+func flattenArmMsSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		id := ""
+		if v.ID != nil {
+			id = *v.ID
+		}
+
+		location := ""
+		if v.Location != nil {
+			location = azureRMNormalizeLocation(*v.Location)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":       id,
+			"location": location,
+			"role":     string(v.ReplicationRole),
+		})
+	}
+
+	return results
+}
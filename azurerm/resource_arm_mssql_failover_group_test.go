@@ -0,0 +1,409 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestMsSqlFailoverGroupDatabasesDiffer(t *testing.T) {
+	cases := []struct {
+		First    []string
+		Second   []string
+		Expected bool
+	}{
+		{
+			First:    []string{},
+			Second:   []string{},
+			Expected: false,
+		},
+		{
+			First:    []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1"},
+			Second:   []string{},
+			Expected: true,
+		},
+		{
+			First: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db2",
+			},
+			Second: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1/providers/Microsoft.Sql/servers/server1/databases/db2",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1/providers/Microsoft.Sql/servers/server1/databases/db1",
+			},
+			Expected: false,
+		},
+		{
+			First: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1",
+			},
+			Second: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db2",
+			},
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := msSqlFailoverGroupDatabasesDiffer(tc.First, tc.Second); actual != tc.Expected {
+			t.Fatalf("Expected %+v and %+v to differ: %t but got %t", tc.First, tc.Second, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMMsSqlFailoverGroup_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_basic(ri, testLocation(), testAltLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partner_server.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partner_server.0.role", "Secondary"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlFailoverGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlFailoverGroup_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_mssql_failover_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlFailoverGroup_includeAllDatabases(t *testing.T) {
+	resourceName := "azurerm_mssql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_includeAllDatabases(ri, location, altLocation, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "1"),
+				),
+			},
+			{
+				// the additional Database is created after the Failover Group, so is picked up on the next apply
+				Config: testAccAzureRMMsSqlFailoverGroup_includeAllDatabases(ri, location, altLocation, testAccAzureRMMsSqlFailoverGroup_additionalDatabase(ri)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_includeAllDatabases(ri, location, altLocation, testAccAzureRMMsSqlFailoverGroup_additionalDatabase(ri)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlFailoverGroup_update(t *testing.T) {
+	resourceName := "azurerm_mssql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlFailoverGroup_updated(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Manual"),
+					resource.TestCheckResourceAttr(resourceName, "readonly_endpoint_failover_policy_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlFailoverGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		serverName := id.Path["servers"]
+		name := id.Path["failoverGroups"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlFailoverGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Failover Group %q (Server %q / Resource Group %q) does not exist", name, serverName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on msSqlFailoverGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlFailoverGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).msSqlFailoverGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_failover_group" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		serverName := id.Path["servers"]
+		name := id.Path["failoverGroups"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Failover Group %q (Server %q / Resource Group %q) still exists", name, serverName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlFailoverGroup_template(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "primary" {
+  name                         = "acctestsqlserver%[1]d-primary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+  name                         = "acctestsqlserver%[1]d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "%[3]s"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.primary.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, altLocation)
+}
+
+func testAccAzureRMMsSqlFailoverGroup_basic(rInt int, location, altLocation string) string {
+	template := testAccAzureRMMsSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.primary.name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_server {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlFailoverGroup_requiresImport(rInt int, location, altLocation string) string {
+	template := testAccAzureRMMsSqlFailoverGroup_basic(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "import" {
+  name                = "${azurerm_mssql_failover_group.test.name}"
+  resource_group_name = "${azurerm_mssql_failover_group.test.resource_group_name}"
+  server_name         = "${azurerm_mssql_failover_group.test.server_name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_server {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, template)
+}
+
+func testAccAzureRMMsSqlFailoverGroup_updated(rInt int, location, altLocation string) string {
+	template := testAccAzureRMMsSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.primary.name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_server {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  readonly_endpoint_failover_policy_enabled = true
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlFailoverGroup_includeAllDatabases(rInt int, location, altLocation string, additional string) string {
+	template := testAccAzureRMMsSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name                  = "acctestsfg%d"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  server_name           = "${azurerm_sql_server.primary.name}"
+  include_all_databases = true
+
+  partner_server {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+
+  depends_on = ["azurerm_sql_database.test"]
+}
+`, template, additional, rInt)
+}
+
+func testAccAzureRMMsSqlFailoverGroup_additionalDatabase(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_sql_database" "additional" {
+  name                             = "acctestdb%d-additional"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.primary.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt)
+}
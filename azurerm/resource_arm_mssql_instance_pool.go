@@ -0,0 +1,256 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// SQL Managed Instance Pools aren't present in the vendored SDK, so are managed using raw requests
+const msSqlInstancePoolApiVersion = "2021-11-01"
+
+type msSqlInstancePool struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Sku        *msSqlInstancePoolSku        `json:"sku,omitempty"`
+	Tags       map[string]*string           `json:"tags"`
+	Properties *msSqlInstancePoolProperties `json:"properties,omitempty"`
+}
+
+type msSqlInstancePoolSku struct {
+	Name   *string `json:"name,omitempty"`
+	Tier   *string `json:"tier,omitempty"`
+	Family *string `json:"family,omitempty"`
+}
+
+type msSqlInstancePoolProperties struct {
+	SubnetID    *string `json:"subnetId,omitempty"`
+	VCores      *int32  `json:"vCores,omitempty"`
+	LicenseType *string `json:"licenseType,omitempty"`
+}
+
+// msSqlInstancePoolSkus maps the supported SKU names to their Tier and Family
+var msSqlInstancePoolSkus = map[string][]string{
+	"GP_Gen5": {"GeneralPurpose", "Gen5"},
+	"GP_G8IM": {"GeneralPurpose", "G8IM"},
+	"GP_G8IH": {"GeneralPurpose", "G8IH"},
+}
+
+func resourceArmMsSqlInstancePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlInstancePoolCreateUpdate,
+		Read:   resourceArmMsSqlInstancePoolRead,
+		Update: resourceArmMsSqlInstancePoolCreateUpdate,
+		Delete: resourceArmMsSqlInstancePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GP_Gen5",
+					"GP_G8IM",
+					"GP_G8IH",
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"vcores": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.IntInSlice([]int{8, 16, 24, 32, 40, 64, 80}),
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"BasePrice",
+					"LicenseIncluded",
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMsSqlInstancePoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := msSqlInstancePoolID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing msSqlInstancePool
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlInstancePoolApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing SQL Instance Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_instance_pool", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := msSqlInstancePool{
+		Location: utils.String(location),
+		Sku:      expandArmMsSqlInstancePoolSku(d.Get("sku_name").(string)),
+		Properties: &msSqlInstancePoolProperties{
+			SubnetID:    utils.String(d.Get("subnet_id").(string)),
+			VCores:      utils.Int32(int32(d.Get("vcores").(int))),
+			LicenseType: utils.String(d.Get("license_type").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	// provisioning an Instance Pool can take several hours
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, msSqlInstancePoolApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating SQL Instance Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read msSqlInstancePool
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, msSqlInstancePoolApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving SQL Instance Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Instance Pool %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlInstancePoolRead(d, meta)
+}
+
+func resourceArmMsSqlInstancePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["instancePools"]
+
+	var pool msSqlInstancePool
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), msSqlInstancePoolApiVersion, &pool)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] SQL Instance Pool %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Instance Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", pool.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := pool.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := pool.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	if props := pool.Properties; props != nil {
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("license_type", props.LicenseType)
+
+		if v := props.VCores; v != nil {
+			d.Set("vcores", int(*v))
+		}
+	}
+
+	flattenAndSetTags(d, pool.Tags)
+
+	return nil
+}
+
+func resourceArmMsSqlInstancePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["instancePools"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), msSqlInstancePoolApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Instance Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmMsSqlInstancePoolSku(name string) *msSqlInstancePoolSku {
+	for k, v := range msSqlInstancePoolSkus {
+		if strings.EqualFold(k, name) {
+			return &msSqlInstancePoolSku{
+				Name:   utils.String(k),
+				Tier:   utils.String(v[0]),
+				Family: utils.String(v[1]),
+			}
+		}
+	}
+
+	return &msSqlInstancePoolSku{
+		Name: utils.String(name),
+	}
+}
+
+func msSqlInstancePoolID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/instancePools/%s", subscriptionId, resourceGroup, name)
+}
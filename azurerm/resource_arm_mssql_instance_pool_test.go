@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMMsSqlInstancePool_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_instance_pool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlInstancePool_basic(ri, location, 8, "LicenseIncluded"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlInstancePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "GP_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "vcores", "8"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "LicenseIncluded"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlInstancePool_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_instance_pool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlInstancePool_basic(ri, location, 8, "LicenseIncluded"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlInstancePoolExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlInstancePool_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mssql_instance_pool"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlInstancePool_update(t *testing.T) {
+	resourceName := "azurerm_mssql_instance_pool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlInstancePool_basic(ri, location, 8, "LicenseIncluded"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlInstancePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vcores", "8"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlInstancePool_basic(ri, location, 16, "BasePrice"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlInstancePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vcores", "16"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "BasePrice"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlInstancePoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var pool msSqlInstancePool
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlInstancePoolApiVersion, &pool)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: SQL Instance Pool %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on SQL Instance Pool: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlInstancePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_instance_pool" {
+			continue
+		}
+
+		var pool msSqlInstancePool
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, msSqlInstancePoolApiVersion, &pool)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Instance Pool still exists:\n%#v", pool)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlInstancePool_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                      = "acctestsubnet-%[1]d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  virtual_network_name      = "${azurerm_virtual_network.test.name}"
+  address_prefix            = "10.0.0.0/24"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  route_table_id            = "${azurerm_route_table.test.id}"
+
+  delegation {
+    name = "managedinstancedelegation"
+
+    service_delegation {
+      name    = "Microsoft.Sql/managedInstances"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+
+resource "azurerm_subnet_network_security_group_association" "test" {
+  subnet_id                 = "${azurerm_subnet.test.id}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  route_table_id = "${azurerm_route_table.test.id}"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlInstancePool_basic(rInt int, location string, vCores int, licenseType string) string {
+	template := testAccAzureRMMsSqlInstancePool_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_instance_pool" "test" {
+  name                = "acctestsqlpool%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+  sku_name            = "GP_Gen5"
+  vcores              = %d
+  license_type        = "%s"
+
+  depends_on = [
+    "azurerm_subnet_network_security_group_association.test",
+    "azurerm_subnet_route_table_association.test",
+  ]
+}
+`, template, rInt, vCores, licenseType)
+}
+
+func testAccAzureRMMsSqlInstancePool_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMsSqlInstancePool_basic(rInt, location, 8, "LicenseIncluded")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_instance_pool" "import" {
+  name                = "${azurerm_mssql_instance_pool.test.name}"
+  resource_group_name = "${azurerm_mssql_instance_pool.test.resource_group_name}"
+  location            = "${azurerm_mssql_instance_pool.test.location}"
+  subnet_id           = "${azurerm_mssql_instance_pool.test.subnet_id}"
+  sku_name            = "${azurerm_mssql_instance_pool.test.sku_name}"
+  vcores              = "${azurerm_mssql_instance_pool.test.vcores}"
+  license_type        = "${azurerm_mssql_instance_pool.test.license_type}"
+}
+`, template)
+}
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Outbound Firewall Rules aren't present in the vendored SDK, so are managed using raw requests
+type msSqlOutboundFirewallRule struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *msSqlOutboundFirewallRuleProperties `json:"properties,omitempty"`
+}
+
+type msSqlOutboundFirewallRuleProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+func resourceArmMsSqlOutboundFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlOutboundFirewallRuleCreate,
+		Read:   resourceArmMsSqlOutboundFirewallRuleRead,
+		Delete: resourceArmMsSqlOutboundFirewallRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the name of the Rule is the FQDN which the SQL Server is allowed to connect to
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FQDN,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlOutboundFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	id := msSqlOutboundFirewallRuleID(meta.(*ArmClient).subscriptionId, resourceGroup, serverName, name)
+
+	if requireResourcesToBeImported {
+		var existing msSqlOutboundFirewallRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, sqlServerExtendedApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing SQL Outbound Firewall Rule %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_outbound_firewall_rule", *existing.ID)
+		}
+	}
+
+	parameters := msSqlOutboundFirewallRule{
+		Properties: &msSqlOutboundFirewallRuleProperties{},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, sqlServerExtendedApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating SQL Outbound Firewall Rule %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	var read msSqlOutboundFirewallRule
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, sqlServerExtendedApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving SQL Outbound Firewall Rule %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Outbound Firewall Rule %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlOutboundFirewallRuleRead(d, meta)
+}
+
+func resourceArmMsSqlOutboundFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["outboundFirewallRules"]
+
+	var rule msSqlOutboundFirewallRule
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion, &rule)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] SQL Outbound Firewall Rule %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Outbound Firewall Rule %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	return nil
+}
+
+func resourceArmMsSqlOutboundFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["outboundFirewallRules"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Outbound Firewall Rule %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func msSqlOutboundFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/outboundFirewallRules/%s", subscriptionId, resourceGroup, serverName, name)
+}
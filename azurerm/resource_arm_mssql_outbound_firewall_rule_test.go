@@ -0,0 +1,151 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlOutboundFirewallRule_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_outbound_firewall_rule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlOutboundFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlOutboundFirewallRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlOutboundFirewallRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctestsa%d.blob.core.windows.net", ri)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlOutboundFirewallRule_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_outbound_firewall_rule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlOutboundFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlOutboundFirewallRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlOutboundFirewallRuleExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlOutboundFirewallRule_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_mssql_outbound_firewall_rule"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlOutboundFirewallRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var rule msSqlOutboundFirewallRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, sqlServerExtendedApiVersion, &rule)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("SQL Outbound Firewall Rule %q was not found", rs.Primary.ID)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlOutboundFirewallRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_outbound_firewall_rule" {
+			continue
+		}
+
+		var rule msSqlOutboundFirewallRule
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, sqlServerExtendedApiVersion, &rule)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Outbound Firewall Rule %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlOutboundFirewallRule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                                 = "acctestsqlserver%[1]d"
+  resource_group_name                  = "${azurerm_resource_group.test.name}"
+  location                             = "${azurerm_resource_group.test.location}"
+  version                              = "12.0"
+  administrator_login                  = "mradministrator"
+  administrator_login_password         = "thisIsDog11"
+  outbound_network_restriction_enabled = true
+}
+
+resource "azurerm_mssql_outbound_firewall_rule" "test" {
+  name                = "acctestsa%[1]d.blob.core.windows.net"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlOutboundFirewallRule_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_outbound_firewall_rule" "import" {
+  name                = "${azurerm_mssql_outbound_firewall_rule.test.name}"
+  resource_group_name = "${azurerm_mssql_outbound_firewall_rule.test.resource_group_name}"
+  server_name         = "${azurerm_mssql_outbound_firewall_rule.test.server_name}"
+}
+`, testAccAzureRMMsSqlOutboundFirewallRule_basic(rInt, location))
+}
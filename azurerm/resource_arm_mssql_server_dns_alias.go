@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlServerDnsAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlServerDnsAliasCreate,
+		Read:   resourceArmMsSqlServerDnsAliasRead,
+		Update: resourceArmMsSqlServerDnsAliasUpdate,
+		Delete: resourceArmMsSqlServerDnsAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// changing the Server acquires the DNS Alias on the new Server, rather than recreating it
+			// which means connection strings using the DNS Alias keep working during a failover
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"dns_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlServerDnsAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlServerDnsAliasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_server_dns_alias", *existing.ID)
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error creating SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Server DNS Alias %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlServerDnsAliasRead(d, meta)
+}
+
+func resourceArmMsSqlServerDnsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlServerDnsAliasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	if !d.HasChange("server_name") {
+		return resourceArmMsSqlServerDnsAliasRead(d, meta)
+	}
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	// the DNS Alias is acquired by the new Server, which repoints it in a single operation
+	log.Printf("[DEBUG] Acquiring SQL Server DNS Alias %q on Server %q (Resource Group %q)..", name, serverName, resourceGroup)
+	parameters := sqlPreview.ServerDNSAliasAcquisition{
+		OldServerDNSAliasID: utils.String(d.Id()),
+	}
+	future, err := client.Acquire(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error acquiring SQL Server DNS Alias %q on Server %q (Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for SQL Server DNS Alias %q to be acquired on Server %q (Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Server DNS Alias %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlServerDnsAliasRead(d, meta)
+}
+
+func resourceArmMsSqlServerDnsAliasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlServerDnsAliasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["dnsAliases"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Server DNS Alias %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.ServerDNSAliasProperties; props != nil {
+		d.Set("dns_record", props.AzureDNSRecord)
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlServerDnsAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlServerDnsAliasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["dnsAliases"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Server DNS Alias %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlServerDnsAlias_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_server_dns_alias.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerDnsAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServerDnsAlias_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerDnsAliasExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_record"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlServerDnsAlias_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_server_dns_alias.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerDnsAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServerDnsAlias_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerDnsAliasExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlServerDnsAlias_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_mssql_server_dns_alias"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlServerDnsAlias_acquire(t *testing.T) {
+	resourceName := "azurerm_mssql_server_dns_alias.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerDnsAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServerDnsAlias_acquire(ri, testLocation(), "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerDnsAliasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_name", fmt.Sprintf("acctestsqlserver%d-primary", ri)),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlServerDnsAlias_acquire(ri, testLocation(), "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerDnsAliasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_name", fmt.Sprintf("acctestsqlserver%d-secondary", ri)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlServerDnsAliasExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlServerDnsAliasesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Server DNS Alias %q (Server %q / Resource Group %q) was not found", name, serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlServerDnsAliasDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_server_dns_alias" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlServerDnsAliasesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Server DNS Alias %q (Server %q / Resource Group %q) still exists: %+v", name, serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlServerDnsAlias_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_server_dns_alias" "test" {
+  name                = "acctestsqlalias%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMMsSqlServerDnsAlias_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_dns_alias" "import" {
+  name                = "${azurerm_mssql_server_dns_alias.test.name}"
+  resource_group_name = "${azurerm_mssql_server_dns_alias.test.resource_group_name}"
+  server_name         = "${azurerm_mssql_server_dns_alias.test.server_name}"
+}
+`, testAccAzureRMMsSqlServerDnsAlias_basic(rInt, location))
+}
+
+func testAccAzureRMMsSqlServerDnsAlias_acquire(rInt int, location string, server string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "primary" {
+  name                         = "acctestsqlserver%d-primary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+  name                         = "acctestsqlserver%d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_server_dns_alias" "test" {
+  name                = "acctestsqlalias%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.%s.name}"
+}
+`, rInt, location, rInt, rInt, rInt, server)
+}
@@ -0,0 +1,270 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlSyncGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncGroupCreateUpdate,
+		Read:   resourceArmMsSqlSyncGroupRead,
+		Update: resourceArmMsSqlSyncGroupCreateUpdate,
+		Delete: resourceArmMsSqlSyncGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			// the Hub Database
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			// the Database used to store the metadata & logs for the Sync Group
+			"sync_database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"conflict_resolution_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.HubWin),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.HubWin),
+					string(sql.MemberWin),
+				}, false),
+			},
+
+			// -1 disables automatic synchronisation
+			"interval_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validateMsSqlSyncGroupInterval,
+			},
+
+			"hub_database_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"hub_database_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"sync_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_sync_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlSyncGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	existing, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_sync_group", *existing.ID)
+		}
+	}
+
+	props := sql.SyncGroupProperties{
+		SyncDatabaseID:           utils.String(d.Get("sync_database_id").(string)),
+		ConflictResolutionPolicy: sql.SyncConflictResolutionPolicy(d.Get("conflict_resolution_policy").(string)),
+		Interval:                 utils.Int32(int32(d.Get("interval_in_seconds").(int))),
+	}
+
+	if v, ok := d.GetOk("hub_database_username"); ok {
+		props.HubDatabaseUserName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hub_database_password"); ok {
+		props.HubDatabasePassword = utils.String(v.(string))
+	}
+
+	// the Sync Schema is managed by the `azurerm_mssql_sync_group_schema` resource, so needs to be retained
+	if existingProps := existing.SyncGroupProperties; existingProps != nil {
+		props.Schema = existingProps.Schema
+	}
+
+	parameters := sql.SyncGroup{
+		SyncGroupProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Sync Group %q (Database %q / Server %q / Resource Group %q)", name, databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlSyncGroupRead(d, meta)
+}
+
+func resourceArmMsSqlSyncGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	name := id.Path["syncGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Sync Group %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := resp.SyncGroupProperties; props != nil {
+		d.Set("sync_database_id", props.SyncDatabaseID)
+		d.Set("conflict_resolution_policy", string(props.ConflictResolutionPolicy))
+		d.Set("hub_database_username", props.HubDatabaseUserName)
+		d.Set("sync_state", string(props.SyncState))
+
+		if interval := props.Interval; interval != nil {
+			d.Set("interval_in_seconds", int(*interval))
+		}
+
+		lastSyncTime := ""
+		if v := props.LastSyncTime; v != nil {
+			lastSyncTime = v.String()
+		}
+		d.Set("last_sync_time", lastSyncTime)
+
+		// the Hub Database Password isn't returned from the API, so we leave it as-is
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlSyncGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	name := id.Path["syncGroups"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, databaseName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// validateMsSqlSyncGroupInterval validates the interval is either -1 (manual) or between 5 minutes and 30 days
+func validateMsSqlSyncGroupInterval(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return warnings, errors
+	}
+
+	if v != -1 && (v < 300 || v > 2592000) {
+		errors = append(errors, fmt.Errorf("%q must be -1 (to disable automatic synchronisation) or between 300 and 2592000 seconds, got %d", k, v))
+	}
+
+	return warnings, errors
+}
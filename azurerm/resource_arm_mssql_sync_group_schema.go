@@ -0,0 +1,320 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const msSqlSyncGroupSchemaIDSuffix = "/schema"
+
+// resourceArmMsSqlSyncGroupSchema manages the tables & columns synchronised by a Sync Group - this is a separate
+// resource since the Schema can reference a Sync Member, which itself depends on the Sync Group
+func resourceArmMsSqlSyncGroupSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncGroupSchemaCreateUpdate,
+		Read:   resourceArmMsSqlSyncGroupSchemaRead,
+		Update: resourceArmMsSqlSyncGroupSchemaCreateUpdate,
+		Delete: resourceArmMsSqlSyncGroupSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"sync_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// when omitted the Schema is taken from the Hub Database
+			"master_sync_member_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"table": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// e.g. `[dbo].[Customers]`
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"column": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// e.g. `[CustomerId]`
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"data_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"data_size": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlSyncGroupSchemaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	syncGroupName := d.Get("sync_group_name").(string)
+
+	syncGroup, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if syncGroup.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Sync Group %q (Database %q / Server %q / Resource Group %q)", syncGroupName, databaseName, serverName, resourceGroup)
+	}
+
+	// the Sync Schema is validated against the Hub Schema, which needs to be refreshed to pick up any recent changes
+	log.Printf("[DEBUG] Refreshing the Hub Schema for SQL Sync Group %q (Database %q / Server %q / Resource Group %q)..", syncGroupName, databaseName, serverName, resourceGroup)
+	refreshFuture, err := client.RefreshHubSchema(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+	if err != nil {
+		return fmt.Errorf("Error refreshing the Hub Schema for SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = refreshFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Hub Schema for SQL Sync Group %q (Database %q / Server %q / Resource Group %q) to be refreshed: %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	syncSchema := sql.SyncGroupSchema{
+		Tables: expandMsSqlSyncGroupSchemaTables(d.Get("table").([]interface{})),
+	}
+	if v, ok := d.GetOk("master_sync_member_name"); ok {
+		syncSchema.MasterSyncMemberName = utils.String(v.(string))
+	}
+
+	parameters := sql.SyncGroup{
+		SyncGroupProperties: &sql.SyncGroupProperties{
+			Schema: &syncSchema,
+		},
+	}
+
+	future, err := client.Update(ctx, resourceGroup, serverName, databaseName, syncGroupName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating the Schema for SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Schema for SQL Sync Group %q (Database %q / Server %q / Resource Group %q) to be updated: %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s%s", *syncGroup.ID, msSqlSyncGroupSchemaIDSuffix))
+	}
+
+	return resourceArmMsSqlSyncGroupSchemaRead(d, meta)
+}
+
+func resourceArmMsSqlSyncGroupSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(strings.TrimSuffix(d.Id(), msSqlSyncGroupSchemaIDSuffix))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	syncGroupName := id.Path["syncGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Sync Group %q was not found - removing Schema from state", syncGroupName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+	d.Set("sync_group_name", syncGroupName)
+
+	if props := resp.SyncGroupProperties; props != nil && props.Schema != nil {
+		d.Set("master_sync_member_name", props.Schema.MasterSyncMemberName)
+
+		if err := d.Set("table", flattenMsSqlSyncGroupSchemaTables(props.Schema.Tables)); err != nil {
+			return fmt.Errorf("Error setting `table`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlSyncGroupSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(strings.TrimSuffix(d.Id(), msSqlSyncGroupSchemaIDSuffix))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	syncGroupName := id.Path["syncGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	tables := make([]sql.SyncGroupSchemaTable, 0)
+	parameters := sql.SyncGroup{
+		SyncGroupProperties: &sql.SyncGroupProperties{
+			Schema: &sql.SyncGroupSchema{
+				Tables: &tables,
+			},
+		},
+	}
+
+	future, err := client.Update(ctx, resourceGroup, serverName, databaseName, syncGroupName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error removing the Schema from SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Schema to be removed from SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlSyncGroupSchemaTables(input []interface{}) *[]sql.SyncGroupSchemaTable {
+	tables := make([]sql.SyncGroupSchemaTable, 0)
+
+	for _, t := range input {
+		table := t.(map[string]interface{})
+
+		columns := make([]sql.SyncGroupSchemaTableColumn, 0)
+		for _, c := range table["column"].([]interface{}) {
+			column := c.(map[string]interface{})
+
+			output := sql.SyncGroupSchemaTableColumn{
+				QuotedName: utils.String(column["name"].(string)),
+			}
+			if v := column["data_type"].(string); v != "" {
+				output.DataType = utils.String(v)
+			}
+			if v := column["data_size"].(string); v != "" {
+				output.DataSize = utils.String(v)
+			}
+
+			columns = append(columns, output)
+		}
+
+		tables = append(tables, sql.SyncGroupSchemaTable{
+			QuotedName: utils.String(table["name"].(string)),
+			Columns:    &columns,
+		})
+	}
+
+	return &tables
+}
+
+func flattenMsSqlSyncGroupSchemaTables(input *[]sql.SyncGroupSchemaTable) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, table := range *input {
+		columns := make([]interface{}, 0)
+		if table.Columns != nil {
+			for _, column := range *table.Columns {
+				output := make(map[string]interface{})
+				if v := column.QuotedName; v != nil {
+					output["name"] = *v
+				}
+				if v := column.DataType; v != nil {
+					output["data_type"] = *v
+				}
+				if v := column.DataSize; v != nil {
+					output["data_size"] = *v
+				}
+				columns = append(columns, output)
+			}
+		}
+
+		output := map[string]interface{}{
+			"column": columns,
+		}
+		if v := table.QuotedName; v != nil {
+			output["name"] = *v
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
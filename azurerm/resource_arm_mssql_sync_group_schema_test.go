@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+// the Hub Database needs to contain a `[dbo].[Customers]` table with `[CustomerId]` and `[Name]` columns,
+// which can't be provisioned using Terraform
+func TestAccAzureRMMsSqlSyncGroupSchema_basic(t *testing.T) {
+	hubDatabaseId := os.Getenv("ARM_TEST_MSSQL_SYNC_HUB_DATABASE_ID")
+	if hubDatabaseId == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_MSSQL_SYNC_HUB_DATABASE_ID")
+		return
+	}
+
+	resourceName := "azurerm_mssql_sync_group_schema.test"
+	ri := tf.AccRandTimeInt()
+
+	id, err := parseAzureResourceID(hubDatabaseId)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %+v", hubDatabaseId, err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncGroupSchema_basic(ri, testLocation(), id, []string{"[CustomerId]"}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupSchemaHasTables(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "table.0.name", "[dbo].[Customers]"),
+					resource.TestCheckResourceAttr(resourceName, "table.0.column.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlSyncGroupSchema_basic(ri, testLocation(), id, []string{"[CustomerId]", "[Name]"}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupSchemaHasTables(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "table.0.column.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMsSqlSyncGroupSchemaTablesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name": "[dbo].[Customers]",
+			"column": []interface{}{
+				map[string]interface{}{
+					"name":      "[CustomerId]",
+					"data_type": "int",
+					"data_size": "4",
+				},
+				map[string]interface{}{
+					"name":      "[Name]",
+					"data_type": "nvarchar",
+					"data_size": "100",
+				},
+			},
+		},
+	}
+
+	actual := flattenMsSqlSyncGroupSchemaTables(expandMsSqlSyncGroupSchemaTables(input))
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+
+	// data types & sizes are optional, in which case they're determined from the Hub Schema
+	tables := expandMsSqlSyncGroupSchemaTables([]interface{}{
+		map[string]interface{}{
+			"name": "[dbo].[Orders]",
+			"column": []interface{}{
+				map[string]interface{}{
+					"name":      "[OrderId]",
+					"data_type": "",
+					"data_size": "",
+				},
+			},
+		},
+	})
+	column := (*(*tables)[0].Columns)[0]
+	if column.DataType != nil || column.DataSize != nil {
+		t.Fatalf("Expected the Data Type and Data Size to be omitted but got %+v", column)
+	}
+}
+
+func testCheckAzureRMMsSqlSyncGroupSchemaHasTables(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlSyncGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving SQL Sync Group %q (Database %q / Server %q / Resource Group %q): %+v", syncGroupName, databaseName, serverName, resourceGroup, err)
+		}
+
+		actual := 0
+		if props := resp.SyncGroupProperties; props != nil && props.Schema != nil && props.Schema.Tables != nil {
+			actual = len(*props.Schema.Tables)
+		}
+
+		if actual != expected {
+			return fmt.Errorf("Expected SQL Sync Group %q to have %d tables but got %d", syncGroupName, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMMsSqlSyncGroupSchema_basic(rInt int, location string, hubDatabaseId *ResourceID, columns []string) string {
+	columnBlocks := ""
+	for _, column := range columns {
+		columnBlocks += fmt.Sprintf(`
+    column {
+      name = "%s"
+    }
+`, column)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_sql_database" "sync" {
+  name                             = "acctestdb%[1]d-sync"
+  resource_group_name              = "%[3]s"
+  server_name                      = "%[4]s"
+  location                         = "%[2]s"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_mssql_sync_group" "test" {
+  name                = "acctestsyncgroup%[1]d"
+  resource_group_name = "%[3]s"
+  server_name         = "%[4]s"
+  database_name       = "%[5]s"
+  sync_database_id    = "${azurerm_sql_database.sync.id}"
+}
+
+resource "azurerm_mssql_sync_group_schema" "test" {
+  resource_group_name = "${azurerm_mssql_sync_group.test.resource_group_name}"
+  server_name         = "${azurerm_mssql_sync_group.test.server_name}"
+  database_name       = "${azurerm_mssql_sync_group.test.database_name}"
+  sync_group_name     = "${azurerm_mssql_sync_group.test.name}"
+
+  table {
+    name = "[dbo].[Customers]"
+%[6]s
+  }
+}
+`, rInt, location, hubDatabaseId.ResourceGroup, hubDatabaseId.Path["servers"], hubDatabaseId.Path["databases"], columnBlocks)
+}
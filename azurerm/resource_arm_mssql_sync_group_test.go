@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlSyncGroup_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_sync_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conflict_resolution_policy", "HubWin"),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "sync_state"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_sync_time"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_sync_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlSyncGroup_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_mssql_sync_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncGroup_update(t *testing.T) {
+	resourceName := "azurerm_mssql_sync_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlSyncGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conflict_resolution_policy", "MemberWin"),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "hub_database_username", "mradministrator"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hub_database_password", "last_sync_time"},
+			},
+		},
+	})
+}
+
+func TestValidateMsSqlSyncGroupInterval(t *testing.T) {
+	cases := []struct {
+		Value  int
+		Errors int
+	}{
+		{
+			Value:  -2,
+			Errors: 1,
+		},
+		{
+			Value:  -1,
+			Errors: 0,
+		},
+		{
+			Value:  0,
+			Errors: 1,
+		},
+		{
+			Value:  299,
+			Errors: 1,
+		},
+		{
+			Value:  300,
+			Errors: 0,
+		},
+		{
+			Value:  2592000,
+			Errors: 0,
+		},
+		{
+			Value:  2592001,
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateMsSqlSyncGroupInterval(tc.Value, "interval_in_seconds")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %d but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMMsSqlSyncGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlSyncGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Sync Group %q (Database %q / Server %q / Resource Group %q) was not found", name, databaseName, serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlSyncGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_sync_group" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlSyncGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Sync Group %q (Database %q / Server %q / Resource Group %q) still exists: %+v", name, databaseName, serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlSyncGroup_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "hub" {
+  name                             = "acctestdb%[1]d-hub"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_sql_database" "sync" {
+  name                             = "acctestdb%[1]d-sync"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlSyncGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_group" "test" {
+  name                = "acctestsyncgroup%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.hub.name}"
+  sync_database_id    = "${azurerm_sql_database.sync.id}"
+}
+`, testAccAzureRMMsSqlSyncGroup_template(rInt, location), rInt)
+}
+
+func testAccAzureRMMsSqlSyncGroup_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_group" "import" {
+  name                = "${azurerm_mssql_sync_group.test.name}"
+  resource_group_name = "${azurerm_mssql_sync_group.test.resource_group_name}"
+  server_name         = "${azurerm_mssql_sync_group.test.server_name}"
+  database_name       = "${azurerm_mssql_sync_group.test.database_name}"
+  sync_database_id    = "${azurerm_mssql_sync_group.test.sync_database_id}"
+}
+`, testAccAzureRMMsSqlSyncGroup_basic(rInt, location))
+}
+
+func testAccAzureRMMsSqlSyncGroup_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_group" "test" {
+  name                       = "acctestsyncgroup%d"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  server_name                = "${azurerm_sql_server.test.name}"
+  database_name              = "${azurerm_sql_database.hub.name}"
+  sync_database_id           = "${azurerm_sql_database.sync.id}"
+  conflict_resolution_policy = "MemberWin"
+  interval_in_seconds        = 300
+  hub_database_username      = "mradministrator"
+  hub_database_password      = "thisIsDog11"
+}
+`, testAccAzureRMMsSqlSyncGroup_template(rInt, location), rInt)
+}
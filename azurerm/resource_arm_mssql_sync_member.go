@@ -0,0 +1,324 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlSyncMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncMemberCreateUpdate,
+		Read:   resourceArmMsSqlSyncMemberRead,
+		Update: resourceArmMsSqlSyncMemberCreateUpdate,
+		Delete: resourceArmMsSqlSyncMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"sync_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"database_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(sql.AzureSQLDatabase),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.AzureSQLDatabase),
+					string(sql.SQLServerDatabase),
+				}, false),
+			},
+
+			// the fully qualified domain name of the Server containing the Member Database
+			"member_server_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"member_database_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// required for on-premises SQL Server Databases
+			"sync_agent_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sql_server_database_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"sync_direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.Bidirectional),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.Bidirectional),
+					string(sql.OneWayHubToMember),
+					string(sql.OneWayMemberToHub),
+				}, false),
+			},
+
+			"sync_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			databaseType := diff.Get("database_type").(string)
+
+			required := []string{"member_server_name", "member_database_name"}
+			if databaseType == string(sql.SQLServerDatabase) {
+				required = []string{"sync_agent_id", "sql_server_database_id"}
+			}
+
+			for _, field := range required {
+				if _, ok := diff.GetOk(field); ok || !diff.NewValueKnown(field) {
+					continue
+				}
+
+				return fmt.Errorf("`%s` must be specified when `database_type` is %q", field, databaseType)
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmMsSqlSyncMemberCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncMembersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	syncGroupName := d.Get("sync_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_sync_member", *existing.ID)
+		}
+	}
+
+	props := sql.SyncMemberProperties{
+		DatabaseType:  sql.SyncMemberDbType(d.Get("database_type").(string)),
+		SyncDirection: sql.SyncDirection(d.Get("sync_direction").(string)),
+	}
+
+	if v, ok := d.GetOk("member_server_name"); ok {
+		props.ServerName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_database_name"); ok {
+		props.DatabaseName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sync_agent_id"); ok {
+		props.SyncAgentID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sql_server_database_id"); ok {
+		databaseId, err := uuid.FromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `sql_server_database_id` %q: %+v", v.(string), err)
+		}
+		props.SQLServerDatabaseID = &databaseId
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		props.UserName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		props.Password = utils.String(v.(string))
+	}
+
+	parameters := sql.SyncMember{
+		SyncMemberProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, syncGroupName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	// provisioning a Sync Member updates the Hub Database, so the cached Databases of its Server are stale
+	meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+
+	read, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q)", name, syncGroupName, databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlSyncMemberRead(d, meta)
+}
+
+func resourceArmMsSqlSyncMemberRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncMembersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["syncMembers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Sync Member %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+	d.Set("sync_group_name", syncGroupName)
+
+	if props := resp.SyncMemberProperties; props != nil {
+		d.Set("database_type", string(props.DatabaseType))
+		d.Set("member_server_name", props.ServerName)
+		d.Set("member_database_name", props.DatabaseName)
+		d.Set("sync_agent_id", props.SyncAgentID)
+		d.Set("username", props.UserName)
+		d.Set("sync_direction", string(props.SyncDirection))
+		d.Set("sync_state", string(props.SyncState))
+
+		sqlServerDatabaseId := ""
+		if v := props.SQLServerDatabaseID; v != nil {
+			sqlServerDatabaseId = v.String()
+		}
+		d.Set("sql_server_database_id", sqlServerDatabaseId)
+
+		// the Password isn't returned from the API, so we leave it as-is
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlSyncMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).msSqlSyncMembersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["syncMembers"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q): %+v", name, syncGroupName, databaseName, serverName, resourceGroup, err)
+		}
+	}
+
+	meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlSyncMember_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_sync_member.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(ri, testLocation(), "Bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database_type", "AzureSQLDatabase"),
+					resource.TestCheckResourceAttr(resourceName, "sync_direction", "Bidirectional"),
+					resource.TestCheckResourceAttrSet(resourceName, "sync_state"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_sync_member.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(ri, testLocation(), "Bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlSyncMember_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_mssql_sync_member"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_syncDirection(t *testing.T) {
+	resourceName := "azurerm_mssql_sync_member.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(ri, location, "Bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(ri, location, "OneWayHubToMember"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_direction", "OneWayHubToMember"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_sqlServerDatabaseRequiresSyncAgent(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMMsSqlSyncMember_sqlServerDatabase(ri, testLocation()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`sync_agent_id` must be specified"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlSyncMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlSyncMembersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q) was not found", name, syncGroupName, databaseName, serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlSyncMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_sync_member" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).msSqlSyncMembersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Sync Member %q (Sync Group %q / Database %q / Server %q / Resource Group %q) still exists: %+v", name, syncGroupName, databaseName, serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlSyncMember_basic(rInt int, location string, syncDirection string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "member" {
+  name                             = "acctestdb%d-member"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_mssql_sync_member" "test" {
+  name                 = "acctestsyncmember%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  server_name          = "${azurerm_sql_server.test.name}"
+  database_name        = "${azurerm_sql_database.hub.name}"
+  sync_group_name      = "${azurerm_mssql_sync_group.test.name}"
+  member_server_name   = "${azurerm_sql_server.test.fully_qualified_domain_name}"
+  member_database_name = "${azurerm_sql_database.member.name}"
+  username             = "mradministrator"
+  password             = "thisIsDog11"
+  sync_direction       = "%s"
+}
+`, testAccAzureRMMsSqlSyncGroup_basic(rInt, location), rInt, rInt, syncDirection)
+}
+
+func testAccAzureRMMsSqlSyncMember_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_member" "import" {
+  name                 = "${azurerm_mssql_sync_member.test.name}"
+  resource_group_name  = "${azurerm_mssql_sync_member.test.resource_group_name}"
+  server_name          = "${azurerm_mssql_sync_member.test.server_name}"
+  database_name        = "${azurerm_mssql_sync_member.test.database_name}"
+  sync_group_name      = "${azurerm_mssql_sync_member.test.sync_group_name}"
+  member_server_name   = "${azurerm_mssql_sync_member.test.member_server_name}"
+  member_database_name = "${azurerm_mssql_sync_member.test.member_database_name}"
+  username             = "mradministrator"
+  password             = "thisIsDog11"
+}
+`, testAccAzureRMMsSqlSyncMember_basic(rInt, location, "Bidirectional"))
+}
+
+func testAccAzureRMMsSqlSyncMember_sqlServerDatabase(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_member" "test" {
+  name                = "acctestsyncmember%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.hub.name}"
+  sync_group_name     = "${azurerm_mssql_sync_group.test.name}"
+  database_type       = "SQLServerDatabase"
+}
+`, testAccAzureRMMsSqlSyncGroup_basic(rInt, location), rInt)
+}
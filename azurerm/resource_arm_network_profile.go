@@ -0,0 +1,327 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var networkProfileResourceName = "azurerm_network_profile"
+
+func resourceArmNetworkProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkProfileCreateUpdate,
+		Read:   resourceArmNetworkProfileRead,
+		Update: resourceArmNetworkProfileCreateUpdate,
+		Delete: resourceArmNetworkProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"container_network_interface": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"ip_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"subnet_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"container_network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).networkProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Network Profile %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_network_profile", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, networkProfileResourceName)
+	defer azureRMUnlockByName(name, networkProfileResourceName)
+
+	parameters := network.Profile{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
+			ContainerNetworkInterfaceConfigurations: expandArmNetworkProfileContainerNetworkInterface(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Network Profile %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNetworkProfileRead(d, meta)
+}
+
+func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).networkProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["networkProfiles"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Network Profile %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ProfilePropertiesFormat; props != nil {
+		if err := d.Set("container_network_interface", flattenArmNetworkProfileContainerNetworkInterface(props.ContainerNetworkInterfaceConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `container_network_interface`: %+v", err)
+		}
+
+		if err := d.Set("container_network_interface_ids", flattenArmNetworkProfileContainerNetworkInterfaceIds(props.ContainerNetworkInterfaces)); err != nil {
+			return fmt.Errorf("Error setting `container_network_interface_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).networkProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["networkProfiles"]
+
+	azureRMLockByName(name, networkProfileResourceName)
+	defer azureRMUnlockByName(name, networkProfileResourceName)
+
+	// the Container Network Interfaces are released asynchronously once the Container Groups using this Network Profile
+	// have been deleted, until which the Network Profile can't be deleted
+	if err := waitForArmNetworkProfileContainerNetworkInterfacesToBeReleased(ctx, client, resourceGroup, name); err != nil {
+		return fmt.Errorf("Error waiting for the Container Network Interfaces of Network Profile %q (Resource Group %q) to be released: %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func waitForArmNetworkProfileContainerNetworkInterfacesToBeReleased(ctx context.Context, client network.ProfilesClient, resourceGroup string, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"InUse"},
+		Target:  []string{"Released"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, resourceGroup, name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "Released", nil
+				}
+
+				return nil, "", err
+			}
+
+			if props := resp.ProfilePropertiesFormat; props != nil && props.ContainerNetworkInterfaces != nil && len(*props.ContainerNetworkInterfaces) > 0 {
+				log.Printf("[DEBUG] Network Profile %q (Resource Group %q) still has %d Container Network Interfaces", name, resourceGroup, len(*props.ContainerNetworkInterfaces))
+				return resp, "InUse", nil
+			}
+
+			return resp, "Released", nil
+		},
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandArmNetworkProfileContainerNetworkInterface(d *schema.ResourceData) *[]network.ContainerNetworkInterfaceConfiguration {
+	inputs := d.Get("container_network_interface").([]interface{})
+	results := make([]network.ContainerNetworkInterfaceConfiguration, 0)
+
+	for _, input := range inputs {
+		v := input.(map[string]interface{})
+
+		ipConfigurations := make([]network.IPConfigurationProfile, 0)
+		for _, raw := range v["ip_configuration"].([]interface{}) {
+			ipConfiguration := raw.(map[string]interface{})
+
+			ipConfigurations = append(ipConfigurations, network.IPConfigurationProfile{
+				Name: utils.String(ipConfiguration["name"].(string)),
+				IPConfigurationProfilePropertiesFormat: &network.IPConfigurationProfilePropertiesFormat{
+					Subnet: &network.Subnet{
+						ID: utils.String(ipConfiguration["subnet_id"].(string)),
+					},
+				},
+			})
+		}
+
+		results = append(results, network.ContainerNetworkInterfaceConfiguration{
+			Name: utils.String(v["name"].(string)),
+			ContainerNetworkInterfaceConfigurationPropertiesFormat: &network.ContainerNetworkInterfaceConfigurationPropertiesFormat{
+				IPConfigurations: &ipConfigurations,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmNetworkProfileContainerNetworkInterface(input *[]network.ContainerNetworkInterfaceConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		ipConfigurations := make([]interface{}, 0)
+		if props := v.ContainerNetworkInterfaceConfigurationPropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, ipConfiguration := range *props.IPConfigurations {
+				ipConfigurationName := ""
+				if ipConfiguration.Name != nil {
+					ipConfigurationName = *ipConfiguration.Name
+				}
+
+				subnetId := ""
+				if ipProps := ipConfiguration.IPConfigurationProfilePropertiesFormat; ipProps != nil && ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
+					subnetId = *ipProps.Subnet.ID
+				}
+
+				ipConfigurations = append(ipConfigurations, map[string]interface{}{
+					"name":      ipConfigurationName,
+					"subnet_id": subnetId,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"ip_configuration": ipConfigurations,
+		})
+	}
+
+	return results
+}
+
+func flattenArmNetworkProfileContainerNetworkInterfaceIds(input *[]network.ContainerNetworkInterface) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			results = append(results, *v.ID)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNetworkProfile_basic(t *testing.T) {
+	resourceName := "azurerm_network_profile.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container_network_interface.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_network_interface.0.ip_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkProfile_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_profile.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkProfile_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_network_profile"),
+			},
+		},
+	})
+}
+
+// the Container Group is destroyed before the Network Profile & Subnet, which requires the Container Network Interface
+// to be released and the Service Association Link to be removed from the Subnet
+func TestAccAzureRMNetworkProfile_withContainerGroup(t *testing.T) {
+	resourceName := "azurerm_network_profile.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_withContainerGroup(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container_network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr("azurerm_container_group.test", "ip_address_type", "Private"),
+					resource.TestCheckResourceAttrSet("azurerm_container_group.test", "network_profile_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetNetworkProfileIds(t *testing.T) {
+	networkProfileId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/networkProfiles/example"
+	input := []network.IPConfigurationProfile{
+		{
+			ID: utils.String(networkProfileId + "/containerNetworkInterfaceConfigurations/nic/ipConfigurations/first"),
+		},
+		{
+			ID: utils.String(networkProfileId + "/containerNetworkInterfaceConfigurations/nic/ipConfigurations/second"),
+		},
+		{
+			ID: nil,
+		},
+	}
+
+	actual := subnetNetworkProfileIds(&input)
+	expected := []string{networkProfileId}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func testCheckAzureRMNetworkProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Network Profile: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).networkProfilesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Network Profile %q (resource group: %q) was not found: %+v", name, resourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on networkProfilesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkProfileDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).networkProfilesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_profile" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Network Profile %q still exists", name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkProfile_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.1.0.0/24"
+
+  delegation {
+    name = "acctestdelegation"
+
+    service_delegation {
+      name    = "Microsoft.ContainerInstance/containerGroups"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMNetworkProfile_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "test" {
+  name                = "acctestnetprofile-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  container_network_interface {
+    name = "acctesteth-%d"
+
+    ip_configuration {
+      name      = "acctestipconfig-%d"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, template, rInt, rInt, rInt)
+}
+
+func testAccAzureRMNetworkProfile_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "import" {
+  name                = "${azurerm_network_profile.test.name}"
+  location            = "${azurerm_network_profile.test.location}"
+  resource_group_name = "${azurerm_network_profile.test.resource_group_name}"
+
+  container_network_interface {
+    name = "acctesteth-%d"
+
+    ip_configuration {
+      name      = "acctestipconfig-%d"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMNetworkProfile_withContainerGroup(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ip_address_type     = "Private"
+  network_profile_id  = "${azurerm_network_profile.test.id}"
+  os_type             = "Linux"
+
+  container {
+    name   = "hw"
+    image  = "microsoft/aci-helloworld:latest"
+    cpu    = "0.5"
+    memory = "0.5"
+    port   = 80
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type nginxCertificate struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *nginxCertificateProperties `json:"properties,omitempty"`
+}
+
+type nginxCertificateProperties struct {
+	KeyVirtualPath         *string `json:"keyVirtualPath,omitempty"`
+	CertificateVirtualPath *string `json:"certificateVirtualPath,omitempty"`
+	KeyVaultSecretID       *string `json:"keyVaultSecretId,omitempty"`
+}
+
+func resourceArmNginxCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNginxCertificateCreateUpdate,
+		Read:   resourceArmNginxCertificateRead,
+		Update: resourceArmNginxCertificateCreateUpdate,
+		Delete: resourceArmNginxCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"nginx_deployment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the Managed Identity of the NGINX Deployment needs permission to read this Secret - a versionless
+			// Secret ID can be specified so that NGINX picks up new versions of the Certificate as it's rotated
+			"key_vault_secret_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"certificate_virtual_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"key_virtual_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmNginxCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	deploymentId := d.Get("nginx_deployment_id").(string)
+	id := fmt.Sprintf("%s/certificates/%s", deploymentId, name)
+
+	deployment, err := parseAzureResourceID(deploymentId)
+	if err != nil {
+		return err
+	}
+	deploymentName := deployment.Path["nginxDeployments"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing nginxCertificate
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Certificate %q (NGINX Deployment %q / Resource Group %q): %+v", name, deploymentName, deployment.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_nginx_certificate", *existing.ID)
+		}
+	}
+
+	parameters := nginxCertificate{
+		Properties: &nginxCertificateProperties{
+			KeyVaultSecretID:       utils.String(d.Get("key_vault_secret_id").(string)),
+			CertificateVirtualPath: utils.String(d.Get("certificate_virtual_path").(string)),
+			KeyVirtualPath:         utils.String(d.Get("key_virtual_path").(string)),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, nginxApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Certificate %q (NGINX Deployment %q / Resource Group %q): %+v", name, deploymentName, deployment.ResourceGroup, err)
+	}
+
+	var read nginxCertificate
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Certificate %q (NGINX Deployment %q / Resource Group %q): %+v", name, deploymentName, deployment.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Certificate %q (NGINX Deployment %q / Resource Group %q)", name, deploymentName, deployment.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNginxCertificateRead(d, meta)
+}
+
+func resourceArmNginxCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	deploymentName := id.Path["nginxDeployments"]
+	name := id.Path["certificates"]
+
+	var resp nginxCertificate
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Certificate %q was not found in NGINX Deployment %q (Resource Group %q) - removing from state", name, deploymentName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Certificate %q (NGINX Deployment %q / Resource Group %q): %+v", name, deploymentName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("nginx_deployment_id", nginxDeploymentID(id.SubscriptionID, resourceGroup, deploymentName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("key_vault_secret_id", props.KeyVaultSecretID)
+		d.Set("certificate_virtual_path", props.CertificateVirtualPath)
+		d.Set("key_virtual_path", props.KeyVirtualPath)
+	}
+
+	return nil
+}
+
+func resourceArmNginxCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	deploymentName := id.Path["nginxDeployments"]
+	name := id.Path["certificates"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Certificate %q (NGINX Deployment %q / Resource Group %q): %+v", name, deploymentName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMNginxCertificate_basic(t *testing.T) {
+	resourceName := "azurerm_nginx_certificate.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxCertificate_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxCertificateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_virtual_path", "/etc/nginx/ssl/test.crt"),
+					resource.TestCheckResourceAttr(resourceName, "key_virtual_path", "/etc/nginx/ssl/test.key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxCertificate_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nginx_certificate.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxCertificate_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxCertificateExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNginxCertificate_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_nginx_certificate"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNginxCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp nginxCertificate
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, nginxApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: NGINX Certificate %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on NGINX Certificate %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNginxCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_nginx_certificate" {
+			continue
+		}
+
+		var resp nginxCertificate
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, nginxApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("NGINX Certificate still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNginxCertificate_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "create",
+      "delete",
+      "get",
+      "update",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "get",
+      "set",
+    ]
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${azurerm_user_assigned_identity.test.principal_id}"
+
+    secret_permissions = [
+      "get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name      = "acctestcert%d"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pem-file"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "digitalSignature",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+
+resource "azurerm_nginx_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "standard_Monthly"
+
+  frontend_public {
+    ip_address = ["${azurerm_public_ip.test.id}"]
+  }
+
+  network_interface {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = ["${azurerm_user_assigned_identity.test.id}"]
+  }
+}
+
+resource "azurerm_nginx_certificate" "test" {
+  name                     = "acctestcert%d"
+  nginx_deployment_id      = "${azurerm_nginx_deployment.test.id}"
+  key_vault_secret_id      = "${azurerm_key_vault_certificate.test.secret_id}"
+  certificate_virtual_path = "/etc/nginx/ssl/test.crt"
+  key_virtual_path         = "/etc/nginx/ssl/test.key"
+}
+`, testAccAzureRMNginxDeployment_template(rInt, location), rInt, rInt%1000000, rInt, rInt%1000000, rInt)
+}
+
+func testAccAzureRMNginxCertificate_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nginx_certificate" "import" {
+  name                     = "${azurerm_nginx_certificate.test.name}"
+  nginx_deployment_id      = "${azurerm_nginx_certificate.test.nginx_deployment_id}"
+  key_vault_secret_id      = "${azurerm_nginx_certificate.test.key_vault_secret_id}"
+  certificate_virtual_path = "${azurerm_nginx_certificate.test.certificate_virtual_path}"
+  key_virtual_path         = "${azurerm_nginx_certificate.test.key_virtual_path}"
+}
+`, testAccAzureRMNginxCertificate_basic(rInt, location))
+}
@@ -0,0 +1,256 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// an NGINX Deployment has a single Configuration, which must be named `default`
+const nginxConfigurationName = "default"
+
+type nginxConfiguration struct {
+	ID         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *nginxConfigurationProperties `json:"properties,omitempty"`
+}
+
+type nginxConfigurationProperties struct {
+	RootFile *string                   `json:"rootFile,omitempty"`
+	Files    *[]nginxConfigurationFile `json:"files,omitempty"`
+}
+
+type nginxConfigurationFile struct {
+	Content     *string `json:"content,omitempty"`
+	VirtualPath *string `json:"virtualPath,omitempty"`
+}
+
+func resourceArmNginxConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNginxConfigurationCreateUpdate,
+		Read:   resourceArmNginxConfigurationRead,
+		Update: resourceArmNginxConfigurationCreateUpdate,
+		Delete: resourceArmNginxConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nginx_deployment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// e.g. `/etc/nginx/nginx.conf` - which must be the `virtual_path` of one of the `config_file` blocks
+			"root_file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"config_file": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.Base64String(),
+						},
+
+						"virtual_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			rootFile := diff.Get("root_file").(string)
+			if rootFile == "" {
+				// the value isn't known until apply time
+				return nil
+			}
+
+			for _, raw := range diff.Get("config_file").(*schema.Set).List() {
+				file := raw.(map[string]interface{})
+				if file["virtual_path"].(string) == rootFile {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("`root_file` must be the `virtual_path` of one of the `config_file` blocks but got %q", rootFile)
+		},
+	}
+}
+
+func resourceArmNginxConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	deploymentId := d.Get("nginx_deployment_id").(string)
+	id := fmt.Sprintf("%s/configurations/%s", deploymentId, nginxConfigurationName)
+
+	deployment, err := parseAzureResourceID(deploymentId)
+	if err != nil {
+		return err
+	}
+	deploymentName := deployment.Path["nginxDeployments"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing nginxConfiguration
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Configuration for NGINX Deployment %q (Resource Group %q): %+v", deploymentName, deployment.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_nginx_configuration", *existing.ID)
+		}
+	}
+
+	files := expandArmNginxConfigurationFiles(d.Get("config_file").(*schema.Set).List())
+	parameters := nginxConfiguration{
+		Properties: &nginxConfigurationProperties{
+			RootFile: utils.String(d.Get("root_file").(string)),
+			Files:    &files,
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, nginxApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Configuration for NGINX Deployment %q (Resource Group %q): %+v", deploymentName, deployment.ResourceGroup, err)
+	}
+
+	var read nginxConfiguration
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Configuration for NGINX Deployment %q (Resource Group %q): %+v", deploymentName, deployment.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Configuration for NGINX Deployment %q (Resource Group %q)", deploymentName, deployment.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNginxConfigurationRead(d, meta)
+}
+
+func resourceArmNginxConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	deploymentName := id.Path["nginxDeployments"]
+
+	var resp nginxConfiguration
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Configuration for NGINX Deployment %q was not found in Resource Group %q - removing from state", deploymentName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Configuration for NGINX Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+	}
+
+	d.Set("nginx_deployment_id", nginxDeploymentID(id.SubscriptionID, resourceGroup, deploymentName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("root_file", props.RootFile)
+
+		if err := d.Set("config_file", flattenArmNginxConfigurationFiles(props.Files)); err != nil {
+			return fmt.Errorf("Error setting `config_file`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmNginxConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	deploymentName := id.Path["nginxDeployments"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Configuration for NGINX Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmNginxConfigurationFiles(input []interface{}) []nginxConfigurationFile {
+	results := make([]nginxConfigurationFile, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		results = append(results, nginxConfigurationFile{
+			Content:     utils.String(v["content"].(string)),
+			VirtualPath: utils.String(v["virtual_path"].(string)),
+		})
+	}
+
+	return results
+}
+
+func flattenArmNginxConfigurationFiles(input *[]nginxConfigurationFile) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		content := ""
+		if v.Content != nil {
+			content = *v.Content
+		}
+
+		virtualPath := ""
+		if v.VirtualPath != nil {
+			virtualPath = *v.VirtualPath
+		}
+
+		results = append(results, map[string]interface{}{
+			"content":      content,
+			"virtual_path": virtualPath,
+		})
+	}
+
+	return results
+}
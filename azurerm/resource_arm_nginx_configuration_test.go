@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMNginxConfiguration_basic(t *testing.T) {
+	resourceName := "azurerm_nginx_configuration.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxConfiguration_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "root_file", "/etc/nginx/nginx.conf"),
+					resource.TestCheckResourceAttr(resourceName, "config_file.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxConfiguration_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nginx_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxConfigurationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNginxConfiguration_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_nginx_configuration"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxConfiguration_update(t *testing.T) {
+	resourceName := "azurerm_nginx_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_file.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMNginxConfiguration_multipleFiles(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_file.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMNginxConfiguration_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_file.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNginxConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp nginxConfiguration
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, nginxApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: NGINX Configuration %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on NGINX Configuration %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMNginxConfiguration_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  config_content = <<EOT
+http {
+  server {
+    listen 80;
+    location / {
+      return 200 "Hello World";
+    }
+  }
+}
+EOT
+}
+
+resource "azurerm_nginx_configuration" "test" {
+  nginx_deployment_id = "${azurerm_nginx_deployment.test.id}"
+  root_file           = "/etc/nginx/nginx.conf"
+
+  config_file {
+    content      = "${base64encode(local.config_content)}"
+    virtual_path = "/etc/nginx/nginx.conf"
+  }
+}
+`, testAccAzureRMNginxDeployment_basic(rInt, location))
+}
+
+func testAccAzureRMNginxConfiguration_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nginx_configuration" "import" {
+  nginx_deployment_id = "${azurerm_nginx_configuration.test.nginx_deployment_id}"
+  root_file           = "${azurerm_nginx_configuration.test.root_file}"
+
+  config_file {
+    content      = "${base64encode(local.config_content)}"
+    virtual_path = "/etc/nginx/nginx.conf"
+  }
+}
+`, testAccAzureRMNginxConfiguration_basic(rInt, location))
+}
+
+func testAccAzureRMNginxConfiguration_multipleFiles(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  config_content = <<EOT
+http {
+  include site/*.conf;
+}
+EOT
+
+  site_content = <<EOT
+server {
+  listen 80;
+  location / {
+    return 200 "Hello World";
+  }
+}
+EOT
+}
+
+resource "azurerm_nginx_configuration" "test" {
+  nginx_deployment_id = "${azurerm_nginx_deployment.test.id}"
+  root_file           = "/etc/nginx/nginx.conf"
+
+  config_file {
+    content      = "${base64encode(local.config_content)}"
+    virtual_path = "/etc/nginx/nginx.conf"
+  }
+
+  config_file {
+    content      = "${base64encode(local.site_content)}"
+    virtual_path = "/etc/nginx/site/default.conf"
+  }
+}
+`, testAccAzureRMNginxDeployment_basic(rInt, location))
+}
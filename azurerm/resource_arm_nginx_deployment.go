@@ -0,0 +1,591 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// NGINX for Azure (NGINXaaS) isn't present in the vendored SDK, so is managed using raw requests
+const nginxApiVersion = "2022-08-01"
+
+type nginxDeployment struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Tags       map[string]*string         `json:"tags"`
+	Sku        *nginxDeploymentSku        `json:"sku,omitempty"`
+	Identity   *nginxDeploymentIdentity   `json:"identity,omitempty"`
+	Properties *nginxDeploymentProperties `json:"properties,omitempty"`
+}
+
+type nginxDeploymentSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type nginxDeploymentIdentity struct {
+	Type                   *string                                         `json:"type,omitempty"`
+	PrincipalID            *string                                         `json:"principalId,omitempty"`
+	TenantID               *string                                         `json:"tenantId,omitempty"`
+	UserAssignedIdentities map[string]*nginxDeploymentUserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
+
+type nginxDeploymentUserAssignedIdentity struct {
+	PrincipalID *string `json:"principalId,omitempty"`
+	ClientID    *string `json:"clientId,omitempty"`
+}
+
+type nginxDeploymentProperties struct {
+	ManagedResourceGroup     *string                        `json:"managedResourceGroup,omitempty"`
+	NetworkProfile           *nginxDeploymentNetworkProfile `json:"networkProfile,omitempty"`
+	EnableDiagnosticsSupport *bool                          `json:"enableDiagnosticsSupport,omitempty"`
+	IPAddress                *string                        `json:"ipAddress,omitempty"`
+	NginxVersion             *string                        `json:"nginxVersion,omitempty"`
+}
+
+type nginxDeploymentNetworkProfile struct {
+	FrontEndIPConfiguration       *nginxDeploymentFrontendIPConfiguration       `json:"frontEndIPConfiguration,omitempty"`
+	NetworkInterfaceConfiguration *nginxDeploymentNetworkInterfaceConfiguration `json:"networkInterfaceConfiguration,omitempty"`
+}
+
+type nginxDeploymentFrontendIPConfiguration struct {
+	PublicIPAddresses  *[]nginxDeploymentPublicIPAddress  `json:"publicIPAddresses,omitempty"`
+	PrivateIPAddresses *[]nginxDeploymentPrivateIPAddress `json:"privateIPAddresses,omitempty"`
+}
+
+type nginxDeploymentPublicIPAddress struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type nginxDeploymentPrivateIPAddress struct {
+	PrivateIPAddress          *string `json:"privateIPAddress,omitempty"`
+	PrivateIPAllocationMethod *string `json:"privateIPAllocationMethod,omitempty"`
+	SubnetID                  *string `json:"subnetId,omitempty"`
+}
+
+type nginxDeploymentNetworkInterfaceConfiguration struct {
+	SubnetID *string `json:"subnetId,omitempty"`
+}
+
+func resourceArmNginxDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNginxDeploymentCreateUpdate,
+		Read:   resourceArmNginxDeploymentRead,
+		Update: resourceArmNginxDeploymentCreateUpdate,
+		Delete: resourceArmNginxDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NginxDeploymentName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			// e.g. `standard_Monthly` - the available plans are published in the Azure Marketplace
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"managed_resource_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"frontend_public": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"frontend_private"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"frontend_private": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"frontend_public"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"allocation_method": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Dynamic",
+								"Static",
+							}, false),
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			// the Subnet must be delegated to `NGINX.NGINXPLUS/nginxDeployments`
+			"network_interface": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"diagnose_support_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+								"UserAssigned",
+								"SystemAssigned,UserAssigned",
+							}, false),
+						},
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"nginx_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			identities := diff.Get("identity").([]interface{})
+			if len(identities) == 0 || identities[0] == nil {
+				return nil
+			}
+
+			identity := identities[0].(map[string]interface{})
+			identityType := identity["type"].(string)
+			identityIds := identity["identity_ids"].(*schema.Set)
+			userAssigned := strings.Contains(identityType, "UserAssigned")
+
+			if userAssigned && identityIds.Len() == 0 {
+				return fmt.Errorf("`identity_ids` must be specified when `type` is %q", identityType)
+			}
+			if !userAssigned && identityIds.Len() > 0 {
+				return fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmNginxDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := nginxDeploymentID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing nginxDeployment
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing NGINX Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_nginx_deployment", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := nginxDeployment{
+		Location: utils.String(location),
+		Sku: &nginxDeploymentSku{
+			Name: utils.String(d.Get("sku").(string)),
+		},
+		Identity: expandArmNginxDeploymentIdentity(d.Get("identity").([]interface{})),
+		Properties: &nginxDeploymentProperties{
+			NetworkProfile: &nginxDeploymentNetworkProfile{
+				FrontEndIPConfiguration:       expandArmNginxDeploymentFrontendIPConfiguration(d.Get("frontend_public").([]interface{}), d.Get("frontend_private").([]interface{})),
+				NetworkInterfaceConfiguration: expandArmNginxDeploymentNetworkInterfaceConfiguration(d.Get("network_interface").([]interface{})),
+			},
+			EnableDiagnosticsSupport: utils.Bool(d.Get("diagnose_support_enabled").(bool)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("managed_resource_group"); ok {
+		parameters.Properties.ManagedResourceGroup = utils.String(v.(string))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, nginxApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating NGINX Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read nginxDeployment
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, nginxApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving NGINX Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of NGINX Deployment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNginxDeploymentRead(d, meta)
+}
+
+func resourceArmNginxDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["nginxDeployments"]
+
+	var resp nginxDeployment
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] NGINX Deployment %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NGINX Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	if err := d.Set("identity", flattenArmNginxDeploymentIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("managed_resource_group", props.ManagedResourceGroup)
+		d.Set("ip_address", props.IPAddress)
+		d.Set("nginx_version", props.NginxVersion)
+
+		diagnoseSupportEnabled := false
+		if props.EnableDiagnosticsSupport != nil {
+			diagnoseSupportEnabled = *props.EnableDiagnosticsSupport
+		}
+		d.Set("diagnose_support_enabled", diagnoseSupportEnabled)
+
+		var frontend *nginxDeploymentFrontendIPConfiguration
+		var networkInterface *nginxDeploymentNetworkInterfaceConfiguration
+		if profile := props.NetworkProfile; profile != nil {
+			frontend = profile.FrontEndIPConfiguration
+			networkInterface = profile.NetworkInterfaceConfiguration
+		}
+
+		frontendPublic, frontendPrivate := flattenArmNginxDeploymentFrontendIPConfiguration(frontend)
+		if err := d.Set("frontend_public", frontendPublic); err != nil {
+			return fmt.Errorf("Error setting `frontend_public`: %+v", err)
+		}
+		if err := d.Set("frontend_private", frontendPrivate); err != nil {
+			return fmt.Errorf("Error setting `frontend_private`: %+v", err)
+		}
+
+		if err := d.Set("network_interface", flattenArmNginxDeploymentNetworkInterfaceConfiguration(networkInterface)); err != nil {
+			return fmt.Errorf("Error setting `network_interface`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmNginxDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["nginxDeployments"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), nginxApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting NGINX Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func nginxDeploymentID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Nginx.NginxPlus/nginxDeployments/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmNginxDeploymentIdentity(input []interface{}) *nginxDeploymentIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &nginxDeploymentIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	output := nginxDeploymentIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+
+	identityIds := v["identity_ids"].(*schema.Set).List()
+	if len(identityIds) > 0 {
+		output.UserAssignedIdentities = make(map[string]*nginxDeploymentUserAssignedIdentity)
+		for _, id := range identityIds {
+			output.UserAssignedIdentities[id.(string)] = &nginxDeploymentUserAssignedIdentity{}
+		}
+	}
+
+	return &output
+}
+
+func flattenArmNginxDeploymentIdentity(input *nginxDeploymentIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         strings.Replace(*input.Type, ", ", ",", -1),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandArmNginxDeploymentFrontendIPConfiguration(public []interface{}, private []interface{}) *nginxDeploymentFrontendIPConfiguration {
+	publicIPAddresses := make([]nginxDeploymentPublicIPAddress, 0)
+	if len(public) > 0 && public[0] != nil {
+		v := public[0].(map[string]interface{})
+		for _, id := range v["ip_address"].(*schema.Set).List() {
+			publicIPAddresses = append(publicIPAddresses, nginxDeploymentPublicIPAddress{
+				ID: utils.String(id.(string)),
+			})
+		}
+	}
+
+	privateIPAddresses := make([]nginxDeploymentPrivateIPAddress, 0)
+	for _, item := range private {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		privateIPAddresses = append(privateIPAddresses, nginxDeploymentPrivateIPAddress{
+			PrivateIPAddress:          utils.String(v["ip_address"].(string)),
+			PrivateIPAllocationMethod: utils.String(v["allocation_method"].(string)),
+			SubnetID:                  utils.String(v["subnet_id"].(string)),
+		})
+	}
+
+	return &nginxDeploymentFrontendIPConfiguration{
+		PublicIPAddresses:  &publicIPAddresses,
+		PrivateIPAddresses: &privateIPAddresses,
+	}
+}
+
+// flattenArmNginxDeploymentFrontendIPConfiguration returns the `frontend_public` and `frontend_private` blocks,
+// at most one of which is populated
+func flattenArmNginxDeploymentFrontendIPConfiguration(input *nginxDeploymentFrontendIPConfiguration) ([]interface{}, []interface{}) {
+	public := make([]interface{}, 0)
+	private := make([]interface{}, 0)
+	if input == nil {
+		return public, private
+	}
+
+	if input.PublicIPAddresses != nil {
+		ids := make([]interface{}, 0)
+		for _, v := range *input.PublicIPAddresses {
+			if v.ID != nil {
+				ids = append(ids, *v.ID)
+			}
+		}
+
+		if len(ids) > 0 {
+			public = append(public, map[string]interface{}{
+				"ip_address": schema.NewSet(schema.HashString, ids),
+			})
+		}
+	}
+
+	if input.PrivateIPAddresses != nil {
+		for _, v := range *input.PrivateIPAddresses {
+			ipAddress := ""
+			if v.PrivateIPAddress != nil {
+				ipAddress = *v.PrivateIPAddress
+			}
+
+			allocationMethod := ""
+			if v.PrivateIPAllocationMethod != nil {
+				allocationMethod = *v.PrivateIPAllocationMethod
+			}
+
+			subnetId := ""
+			if v.SubnetID != nil {
+				subnetId = *v.SubnetID
+			}
+
+			private = append(private, map[string]interface{}{
+				"ip_address":        ipAddress,
+				"allocation_method": allocationMethod,
+				"subnet_id":         subnetId,
+			})
+		}
+	}
+
+	return public, private
+}
+
+func expandArmNginxDeploymentNetworkInterfaceConfiguration(input []interface{}) *nginxDeploymentNetworkInterfaceConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &nginxDeploymentNetworkInterfaceConfiguration{
+		SubnetID: utils.String(v["subnet_id"].(string)),
+	}
+}
+
+func flattenArmNginxDeploymentNetworkInterfaceConfiguration(input *nginxDeploymentNetworkInterfaceConfiguration) []interface{} {
+	if input == nil || input.SubnetID == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"subnet_id": *input.SubnetID,
+		},
+	}
+}
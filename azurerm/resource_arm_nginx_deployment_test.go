@@ -0,0 +1,451 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestNginxDeploymentFrontendIPConfiguration(t *testing.T) {
+	publicIpId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+
+	testData := []struct {
+		name            string
+		public          []interface{}
+		private         []interface{}
+		expectedPublic  int
+		expectedPrivate int
+	}{
+		{
+			name:            "none",
+			public:          []interface{}{},
+			private:         []interface{}{},
+			expectedPublic:  0,
+			expectedPrivate: 0,
+		},
+		{
+			name: "public",
+			public: []interface{}{
+				map[string]interface{}{
+					"ip_address": schema.NewSet(schema.HashString, []interface{}{publicIpId}),
+				},
+			},
+			private:         []interface{}{},
+			expectedPublic:  1,
+			expectedPrivate: 0,
+		},
+		{
+			name:   "private",
+			public: []interface{}{},
+			private: []interface{}{
+				map[string]interface{}{
+					"ip_address":        "10.0.1.4",
+					"allocation_method": "Static",
+					"subnet_id":         subnetId,
+				},
+			},
+			expectedPublic:  0,
+			expectedPrivate: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		public, private := flattenArmNginxDeploymentFrontendIPConfiguration(expandArmNginxDeploymentFrontendIPConfiguration(v.public, v.private))
+		if len(public) != v.expectedPublic {
+			t.Fatalf("Expected %d `frontend_public` blocks but got %d", v.expectedPublic, len(public))
+		}
+		if len(private) != v.expectedPrivate {
+			t.Fatalf("Expected %d `frontend_private` blocks but got %d", v.expectedPrivate, len(private))
+		}
+
+		if v.expectedPublic > 0 {
+			ids := public[0].(map[string]interface{})["ip_address"].(*schema.Set)
+			if ids.Len() != 1 || !ids.Contains(publicIpId) {
+				t.Fatalf("Expected `ip_address` to contain %q but got %+v", publicIpId, ids.List())
+			}
+		}
+
+		if v.expectedPrivate > 0 {
+			actual := private[0].(map[string]interface{})
+			expected := v.private[0].(map[string]interface{})
+			for _, key := range []string{"ip_address", "allocation_method", "subnet_id"} {
+				if actual[key] != expected[key] {
+					t.Fatalf("Expected `%s` to be %q but got %q", key, expected[key], actual[key])
+				}
+			}
+		}
+	}
+}
+
+func TestNginxDeploymentIdentity(t *testing.T) {
+	identityId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+
+	none := expandArmNginxDeploymentIdentity([]interface{}{})
+	if none.Type == nil || *none.Type != "None" {
+		t.Fatalf("Expected the Identity to be `None` when no `identity` block is specified")
+	}
+	if len(flattenArmNginxDeploymentIdentity(none)) != 0 {
+		t.Fatalf("Expected no `identity` blocks when the Identity is `None`")
+	}
+
+	userAssigned := expandArmNginxDeploymentIdentity([]interface{}{
+		map[string]interface{}{
+			"type":         "UserAssigned",
+			"identity_ids": schema.NewSet(schema.HashString, []interface{}{identityId}),
+		},
+	})
+	if _, ok := userAssigned.UserAssignedIdentities[identityId]; !ok {
+		t.Fatalf("Expected the User Assigned Identity %q to be present", identityId)
+	}
+
+	// the API returns multiple Identity Types separated by a comma and a space
+	flattened := flattenArmNginxDeploymentIdentity(&nginxDeploymentIdentity{
+		Type:     utils.String("SystemAssigned, UserAssigned"),
+		TenantID: utils.String("11111111-1111-1111-1111-111111111111"),
+		UserAssignedIdentities: map[string]*nginxDeploymentUserAssignedIdentity{
+			identityId: {},
+		},
+	})
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 `identity` block but got %d", len(flattened))
+	}
+
+	identity := flattened[0].(map[string]interface{})
+	if identity["type"].(string) != "SystemAssigned,UserAssigned" {
+		t.Fatalf("Expected `type` to be %q but got %q", "SystemAssigned,UserAssigned", identity["type"])
+	}
+	if identity["identity_ids"].(*schema.Set).Len() != 1 {
+		t.Fatalf("Expected 1 `identity_ids` but got %d", identity["identity_ids"].(*schema.Set).Len())
+	}
+}
+
+func TestAccAzureRMNginxDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_nginx_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frontend_public.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "nginx_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_resource_group"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxDeployment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nginx_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNginxDeployment_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_nginx_deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxDeployment_complete(t *testing.T) {
+	resourceName := "azurerm_nginx_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMNginxDeployment_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "diagnose_support_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.identity_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMNginxDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "diagnose_support_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNginxDeployment_privateFrontend(t *testing.T) {
+	resourceName := "azurerm_nginx_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNginxDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNginxDeployment_privateFrontend(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNginxDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frontend_public.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "frontend_private.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "frontend_private.0.ip_address", "10.0.2.10"),
+					resource.TestCheckResourceAttr(resourceName, "frontend_private.0.allocation_method", "Static"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNginxDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp nginxDeployment
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, nginxApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: NGINX Deployment %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on NGINX Deployment %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNginxDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_nginx_deployment" {
+			continue
+		}
+
+		var resp nginxDeployment
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, nginxApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("NGINX Deployment still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNginxDeployment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+
+  delegation {
+    name = "nginx"
+
+    service_delegation {
+      name    = "NGINX.NGINXPLUS/nginxDeployments"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+    }
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMNginxDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nginx_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "standard_Monthly"
+
+  frontend_public {
+    ip_address = ["${azurerm_public_ip.test.id}"]
+  }
+
+  network_interface {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, testAccAzureRMNginxDeployment_template(rInt, location), rInt%1000000)
+}
+
+func testAccAzureRMNginxDeployment_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nginx_deployment" "import" {
+  name                = "${azurerm_nginx_deployment.test.name}"
+  resource_group_name = "${azurerm_nginx_deployment.test.resource_group_name}"
+  location            = "${azurerm_nginx_deployment.test.location}"
+  sku                 = "${azurerm_nginx_deployment.test.sku}"
+
+  frontend_public {
+    ip_address = ["${azurerm_public_ip.test.id}"]
+  }
+
+  network_interface {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, testAccAzureRMNginxDeployment_basic(rInt, location))
+}
+
+func testAccAzureRMNginxDeployment_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_nginx_deployment" "test" {
+  name                     = "acctest-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  sku                      = "standard_Monthly"
+  diagnose_support_enabled = true
+
+  frontend_public {
+    ip_address = ["${azurerm_public_ip.test.id}"]
+  }
+
+  network_interface {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  identity {
+    type         = "SystemAssigned,UserAssigned"
+    identity_ids = ["${azurerm_user_assigned_identity.test.id}"]
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, testAccAzureRMNginxDeployment_template(rInt, location), rInt, rInt%1000000)
+}
+
+func testAccAzureRMNginxDeployment_privateFrontend(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nginx_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "standard_Monthly"
+
+  frontend_private {
+    ip_address        = "10.0.2.10"
+    allocation_method = "Static"
+    subnet_id         = "${azurerm_subnet.test.id}"
+  }
+
+  network_interface {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, testAccAzureRMNginxDeployment_template(rInt, location), rInt%1000000)
+}
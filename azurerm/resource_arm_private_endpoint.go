@@ -0,0 +1,629 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Private Endpoints (and Private DNS Zone Groups) aren't present in the vendored Network SDK, so are managed using raw requests
+const privateEndpointApiVersion = "2021-05-01"
+
+type privateEndpoint struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Tags       map[string]*string         `json:"tags"`
+	Properties *privateEndpointProperties `json:"properties,omitempty"`
+}
+
+type privateEndpointProperties struct {
+	Subnet                              *privateEndpointSubResource         `json:"subnet,omitempty"`
+	PrivateLinkServiceConnections       *[]privateEndpointServiceConnection `json:"privateLinkServiceConnections,omitempty"`
+	ManualPrivateLinkServiceConnections *[]privateEndpointServiceConnection `json:"manualPrivateLinkServiceConnections,omitempty"`
+	NetworkInterfaces                   *[]privateEndpointSubResource       `json:"networkInterfaces,omitempty"`
+}
+
+type privateEndpointSubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type privateEndpointServiceConnection struct {
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *privateEndpointServiceConnectionProperties `json:"properties,omitempty"`
+}
+
+type privateEndpointServiceConnectionProperties struct {
+	PrivateLinkServiceID              *string                                `json:"privateLinkServiceId,omitempty"`
+	GroupIDs                          *[]string                              `json:"groupIds,omitempty"`
+	RequestMessage                    *string                                `json:"requestMessage,omitempty"`
+	PrivateLinkServiceConnectionState *privateEndpointServiceConnectionState `json:"privateLinkServiceConnectionState,omitempty"`
+}
+
+type privateEndpointServiceConnectionState struct {
+	Status      *string `json:"status,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type privateDnsZoneGroupList struct {
+	Value *[]privateDnsZoneGroup `json:"value,omitempty"`
+}
+
+type privateDnsZoneGroup struct {
+	ID         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *privateDnsZoneGroupProperties `json:"properties,omitempty"`
+}
+
+type privateDnsZoneGroupProperties struct {
+	PrivateDNSZoneConfigs *[]privateDnsZoneConfig `json:"privateDnsZoneConfigs,omitempty"`
+}
+
+type privateDnsZoneConfig struct {
+	Name       *string                         `json:"name,omitempty"`
+	Properties *privateDnsZoneConfigProperties `json:"properties,omitempty"`
+}
+
+type privateDnsZoneConfigProperties struct {
+	PrivateDNSZoneID *string                    `json:"privateDnsZoneId,omitempty"`
+	RecordSets       *[]privateDnsZoneRecordSet `json:"recordSets,omitempty"`
+}
+
+type privateDnsZoneRecordSet struct {
+	RecordSetName *string   `json:"recordSetName,omitempty"`
+	Fqdn          *string   `json:"fqdn,omitempty"`
+	IPAddresses   *[]string `json:"ipAddresses,omitempty"`
+}
+
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateLinkName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.PrivateLinkName,
+						},
+
+						"private_connection_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// the Group ID's (such as `sqlServer`) of the Sub Resources which should be connected to
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"request_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_dns_zone_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.PrivateLinkName,
+						},
+
+						"private_dns_zone_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						// the A Records which Azure manages within the Private DNS Zones for this Private Endpoint
+						"record_sets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"ip_addresses": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if v, ok := diff.GetOk("private_service_connection.0.request_message"); ok && v.(string) != "" {
+				if !diff.Get("private_service_connection.0.is_manual_connection").(bool) {
+					return fmt.Errorf("`request_message` can only be specified when `is_manual_connection` is set to `true`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := privateEndpointID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing privateEndpoint
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, privateEndpointApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := privateEndpointProperties{
+		Subnet: &privateEndpointSubResource{
+			ID: utils.String(d.Get("subnet_id").(string)),
+		},
+	}
+
+	connection, isManual := expandArmPrivateEndpointServiceConnection(d.Get("private_service_connection").([]interface{}))
+	if isManual {
+		properties.ManualPrivateLinkServiceConnections = &[]privateEndpointServiceConnection{connection}
+	} else {
+		properties.PrivateLinkServiceConnections = &[]privateEndpointServiceConnection{connection}
+	}
+
+	parameters := privateEndpoint{
+		Location:   utils.String(location),
+		Properties: &properties,
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, privateEndpointApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	// the Private DNS Zone Group is a child resource, which Azure uses to manage the A Records in the Private DNS Zones
+	if d.HasChange("private_dns_zone_group") {
+		old, new := d.GetChange("private_dns_zone_group")
+		oldGroups := old.([]interface{})
+		newGroups := new.([]interface{})
+
+		oldName := ""
+		if len(oldGroups) > 0 && oldGroups[0] != nil {
+			oldName = oldGroups[0].(map[string]interface{})["name"].(string)
+		}
+
+		newName := ""
+		if len(newGroups) > 0 && newGroups[0] != nil {
+			newName = newGroups[0].(map[string]interface{})["name"].(string)
+		}
+
+		// only a single Private DNS Zone Group can exist, so the existing one needs to be removed when it's renamed
+		if oldName != "" && oldName != newName {
+			groupId := privateDnsZoneGroupID(id, oldName)
+			log.Printf("[DEBUG] Deleting Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q)..", oldName, name, resourceGroup)
+			resp, err := armRawDelete(ctx, client.Client, client.BaseURI, groupId, privateEndpointApiVersion)
+			if err != nil && !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error deleting Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", oldName, name, resourceGroup, err)
+			}
+		}
+
+		if newName != "" {
+			group, err := expandArmPrivateDnsZoneGroup(newGroups[0].(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+
+			groupId := privateDnsZoneGroupID(id, newName)
+			log.Printf("[DEBUG] Creating/Updating Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q)..", newName, name, resourceGroup)
+			if err := armRawPut(ctx, client.Client, client.BaseURI, groupId, privateEndpointApiVersion, group); err != nil {
+				return fmt.Errorf("Error creating/updating Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", newName, name, resourceGroup, err)
+			}
+		}
+	}
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateEndpoints"]
+
+	// when importing only the ID is known, so we also need to look up the Private DNS Zone Group
+	importing := d.Get("subnet_id").(string) == ""
+
+	var resp privateEndpoint
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), privateEndpointApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Private Endpoint %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	privateIpAddress := ""
+	if props := resp.Properties; props != nil {
+		if subnet := props.Subnet; subnet != nil {
+			d.Set("subnet_id", subnet.ID)
+		}
+
+		if err := d.Set("private_service_connection", flattenArmPrivateEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections)); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+
+		// the Private IP Address is assigned to the Network Interface which Azure creates for the Private Endpoint
+		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 && (*nics)[0].ID != nil {
+			privateIpAddress, err = retrieveArmPrivateEndpointPrivateIPAddress(*(*nics)[0].ID, meta)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Private IP Address for Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+	d.Set("private_ip_address", privateIpAddress)
+
+	if importing || len(d.Get("private_dns_zone_group").([]interface{})) > 0 {
+		var groups privateDnsZoneGroupList
+		groupsResp, err := armRawGet(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/privateDnsZoneGroups", d.Id()), privateEndpointApiVersion, &groups)
+		if err != nil && !utils.ResponseWasNotFound(autorest.Response{Response: groupsResp}) {
+			return fmt.Errorf("Error retrieving Private DNS Zone Groups for Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := d.Set("private_dns_zone_group", flattenArmPrivateDnsZoneGroups(groups.Value)); err != nil {
+			return fmt.Errorf("Error setting `private_dns_zone_group`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateEndpoints"]
+
+	// the Private DNS Zone Group (and the A Records within the Private DNS Zones) are removed alongside the Private Endpoint
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), privateEndpointApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func privateEndpointID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s", subscriptionId, resourceGroup, name)
+}
+
+func privateDnsZoneGroupID(privateEndpointId, name string) string {
+	return fmt.Sprintf("%s/privateDnsZoneGroups/%s", privateEndpointId, name)
+}
+
+func retrieveArmPrivateEndpointPrivateIPAddress(networkInterfaceId string, meta interface{}) (string, error) {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(networkInterfaceId)
+	if err != nil {
+		return "", err
+	}
+
+	nic, err := client.Get(ctx, id.ResourceGroup, id.Path["networkInterfaces"], "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Network Interface %q: %+v", networkInterfaceId, err)
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if config.InterfaceIPConfigurationPropertiesFormat != nil && config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress != nil {
+				return *config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func expandArmPrivateEndpointServiceConnection(input []interface{}) (privateEndpointServiceConnection, bool) {
+	v := input[0].(map[string]interface{})
+
+	groupIds := make([]string, 0)
+	for _, groupId := range v["subresource_names"].([]interface{}) {
+		groupIds = append(groupIds, groupId.(string))
+	}
+
+	properties := privateEndpointServiceConnectionProperties{
+		PrivateLinkServiceID: utils.String(v["private_connection_resource_id"].(string)),
+		GroupIDs:             &groupIds,
+	}
+
+	isManual := v["is_manual_connection"].(bool)
+	if message := v["request_message"].(string); isManual && message != "" {
+		properties.RequestMessage = utils.String(message)
+	}
+
+	return privateEndpointServiceConnection{
+		Name:       utils.String(v["name"].(string)),
+		Properties: &properties,
+	}, isManual
+}
+
+func flattenArmPrivateEndpointServiceConnection(automatic *[]privateEndpointServiceConnection, manual *[]privateEndpointServiceConnection) []interface{} {
+	results := make([]interface{}, 0)
+
+	flatten := func(input *[]privateEndpointServiceConnection, isManual bool) {
+		if input == nil {
+			return
+		}
+
+		for _, connection := range *input {
+			name := ""
+			if connection.Name != nil {
+				name = *connection.Name
+			}
+
+			privateConnectionResourceId := ""
+			requestMessage := ""
+			status := ""
+			subresourceNames := make([]interface{}, 0)
+			if props := connection.Properties; props != nil {
+				if props.PrivateLinkServiceID != nil {
+					privateConnectionResourceId = *props.PrivateLinkServiceID
+				}
+				if props.RequestMessage != nil && isManual {
+					requestMessage = *props.RequestMessage
+				}
+				if state := props.PrivateLinkServiceConnectionState; state != nil && state.Status != nil {
+					status = *state.Status
+				}
+				subresourceNames = utils.FlattenStringArray(props.GroupIDs)
+			}
+
+			results = append(results, map[string]interface{}{
+				"name":                           name,
+				"private_connection_resource_id": privateConnectionResourceId,
+				"is_manual_connection":           isManual,
+				"subresource_names":              subresourceNames,
+				"request_message":                requestMessage,
+				"status":                         status,
+			})
+		}
+	}
+
+	flatten(automatic, false)
+	flatten(manual, true)
+
+	return results
+}
+
+func expandArmPrivateDnsZoneGroup(input map[string]interface{}) (privateDnsZoneGroup, error) {
+	configs := make([]privateDnsZoneConfig, 0)
+	for _, v := range input["private_dns_zone_ids"].([]interface{}) {
+		zoneId := v.(string)
+
+		id, err := parseAzureResourceID(zoneId)
+		if err != nil {
+			return privateDnsZoneGroup{}, fmt.Errorf("Error parsing Private DNS Zone ID %q: %+v", zoneId, err)
+		}
+
+		zoneName := id.Path["privateDnsZones"]
+		if zoneName == "" {
+			return privateDnsZoneGroup{}, fmt.Errorf("Expected %q to be the ID of a Private DNS Zone", zoneId)
+		}
+
+		configs = append(configs, privateDnsZoneConfig{
+			Name: utils.String(zoneName),
+			Properties: &privateDnsZoneConfigProperties{
+				PrivateDNSZoneID: utils.String(zoneId),
+			},
+		})
+	}
+
+	return privateDnsZoneGroup{
+		Name: utils.String(input["name"].(string)),
+		Properties: &privateDnsZoneGroupProperties{
+			PrivateDNSZoneConfigs: &configs,
+		},
+	}, nil
+}
+
+func flattenArmPrivateDnsZoneGroups(input *[]privateDnsZoneGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		id := ""
+		if group.ID != nil {
+			id = *group.ID
+		}
+
+		name := ""
+		if group.Name != nil {
+			name = *group.Name
+		}
+
+		zoneIds := make([]interface{}, 0)
+		recordSets := make([]interface{}, 0)
+		if props := group.Properties; props != nil && props.PrivateDNSZoneConfigs != nil {
+			for _, config := range *props.PrivateDNSZoneConfigs {
+				if config.Properties == nil {
+					continue
+				}
+
+				if config.Properties.PrivateDNSZoneID != nil {
+					zoneIds = append(zoneIds, *config.Properties.PrivateDNSZoneID)
+				}
+
+				if config.Properties.RecordSets == nil {
+					continue
+				}
+
+				for _, recordSet := range *config.Properties.RecordSets {
+					recordSetName := ""
+					if recordSet.RecordSetName != nil {
+						recordSetName = *recordSet.RecordSetName
+					}
+
+					fqdn := ""
+					if recordSet.Fqdn != nil {
+						fqdn = *recordSet.Fqdn
+					}
+
+					recordSets = append(recordSets, map[string]interface{}{
+						"name":         recordSetName,
+						"fqdn":         fqdn,
+						"ip_addresses": utils.FlattenStringArray(recordSet.IPAddresses),
+					})
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   id,
+			"name":                 name,
+			"private_dns_zone_ids": zoneIds,
+			"record_sets":          recordSets,
+		})
+	}
+
+	return results
+}
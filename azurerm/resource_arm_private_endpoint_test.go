@@ -0,0 +1,410 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandArmPrivateEndpointServiceConnection(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":                           "connection1",
+			"private_connection_resource_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+			"is_manual_connection":           true,
+			"subresource_names":              []interface{}{"sqlServer"},
+			"request_message":                "Please approve",
+		},
+	}
+
+	connection, isManual := expandArmPrivateEndpointServiceConnection(input)
+	if !isManual {
+		t.Fatalf("Expected the connection to be a Manual connection")
+	}
+
+	if *connection.Name != "connection1" {
+		t.Fatalf("Expected the Name to be `connection1` but got %q", *connection.Name)
+	}
+
+	if groupIds := *connection.Properties.GroupIDs; len(groupIds) != 1 || groupIds[0] != "sqlServer" {
+		t.Fatalf("Expected the Group ID's to be `sqlServer` but got %+v", groupIds)
+	}
+
+	if connection.Properties.RequestMessage == nil || *connection.Properties.RequestMessage != "Please approve" {
+		t.Fatalf("Expected the Request Message to be set for a Manual connection")
+	}
+
+	flattened := flattenArmPrivateEndpointServiceConnection(nil, &[]privateEndpointServiceConnection{connection})
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 connection but got %d", len(flattened))
+	}
+
+	v := flattened[0].(map[string]interface{})
+	if v["is_manual_connection"] != true || v["request_message"] != "Please approve" {
+		t.Fatalf("Expected the Manual connection to round-trip but got %+v", v)
+	}
+}
+
+func TestExpandArmPrivateDnsZoneGroup(t *testing.T) {
+	zoneId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net"
+	group, err := expandArmPrivateDnsZoneGroup(map[string]interface{}{
+		"name":                 "group1",
+		"private_dns_zone_ids": []interface{}{zoneId},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	configs := *group.Properties.PrivateDNSZoneConfigs
+	if len(configs) != 1 {
+		t.Fatalf("Expected 1 Private DNS Zone Config but got %d", len(configs))
+	}
+
+	if *configs[0].Name != "privatelink.database.windows.net" {
+		t.Fatalf("Expected the Private DNS Zone Config to be named after the Private DNS Zone but got %q", *configs[0].Name)
+	}
+
+	if *configs[0].Properties.PrivateDNSZoneID != zoneId {
+		t.Fatalf("Expected the Private DNS Zone ID to be %q but got %q", zoneId, *configs[0].Properties.PrivateDNSZoneID)
+	}
+
+	_, err = expandArmPrivateDnsZoneGroup(map[string]interface{}{
+		"name":                 "group1",
+		"private_dns_zone_ids": []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/example.com"},
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for an ID which isn't a Private DNS Zone")
+	}
+}
+
+func TestAccAzureRMPrivateEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.subresource_names.0", "sqlServer"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.status", "Approved"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateEndpoint_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_updateTags(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateEndpoint_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_privateDnsZoneGroup(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_privateDnsZoneGroup(ri, location, "acctestzg"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.0.private_dns_zone_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.0.record_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "private_dns_zone_group.0.record_sets.0.ip_addresses.0", resourceName, "private_ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMPrivateEndpoint_privateDnsZoneGroup(ri, location, "acctestzgrenamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.0.name", "acctestzgrenamed"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_zone_group.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp privateEndpoint
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, privateEndpointApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Private Endpoint %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Private Endpoint %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).ifaceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_endpoint" {
+			continue
+		}
+
+		var resp privateEndpoint
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, privateEndpointApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private Endpoint still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateEndpoint_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateEndpoint_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    private_connection_resource_id = "${azurerm_sql_server.test.id}"
+    is_manual_connection           = false
+    subresource_names              = ["sqlServer"]
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "import" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+  location            = "${azurerm_private_endpoint.test.location}"
+  subnet_id           = "${azurerm_private_endpoint.test.subnet_id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    private_connection_resource_id = "${azurerm_sql_server.test.id}"
+    is_manual_connection           = false
+    subresource_names              = ["sqlServer"]
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_tags(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    private_connection_resource_id = "${azurerm_sql_server.test.id}"
+    is_manual_connection           = false
+    subresource_names              = ["sqlServer"]
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_privateDnsZoneGroup(rInt int, location string, groupName string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+# Private DNS Zones aren't supported by this provider, so one is created using a Template Deployment
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/privateDnsZones",
+      "apiVersion": "2018-09-01",
+      "name": "privatelink.database.windows.net",
+      "location": "global"
+    }
+  ]
+}
+DEPLOY
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    private_connection_resource_id = "${azurerm_sql_server.test.id}"
+    is_manual_connection           = false
+    subresource_names              = ["sqlServer"]
+  }
+
+  private_dns_zone_group {
+    name                 = "%s"
+    private_dns_zone_ids = ["${azurerm_resource_group.test.id}/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net"]
+  }
+
+  depends_on = ["azurerm_template_deployment.test"]
+}
+`, template, rInt, rInt, rInt, groupName)
+}
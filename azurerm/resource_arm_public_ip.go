@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -10,11 +11,30 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const publicIpExtendedApiVersion = "2022-07-01"
+
+type publicIpExtended struct {
+	Properties *publicIpExtendedProperties `json:"properties,omitempty"`
+}
+
+type publicIpExtendedProperties struct {
+	DdosSettings *publicIpDdosSettings `json:"ddosSettings,omitempty"`
+}
+
+type publicIpDdosSettings struct {
+	ProtectionMode     *string              `json:"protectionMode,omitempty"`
+	DdosProtectionPlan *network.SubResource `json:"ddosProtectionPlan,omitempty"`
+}
+
+// the Routing Preference is exposed by the API as an IP Tag of this type
+const publicIpRoutingPreferenceIpTagType = "RoutingPreference"
+
 func resourceArmPublicIp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmPublicIpCreateUpdate,
@@ -128,8 +148,63 @@ func resourceArmPublicIp() *schema.Resource {
 
 			"zones": singleZonesSchema(),
 
+			// a map of the IP Tag Type (e.g. `FirstPartyUsage`) to the Tag (e.g. `SQL`)
+			"ip_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"routing_preference": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "MicrosoftNetwork",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Internet",
+					"MicrosoftNetwork",
+				}, false),
+			},
+
+			"ddos_protection_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "VirtualNetworkInherited",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Disabled",
+					"Enabled",
+					"VirtualNetworkInherited",
+				}, false),
+			},
+
+			"ddos_protection_plan_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if _, ok := diff.Get("ip_tags").(map[string]interface{})[publicIpRoutingPreferenceIpTagType]; ok {
+				return fmt.Errorf("the Routing Preference must be specified using the `routing_preference` field rather than within `ip_tags`")
+			}
+
+			ddosProtectionMode := diff.Get("ddos_protection_mode").(string)
+			if ddosProtectionMode == "Enabled" && !strings.EqualFold(diff.Get("sku").(string), string(network.PublicIPAddressSkuNameStandard)) {
+				return fmt.Errorf("`ddos_protection_mode` can only be `Enabled` for Public IP's using the `Standard` SKU")
+			}
+
+			if diff.Get("ddos_protection_plan_id").(string) != "" && ddosProtectionMode != "Enabled" {
+				return fmt.Errorf("`ddos_protection_plan_id` can only be specified when `ddos_protection_mode` is `Enabled`")
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -192,6 +267,7 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			PublicIPAllocationMethod: network.IPAllocationMethod(ipAllocationMethod),
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
+			IPTags:                   expandArmPublicIpIpTags(d.Get("ip_tags").(map[string]interface{}), d.Get("routing_preference").(string)),
 		},
 		Tags:  expandTags(tags),
 		Zones: zones,
@@ -214,13 +290,29 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		publicIp.PublicIPAddressPropertiesFormat.DNSSettings = &dnsSettings
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, publicIp)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Public IP %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	// the DDoS Settings aren't available in the vendored SDK - and since they can't be PATCH'd these need to be sent
+	// in the same request as the rest of the Public IP
+	ddosProtectionMode := d.Get("ddos_protection_mode").(string)
+	_, hasDdosProtectionPlan := d.GetOk("ddos_protection_plan_id")
+	if ddosProtectionMode != "VirtualNetworkInherited" || hasDdosProtectionPlan || d.HasChange("ddos_protection_mode") || d.HasChange("ddos_protection_plan_id") {
+		body, err := expandArmPublicIpWithExtendedProperties(d, publicIp)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+		id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s", meta.(*ArmClient).subscriptionId, resGroup, name)
+		if err := armRawPut(ctx, client.Client, client.BaseURI, id, publicIpExtendedApiVersion, body); err != nil {
+			return fmt.Errorf("Error Creating/Updating Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, publicIp)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for completion of Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
@@ -281,7 +373,31 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		ipTags, routingPreference := flattenArmPublicIpIpTags(props.IPTags)
+		if err := d.Set("ip_tags", ipTags); err != nil {
+			return fmt.Errorf("Error setting `ip_tags`: %+v", err)
+		}
+		d.Set("routing_preference", routingPreference)
+	}
+
+	var extended publicIpExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), publicIpExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the DDoS Settings for Public IP %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	ddosProtectionMode := "VirtualNetworkInherited"
+	ddosProtectionPlanId := ""
+	if props := extended.Properties; props != nil && props.DdosSettings != nil {
+		if props.DdosSettings.ProtectionMode != nil && *props.DdosSettings.ProtectionMode != "" {
+			ddosProtectionMode = *props.DdosSettings.ProtectionMode
+		}
+		if plan := props.DdosSettings.DdosProtectionPlan; plan != nil && plan.ID != nil {
+			ddosProtectionPlanId = *plan.ID
+		}
 	}
+	d.Set("ddos_protection_mode", ddosProtectionMode)
+	d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
 
 	flattenAndSetTags(d, resp.Tags)
 
@@ -310,3 +426,78 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandArmPublicIpIpTags(input map[string]interface{}, routingPreference string) *[]network.IPTag {
+	ipTags := make([]network.IPTag, 0)
+
+	for ipTagType, tag := range input {
+		ipTags = append(ipTags, network.IPTag{
+			IPTagType: utils.String(ipTagType),
+			Tag:       utils.String(tag.(string)),
+		})
+	}
+
+	// routing over the Microsoft Network is the default, which is represented by the absence of the IP Tag
+	if routingPreference == "Internet" {
+		ipTags = append(ipTags, network.IPTag{
+			IPTagType: utils.String(publicIpRoutingPreferenceIpTagType),
+			Tag:       utils.String(routingPreference),
+		})
+	}
+
+	return &ipTags
+}
+
+func flattenArmPublicIpIpTags(input *[]network.IPTag) (map[string]interface{}, string) {
+	ipTags := make(map[string]interface{})
+	routingPreference := "MicrosoftNetwork"
+
+	if input == nil {
+		return ipTags, routingPreference
+	}
+
+	for _, v := range *input {
+		if v.IPTagType == nil || v.Tag == nil {
+			continue
+		}
+
+		if strings.EqualFold(*v.IPTagType, publicIpRoutingPreferenceIpTagType) {
+			routingPreference = *v.Tag
+			continue
+		}
+
+		ipTags[*v.IPTagType] = *v.Tag
+	}
+
+	return ipTags, routingPreference
+}
+
+func expandArmPublicIpWithExtendedProperties(d *schema.ResourceData, publicIp network.PublicIPAddress) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(publicIp)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Public IP: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing Public IP: %+v", err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+
+	ddosSettings := publicIpDdosSettings{
+		ProtectionMode: utils.String(d.Get("ddos_protection_mode").(string)),
+	}
+	if v, ok := d.GetOk("ddos_protection_plan_id"); ok {
+		ddosSettings.DdosProtectionPlan = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+	properties["ddosSettings"] = ddosSettings
+
+	return body, nil
+}
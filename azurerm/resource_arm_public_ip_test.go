@@ -7,9 +7,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMPublicIpStatic_basic(t *testing.T) {
@@ -475,6 +477,141 @@ func TestAccAzureRMPublicIpStatic_canLabelBe63(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPublicIpStatic_ipTags(t *testing.T) {
+	resourceName := "azurerm_public_ip.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIPStatic_ipTags(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_tags.FirstPartyUsage", "/Sql"),
+					resource.TestCheckResourceAttr(resourceName, "routing_preference", "MicrosoftNetwork"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPublicIpStatic_routingPreference(t *testing.T) {
+	resourceName := "azurerm_public_ip.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIPStatic_routingPreference(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_preference", "Internet"),
+					resource.TestCheckResourceAttr(resourceName, "ip_tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPublicIpStatic_ddosProtection(t *testing.T) {
+	resourceName := "azurerm_public_ip.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIPStatic_standard(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_mode", "VirtualNetworkInherited"),
+				),
+			},
+			{
+				Config: testAccAzureRMPublicIPStatic_ddosProtection(ri, location, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_mode", "Disabled"),
+				),
+			},
+			{
+				Config: testAccAzureRMPublicIPStatic_ddosProtectionPlan(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_mode", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "ddos_protection_plan_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPublicIpStatic_ddosProtectionRequiresStandardSku(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMPublicIPStatic_ddosProtectionBasicSku(ri, testLocation()),
+				ExpectError: regexp.MustCompile("`ddos_protection_mode` can only be `Enabled` for Public IP's using the `Standard` SKU"),
+			},
+		},
+	})
+}
+
+func TestFlattenArmPublicIpIpTags(t *testing.T) {
+	input := &[]network.IPTag{
+		{
+			IPTagType: utils.String("FirstPartyUsage"),
+			Tag:       utils.String("/Sql"),
+		},
+		{
+			IPTagType: utils.String("RoutingPreference"),
+			Tag:       utils.String("Internet"),
+		},
+	}
+
+	ipTags, routingPreference := flattenArmPublicIpIpTags(input)
+	if routingPreference != "Internet" {
+		t.Fatalf("Expected the Routing Preference to be %q but got %q", "Internet", routingPreference)
+	}
+	if len(ipTags) != 1 || ipTags["FirstPartyUsage"] != "/Sql" {
+		t.Fatalf("Expected the IP Tags to only contain `FirstPartyUsage` but got %+v", ipTags)
+	}
+
+	if _, routingPreference := flattenArmPublicIpIpTags(nil); routingPreference != "MicrosoftNetwork" {
+		t.Fatalf("Expected the default Routing Preference to be %q but got %q", "MicrosoftNetwork", routingPreference)
+	}
+}
+
 func testCheckAzureRMPublicIpExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -817,3 +954,102 @@ resource "azurerm_public_ip" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMPublicIPStatic_ipTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ip_tags = {
+    FirstPartyUsage = "/Sql"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPublicIPStatic_routingPreference(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  routing_preference  = "Internet"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPublicIPStatic_ddosProtection(rInt int, location string, mode string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                 = "acctestpublicip-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  allocation_method    = "Static"
+  sku                  = "Standard"
+  ddos_protection_mode = "%s"
+}
+`, rInt, location, rInt, mode)
+}
+
+func testAccAzureRMPublicIPStatic_ddosProtectionPlan(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                    = "acctestpublicip-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  allocation_method       = "Static"
+  sku                     = "Standard"
+  ddos_protection_mode    = "Enabled"
+  ddos_protection_plan_id = "${azurerm_ddos_protection_plan.test.id}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPublicIPStatic_ddosProtectionBasicSku(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                 = "acctestpublicip-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  allocation_method    = "Static"
+  ddos_protection_mode = "Enabled"
+}
+`, rInt, location, rInt)
+}
@@ -19,6 +19,31 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+type recoveryServicesProtectedVmExtended struct {
+	ID         *string                                        `json:"id,omitempty"`
+	Tags       map[string]*string                             `json:"tags"`
+	Properties *recoveryServicesProtectedVmExtendedProperties `json:"properties,omitempty"`
+}
+
+type recoveryServicesProtectedVmExtendedProperties struct {
+	ProtectedItemType  string                                   `json:"protectedItemType"`
+	WorkloadType       string                                   `json:"workloadType,omitempty"`
+	SourceResourceID   *string                                  `json:"sourceResourceId,omitempty"`
+	PolicyID           *string                                  `json:"policyId,omitempty"`
+	FriendlyName       *string                                  `json:"friendlyName,omitempty"`
+	VirtualMachineID   *string                                  `json:"virtualMachineId,omitempty"`
+	ExtendedProperties *recoveryServicesProtectedVmExtendedInfo `json:"extendedProperties,omitempty"`
+}
+
+type recoveryServicesProtectedVmExtendedInfo struct {
+	DiskExclusionProperties *recoveryServicesProtectedVmDiskExclusion `json:"diskExclusionProperties,omitempty"`
+}
+
+type recoveryServicesProtectedVmDiskExclusion struct {
+	DiskLunList     *[]int32 `json:"diskLunList,omitempty"`
+	IsInclusionList *bool    `json:"isInclusionList,omitempty"`
+}
+
 func resourceArmRecoveryServicesProtectedVm() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmRecoveryServicesProtectedVmCreateUpdate,
@@ -58,6 +83,26 @@ func resourceArmRecoveryServicesProtectedVm() *schema.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"exclude_disk_luns": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"include_disk_luns"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+
+			"include_disk_luns": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"exclude_disk_luns"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -89,7 +134,7 @@ func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData,
 
 	log.Printf("[DEBUG] Creating/updating Recovery Service Protected VM %s (resource group %q)", protectedItemName, resourceGroup)
 
-	if requireResourcesToBeImported {
+	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err2 := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
 		if err2 != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -102,19 +147,22 @@ func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData,
 		}
 	}
 
-	item := backup.ProtectedItemResource{
+	// the vendored SDK doesn't support excluding Disks from the backup, so the Protected Item is created using a newer API Version
+	item := recoveryServicesProtectedVmExtended{
 		Tags: expandTags(tags),
-		Properties: &backup.AzureIaaSComputeVMProtectedItem{
-			PolicyID:          &policyId,
-			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
-			WorkloadType:      backup.DataSourceTypeVM,
-			SourceResourceID:  utils.String(vmId),
-			FriendlyName:      utils.String(vmName),
-			VirtualMachineID:  utils.String(vmId),
+		Properties: &recoveryServicesProtectedVmExtendedProperties{
+			PolicyID:           &policyId,
+			ProtectedItemType:  string(backup.ProtectedItemTypeMicrosoftComputevirtualMachines),
+			WorkloadType:       string(backup.DataSourceTypeVM),
+			SourceResourceID:   utils.String(vmId),
+			FriendlyName:       utils.String(vmName),
+			VirtualMachineID:   utils.String(vmId),
+			ExtendedProperties: expandArmRecoveryServicesProtectedVmDiskExclusion(d),
 		},
 	}
 
-	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+	itemId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/Azure/protectionContainers/%s/protectedItems/%s", client.SubscriptionID, resourceGroup, vaultName, containerName, protectedItemName)
+	if err = armRawPut(ctx, client.Client, client.BaseURI, itemId, recoveryServicesBackupExtendedApiVersion, item); err != nil {
 		return fmt.Errorf("Error creating/updating Recovery Service Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
 	}
 
@@ -167,6 +215,19 @@ func resourceArmRecoveryServicesProtectedVmRead(d *schema.ResourceData, meta int
 		}
 	}
 
+	var extended recoveryServicesProtectedVmExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), recoveryServicesBackupExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Disk Exclusions for Recovery Service Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	}
+
+	excludeDiskLuns, includeDiskLuns := flattenArmRecoveryServicesProtectedVmDiskExclusion(extended.Properties)
+	if err := d.Set("exclude_disk_luns", excludeDiskLuns); err != nil {
+		return fmt.Errorf("Error setting `exclude_disk_luns`: %+v", err)
+	}
+	if err := d.Set("include_disk_luns", includeDiskLuns); err != nil {
+		return fmt.Errorf("Error setting `include_disk_luns`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -238,3 +299,49 @@ func resourceArmRecoveryServicesProtectedVmWaitForState(client backup.ProtectedI
 
 	return resp.(backup.ProtectedItemResource), nil
 }
+
+func expandArmRecoveryServicesProtectedVmDiskExclusion(d *schema.ResourceData) *recoveryServicesProtectedVmExtendedInfo {
+	isInclusionList := false
+	luns := d.Get("exclude_disk_luns").(*schema.Set).List()
+	if v := d.Get("include_disk_luns").(*schema.Set).List(); len(v) > 0 {
+		isInclusionList = true
+		luns = v
+	}
+
+	// an empty exclusion list backs up every Disk, which also removes any previous exclusions
+	diskLunList := make([]int32, 0)
+	for _, v := range luns {
+		diskLunList = append(diskLunList, int32(v.(int)))
+	}
+
+	return &recoveryServicesProtectedVmExtendedInfo{
+		DiskExclusionProperties: &recoveryServicesProtectedVmDiskExclusion{
+			DiskLunList:     &diskLunList,
+			IsInclusionList: utils.Bool(isInclusionList),
+		},
+	}
+}
+
+func flattenArmRecoveryServicesProtectedVmDiskExclusion(input *recoveryServicesProtectedVmExtendedProperties) ([]interface{}, []interface{}) {
+	excludeDiskLuns := make([]interface{}, 0)
+	includeDiskLuns := make([]interface{}, 0)
+	if input == nil || input.ExtendedProperties == nil || input.ExtendedProperties.DiskExclusionProperties == nil {
+		return excludeDiskLuns, includeDiskLuns
+	}
+
+	exclusion := input.ExtendedProperties.DiskExclusionProperties
+	if exclusion.DiskLunList == nil {
+		return excludeDiskLuns, includeDiskLuns
+	}
+
+	luns := make([]interface{}, 0)
+	for _, v := range *exclusion.DiskLunList {
+		luns = append(luns, int(v))
+	}
+
+	if exclusion.IsInclusionList != nil && *exclusion.IsInclusionList {
+		return excludeDiskLuns, luns
+	}
+
+	return luns, includeDiskLuns
+}
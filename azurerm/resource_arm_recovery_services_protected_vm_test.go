@@ -41,6 +41,52 @@ func TestAccAzureRMRecoveryServicesProtectedVm_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRecoveryServicesProtectedVm_diskLuns(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protected_vm.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectedVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedVm_diskLuns(ri, testLocation(), "exclude_disk_luns"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedVmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_disk_luns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_disk_luns.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedVm_diskLuns(ri, testLocation(), "include_disk_luns"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedVmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_disk_luns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "include_disk_luns.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesProtectedVm_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectedVmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_disk_luns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "include_disk_luns.#", "0"),
+				),
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMRecoveryServicesProtectedVm_base(ri, testLocation()),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMRecoveryServicesProtectedVm_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -331,6 +377,20 @@ resource "azurerm_recovery_services_protected_vm" "test" {
 `, testAccAzureRMRecoveryServicesProtectedVm_base(rInt, location))
 }
 
+func testAccAzureRMRecoveryServicesProtectedVm_diskLuns(rInt int, location string, field string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protected_vm" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  source_vm_id        = "${azurerm_virtual_machine.test.id}"
+  backup_policy_id    = "${azurerm_recovery_services_protection_policy_vm.test.id}"
+  %-19s = [0]
+}
+`, testAccAzureRMRecoveryServicesProtectedVm_base(rInt, location), field)
+}
+
 func testAccAzureRMRecoveryServicesProtectedVm_requiresImport(rInt int, location string) string {
 	return fmt.Sprintf(`
 %s
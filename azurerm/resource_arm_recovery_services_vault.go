@@ -14,6 +14,21 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the vendored SDKs don't support Zone Redundant storage, Cross Region Restore or excluding Disks from the backup
+// of a Virtual Machine - so these are managed using a newer API Version
+const recoveryServicesBackupExtendedApiVersion = "2023-02-01"
+
+type recoveryServicesVaultStorageConfig struct {
+	Properties *recoveryServicesVaultStorageConfigProperties `json:"properties,omitempty"`
+}
+
+type recoveryServicesVaultStorageConfigProperties struct {
+	StorageModelType       *string `json:"storageModelType,omitempty"`
+	StorageType            *string `json:"storageType,omitempty"`
+	StorageTypeState       *string `json:"storageTypeState,omitempty"`
+	CrossRegionRestoreFlag *bool   `json:"crossRegionRestoreFlag,omitempty"`
+}
+
 func resourceArmRecoveryServicesVault() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmRecoveryServicesVaultCreateUpdate,
@@ -51,6 +66,32 @@ func resourceArmRecoveryServicesVault() *schema.Resource {
 					string(recoveryservices.Standard),
 				}, true),
 			},
+
+			// the storage redundancy can only be changed until an item has been protected by the Vault
+			"storage_mode_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GeoRedundant",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GeoRedundant",
+					"LocallyRedundant",
+					"ZoneRedundant",
+				}, false),
+			},
+
+			"cross_region_restore_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Get("cross_region_restore_enabled").(bool) && diff.Get("storage_mode_type").(string) != "GeoRedundant" {
+				return fmt.Errorf("`cross_region_restore_enabled` can only be enabled when `storage_mode_type` is `GeoRedundant`")
+			}
+
+			return nil
 		},
 	}
 }
@@ -66,7 +107,7 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 
 	log.Printf("[DEBUG] Creating/updating Recovery Service Vault %q (resource group %q)", name, resourceGroup)
 
-	if requireResourcesToBeImported {
+	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -97,6 +138,20 @@ func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta i
 
 	d.SetId(*vault.ID)
 
+	if d.IsNewResource() || d.HasChange("storage_mode_type") || d.HasChange("cross_region_restore_enabled") {
+		storageConfig := recoveryServicesVaultStorageConfig{
+			Properties: &recoveryServicesVaultStorageConfigProperties{
+				StorageModelType:       utils.String(d.Get("storage_mode_type").(string)),
+				CrossRegionRestoreFlag: utils.Bool(d.Get("cross_region_restore_enabled").(bool)),
+			},
+		}
+
+		storageConfigId := fmt.Sprintf("%s/backupstorageconfig/vaultstorageconfig", d.Id())
+		if err := armRawPut(ctx, client.Client, client.BaseURI, storageConfigId, recoveryServicesBackupExtendedApiVersion, storageConfig); err != nil {
+			return fmt.Errorf("Error updating the Storage Configuration for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceArmRecoveryServicesVaultRead(d, meta)
 }
 
@@ -134,6 +189,25 @@ func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface
 		d.Set("sku", string(sku.Name))
 	}
 
+	var storageConfig recoveryServicesVaultStorageConfig
+	storageConfigId := fmt.Sprintf("%s/backupstorageconfig/vaultstorageconfig", d.Id())
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, storageConfigId, recoveryServicesBackupExtendedApiVersion, &storageConfig); err != nil {
+		return fmt.Errorf("Error retrieving the Storage Configuration for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	storageModelType := "GeoRedundant"
+	crossRegionRestoreEnabled := false
+	if props := storageConfig.Properties; props != nil {
+		if props.StorageModelType != nil && *props.StorageModelType != "" {
+			storageModelType = *props.StorageModelType
+		}
+		if props.CrossRegionRestoreFlag != nil {
+			crossRegionRestoreEnabled = *props.CrossRegionRestoreFlag
+		}
+	}
+	d.Set("storage_mode_type", storageModelType)
+	d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -28,6 +28,8 @@ func TestAccAzureRMRecoveryServicesVault_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "resource_group_name"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "sku", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "GeoRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "cross_region_restore_enabled", "false"),
 				),
 			},
 			{
@@ -39,6 +41,40 @@ func TestAccAzureRMRecoveryServicesVault_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRecoveryServicesVault_storageConfig(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_recovery_services_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesVault_storageConfig(ri, testLocation(), "GeoRedundant", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "GeoRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "cross_region_restore_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesVault_storageConfig(ri, testLocation(), "LocallyRedundant", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "LocallyRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "cross_region_restore_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMRecoveryServicesVault_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -157,3 +193,21 @@ resource "azurerm_recovery_services_vault" "import" {
 }
 `, testAccAzureRMRecoveryServicesVault_basic(rInt, location))
 }
+
+func testAccAzureRMRecoveryServicesVault_storageConfig(rInt int, location string, storageModeType string, crossRegionRestoreEnabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                         = "acctest-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  sku                          = "Standard"
+  storage_mode_type            = "%s"
+  cross_region_restore_enabled = %t
+}
+`, rInt, location, rInt, storageModeType, crossRegionRestoreEnabled)
+}
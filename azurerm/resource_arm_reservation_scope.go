@@ -0,0 +1,279 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/reservations/mgmt/2018-06-01/reservations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmReservationScope manages where the benefit of an existing Reservation is applied - Reservations are
+// purchased (and exchanged) outside of Terraform, so this can't create or remove the Reservation itself
+func resourceArmReservationScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmReservationScopeCreateUpdate,
+		Read:   resourceArmReservationScopeRead,
+		Update: resourceArmReservationScopeCreateUpdate,
+		Delete: resourceArmReservationScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"reservation_order_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"reservation_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"applied_scope_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(reservations.Shared),
+					string(reservations.Single),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			// the API only allows a single Subscription at this time, but models this as a list
+			"applied_scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateReservationAppliedScope,
+				},
+			},
+
+			"instance_flexibility_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"reserved_resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"quantity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmReservationScopeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).reservationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	orderId := d.Get("reservation_order_id").(string)
+	reservationId := d.Get("reservation_id").(string)
+
+	scopeType := reservations.AppliedScopeType(d.Get("applied_scope_type").(string))
+	appliedScopes := d.Get("applied_scopes").([]interface{})
+	if strings.EqualFold(string(scopeType), string(reservations.Single)) {
+		scopeType = reservations.Single
+		if len(appliedScopes) == 0 {
+			return fmt.Errorf("`applied_scopes` must be specified when `applied_scope_type` is `Single`")
+		}
+	} else {
+		scopeType = reservations.Shared
+		if len(appliedScopes) > 0 {
+			return fmt.Errorf("`applied_scopes` cannot be specified when `applied_scope_type` is `Shared`")
+		}
+	}
+
+	existing, err := client.Get(ctx, reservationId, orderId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Reservation %q (Order %q): %+v", reservationId, orderId, err)
+	}
+
+	if existing.ID == nil {
+		return fmt.Errorf("Cannot read ID of Reservation %q (Order %q)", reservationId, orderId)
+	}
+
+	properties := reservations.PatchProperties{
+		AppliedScopeType: scopeType,
+	}
+	if scopeType == reservations.Single {
+		properties.AppliedScopes = utils.ExpandStringArray(appliedScopes)
+	}
+
+	// Instance Flexibility can only be toggled for Reservations which support it, so is only sent when configured
+	if v, ok := d.GetOkExists("instance_flexibility_enabled"); ok && (d.IsNewResource() || d.HasChange("instance_flexibility_enabled")) {
+		properties.InstanceFlexibility = reservations.Off
+		if v.(bool) {
+			properties.InstanceFlexibility = reservations.On
+		}
+	}
+
+	parameters := reservations.Patch{
+		PatchProperties: &properties,
+	}
+
+	log.Printf("[DEBUG] Updating the Applied Scope of Reservation %q (Order %q)..", reservationId, orderId)
+	future, err := client.Update(ctx, orderId, reservationId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating the Applied Scope of Reservation %q (Order %q): %+v", reservationId, orderId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Applied Scope of Reservation %q (Order %q) to be updated: %+v", reservationId, orderId, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(*existing.ID)
+	}
+
+	return resourceArmReservationScopeRead(d, meta)
+}
+
+func resourceArmReservationScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).reservationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	orderId, reservationId, err := parseReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, reservationId, orderId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Reservation %q (Order %q) was not found - removing from state", reservationId, orderId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Reservation %q (Order %q): %+v", reservationId, orderId, err)
+	}
+
+	d.Set("reservation_order_id", orderId)
+	d.Set("reservation_id", reservationId)
+	d.Set("location", resp.Location)
+
+	skuName := ""
+	if sku := resp.Sku; sku != nil && sku.Name != nil {
+		skuName = *sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("applied_scope_type", string(props.AppliedScopeType))
+		if err := d.Set("applied_scopes", utils.FlattenStringArray(props.AppliedScopes)); err != nil {
+			return fmt.Errorf("Error setting `applied_scopes`: %+v", err)
+		}
+
+		d.Set("instance_flexibility_enabled", props.InstanceFlexibility == reservations.On)
+		d.Set("display_name", props.DisplayName)
+		d.Set("reserved_resource_type", string(props.ReservedResourceType))
+
+		quantity := 0
+		if props.Quantity != nil {
+			quantity = int(*props.Quantity)
+		}
+		d.Set("quantity", quantity)
+	}
+
+	return nil
+}
+
+func resourceArmReservationScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).reservationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	orderId, reservationId, err := parseReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Reservation itself remains until it expires, so we reset it to the default (Shared) scope
+	parameters := reservations.Patch{
+		PatchProperties: &reservations.PatchProperties{
+			AppliedScopeType: reservations.Shared,
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting the Applied Scope of Reservation %q (Order %q) to Shared..", reservationId, orderId)
+	future, err := client.Update(ctx, orderId, reservationId, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error resetting the Applied Scope of Reservation %q (Order %q): %+v", reservationId, orderId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Applied Scope of Reservation %q (Order %q) to be reset: %+v", reservationId, orderId, err)
+	}
+
+	return nil
+}
+
+// parseReservationID parses a Reservation ID in the format
+// `/providers/Microsoft.Capacity/reservationOrders/{orderId}/reservations/{reservationId}` - which isn't scoped
+// to a Subscription and as such can't be parsed using `parseAzureResourceID`
+func parseReservationID(input string) (string, string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 6 ||
+		!strings.EqualFold(segments[0], "providers") ||
+		!strings.EqualFold(segments[1], "Microsoft.Capacity") ||
+		!strings.EqualFold(segments[2], "reservationOrders") ||
+		!strings.EqualFold(segments[4], "reservations") ||
+		segments[3] == "" || segments[5] == "" {
+		return "", "", fmt.Errorf("Expected a Reservation ID in the format `/providers/Microsoft.Capacity/reservationOrders/{orderId}/reservations/{reservationId}` but got %q", input)
+	}
+
+	return segments[3], segments[5], nil
+}
+
+func validateReservationAppliedScope(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^/subscriptions/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a Subscription scope in the format `/subscriptions/{subscriptionId}`, got %q", k, v))
+	}
+
+	return
+}
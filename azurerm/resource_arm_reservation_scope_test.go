@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMReservationScope_basic(t *testing.T) {
+	// Reservations have to be purchased outside of Terraform, as such this test requires an existing Reservation
+	orderIdEnvVariable := "ARM_TEST_RESERVATION_ORDER_ID"
+	orderId := os.Getenv(orderIdEnvVariable)
+	if orderId == "" {
+		t.Skipf("Skipping as %q is not specified", orderIdEnvVariable)
+	}
+
+	reservationIdEnvVariable := "ARM_TEST_RESERVATION_ID"
+	reservationId := os.Getenv(reservationIdEnvVariable)
+	if reservationId == "" {
+		t.Skipf("Skipping as %q is not specified", reservationIdEnvVariable)
+	}
+
+	resourceName := "azurerm_reservation_scope.test"
+
+	// the Reservation is shared across tests, so these can't run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMReservationScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMReservationScope_single(orderId, reservationId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMReservationScopeExists(resourceName, "Single"),
+					resource.TestCheckResourceAttr(resourceName, "applied_scopes.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "reserved_resource_type"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMReservationScope_shared(orderId, reservationId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMReservationScopeExists(resourceName, "Shared"),
+					resource.TestCheckResourceAttr(resourceName, "applied_scopes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseReservationID(t *testing.T) {
+	testData := []struct {
+		Input         string
+		OrderID       string
+		ReservationID string
+		Error         bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/reservations/",
+			Error: true,
+		},
+		{
+			Input:         "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/reservations/11111111-1111-1111-1111-111111111111",
+			OrderID:       "00000000-0000-0000-0000-000000000000",
+			ReservationID: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input:         "/providers/microsoft.capacity/reservationorders/00000000-0000-0000-0000-000000000000/reservations/11111111-1111-1111-1111-111111111111",
+			OrderID:       "00000000-0000-0000-0000-000000000000",
+			ReservationID: "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		orderId, reservationId, err := parseReservationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one for %q", v.Input)
+		}
+
+		if orderId != v.OrderID {
+			t.Fatalf("Expected Order ID to be %q but got %q", v.OrderID, orderId)
+		}
+
+		if reservationId != v.ReservationID {
+			t.Fatalf("Expected Reservation ID to be %q but got %q", v.ReservationID, reservationId)
+		}
+	}
+}
+
+func TestValidateReservationAppliedScope(t *testing.T) {
+	testData := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "00000000-0000-0000-0000-000000000000",
+			Errors: 1,
+		},
+		{
+			Value:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Errors: 1,
+		},
+		{
+			Value:  "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Errors: 0,
+		},
+	}
+
+	for _, v := range testData {
+		_, errors := validateReservationAppliedScope(v.Value, "applied_scopes")
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d errors for %q but got %d", v.Errors, v.Value, len(errors))
+		}
+	}
+}
+
+func testCheckAzureRMReservationScopeExists(resourceName string, scopeType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		orderId, reservationId, err := parseReservationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).reservationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, reservationId, orderId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on reservationsClient: %+v", err)
+		}
+
+		if props := resp.Properties; props == nil || string(props.AppliedScopeType) != scopeType {
+			return fmt.Errorf("Bad: Expected Reservation %q (Order %q) to have the Applied Scope Type %q", reservationId, orderId, scopeType)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMReservationScopeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).reservationsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_reservation_scope" {
+			continue
+		}
+
+		orderId, reservationId, err := parseReservationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		// the Reservation remains once the resource is removed, but should be reset to the Shared scope
+		resp, err := client.Get(ctx, reservationId, orderId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on reservationsClient: %+v", err)
+		}
+
+		if props := resp.Properties; props != nil && props.AppliedScopeType != "Shared" {
+			return fmt.Errorf("Reservation %q (Order %q) still has the Applied Scope Type %q", reservationId, orderId, string(props.AppliedScopeType))
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMReservationScope_single(orderId string, reservationId string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_reservation_scope" "test" {
+  reservation_order_id = "%s"
+  reservation_id       = "%s"
+  applied_scope_type   = "Single"
+  applied_scopes       = ["/subscriptions/${data.azurerm_subscription.current.subscription_id}"]
+}
+`, orderId, reservationId)
+}
+
+func testAccAzureRMReservationScope_shared(orderId string, reservationId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_reservation_scope" "test" {
+  reservation_order_id = "%s"
+  reservation_id       = "%s"
+  applied_scope_type   = "Shared"
+}
+`, orderId, reservationId)
+}
@@ -0,0 +1,295 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure Resource Mover isn't present in the vendored SDK, so is managed using raw requests
+const resourceMoverApiVersion = "2021-08-01"
+
+var resourceMoverMoveCollectionResourceName = "azurerm_resource_mover_move_collection"
+
+type resourceMoverMoveCollection struct {
+	ID         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Location   *string                                `json:"location,omitempty"`
+	Tags       map[string]*string                     `json:"tags"`
+	Identity   *resourceMoverMoveCollectionIdentity   `json:"identity,omitempty"`
+	Properties *resourceMoverMoveCollectionProperties `json:"properties,omitempty"`
+}
+
+type resourceMoverMoveCollectionIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type resourceMoverMoveCollectionProperties struct {
+	SourceRegion      *string `json:"sourceRegion,omitempty"`
+	TargetRegion      *string `json:"targetRegion,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+func resourceArmResourceMoverMoveCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceMoverMoveCollectionCreateUpdate,
+		Read:   resourceArmResourceMoverMoveCollectionRead,
+		Update: resourceArmResourceMoverMoveCollectionCreateUpdate,
+		Delete: resourceArmResourceMoverMoveCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"source_region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"target_region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			// the Managed Identity is used by Resource Mover to access the Resources being moved
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			source := azureRMNormalizeLocation(diff.Get("source_region").(string))
+			target := azureRMNormalizeLocation(diff.Get("target_region").(string))
+			if source != "" && source == target {
+				return fmt.Errorf("`source_region` and `target_region` must be different Regions")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmResourceMoverMoveCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := resourceMoverMoveCollectionID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing resourceMoverMoveCollection
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Move Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_resource_mover_move_collection", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := resourceMoverMoveCollection{
+		Location: utils.String(location),
+		Identity: expandArmResourceMoverMoveCollectionIdentity(d.Get("identity").([]interface{})),
+		Properties: &resourceMoverMoveCollectionProperties{
+			SourceRegion: utils.String(azureRMNormalizeLocation(d.Get("source_region").(string))),
+			TargetRegion: utils.String(azureRMNormalizeLocation(d.Get("target_region").(string))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	azureRMLockByName(name, resourceMoverMoveCollectionResourceName)
+	defer azureRMUnlockByName(name, resourceMoverMoveCollectionResourceName)
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Move Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read resourceMoverMoveCollection
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Move Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Move Collection %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmResourceMoverMoveCollectionRead(d, meta)
+}
+
+func resourceArmResourceMoverMoveCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["moveCollections"]
+
+	var resp resourceMoverMoveCollection
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), resourceMoverApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Move Collection %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Move Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenArmResourceMoverMoveCollectionIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		if props.SourceRegion != nil {
+			d.Set("source_region", azureRMNormalizeLocation(*props.SourceRegion))
+		}
+		if props.TargetRegion != nil {
+			d.Set("target_region", azureRMNormalizeLocation(*props.TargetRegion))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmResourceMoverMoveCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["moveCollections"]
+
+	azureRMLockByName(name, resourceMoverMoveCollectionResourceName)
+	defer azureRMUnlockByName(name, resourceMoverMoveCollectionResourceName)
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), resourceMoverApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Move Collection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceMoverMoveCollectionID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Migrate/moveCollections/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmResourceMoverMoveCollectionIdentity(input []interface{}) *resourceMoverMoveCollectionIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &resourceMoverMoveCollectionIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &resourceMoverMoveCollectionIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmResourceMoverMoveCollectionIdentity(input *resourceMoverMoveCollectionIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMResourceMoverMoveCollection_basic(t *testing.T) {
+	resourceName := "azurerm_resource_mover_move_collection.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveCollection_basic(ri, testLocation(), testAltLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveCollectionExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceMoverMoveCollection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource_mover_move_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveCollection_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveCollectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceMoverMoveCollection_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_resource_mover_move_collection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceMoverMoveCollection_complete(t *testing.T) {
+	resourceName := "azurerm_resource_mover_move_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveCollection_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveCollectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceMoverMoveCollection_complete(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceMoverMoveCollectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp resourceMoverMoveCollection
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, resourceMoverApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Move Collection %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Move Collection %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceMoverMoveCollectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource_mover_move_collection" {
+			continue
+		}
+
+		var resp resourceMoverMoveCollection
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, resourceMoverApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Move Collection still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMResourceMoverMoveCollection_basic(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  source_region       = "${azurerm_resource_group.test.location}"
+  target_region       = "%s"
+}
+`, rInt, location, rInt, altLocation)
+}
+
+func testAccAzureRMResourceMoverMoveCollection_requiresImport(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_collection" "import" {
+  name                = "${azurerm_resource_mover_move_collection.test.name}"
+  resource_group_name = "${azurerm_resource_mover_move_collection.test.resource_group_name}"
+  location            = "${azurerm_resource_mover_move_collection.test.location}"
+  source_region       = "${azurerm_resource_mover_move_collection.test.source_region}"
+  target_region       = "${azurerm_resource_mover_move_collection.test.target_region}"
+}
+`, testAccAzureRMResourceMoverMoveCollection_basic(rInt, location, altLocation))
+}
+
+func testAccAzureRMResourceMoverMoveCollection_complete(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  source_region       = "${azurerm_resource_group.test.location}"
+  target_region       = "%s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, rInt, location, rInt, altLocation)
+}
@@ -0,0 +1,445 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the steps of a move are exposed as the status the Move Resource should be in, in the order they happen
+const (
+	resourceMoverMoveStatusAdded         = "Added"
+	resourceMoverMoveStatusPrepared      = "Prepared"
+	resourceMoverMoveStatusMoveInitiated = "MoveInitiated"
+	resourceMoverMoveStatusCommitted     = "Committed"
+)
+
+var resourceMoverMoveStatuses = []string{
+	resourceMoverMoveStatusAdded,
+	resourceMoverMoveStatusPrepared,
+	resourceMoverMoveStatusMoveInitiated,
+	resourceMoverMoveStatusCommitted,
+}
+
+type resourceMoverMoveResource struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *resourceMoverMoveResourceProperties `json:"properties,omitempty"`
+}
+
+type resourceMoverMoveResourceProperties struct {
+	SourceID         *string                            `json:"sourceId,omitempty"`
+	TargetID         *string                            `json:"targetId,omitempty"`
+	ResourceSettings *resourceMoverMoveResourceSettings `json:"resourceSettings,omitempty"`
+	MoveStatus       *resourceMoverMoveResourceStatus   `json:"moveStatus,omitempty"`
+}
+
+type resourceMoverMoveResourceSettings struct {
+	ResourceType       *string `json:"resourceType,omitempty"`
+	TargetResourceName *string `json:"targetResourceName,omitempty"`
+}
+
+type resourceMoverMoveResourceStatus struct {
+	MoveState *string `json:"moveState,omitempty"`
+}
+
+type resourceMoverOperationInputs struct {
+	MoveResources         []string `json:"moveResources"`
+	MoveResourceInputType string   `json:"moveResourceInputType"`
+	ValidateOnly          bool     `json:"validateOnly"`
+}
+
+func resourceArmResourceMoverMoveResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceMoverMoveResourceCreate,
+		Read:   resourceArmResourceMoverMoveResourceRead,
+		Update: resourceArmResourceMoverMoveResourceUpdate,
+		Delete: resourceArmResourceMoverMoveResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"move_collection_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     azure.ValidateResourceID,
+			},
+
+			"resource_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Microsoft.Compute/availabilitySets",
+								"Microsoft.Compute/virtualMachines",
+								"Microsoft.Network/loadBalancers",
+								"Microsoft.Network/networkInterfaces",
+								"Microsoft.Network/networkSecurityGroups",
+								"Microsoft.Network/publicIPAddresses",
+								"Microsoft.Network/virtualNetworks",
+								"Microsoft.Resources/resourceGroups",
+							}, false),
+						},
+
+						"target_resource_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			// when omitted the Move Resource is left in whichever status it's currently in
+			"move_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resourceMoverMoveStatuses, false),
+			},
+
+			"move_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.Id() == "" || !diff.HasChange("move_status") {
+				return nil
+			}
+
+			old, new := diff.GetChange("move_status")
+			if new.(string) == "" {
+				return nil
+			}
+
+			if _, err := resourceMoverMoveResourceOperations(old.(string), new.(string)); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmResourceMoverMoveResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	moveCollectionId := d.Get("move_collection_id").(string)
+	id := fmt.Sprintf("%s/moveResources/%s", moveCollectionId, name)
+
+	collection, err := parseAzureResourceID(moveCollectionId)
+	if err != nil {
+		return err
+	}
+	collectionName := collection.Path["moveCollections"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing resourceMoverMoveResource
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Move Resource %q (Move Collection %q): %+v", name, collectionName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_resource_mover_move_resource", *existing.ID)
+		}
+	}
+
+	parameters := resourceMoverMoveResource{
+		Properties: &resourceMoverMoveResourceProperties{
+			SourceID:         utils.String(d.Get("source_id").(string)),
+			ResourceSettings: expandArmResourceMoverMoveResourceSettings(d.Get("resource_settings").([]interface{})),
+		},
+	}
+
+	// operations on a Move Collection (including changes to its Move Resources) can't happen concurrently
+	azureRMLockByName(collectionName, resourceMoverMoveCollectionResourceName)
+	defer azureRMUnlockByName(collectionName, resourceMoverMoveCollectionResourceName)
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating Move Resource %q (Move Collection %q): %+v", name, collectionName, err)
+	}
+
+	var read resourceMoverMoveResource
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, resourceMoverApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Move Resource %q (Move Collection %q): %+v", name, collectionName, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Move Resource %q (Move Collection %q)", name, collectionName)
+	}
+
+	d.SetId(*read.ID)
+
+	// the dependencies of this Resource need to be resolved against the other Move Resources before it can be prepared
+	if err := armRawPost(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/resolveDependencies", moveCollectionId), resourceMoverApiVersion); err != nil {
+		return fmt.Errorf("Error resolving dependencies for Move Collection %q: %+v", collectionName, err)
+	}
+
+	if status := d.Get("move_status").(string); status != "" {
+		if err := updateArmResourceMoverMoveResourceStatus(d, meta, moveCollectionId, flattenArmResourceMoverMoveStatus(read.Properties), status); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmResourceMoverMoveResourceRead(d, meta)
+}
+
+func resourceArmResourceMoverMoveResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	moveCollectionId := d.Get("move_collection_id").(string)
+
+	collection, err := parseAzureResourceID(moveCollectionId)
+	if err != nil {
+		return err
+	}
+	collectionName := collection.Path["moveCollections"]
+
+	if d.HasChange("move_status") {
+		azureRMLockByName(collectionName, resourceMoverMoveCollectionResourceName)
+		defer azureRMUnlockByName(collectionName, resourceMoverMoveCollectionResourceName)
+
+		old, new := d.GetChange("move_status")
+		if err := updateArmResourceMoverMoveResourceStatus(d, meta, moveCollectionId, old.(string), new.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmResourceMoverMoveResourceRead(d, meta)
+}
+
+func resourceArmResourceMoverMoveResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collectionName := id.Path["moveCollections"]
+	name := id.Path["moveResources"]
+
+	var resp resourceMoverMoveResource
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), resourceMoverApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Move Resource %q was not found in Move Collection %q - removing from state", name, collectionName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Move Resource %q (Move Collection %q): %+v", name, collectionName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("move_collection_id", resourceMoverMoveCollectionID(id.SubscriptionID, id.ResourceGroup, collectionName))
+	d.Set("move_status", flattenArmResourceMoverMoveStatus(resp.Properties))
+
+	if props := resp.Properties; props != nil {
+		d.Set("source_id", props.SourceID)
+		d.Set("target_id", props.TargetID)
+
+		moveState := ""
+		if props.MoveStatus != nil && props.MoveStatus.MoveState != nil {
+			moveState = *props.MoveStatus.MoveState
+		}
+		d.Set("move_state", moveState)
+
+		if err := d.Set("resource_settings", flattenArmResourceMoverMoveResourceSettings(props.ResourceSettings)); err != nil {
+			return fmt.Errorf("Error setting `resource_settings`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmResourceMoverMoveResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collectionName := id.Path["moveCollections"]
+	name := id.Path["moveResources"]
+
+	azureRMLockByName(collectionName, resourceMoverMoveCollectionResourceName)
+	defer azureRMUnlockByName(collectionName, resourceMoverMoveCollectionResourceName)
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), resourceMoverApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Move Resource %q (Move Collection %q): %+v", name, collectionName, err)
+	}
+
+	return nil
+}
+
+// updateArmResourceMoverMoveResourceStatus performs each of the operations needed to move this Move Resource from the
+// `current` status to the `desired` status - the caller is expected to hold the lock on the Move Collection
+func updateArmResourceMoverMoveResourceStatus(d *schema.ResourceData, meta interface{}, moveCollectionId string, current string, desired string) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	operations, err := resourceMoverMoveResourceOperations(current, desired)
+	if err != nil {
+		return err
+	}
+
+	inputs := resourceMoverOperationInputs{
+		MoveResources:         []string{d.Id()},
+		MoveResourceInputType: "MoveResourceId",
+		ValidateOnly:          false,
+	}
+
+	for _, operation := range operations {
+		log.Printf("[DEBUG] Performing %q on Move Resource %q..", operation, d.Id())
+		if err := armRawPostWithBody(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/%s", moveCollectionId, operation), resourceMoverApiVersion, inputs); err != nil {
+			return fmt.Errorf("Error performing %q on Move Resource %q: %+v", operation, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// resourceMoverMoveResourceOperations returns the operations which need to be performed on the Move Collection to
+// move a Move Resource from the `current` status to the `desired` status. Moves can only go forwards, with the
+// exception of a move which has been initiated, which can be discarded to return it to the `Prepared` status.
+func resourceMoverMoveResourceOperations(current string, desired string) ([]string, error) {
+	currentIndex := resourceMoverMoveStatusIndex(current)
+	desiredIndex := resourceMoverMoveStatusIndex(desired)
+	if currentIndex == -1 {
+		return nil, fmt.Errorf("Unsupported current `move_status` %q", current)
+	}
+	if desiredIndex == -1 {
+		return nil, fmt.Errorf("Unsupported `move_status` %q", desired)
+	}
+
+	if current == resourceMoverMoveStatusMoveInitiated && desired == resourceMoverMoveStatusPrepared {
+		return []string{"discard"}, nil
+	}
+
+	if desiredIndex < currentIndex {
+		return nil, fmt.Errorf("`move_status` can't be changed from %q to %q - only a move which has been initiated can be discarded (by changing `move_status` from %q to %q)", current, desired, resourceMoverMoveStatusMoveInitiated, resourceMoverMoveStatusPrepared)
+	}
+
+	// the operation which moves the Move Resource out of each status, in order
+	operations := []string{"prepare", "initiateMove", "commit"}
+	return operations[currentIndex:desiredIndex], nil
+}
+
+func resourceMoverMoveStatusIndex(status string) int {
+	for i, v := range resourceMoverMoveStatuses {
+		if v == status {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// flattenArmResourceMoverMoveStatus maps the Move State returned from the API onto the last step of the move which has
+// completed - where a step is in progress or has failed, the Move Resource is considered to be in the previous status
+func flattenArmResourceMoverMoveStatus(input *resourceMoverMoveResourceProperties) string {
+	if input == nil || input.MoveStatus == nil || input.MoveStatus.MoveState == nil {
+		return resourceMoverMoveStatusAdded
+	}
+
+	switch strings.ToLower(*input.MoveStatus.MoveState) {
+	case "movepending", "moveinprogress", "movefailed", "discardinprogress":
+		return resourceMoverMoveStatusPrepared
+	case "commitpending", "commitinprogress", "commitfailed", "discardfailed":
+		return resourceMoverMoveStatusMoveInitiated
+	case "committed", "deletesourcepending", "resourcemovecompleted":
+		return resourceMoverMoveStatusCommitted
+	}
+
+	// e.g. `AssignmentPending`, `PreparePending`, `PrepareInProgress` and `PrepareFailed`
+	return resourceMoverMoveStatusAdded
+}
+
+func expandArmResourceMoverMoveResourceSettings(input []interface{}) *resourceMoverMoveResourceSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &resourceMoverMoveResourceSettings{
+		ResourceType:       utils.String(v["resource_type"].(string)),
+		TargetResourceName: utils.String(v["target_resource_name"].(string)),
+	}
+}
+
+func flattenArmResourceMoverMoveResourceSettings(input *resourceMoverMoveResourceSettings) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	resourceType := ""
+	if input.ResourceType != nil {
+		resourceType = *input.ResourceType
+	}
+
+	targetResourceName := ""
+	if input.TargetResourceName != nil {
+		targetResourceName = *input.TargetResourceName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"resource_type":        resourceType,
+			"target_resource_name": targetResourceName,
+		},
+	}
+}
@@ -0,0 +1,336 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourceMoverMoveResourceOperations(t *testing.T) {
+	cases := []struct {
+		Current  string
+		Desired  string
+		Expected []string
+		Error    bool
+	}{
+		{
+			Current:  "Added",
+			Desired:  "Added",
+			Expected: []string{},
+		},
+		{
+			Current:  "Added",
+			Desired:  "Prepared",
+			Expected: []string{"prepare"},
+		},
+		{
+			Current:  "Added",
+			Desired:  "Committed",
+			Expected: []string{"prepare", "initiateMove", "commit"},
+		},
+		{
+			Current:  "Prepared",
+			Desired:  "MoveInitiated",
+			Expected: []string{"initiateMove"},
+		},
+		{
+			Current:  "MoveInitiated",
+			Desired:  "Prepared",
+			Expected: []string{"discard"},
+		},
+		{
+			Current: "Committed",
+			Desired: "MoveInitiated",
+			Error:   true,
+		},
+		{
+			Current: "Prepared",
+			Desired: "Added",
+			Error:   true,
+		},
+		{
+			Current: "Added",
+			Desired: "Moved",
+			Error:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := resourceMoverMoveResourceOperations(tc.Current, tc.Desired)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error moving from %q to %q but got: %+v", tc.Current, tc.Desired, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error moving from %q to %q but didn't get one", tc.Current, tc.Desired)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected the operations to move from %q to %q to be %+v but got %+v", tc.Current, tc.Desired, tc.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenResourceMoverMoveStatus(t *testing.T) {
+	cases := map[string]string{
+		"PreparePending":      "Added",
+		"PrepareFailed":       "Added",
+		"MovePending":         "Prepared",
+		"MoveFailed":          "Prepared",
+		"DiscardInProgress":   "Prepared",
+		"CommitPending":       "MoveInitiated",
+		"DiscardFailed":       "MoveInitiated",
+		"DeleteSourcePending": "Committed",
+	}
+
+	for moveState, expected := range cases {
+		input := &resourceMoverMoveResourceProperties{
+			MoveStatus: &resourceMoverMoveResourceStatus{
+				MoveState: utils.String(moveState),
+			},
+		}
+
+		if actual := flattenArmResourceMoverMoveStatus(input); actual != expected {
+			t.Fatalf("Expected the Move State %q to be %q but got %q", moveState, expected, actual)
+		}
+	}
+
+	if actual := flattenArmResourceMoverMoveStatus(nil); actual != "Added" {
+		t.Fatalf("Expected a missing Move State to be %q but got %q", "Added", actual)
+	}
+}
+
+func TestAccAzureRMResourceMoverMoveResource_basic(t *testing.T) {
+	resourceName := "azurerm_resource_mover_move_resource.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveResource_basic(ri, testLocation(), testAltLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "move_status", "Added"),
+					resource.TestCheckResourceAttrSet(resourceName, "move_state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceMoverMoveResource_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource_mover_move_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveResource_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceMoverMoveResource_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_resource_mover_move_resource"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceMoverMoveResource_moveStatus(t *testing.T) {
+	resourceName := "azurerm_resource_mover_move_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceMoverMoveResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceMoverMoveResource_moveStatus(ri, location, altLocation, "Prepared"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "move_status", "Prepared"),
+					resource.TestCheckResourceAttr(resourceName, "move_state", "MovePending"),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceMoverMoveResource_moveStatus(ri, location, altLocation, "MoveInitiated"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "move_status", "MoveInitiated"),
+					resource.TestCheckResourceAttr(resourceName, "move_state", "CommitPending"),
+				),
+			},
+			{
+				// discarding the move returns the Move Resource to the Prepared status
+				Config: testAccAzureRMResourceMoverMoveResource_moveStatus(ri, location, altLocation, "Prepared"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceMoverMoveResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "move_status", "Prepared"),
+					resource.TestCheckResourceAttr(resourceName, "move_state", "MovePending"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceMoverMoveResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp resourceMoverMoveResource
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, resourceMoverApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Move Resource %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Move Resource %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceMoverMoveResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource_mover_move_resource" {
+			continue
+		}
+
+		var resp resourceMoverMoveResource
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, resourceMoverApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Move Resource still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMResourceMoverMoveResource_template(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  source_region       = "${azurerm_resource_group.test.location}"
+  target_region       = "%s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location, rInt, rInt, altLocation)
+}
+
+func testAccAzureRMResourceMoverMoveResource_basic(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_resource" "test" {
+  name               = "acctest-mr-%d"
+  move_collection_id = "${azurerm_resource_mover_move_collection.test.id}"
+  source_id          = "${azurerm_virtual_network.test.id}"
+
+  resource_settings {
+    resource_type        = "Microsoft.Network/virtualNetworks"
+    target_resource_name = "${azurerm_virtual_network.test.name}"
+  }
+}
+`, testAccAzureRMResourceMoverMoveResource_template(rInt, location, altLocation), rInt)
+}
+
+func testAccAzureRMResourceMoverMoveResource_requiresImport(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_resource" "import" {
+  name               = "${azurerm_resource_mover_move_resource.test.name}"
+  move_collection_id = "${azurerm_resource_mover_move_resource.test.move_collection_id}"
+  source_id          = "${azurerm_resource_mover_move_resource.test.source_id}"
+
+  resource_settings {
+    resource_type        = "Microsoft.Network/virtualNetworks"
+    target_resource_name = "${azurerm_virtual_network.test.name}"
+  }
+}
+`, testAccAzureRMResourceMoverMoveResource_basic(rInt, location, altLocation))
+}
+
+func testAccAzureRMResourceMoverMoveResource_moveStatus(rInt int, location string, altLocation string, moveStatus string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_resource" "test" {
+  name               = "acctest-mr-%d"
+  move_collection_id = "${azurerm_resource_mover_move_collection.test.id}"
+  source_id          = "${azurerm_virtual_network.test.id}"
+  move_status        = "%s"
+
+  resource_settings {
+    resource_type        = "Microsoft.Network/virtualNetworks"
+    target_resource_name = "${azurerm_virtual_network.test.name}"
+  }
+}
+`, testAccAzureRMResourceMoverMoveResource_template(rInt, location, altLocation), rInt, moveStatus)
+}
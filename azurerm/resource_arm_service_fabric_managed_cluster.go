@@ -0,0 +1,943 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Service Fabric Managed Clusters aren't present in the vendored SDK, so are managed using raw requests
+const serviceFabricManagedClusterApiVersion = "2021-05-01"
+
+type serviceFabricManagedCluster struct {
+	ID         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Location   *string                                `json:"location,omitempty"`
+	Tags       map[string]*string                     `json:"tags"`
+	Sku        *serviceFabricManagedClusterSku        `json:"sku,omitempty"`
+	Properties *serviceFabricManagedClusterProperties `json:"properties,omitempty"`
+}
+
+type serviceFabricManagedClusterSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type serviceFabricManagedClusterProperties struct {
+	DNSName               *string                                          `json:"dnsName,omitempty"`
+	Fqdn                  *string                                          `json:"fqdn,omitempty"`
+	ClusterID             *string                                          `json:"clusterId,omitempty"`
+	AdminUserName         *string                                          `json:"adminUserName,omitempty"`
+	AdminPassword         *string                                          `json:"adminPassword,omitempty"`
+	ClientConnectionPort  *int32                                           `json:"clientConnectionPort,omitempty"`
+	HTTPGatewayConnection *int32                                           `json:"httpGatewayConnectionPort,omitempty"`
+	ClusterUpgradeCadence *string                                          `json:"clusterUpgradeCadence,omitempty"`
+	AzureActiveDirectory  *serviceFabricManagedClusterAzureActiveDirectory `json:"azureActiveDirectory,omitempty"`
+	Clients               *[]serviceFabricManagedClusterClientCertificate  `json:"clients,omitempty"`
+	LoadBalancingRules    *[]serviceFabricManagedClusterLoadBalancingRule  `json:"loadBalancingRules,omitempty"`
+}
+
+type serviceFabricManagedClusterAzureActiveDirectory struct {
+	TenantID           *string `json:"tenantId,omitempty"`
+	ClusterApplication *string `json:"clusterApplication,omitempty"`
+	ClientApplication  *string `json:"clientApplication,omitempty"`
+}
+
+type serviceFabricManagedClusterClientCertificate struct {
+	IsAdmin          *bool   `json:"isAdmin,omitempty"`
+	Thumbprint       *string `json:"thumbprint,omitempty"`
+	CommonName       *string `json:"commonName,omitempty"`
+	IssuerThumbprint *string `json:"issuerThumbprint,omitempty"`
+}
+
+type serviceFabricManagedClusterLoadBalancingRule struct {
+	FrontendPort     *int32  `json:"frontendPort,omitempty"`
+	BackendPort      *int32  `json:"backendPort,omitempty"`
+	Protocol         *string `json:"protocol,omitempty"`
+	ProbeProtocol    *string `json:"probeProtocol,omitempty"`
+	ProbeRequestPath *string `json:"probeRequestPath,omitempty"`
+}
+
+type serviceFabricManagedClusterNodeType struct {
+	ID         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Properties *serviceFabricManagedClusterNodeTypeProperties `json:"properties,omitempty"`
+}
+
+type serviceFabricManagedClusterNodeTypeList struct {
+	Value *[]serviceFabricManagedClusterNodeType `json:"value,omitempty"`
+}
+
+type serviceFabricManagedClusterNodeTypeProperties struct {
+	IsPrimary               *bool                                 `json:"isPrimary,omitempty"`
+	VMInstanceCount         *int32                                `json:"vmInstanceCount,omitempty"`
+	DataDiskSizeGB          *int32                                `json:"dataDiskSizeGB,omitempty"`
+	DataDiskType            *string                               `json:"dataDiskType,omitempty"`
+	PlacementProperties     map[string]*string                    `json:"placementProperties,omitempty"`
+	Capacities              map[string]*string                    `json:"capacities,omitempty"`
+	ApplicationPorts        *serviceFabricManagedClusterPortRange `json:"applicationPorts,omitempty"`
+	EphemeralPorts          *serviceFabricManagedClusterPortRange `json:"ephemeralPorts,omitempty"`
+	VMSize                  *string                               `json:"vmSize,omitempty"`
+	VMImagePublisher        *string                               `json:"vmImagePublisher,omitempty"`
+	VMImageOffer            *string                               `json:"vmImageOffer,omitempty"`
+	VMImageSku              *string                               `json:"vmImageSku,omitempty"`
+	VMImageVersion          *string                               `json:"vmImageVersion,omitempty"`
+	IsStateless             *bool                                 `json:"isStateless,omitempty"`
+	MultiplePlacementGroups *bool                                 `json:"multiplePlacementGroups,omitempty"`
+}
+
+type serviceFabricManagedClusterPortRange struct {
+	StartPort *int32 `json:"startPort,omitempty"`
+	EndPort   *int32 `json:"endPort,omitempty"`
+}
+
+func resourceArmServiceFabricManagedCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceFabricManagedClusterCreateUpdate,
+		Read:   resourceArmServiceFabricManagedClusterRead,
+		Update: resourceArmServiceFabricManagedClusterCreateUpdate,
+		Delete: resourceArmServiceFabricManagedClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ServiceFabricManagedClusterName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Basic",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Basic",
+					"Standard",
+				}, false),
+			},
+
+			"dns_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the API doesn't return the Admin Password, so changes to this can't be detected
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"client_connection_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      19000,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"http_gateway_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      19080,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"upgrade_wave": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Wave0",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Wave0",
+					"Wave1",
+					"Wave2",
+				}, false),
+			},
+
+			"azure_active_directory": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"cluster_application_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"client_application_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+					},
+				},
+			},
+
+			"client_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"thumbprint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"common_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"issuer_thumbprint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"is_admin": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"load_balancing_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frontend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"tcp",
+								"udp",
+							}, false),
+						},
+						"probe_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"tcp",
+								"http",
+								"https",
+							}, false),
+						},
+						"probe_request_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"node_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ServiceFabricManagedClusterNodeTypeName,
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"vm_size": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"vm_instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"data_disk_size_gb": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"data_disk_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Standard_LRS",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Standard_LRS",
+								"StandardSSD_LRS",
+								"Premium_LRS",
+							}, false),
+						},
+						"vm_image_publisher": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "MicrosoftWindowsServer",
+						},
+						"vm_image_offer": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "WindowsServer",
+						},
+						"vm_image_sku": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "2019-Datacenter",
+						},
+						"vm_image_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "latest",
+						},
+						"application_ports": serviceFabricManagedClusterPortRangeSchema(),
+						"ephemeral_ports":   serviceFabricManagedClusterPortRangeSchema(),
+						"placement_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"capacities": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"stateless": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"multiple_placement_groups": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func serviceFabricManagedClusterPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validate.PortNumber,
+				},
+				"end_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validate.PortNumber,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmServiceFabricManagedClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := serviceFabricManagedClusterID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing serviceFabricManagedCluster
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, serviceFabricManagedClusterApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_service_fabric_managed_cluster", *existing.ID)
+		}
+	}
+
+	nodeTypes, err := expandServiceFabricManagedClusterNodeTypes(d.Get("node_type").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	dnsName := name
+	if v, ok := d.GetOk("dns_name"); ok {
+		dnsName = v.(string)
+	}
+
+	properties := serviceFabricManagedClusterProperties{
+		DNSName:               utils.String(dnsName),
+		AdminUserName:         utils.String(d.Get("admin_username").(string)),
+		ClientConnectionPort:  utils.Int32(int32(d.Get("client_connection_port").(int))),
+		HTTPGatewayConnection: utils.Int32(int32(d.Get("http_gateway_port").(int))),
+		ClusterUpgradeCadence: utils.String(d.Get("upgrade_wave").(string)),
+		AzureActiveDirectory:  expandServiceFabricManagedClusterAzureActiveDirectory(d.Get("azure_active_directory").([]interface{})),
+		Clients:               expandServiceFabricManagedClusterClientCertificates(d.Get("client_certificate").([]interface{})),
+		LoadBalancingRules:    expandServiceFabricManagedClusterLoadBalancingRules(d.Get("load_balancing_rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("admin_password"); ok {
+		properties.AdminPassword = utils.String(v.(string))
+	}
+
+	parameters := serviceFabricManagedCluster{
+		Location: utils.String(location),
+		Sku: &serviceFabricManagedClusterSku{
+			Name: utils.String(d.Get("sku").(string)),
+		},
+		Properties: &properties,
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, serviceFabricManagedClusterApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// Node Types are sub-resources of the Cluster - the Primary Node Type is provisioned first since the
+	// Service Fabric system services run on it
+	for _, nodeType := range nodeTypes {
+		nodeTypeName := *nodeType.Name
+		nodeTypeId := fmt.Sprintf("%s/nodeTypes/%s", id, nodeTypeName)
+
+		log.Printf("[DEBUG] Creating/updating Node Type %q for Service Fabric Managed Cluster %q (Resource Group %q)..", nodeTypeName, name, resourceGroup)
+		if err := armRawPut(ctx, client.Client, client.BaseURI, nodeTypeId, serviceFabricManagedClusterApiVersion, nodeType); err != nil {
+			return fmt.Errorf("Error creating/updating Node Type %q for Service Fabric Managed Cluster %q (Resource Group %q): %+v", nodeTypeName, name, resourceGroup, err)
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("node_type") {
+		old, _ := d.GetChange("node_type")
+		for _, v := range old.([]interface{}) {
+			nodeTypeName := v.(map[string]interface{})["name"].(string)
+
+			exists := false
+			for _, nodeType := range nodeTypes {
+				if *nodeType.Name == nodeTypeName {
+					exists = true
+					break
+				}
+			}
+			if exists {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting Node Type %q from Service Fabric Managed Cluster %q (Resource Group %q)..", nodeTypeName, name, resourceGroup)
+			nodeTypeId := fmt.Sprintf("%s/nodeTypes/%s", id, nodeTypeName)
+			resp, err := armRawDelete(ctx, client.Client, client.BaseURI, nodeTypeId, serviceFabricManagedClusterApiVersion)
+			if err != nil && !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error deleting Node Type %q from Service Fabric Managed Cluster %q (Resource Group %q): %+v", nodeTypeName, name, resourceGroup, err)
+			}
+		}
+	}
+
+	var read serviceFabricManagedCluster
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, serviceFabricManagedClusterApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Service Fabric Managed Cluster %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmServiceFabricManagedClusterRead(d, meta)
+}
+
+func resourceArmServiceFabricManagedClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedClusters"]
+
+	var cluster serviceFabricManagedCluster
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), serviceFabricManagedClusterApiVersion, &cluster)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Service Fabric Managed Cluster %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var nodeTypes serviceFabricManagedClusterNodeTypeList
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/nodeTypes", d.Id()), serviceFabricManagedClusterApiVersion, &nodeTypes); err != nil {
+		return fmt.Errorf("Error listing Node Types for Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := cluster.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := cluster.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+	}
+
+	if props := cluster.Properties; props != nil {
+		d.Set("dns_name", props.DNSName)
+		d.Set("fqdn", props.Fqdn)
+		d.Set("cluster_id", props.ClusterID)
+		d.Set("admin_username", props.AdminUserName)
+		d.Set("upgrade_wave", props.ClusterUpgradeCadence)
+
+		if props.ClientConnectionPort != nil {
+			d.Set("client_connection_port", int(*props.ClientConnectionPort))
+		}
+
+		if props.HTTPGatewayConnection != nil {
+			d.Set("http_gateway_port", int(*props.HTTPGatewayConnection))
+		}
+
+		if err := d.Set("azure_active_directory", flattenServiceFabricManagedClusterAzureActiveDirectory(props.AzureActiveDirectory)); err != nil {
+			return fmt.Errorf("Error setting `azure_active_directory`: %+v", err)
+		}
+
+		if err := d.Set("client_certificate", flattenServiceFabricManagedClusterClientCertificates(props.Clients)); err != nil {
+			return fmt.Errorf("Error setting `client_certificate`: %+v", err)
+		}
+
+		if err := d.Set("load_balancing_rule", flattenServiceFabricManagedClusterLoadBalancingRules(props.LoadBalancingRules)); err != nil {
+			return fmt.Errorf("Error setting `load_balancing_rule`: %+v", err)
+		}
+	}
+
+	if err := d.Set("node_type", flattenServiceFabricManagedClusterNodeTypes(d.Get("node_type").([]interface{}), nodeTypes.Value)); err != nil {
+		return fmt.Errorf("Error setting `node_type`: %+v", err)
+	}
+
+	flattenAndSetTags(d, cluster.Tags)
+
+	return nil
+}
+
+func resourceArmServiceFabricManagedClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedClusters"]
+
+	// deleting the Cluster also deletes the Node Types within it
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), serviceFabricManagedClusterApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Service Fabric Managed Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func serviceFabricManagedClusterID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabric/managedClusters/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandServiceFabricManagedClusterAzureActiveDirectory(input []interface{}) *serviceFabricManagedClusterAzureActiveDirectory {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &serviceFabricManagedClusterAzureActiveDirectory{
+		TenantID:           utils.String(v["tenant_id"].(string)),
+		ClusterApplication: utils.String(v["cluster_application_id"].(string)),
+		ClientApplication:  utils.String(v["client_application_id"].(string)),
+	}
+}
+
+func flattenServiceFabricManagedClusterAzureActiveDirectory(input *serviceFabricManagedClusterAzureActiveDirectory) []interface{} {
+	if input == nil || input.TenantID == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"tenant_id": *input.TenantID,
+	}
+
+	if v := input.ClusterApplication; v != nil {
+		output["cluster_application_id"] = *v
+	}
+
+	if v := input.ClientApplication; v != nil {
+		output["client_application_id"] = *v
+	}
+
+	return []interface{}{output}
+}
+
+func expandServiceFabricManagedClusterClientCertificates(input []interface{}) *[]serviceFabricManagedClusterClientCertificate {
+	output := make([]serviceFabricManagedClusterClientCertificate, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		certificate := serviceFabricManagedClusterClientCertificate{
+			IsAdmin: utils.Bool(v["is_admin"].(bool)),
+		}
+
+		if thumbprint := v["thumbprint"].(string); thumbprint != "" {
+			certificate.Thumbprint = utils.String(thumbprint)
+		}
+
+		if commonName := v["common_name"].(string); commonName != "" {
+			certificate.CommonName = utils.String(commonName)
+		}
+
+		if issuerThumbprint := v["issuer_thumbprint"].(string); issuerThumbprint != "" {
+			certificate.IssuerThumbprint = utils.String(issuerThumbprint)
+		}
+
+		output = append(output, certificate)
+	}
+
+	return &output
+}
+
+func flattenServiceFabricManagedClusterClientCertificates(input *[]serviceFabricManagedClusterClientCertificate) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		certificate := map[string]interface{}{
+			"is_admin": v.IsAdmin != nil && *v.IsAdmin,
+		}
+
+		if v.Thumbprint != nil {
+			certificate["thumbprint"] = *v.Thumbprint
+		}
+
+		if v.CommonName != nil {
+			certificate["common_name"] = *v.CommonName
+		}
+
+		if v.IssuerThumbprint != nil {
+			certificate["issuer_thumbprint"] = *v.IssuerThumbprint
+		}
+
+		output = append(output, certificate)
+	}
+
+	return output
+}
+
+func expandServiceFabricManagedClusterLoadBalancingRules(input []interface{}) *[]serviceFabricManagedClusterLoadBalancingRule {
+	output := make([]serviceFabricManagedClusterLoadBalancingRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		rule := serviceFabricManagedClusterLoadBalancingRule{
+			FrontendPort:  utils.Int32(int32(v["frontend_port"].(int))),
+			BackendPort:   utils.Int32(int32(v["backend_port"].(int))),
+			Protocol:      utils.String(v["protocol"].(string)),
+			ProbeProtocol: utils.String(v["probe_protocol"].(string)),
+		}
+
+		if path := v["probe_request_path"].(string); path != "" {
+			rule.ProbeRequestPath = utils.String(path)
+		}
+
+		output = append(output, rule)
+	}
+
+	return &output
+}
+
+func flattenServiceFabricManagedClusterLoadBalancingRules(input *[]serviceFabricManagedClusterLoadBalancingRule) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		rule := make(map[string]interface{})
+
+		if v.FrontendPort != nil {
+			rule["frontend_port"] = int(*v.FrontendPort)
+		}
+
+		if v.BackendPort != nil {
+			rule["backend_port"] = int(*v.BackendPort)
+		}
+
+		if v.Protocol != nil {
+			rule["protocol"] = *v.Protocol
+		}
+
+		if v.ProbeProtocol != nil {
+			rule["probe_protocol"] = *v.ProbeProtocol
+		}
+
+		if v.ProbeRequestPath != nil {
+			rule["probe_request_path"] = *v.ProbeRequestPath
+		}
+
+		output = append(output, rule)
+	}
+
+	return output
+}
+
+func expandServiceFabricManagedClusterNodeTypes(input []interface{}) ([]serviceFabricManagedClusterNodeType, error) {
+	output := make([]serviceFabricManagedClusterNodeType, 0)
+	primaryCount := 0
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		isPrimary := v["primary"].(bool)
+		if isPrimary {
+			primaryCount++
+		}
+
+		output = append(output, serviceFabricManagedClusterNodeType{
+			Name: utils.String(v["name"].(string)),
+			Properties: &serviceFabricManagedClusterNodeTypeProperties{
+				IsPrimary:               utils.Bool(isPrimary),
+				VMInstanceCount:         utils.Int32(int32(v["vm_instance_count"].(int))),
+				DataDiskSizeGB:          utils.Int32(int32(v["data_disk_size_gb"].(int))),
+				DataDiskType:            utils.String(v["data_disk_type"].(string)),
+				PlacementProperties:     expandServiceFabricManagedClusterStringMap(v["placement_properties"].(map[string]interface{})),
+				Capacities:              expandServiceFabricManagedClusterStringMap(v["capacities"].(map[string]interface{})),
+				ApplicationPorts:        expandServiceFabricManagedClusterPortRange(v["application_ports"].([]interface{})),
+				EphemeralPorts:          expandServiceFabricManagedClusterPortRange(v["ephemeral_ports"].([]interface{})),
+				VMSize:                  utils.String(v["vm_size"].(string)),
+				VMImagePublisher:        utils.String(v["vm_image_publisher"].(string)),
+				VMImageOffer:            utils.String(v["vm_image_offer"].(string)),
+				VMImageSku:              utils.String(v["vm_image_sku"].(string)),
+				VMImageVersion:          utils.String(v["vm_image_version"].(string)),
+				IsStateless:             utils.Bool(v["stateless"].(bool)),
+				MultiplePlacementGroups: utils.Bool(v["multiple_placement_groups"].(bool)),
+			},
+		})
+	}
+
+	if primaryCount != 1 {
+		return nil, fmt.Errorf("Exactly one `node_type` must be marked as `primary` but got %d", primaryCount)
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return *output[i].Properties.IsPrimary && !*output[j].Properties.IsPrimary
+	})
+
+	return output, nil
+}
+
+// flattenServiceFabricManagedClusterNodeTypes returns the Node Types in the order they're defined in the
+// configuration, followed by any which aren't - since the API doesn't guarantee the ordering
+func flattenServiceFabricManagedClusterNodeTypes(existing []interface{}, input *[]serviceFabricManagedClusterNodeType) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	order := make(map[string]int)
+	for i, v := range existing {
+		if raw, ok := v.(map[string]interface{}); ok {
+			order[raw["name"].(string)] = i
+		}
+	}
+
+	position := func(nodeType serviceFabricManagedClusterNodeType) int {
+		if nodeType.Name != nil {
+			if i, ok := order[*nodeType.Name]; ok {
+				return i
+			}
+		}
+		return len(existing)
+	}
+
+	nodeTypes := *input
+	sort.SliceStable(nodeTypes, func(i, j int) bool {
+		return position(nodeTypes[i]) < position(nodeTypes[j])
+	})
+
+	for _, v := range nodeTypes {
+		nodeType := make(map[string]interface{})
+
+		if v.Name != nil {
+			nodeType["name"] = *v.Name
+		}
+
+		if v.ID != nil {
+			nodeType["id"] = *v.ID
+		}
+
+		if props := v.Properties; props != nil {
+			nodeType["primary"] = props.IsPrimary != nil && *props.IsPrimary
+			nodeType["stateless"] = props.IsStateless != nil && *props.IsStateless
+			nodeType["multiple_placement_groups"] = props.MultiplePlacementGroups != nil && *props.MultiplePlacementGroups
+			nodeType["application_ports"] = flattenServiceFabricManagedClusterPortRange(props.ApplicationPorts)
+			nodeType["ephemeral_ports"] = flattenServiceFabricManagedClusterPortRange(props.EphemeralPorts)
+
+			if props.VMSize != nil {
+				nodeType["vm_size"] = *props.VMSize
+			}
+
+			if props.VMInstanceCount != nil {
+				nodeType["vm_instance_count"] = int(*props.VMInstanceCount)
+			}
+
+			if props.DataDiskSizeGB != nil {
+				nodeType["data_disk_size_gb"] = int(*props.DataDiskSizeGB)
+			}
+
+			if props.DataDiskType != nil {
+				nodeType["data_disk_type"] = *props.DataDiskType
+			}
+
+			if props.VMImagePublisher != nil {
+				nodeType["vm_image_publisher"] = *props.VMImagePublisher
+			}
+
+			if props.VMImageOffer != nil {
+				nodeType["vm_image_offer"] = *props.VMImageOffer
+			}
+
+			if props.VMImageSku != nil {
+				nodeType["vm_image_sku"] = *props.VMImageSku
+			}
+
+			if props.VMImageVersion != nil {
+				nodeType["vm_image_version"] = *props.VMImageVersion
+			}
+
+			nodeType["placement_properties"] = flattenServiceFabricManagedClusterStringMap(props.PlacementProperties)
+			nodeType["capacities"] = flattenServiceFabricManagedClusterStringMap(props.Capacities)
+		}
+
+		output = append(output, nodeType)
+	}
+
+	return output
+}
+
+func expandServiceFabricManagedClusterStringMap(input map[string]interface{}) map[string]*string {
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+	return output
+}
+
+func flattenServiceFabricManagedClusterStringMap(input map[string]*string) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+	return output
+}
+
+func expandServiceFabricManagedClusterPortRange(input []interface{}) *serviceFabricManagedClusterPortRange {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &serviceFabricManagedClusterPortRange{
+		StartPort: utils.Int32(int32(v["start_port"].(int))),
+		EndPort:   utils.Int32(int32(v["end_port"].(int))),
+	}
+}
+
+func flattenServiceFabricManagedClusterPortRange(input *serviceFabricManagedClusterPortRange) []interface{} {
+	if input == nil || input.StartPort == nil || input.EndPort == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"start_port": int(*input.StartPort),
+			"end_port":   int(*input.EndPort),
+		},
+	}
+}
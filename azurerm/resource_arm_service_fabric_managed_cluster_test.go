@@ -0,0 +1,386 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMServiceFabricManagedCluster_basic(t *testing.T) {
+	resourceName := "azurerm_service_fabric_managed_cluster.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceFabricManagedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceFabricManagedCluster_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceFabricManagedClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Basic"),
+					resource.TestCheckResourceAttr(resourceName, "node_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_type.0.primary", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceFabricManagedCluster_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_service_fabric_managed_cluster.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceFabricManagedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceFabricManagedCluster_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceFabricManagedClusterExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMServiceFabricManagedCluster_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_service_fabric_managed_cluster"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceFabricManagedCluster_nodeTypes(t *testing.T) {
+	resourceName := "azurerm_service_fabric_managed_cluster.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceFabricManagedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceFabricManagedCluster_standard(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceFabricManagedClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_type.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMServiceFabricManagedCluster_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceFabricManagedClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "node_type.1.name", "secondary"),
+					resource.TestCheckResourceAttr(resourceName, "node_type.1.placement_properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_type.1.application_ports.0.start_port", "20000"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_certificate.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password"},
+			},
+			{
+				Config: testAccAzureRMServiceFabricManagedCluster_standard(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceFabricManagedClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_type.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandServiceFabricManagedClusterNodeTypes(t *testing.T) {
+	nodeType := func(name string, primary bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                      name,
+			"primary":                   primary,
+			"vm_size":                   "Standard_D2s_v3",
+			"vm_instance_count":         3,
+			"data_disk_size_gb":         130,
+			"data_disk_type":            "Standard_LRS",
+			"vm_image_publisher":        "MicrosoftWindowsServer",
+			"vm_image_offer":            "WindowsServer",
+			"vm_image_sku":              "2019-Datacenter",
+			"vm_image_version":          "latest",
+			"application_ports":         []interface{}{},
+			"ephemeral_ports":           []interface{}{},
+			"placement_properties":      map[string]interface{}{},
+			"capacities":                map[string]interface{}{},
+			"stateless":                 false,
+			"multiple_placement_groups": false,
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []string
+		Error    bool
+	}{
+		{
+			Name:  "No Primary",
+			Input: []interface{}{nodeType("first", false)},
+			Error: true,
+		},
+		{
+			Name:  "Multiple Primaries",
+			Input: []interface{}{nodeType("first", true), nodeType("second", true)},
+			Error: true,
+		},
+		{
+			Name:     "Primary First",
+			Input:    []interface{}{nodeType("first", true), nodeType("second", false)},
+			Expected: []string{"first", "second"},
+		},
+		{
+			Name:     "Primary Last",
+			Input:    []interface{}{nodeType("first", false), nodeType("second", false), nodeType("third", true)},
+			Expected: []string{"third", "first", "second"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := expandServiceFabricManagedClusterNodeTypes(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d Node Types but got %d", len(v.Expected), len(actual))
+		}
+
+		for i, name := range v.Expected {
+			if *actual[i].Name != name {
+				t.Fatalf("Expected Node Type %d to be %q but got %q", i, name, *actual[i].Name)
+			}
+		}
+	}
+}
+
+func testCheckAzureRMServiceFabricManagedClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var cluster serviceFabricManagedCluster
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, serviceFabricManagedClusterApiVersion, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Service Fabric Managed Cluster %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Service Fabric Managed Cluster %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServiceFabricManagedClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_service_fabric_managed_cluster" {
+			continue
+		}
+
+		var cluster serviceFabricManagedCluster
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, serviceFabricManagedClusterApiVersion, &cluster)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Service Fabric Managed Cluster %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMServiceFabricManagedCluster_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_service_fabric_managed_cluster" "test" {
+  name                = "acctest-sfmc-%[2]s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  admin_username      = "testadmin"
+  admin_password      = "Password1234!"
+
+  node_type {
+    name              = "primary"
+    primary           = true
+    vm_size           = "Standard_D2s_v3"
+    vm_instance_count = 3
+    data_disk_size_gb = 130
+  }
+}
+`, rInt, rString, location)
+}
+
+func testAccAzureRMServiceFabricManagedCluster_requiresImport(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster" "import" {
+  name                = "${azurerm_service_fabric_managed_cluster.test.name}"
+  resource_group_name = "${azurerm_service_fabric_managed_cluster.test.resource_group_name}"
+  location            = "${azurerm_service_fabric_managed_cluster.test.location}"
+  admin_username      = "testadmin"
+  admin_password      = "Password1234!"
+
+  node_type {
+    name              = "primary"
+    primary           = true
+    vm_size           = "Standard_D2s_v3"
+    vm_instance_count = 3
+    data_disk_size_gb = 130
+  }
+}
+`, testAccAzureRMServiceFabricManagedCluster_basic(rInt, rString, location))
+}
+
+func testAccAzureRMServiceFabricManagedCluster_standard(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_service_fabric_managed_cluster" "test" {
+  name                = "acctest-sfmc-%[2]s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard"
+  admin_username      = "testadmin"
+  admin_password      = "Password1234!"
+
+  node_type {
+    name              = "primary"
+    primary           = true
+    vm_size           = "Standard_D2s_v3"
+    vm_instance_count = 5
+    data_disk_size_gb = 130
+  }
+}
+`, rInt, rString, location)
+}
+
+func testAccAzureRMServiceFabricManagedCluster_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_service_fabric_managed_cluster" "test" {
+  name                = "acctest-sfmc-%[2]s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard"
+  admin_username      = "testadmin"
+  admin_password      = "Password1234!"
+
+  client_certificate {
+    thumbprint = "33:41:DB:6C:F2:AF:72:C6:11:DF:3B:E3:72:1A:65:3A:F1:D4:3E:CD:50:F5:84:F8:28:79:3D:BE:91:03:C3:EE"
+    is_admin   = true
+  }
+
+  load_balancing_rule {
+    frontend_port      = 443
+    backend_port       = 8443
+    protocol           = "tcp"
+    probe_protocol     = "https"
+    probe_request_path = "/health"
+  }
+
+  node_type {
+    name              = "primary"
+    primary           = true
+    vm_size           = "Standard_D2s_v3"
+    vm_instance_count = 5
+    data_disk_size_gb = 130
+  }
+
+  node_type {
+    name              = "secondary"
+    vm_size           = "Standard_D2s_v3"
+    vm_instance_count = 3
+    data_disk_size_gb = 130
+    data_disk_type    = "StandardSSD_LRS"
+
+    application_ports {
+      start_port = 20000
+      end_port   = 29999
+    }
+
+    ephemeral_ports {
+      start_port = 49000
+      end_port   = 64000
+    }
+
+    placement_properties = {
+      workload = "frontend"
+    }
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, rString, location)
+}
@@ -0,0 +1,424 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Zone Balancing and Elastic Scale aren't present in the vendored SDK, so Service Plans are managed using raw requests
+const servicePlanApiVersion = "2022-09-01"
+
+type servicePlan struct {
+	ID         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Kind       *string                `json:"kind,omitempty"`
+	Tags       map[string]*string     `json:"tags"`
+	Sku        *servicePlanSku        `json:"sku,omitempty"`
+	Properties *servicePlanProperties `json:"properties,omitempty"`
+}
+
+type servicePlanSku struct {
+	Name     *string `json:"name,omitempty"`
+	Tier     *string `json:"tier,omitempty"`
+	Capacity *int32  `json:"capacity,omitempty"`
+}
+
+type servicePlanProperties struct {
+	HostingEnvironmentProfile *servicePlanHostingEnvironmentProfile `json:"hostingEnvironmentProfile,omitempty"`
+	PerSiteScaling            *bool                                 `json:"perSiteScaling,omitempty"`
+	ElasticScaleEnabled       *bool                                 `json:"elasticScaleEnabled,omitempty"`
+	MaximumElasticWorkerCount *int32                                `json:"maximumElasticWorkerCount,omitempty"`
+	MaximumNumberOfWorkers    *int32                                `json:"maximumNumberOfWorkers,omitempty"`
+	Reserved                  *bool                                 `json:"reserved,omitempty"`
+	HyperV                    *bool                                 `json:"hyperV,omitempty"`
+	ZoneRedundant             *bool                                 `json:"zoneRedundant,omitempty"`
+}
+
+type servicePlanHostingEnvironmentProfile struct {
+	ID *string `json:"id,omitempty"`
+}
+
+var servicePlanSkuNames = []string{
+	"B1", "B2", "B3",
+	"D1", "F1", "FREE", "SHARED",
+	"S1", "S2", "S3",
+	"P1v2", "P2v2", "P3v2",
+	"P0v3", "P1v3", "P2v3", "P3v3", "P1mv3", "P2mv3", "P3mv3", "P4mv3", "P5mv3",
+	"I1", "I2", "I3", "I1v2", "I2v2", "I3v2",
+	"EP1", "EP2", "EP3",
+	"WS1", "WS2", "WS3",
+	"Y1",
+}
+
+func resourceArmServicePlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServicePlanCreateUpdate,
+		Read:   resourceArmServicePlanRead,
+		Update: resourceArmServicePlanCreateUpdate,
+		Delete: resourceArmServicePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServicePlanName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+					"WindowsContainer",
+				}, false),
+			},
+
+			"sku_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(servicePlanSkuNames, false),
+			},
+
+			"app_service_environment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the number of workers (instances) can be changed in-place, and defaults to the minimum for the SKU
+			"worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"per_site_scaling_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Azure only supports enabling/disabling Zone Balancing when the Service Plan is created
+			"zone_balancing_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			// elastic scale allows Web Apps in Premium Service Plans to scale out per-minute based on HTTP traffic
+			"elastic_scale_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"maximum_elastic_worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"maximum_number_of_workers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"reserved": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("sku_name") {
+				return nil
+			}
+
+			skuName := diff.Get("sku_name").(string)
+
+			if diff.Get("zone_balancing_enabled").(bool) && !servicePlanSkuSupportsZoneBalancing(skuName) {
+				return fmt.Errorf("`zone_balancing_enabled` can only be enabled for Premium v2/v3, Elastic Premium and Isolated v2 SKUs but got %q", skuName)
+			}
+
+			if diff.Get("zone_balancing_enabled").(bool) && diff.NewValueKnown("worker_count") {
+				if v, ok := diff.GetOk("worker_count"); ok && v.(int) < 2 {
+					return fmt.Errorf("`worker_count` must be at least 2 when `zone_balancing_enabled` is enabled but got %d", v.(int))
+				}
+			}
+
+			if v, ok := diff.GetOk("elastic_scale_enabled"); ok && v.(bool) && !servicePlanSkuSupportsElasticScale(skuName) {
+				return fmt.Errorf("`elastic_scale_enabled` can only be enabled for Premium v2/v3 and Elastic Premium SKUs but got %q", skuName)
+			}
+
+			if v, ok := diff.GetOk("maximum_elastic_worker_count"); ok && diff.HasChange("maximum_elastic_worker_count") {
+				if !servicePlanSkuSupportsElasticScale(skuName) {
+					return fmt.Errorf("`maximum_elastic_worker_count` can only be set for Premium v2/v3 and Elastic Premium SKUs but got %q", skuName)
+				}
+
+				if workerCount, ok := diff.GetOk("worker_count"); ok && diff.NewValueKnown("worker_count") && v.(int) < workerCount.(int) {
+					return fmt.Errorf("`maximum_elastic_worker_count` (%d) must be at least `worker_count` (%d)", v.(int), workerCount.(int))
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := servicePlanID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing servicePlan
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, servicePlanApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_service_plan", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	osType := d.Get("os_type").(string)
+	skuName := d.Get("sku_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := servicePlanProperties{
+		PerSiteScaling: utils.Bool(d.Get("per_site_scaling_enabled").(bool)),
+		ZoneRedundant:  utils.Bool(d.Get("zone_balancing_enabled").(bool)),
+		Reserved:       utils.Bool(osType == "Linux"),
+		HyperV:         utils.Bool(osType == "WindowsContainer"),
+	}
+
+	if v := d.Get("app_service_environment_id").(string); v != "" {
+		properties.HostingEnvironmentProfile = &servicePlanHostingEnvironmentProfile{
+			ID: utils.String(v),
+		}
+	}
+
+	if servicePlanSkuSupportsElasticScale(skuName) {
+		// Elastic Premium SKUs always scale elastically, so this is only configurable for Premium SKUs
+		if !servicePlanSkuIsElasticPremium(skuName) {
+			if v, ok := d.GetOkExists("elastic_scale_enabled"); ok {
+				properties.ElasticScaleEnabled = utils.Bool(v.(bool))
+			}
+		}
+
+		if v, ok := d.GetOk("maximum_elastic_worker_count"); ok {
+			properties.MaximumElasticWorkerCount = utils.Int32(int32(v.(int)))
+		}
+	}
+
+	sku := servicePlanSku{
+		Name: utils.String(skuName),
+	}
+
+	// when the SKU changes the worker count (computed for the previous SKU) is only sent where specified
+	if v, ok := d.GetOk("worker_count"); ok && (!d.HasChange("sku_name") || d.HasChange("worker_count") || d.IsNewResource()) {
+		sku.Capacity = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := servicePlan{
+		Location:   utils.String(location),
+		Kind:       utils.String(servicePlanKind(osType, skuName)),
+		Sku:        &sku,
+		Properties: &properties,
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, servicePlanApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read servicePlan
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, servicePlanApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Service Plan %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmServicePlanRead(d, meta)
+}
+
+func resourceArmServicePlanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["serverfarms"]
+
+	var plan servicePlan
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), servicePlanApiVersion, &plan)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Service Plan %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := plan.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("kind", plan.Kind)
+
+	if sku := plan.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+
+		if capacity := sku.Capacity; capacity != nil {
+			d.Set("worker_count", int(*capacity))
+		}
+	}
+
+	if props := plan.Properties; props != nil {
+		appServiceEnvironmentId := ""
+		if profile := props.HostingEnvironmentProfile; profile != nil && profile.ID != nil {
+			appServiceEnvironmentId = *profile.ID
+		}
+		d.Set("app_service_environment_id", appServiceEnvironmentId)
+
+		reserved := props.Reserved != nil && *props.Reserved
+		hyperV := props.HyperV != nil && *props.HyperV
+		osType := "Windows"
+		if reserved {
+			osType = "Linux"
+		} else if hyperV {
+			osType = "WindowsContainer"
+		}
+		d.Set("os_type", osType)
+		d.Set("reserved", reserved)
+
+		d.Set("per_site_scaling_enabled", props.PerSiteScaling != nil && *props.PerSiteScaling)
+		d.Set("zone_balancing_enabled", props.ZoneRedundant != nil && *props.ZoneRedundant)
+		d.Set("elastic_scale_enabled", props.ElasticScaleEnabled != nil && *props.ElasticScaleEnabled)
+
+		if v := props.MaximumElasticWorkerCount; v != nil {
+			d.Set("maximum_elastic_worker_count", int(*v))
+		}
+
+		if v := props.MaximumNumberOfWorkers; v != nil {
+			d.Set("maximum_number_of_workers", int(*v))
+		}
+	}
+
+	flattenAndSetTags(d, plan.Tags)
+
+	return nil
+}
+
+func resourceArmServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["serverfarms"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), servicePlanApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func servicePlanID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s", subscriptionId, resourceGroup, name)
+}
+
+// servicePlanKind returns the `kind` Azure expects for the specified OS Type and SKU
+func servicePlanKind(osType string, skuName string) string {
+	if servicePlanSkuIsElasticPremium(skuName) {
+		return "elastic"
+	}
+
+	if strings.EqualFold(skuName, "Y1") {
+		return "functionapp"
+	}
+
+	if osType == "Linux" {
+		return "linux"
+	}
+
+	return "windows"
+}
+
+func servicePlanSkuIsElasticPremium(skuName string) bool {
+	return strings.HasPrefix(strings.ToUpper(skuName), "EP") || strings.HasPrefix(strings.ToUpper(skuName), "WS")
+}
+
+func servicePlanSkuIsPremium(skuName string) bool {
+	name := strings.ToLower(skuName)
+	return strings.HasPrefix(name, "p") && (strings.HasSuffix(name, "v2") || strings.HasSuffix(name, "v3"))
+}
+
+func servicePlanSkuSupportsElasticScale(skuName string) bool {
+	return servicePlanSkuIsElasticPremium(skuName) || servicePlanSkuIsPremium(skuName)
+}
+
+func servicePlanSkuSupportsZoneBalancing(skuName string) bool {
+	name := strings.ToLower(skuName)
+	isolatedV2 := strings.HasPrefix(name, "i") && strings.HasSuffix(name, "v2")
+	return servicePlanSkuSupportsElasticScale(skuName) || isolatedV2
+}
@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMServicePlan_basic(t *testing.T) {
+	resourceName := "azurerm_service_plan.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServicePlan_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServicePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "linux"),
+					resource.TestCheckResourceAttr(resourceName, "reserved", "true"),
+					resource.TestCheckResourceAttr(resourceName, "worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zone_balancing_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServicePlan_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_service_plan.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServicePlan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServicePlanExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMServicePlan_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_service_plan"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServicePlan_workerCount(t *testing.T) {
+	resourceName := "azurerm_service_plan.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServicePlan_premium(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServicePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "worker_count", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMServicePlan_premium(ri, location, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServicePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "worker_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "elastic_scale_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_elastic_worker_count", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServicePlan_zoneBalancing(t *testing.T) {
+	resourceName := "azurerm_service_plan.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServicePlan_zoneBalancing(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServicePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_balancing_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "worker_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServicePlan_zoneBalancingUnsupportedSku(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServicePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMServicePlan_zoneBalancingUnsupportedSku(ri, testLocation()),
+				ExpectError: regexp.MustCompile("`zone_balancing_enabled` can only be enabled for"),
+			},
+		},
+	})
+}
+
+func TestServicePlanKind(t *testing.T) {
+	cases := []struct {
+		OSType   string
+		SkuName  string
+		Expected string
+	}{
+		{OSType: "Linux", SkuName: "B1", Expected: "linux"},
+		{OSType: "Windows", SkuName: "S1", Expected: "windows"},
+		{OSType: "WindowsContainer", SkuName: "P1v3", Expected: "windows"},
+		{OSType: "Linux", SkuName: "EP1", Expected: "elastic"},
+		{OSType: "Windows", SkuName: "Y1", Expected: "functionapp"},
+	}
+
+	for _, tc := range cases {
+		if actual := servicePlanKind(tc.OSType, tc.SkuName); actual != tc.Expected {
+			t.Fatalf("Expected the kind for %q / %q to be %q but got %q", tc.OSType, tc.SkuName, tc.Expected, actual)
+		}
+	}
+}
+
+func TestServicePlanSkuSupportsZoneBalancing(t *testing.T) {
+	cases := map[string]bool{
+		"B1":   false,
+		"S1":   false,
+		"P1v2": true,
+		"P1v3": true,
+		"EP1":  true,
+		"I1":   false,
+		"I1v2": true,
+		"Y1":   false,
+	}
+
+	for skuName, expected := range cases {
+		if actual := servicePlanSkuSupportsZoneBalancing(skuName); actual != expected {
+			t.Fatalf("Expected Zone Balancing support for %q to be %t but got %t", skuName, expected, actual)
+		}
+	}
+}
+
+func testCheckAzureRMServicePlanExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing servicePlan
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, servicePlanApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Service Plan %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Service Plan %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMServicePlanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_service_plan" {
+			continue
+		}
+
+		var existing servicePlan
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, servicePlanApiVersion, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Service Plan %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMServicePlan_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  os_type             = "Linux"
+  sku_name            = "B1"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMServicePlan_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_plan" "import" {
+  name                = "${azurerm_service_plan.test.name}"
+  resource_group_name = "${azurerm_service_plan.test.resource_group_name}"
+  location            = "${azurerm_service_plan.test.location}"
+  os_type             = "${azurerm_service_plan.test.os_type}"
+  sku_name            = "${azurerm_service_plan.test.sku_name}"
+}
+`, testAccAzureRMServicePlan_basic(rInt, location))
+}
+
+func testAccAzureRMServicePlan_premium(rInt int, location string, workerCount int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                         = "acctestASP-%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  os_type                      = "Windows"
+  sku_name                     = "P1v3"
+  worker_count                 = %[3]d
+  elastic_scale_enabled        = true
+  maximum_elastic_worker_count = 5
+}
+`, rInt, location, workerCount)
+}
+
+func testAccAzureRMServicePlan_zoneBalancing(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                   = "acctestASP-%[1]d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  os_type                = "Linux"
+  sku_name               = "P1v3"
+  worker_count           = 3
+  zone_balancing_enabled = true
+}
+`, rInt, location)
+}
+
+func testAccAzureRMServicePlan_zoneBalancingUnsupportedSku(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                   = "acctestASP-%[1]d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  os_type                = "Linux"
+  sku_name               = "S1"
+  zone_balancing_enabled = true
+}
+`, rInt, location)
+}
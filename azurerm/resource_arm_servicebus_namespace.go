@@ -67,6 +67,18 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 				ValidateFunc: validate.IntInSlice([]int{1, 2, 4}),
 			},
 
+			"local_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -105,6 +117,14 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 				}
 			}
 
+			// Public Network Access can only be disabled when Private Endpoints are supported
+			if !d.Get("public_network_access_enabled").(bool) {
+				sku := d.Get("sku").(string)
+				if !strings.EqualFold(sku, string(servicebus.Premium)) {
+					return fmt.Errorf("`public_network_access_enabled` can only be disabled for a Premium SKU")
+				}
+			}
+
 			return nil
 		},
 	}
@@ -148,13 +168,11 @@ func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta int
 		parameters.Sku.Capacity = utils.Int32(int32(capacity.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
-	if err != nil {
-		return err
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s", meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	localAuthenticationEnabled := d.Get("local_authentication_enabled").(bool)
+	publicNetworkAccessEnabled := d.Get("public_network_access_enabled").(bool)
+	if err := createUpdateArmResourceWithDataPlaneAccess(ctx, client.Client, client.BaseURI, id, serviceBusNamespaceDataPlaneAccessApiVersion, parameters, localAuthenticationEnabled, publicNetworkAccessEnabled); err != nil {
+		return fmt.Errorf("Error creating/updating ServiceBus Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
@@ -202,8 +220,20 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 		d.Set("capacity", sku.Capacity)
 	}
 
-	keys, err := client.ListKeys(ctx, resourceGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
+	localAuthenticationEnabled, publicNetworkAccessEnabled, err := retrieveArmDataPlaneAccess(ctx, client.Client, client.BaseURI, d.Id(), serviceBusNamespaceDataPlaneAccessApiVersion)
 	if err != nil {
+		return fmt.Errorf("Error retrieving the Local Authentication/Public Network Access for Azure ServiceBus Namespace %q: %+v", name, err)
+	}
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	// the default keys can't be used when Local Authentication is disabled, so aren't exported
+	if !localAuthenticationEnabled {
+		d.Set("default_primary_connection_string", "")
+		d.Set("default_secondary_connection_string", "")
+		d.Set("default_primary_key", "")
+		d.Set("default_secondary_key", "")
+	} else if keys, err := client.ListKeys(ctx, resourceGroup, name, serviceBusNamespaceDefaultAuthorizationRule); err != nil {
 		log.Printf("[WARN] Unable to List default keys for Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
 	} else {
 		d.Set("default_primary_connection_string", keys.PrimaryConnectionString)
@@ -146,6 +146,42 @@ func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_dataPlaneAccess(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceBusNamespace_dataPlaneAccess(ri, testLocation(), false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_primary_key", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMServiceBusNamespace_dataPlaneAccess(ri, testLocation(), true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_primary_key"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -263,3 +299,22 @@ resource "azurerm_servicebus_namespace" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMServiceBusNamespace_dataPlaneAccess(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                          = "acctestservicebusnamespace-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  sku                           = "Premium"
+  capacity                      = 1
+  local_authentication_enabled  = %t
+  public_network_access_enabled = %t
+}
+`, rInt, location, rInt, enabled, enabled)
+}
@@ -60,6 +60,18 @@ func resourceArmSignalRService() *schema.Resource {
 				},
 			},
 
+			"local_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"hostname": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -140,13 +152,12 @@ func resourceArmSignalRServiceCreateUpdate(d *schema.ResourceData, meta interfac
 		Tags:     expandedTags,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
-	if err != nil {
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SignalRService/SignalR/%s", meta.(*ArmClient).subscriptionId, resourceGroup, name)
+	localAuthenticationEnabled := d.Get("local_authentication_enabled").(bool)
+	publicNetworkAccessEnabled := d.Get("public_network_access_enabled").(bool)
+	if err := createUpdateArmResourceWithDataPlaneAccess(ctx, client.Client, client.BaseURI, id, signalRDataPlaneAccessApiVersion, parameters, localAuthenticationEnabled, publicNetworkAccessEnabled); err != nil {
 		return fmt.Errorf("Error creating or updating SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the result of creating or updating SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -181,9 +192,20 @@ func resourceArmSignalRServiceRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error getting SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	keys, err := client.ListKeys(ctx, resourceGroup, name)
+	localAuthenticationEnabled, publicNetworkAccessEnabled, err := retrieveArmDataPlaneAccess(ctx, client.Client, client.BaseURI, d.Id(), signalRDataPlaneAccessApiVersion)
 	if err != nil {
-		return fmt.Errorf("Error getting keys of SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving the Local Authentication/Public Network Access for SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+	// the access keys can't be used when Local Authentication is disabled, so aren't exported
+	keys := signalr.Keys{}
+	if localAuthenticationEnabled {
+		keys, err = client.ListKeys(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error getting keys of SignalR %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
 	}
 
 	d.Set("name", name)
@@ -340,6 +340,42 @@ func TestAccAzureRMSignalRService_skuAndCapacityUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSignalRService_dataPlaneAccess(t *testing.T) {
+	resourceName := "azurerm_signalr_service.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSignalRServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSignalRService_dataPlaneAccess(ri, testLocation(), false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSignalRServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "primary_access_key", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSignalRService_dataPlaneAccess(ri, testLocation(), true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSignalRServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMSignalRService_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -447,3 +483,25 @@ func testCheckAzureRMSignalRServiceExists(resourceName string) resource.TestChec
 		return nil
 	}
 }
+
+func testAccAzureRMSignalRService_dataPlaneAccess(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_signalr_service" "test" {
+  name                          = "acctestSignalR-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  local_authentication_enabled  = %t
+  public_network_access_enabled = %t
+
+  sku {
+    name     = "Standard_S1"
+    capacity = 1
+  }
+}
+`, rInt, location, rInt, enabled, enabled)
+}
@@ -0,0 +1,619 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSiteRecoveryRecoveryPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSiteRecoveryRecoveryPlanCreate,
+		Read:   resourceArmSiteRecoveryRecoveryPlanRead,
+		Update: resourceArmSiteRecoveryRecoveryPlanUpdate,
+		Delete: resourceArmSiteRecoveryRecoveryPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{0,62}$"),
+					"Recovery Plan name must be 1 - 63 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_recovery_fabric_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_recovery_fabric_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"shutdown_recovery_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pre_action":  siteRecoveryRecoveryPlanActionSchema(),
+						"post_action": siteRecoveryRecoveryPlanActionSchema(),
+					},
+				},
+			},
+
+			"failover_recovery_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pre_action":  siteRecoveryRecoveryPlanActionSchema(),
+						"post_action": siteRecoveryRecoveryPlanActionSchema(),
+					},
+				},
+			},
+
+			"boot_recovery_group": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replicated_protected_items": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+
+						"pre_action":  siteRecoveryRecoveryPlanActionSchema(),
+						"post_action": siteRecoveryRecoveryPlanActionSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func siteRecoveryRecoveryPlanActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.InstanceTypeAutomationRunbookActionDetails),
+						string(siterecovery.InstanceTypeManualActionDetails),
+						string(siterecovery.InstanceTypeScriptActionDetails),
+					}, false),
+				},
+
+				"failover_directions": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.PrimaryToRecovery),
+							string(siterecovery.RecoveryToPrimary),
+						}, false),
+					},
+					Set: schema.HashString,
+				},
+
+				"failover_types": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.ReplicationProtectedItemOperationPlannedFailover),
+							string(siterecovery.ReplicationProtectedItemOperationTestFailover),
+							string(siterecovery.ReplicationProtectedItemOperationTestFailoverCleanup),
+							string(siterecovery.ReplicationProtectedItemOperationUnplannedFailover),
+						}, false),
+					},
+					Set: schema.HashString,
+				},
+
+				"fabric_location": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.Primary),
+						string(siterecovery.Recovery),
+					}, false),
+				},
+
+				"runbook_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"script_path": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"manual_action_instruction": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSiteRecoveryRecoveryPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+
+	client := meta.(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+	ctx := meta.(*ArmClient).StopContext
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_site_recovery_recovery_plan", *existing.ID)
+		}
+	}
+
+	groups, err := expandArmSiteRecoveryRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	input := siterecovery.CreateRecoveryPlanInput{
+		Properties: &siterecovery.CreateRecoveryPlanInputProperties{
+			PrimaryFabricID:         utils.String(d.Get("source_recovery_fabric_id").(string)),
+			RecoveryFabricID:        utils.String(d.Get("target_recovery_fabric_id").(string)),
+			FailoverDeploymentModel: siterecovery.ResourceManager,
+			Groups:                  groups,
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q)", name, vaultName, resourceGroup)
+	future, err := client.Create(ctx, name, input)
+	if err != nil {
+		return fmt.Errorf("Error creating Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q) ID", name, vaultName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSiteRecoveryRecoveryPlanRead(d, meta)
+}
+
+func resourceArmSiteRecoveryRecoveryPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+	ctx := meta.(*ArmClient).StopContext
+
+	groups, err := expandArmSiteRecoveryRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	input := siterecovery.UpdateRecoveryPlanInput{
+		Properties: &siterecovery.UpdateRecoveryPlanInputProperties{
+			Groups: groups,
+		},
+	}
+
+	log.Printf("[DEBUG] Updating Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q)", name, vaultName, resourceGroup)
+	future, err := client.Update(ctx, name, input)
+	if err != nil {
+		return fmt.Errorf("Error updating Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	return resourceArmSiteRecoveryRecoveryPlanRead(d, meta)
+}
+
+func resourceArmSiteRecoveryRecoveryPlanRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Site Recovery Recovery Plan %q was not found in Recovery Vault %q (Resource Group %q) - removing from state", name, vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("source_recovery_fabric_id", props.PrimaryFabricID)
+		d.Set("target_recovery_fabric_id", props.RecoveryFabricID)
+
+		shutdownGroup, failoverGroup, bootGroups := flattenArmSiteRecoveryRecoveryPlanGroups(props.Groups)
+		if err := d.Set("shutdown_recovery_group", shutdownGroup); err != nil {
+			return fmt.Errorf("Error setting `shutdown_recovery_group`: %+v", err)
+		}
+		if err := d.Set("failover_recovery_group", failoverGroup); err != nil {
+			return fmt.Errorf("Error setting `failover_recovery_group`: %+v", err)
+		}
+		if err := d.Set("boot_recovery_group", bootGroups); err != nil {
+			return fmt.Errorf("Error setting `boot_recovery_group`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSiteRecoveryRecoveryPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q)", name, vaultName, resourceGroup)
+	future, err := client.Delete(ctx, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error issuing delete request for Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// expandArmSiteRecoveryRecoveryPlanGroups builds the groups in the order the API requires them:
+// the Shutdown group, then the Failover group, followed by each of the Boot groups
+func expandArmSiteRecoveryRecoveryPlanGroups(d *schema.ResourceData) (*[]siterecovery.RecoveryPlanGroup, error) {
+	shutdownGroup, err := expandArmSiteRecoveryRecoveryPlanGroup(siterecovery.Shutdown, d.Get("shutdown_recovery_group").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding `shutdown_recovery_group`: %+v", err)
+	}
+
+	failoverGroup, err := expandArmSiteRecoveryRecoveryPlanGroup(siterecovery.Failover, d.Get("failover_recovery_group").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding `failover_recovery_group`: %+v", err)
+	}
+
+	groups := []siterecovery.RecoveryPlanGroup{*shutdownGroup, *failoverGroup}
+
+	for i, v := range d.Get("boot_recovery_group").([]interface{}) {
+		bootGroup, err := expandArmSiteRecoveryRecoveryPlanGroup(siterecovery.Boot, []interface{}{v})
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `boot_recovery_group.%d`: %+v", i, err)
+		}
+
+		groups = append(groups, *bootGroup)
+	}
+
+	return &groups, nil
+}
+
+func expandArmSiteRecoveryRecoveryPlanGroup(groupType siterecovery.RecoveryPlanGroupType, input []interface{}) (*siterecovery.RecoveryPlanGroup, error) {
+	group := siterecovery.RecoveryPlanGroup{
+		GroupType:                 groupType,
+		ReplicationProtectedItems: &[]siterecovery.RecoveryPlanProtectedItem{},
+		StartGroupActions:         &[]siterecovery.RecoveryPlanAction{},
+		EndGroupActions:           &[]siterecovery.RecoveryPlanAction{},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &group, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if raw, ok := v["replicated_protected_items"]; ok {
+		items := make([]siterecovery.RecoveryPlanProtectedItem, 0)
+		for _, id := range raw.(*schema.Set).List() {
+			items = append(items, siterecovery.RecoveryPlanProtectedItem{
+				ID: utils.String(id.(string)),
+			})
+		}
+		group.ReplicationProtectedItems = &items
+	}
+
+	preActions, err := expandArmSiteRecoveryRecoveryPlanActions(v["pre_action"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding `pre_action`: %+v", err)
+	}
+	group.StartGroupActions = preActions
+
+	postActions, err := expandArmSiteRecoveryRecoveryPlanActions(v["post_action"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding `post_action`: %+v", err)
+	}
+	group.EndGroupActions = postActions
+
+	return &group, nil
+}
+
+func expandArmSiteRecoveryRecoveryPlanActions(input []interface{}) (*[]siterecovery.RecoveryPlanAction, error) {
+	actions := make([]siterecovery.RecoveryPlanAction, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		actionType := v["type"].(string)
+		fabricLocation := siterecovery.RecoveryPlanActionLocation(v["fabric_location"].(string))
+		runbookId := v["runbook_id"].(string)
+		scriptPath := v["script_path"].(string)
+		instruction := v["manual_action_instruction"].(string)
+
+		var details siterecovery.BasicRecoveryPlanActionDetails
+		switch siterecovery.InstanceTypeBasicRecoveryPlanActionDetails(actionType) {
+		case siterecovery.InstanceTypeAutomationRunbookActionDetails:
+			if runbookId == "" || fabricLocation == "" {
+				return nil, fmt.Errorf("`runbook_id` and `fabric_location` must be specified for the %q action %q", actionType, name)
+			}
+			if scriptPath != "" || instruction != "" {
+				return nil, fmt.Errorf("`script_path` and `manual_action_instruction` cannot be specified for the %q action %q", actionType, name)
+			}
+			details = siterecovery.RecoveryPlanAutomationRunbookActionDetails{
+				RunbookID:      utils.String(runbookId),
+				FabricLocation: fabricLocation,
+				InstanceType:   siterecovery.InstanceTypeAutomationRunbookActionDetails,
+			}
+
+		case siterecovery.InstanceTypeManualActionDetails:
+			if runbookId != "" || scriptPath != "" || fabricLocation != "" {
+				return nil, fmt.Errorf("`runbook_id`, `script_path` and `fabric_location` cannot be specified for the %q action %q", actionType, name)
+			}
+			details = siterecovery.RecoveryPlanManualActionDetails{
+				Description:  utils.String(instruction),
+				InstanceType: siterecovery.InstanceTypeManualActionDetails,
+			}
+
+		case siterecovery.InstanceTypeScriptActionDetails:
+			if scriptPath == "" || fabricLocation == "" {
+				return nil, fmt.Errorf("`script_path` and `fabric_location` must be specified for the %q action %q", actionType, name)
+			}
+			if runbookId != "" || instruction != "" {
+				return nil, fmt.Errorf("`runbook_id` and `manual_action_instruction` cannot be specified for the %q action %q", actionType, name)
+			}
+			details = siterecovery.RecoveryPlanScriptActionDetails{
+				Path:           utils.String(scriptPath),
+				FabricLocation: fabricLocation,
+				InstanceType:   siterecovery.InstanceTypeScriptActionDetails,
+			}
+		}
+
+		failoverTypes := make([]siterecovery.ReplicationProtectedItemOperation, 0)
+		for _, t := range v["failover_types"].(*schema.Set).List() {
+			failoverTypes = append(failoverTypes, siterecovery.ReplicationProtectedItemOperation(t.(string)))
+		}
+
+		failoverDirections := make([]siterecovery.PossibleOperationsDirections, 0)
+		for _, direction := range v["failover_directions"].(*schema.Set).List() {
+			failoverDirections = append(failoverDirections, siterecovery.PossibleOperationsDirections(direction.(string)))
+		}
+
+		actions = append(actions, siterecovery.RecoveryPlanAction{
+			ActionName:         utils.String(name),
+			FailoverTypes:      &failoverTypes,
+			FailoverDirections: &failoverDirections,
+			CustomDetails:      details,
+		})
+	}
+
+	return &actions, nil
+}
+
+func flattenArmSiteRecoveryRecoveryPlanGroups(input *[]siterecovery.RecoveryPlanGroup) ([]interface{}, []interface{}, []interface{}) {
+	shutdownGroup := make([]interface{}, 0)
+	failoverGroup := make([]interface{}, 0)
+	bootGroups := make([]interface{}, 0)
+
+	if input == nil {
+		return shutdownGroup, failoverGroup, bootGroups
+	}
+
+	for _, group := range *input {
+		preActions := flattenArmSiteRecoveryRecoveryPlanActions(group.StartGroupActions)
+		postActions := flattenArmSiteRecoveryRecoveryPlanActions(group.EndGroupActions)
+
+		switch group.GroupType {
+		case siterecovery.Shutdown, siterecovery.Failover:
+			// the Shutdown and Failover groups always exist, so are only returned when they contain actions
+			if len(preActions) == 0 && len(postActions) == 0 {
+				continue
+			}
+
+			flattened := []interface{}{
+				map[string]interface{}{
+					"pre_action":  preActions,
+					"post_action": postActions,
+				},
+			}
+
+			if group.GroupType == siterecovery.Shutdown {
+				shutdownGroup = flattened
+			} else {
+				failoverGroup = flattened
+			}
+
+		case siterecovery.Boot:
+			items := make([]interface{}, 0)
+			if group.ReplicationProtectedItems != nil {
+				for _, item := range *group.ReplicationProtectedItems {
+					if item.ID != nil {
+						items = append(items, *item.ID)
+					}
+				}
+			}
+
+			bootGroups = append(bootGroups, map[string]interface{}{
+				"replicated_protected_items": schema.NewSet(schema.HashString, items),
+				"pre_action":                 preActions,
+				"post_action":                postActions,
+			})
+		}
+	}
+
+	return shutdownGroup, failoverGroup, bootGroups
+}
+
+func flattenArmSiteRecoveryRecoveryPlanActions(input *[]siterecovery.RecoveryPlanAction) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, action := range *input {
+		result := make(map[string]interface{})
+
+		if action.ActionName != nil {
+			result["name"] = *action.ActionName
+		}
+
+		failoverTypes := make([]interface{}, 0)
+		if action.FailoverTypes != nil {
+			for _, t := range *action.FailoverTypes {
+				failoverTypes = append(failoverTypes, string(t))
+			}
+		}
+		result["failover_types"] = schema.NewSet(schema.HashString, failoverTypes)
+
+		failoverDirections := make([]interface{}, 0)
+		if action.FailoverDirections != nil {
+			for _, direction := range *action.FailoverDirections {
+				failoverDirections = append(failoverDirections, string(direction))
+			}
+		}
+		result["failover_directions"] = schema.NewSet(schema.HashString, failoverDirections)
+
+		if details := action.CustomDetails; details != nil {
+			if runbook, ok := details.AsRecoveryPlanAutomationRunbookActionDetails(); ok && runbook != nil {
+				result["type"] = string(siterecovery.InstanceTypeAutomationRunbookActionDetails)
+				result["fabric_location"] = string(runbook.FabricLocation)
+				if runbook.RunbookID != nil {
+					result["runbook_id"] = *runbook.RunbookID
+				}
+			}
+
+			if manual, ok := details.AsRecoveryPlanManualActionDetails(); ok && manual != nil {
+				result["type"] = string(siterecovery.InstanceTypeManualActionDetails)
+				if manual.Description != nil {
+					result["manual_action_instruction"] = *manual.Description
+				}
+			}
+
+			if script, ok := details.AsRecoveryPlanScriptActionDetails(); ok && script != nil {
+				result["type"] = string(siterecovery.InstanceTypeScriptActionDetails)
+				result["fabric_location"] = string(script.FabricLocation)
+				if script.Path != nil {
+					result["script_path"] = *script.Path
+				}
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
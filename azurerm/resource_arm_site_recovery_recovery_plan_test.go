@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Recovery Plans require existing Replication Fabrics and Replicated Items, which can't yet be provisioned
+// by the Provider - as such these tests require them to be created up front and their details specified
+type siteRecoveryRecoveryPlanTestData struct {
+	ResourceGroup   string
+	VaultName       string
+	SourceFabricId  string
+	TargetFabricId  string
+	ProtectedItemId string
+}
+
+func testAccAzureRMSiteRecoveryRecoveryPlanTestData(t *testing.T) siteRecoveryRecoveryPlanTestData {
+	envVariables := []string{
+		"ARM_TEST_SITE_RECOVERY_RESOURCE_GROUP",
+		"ARM_TEST_SITE_RECOVERY_VAULT",
+		"ARM_TEST_SITE_RECOVERY_SOURCE_FABRIC_ID",
+		"ARM_TEST_SITE_RECOVERY_TARGET_FABRIC_ID",
+		"ARM_TEST_SITE_RECOVERY_PROTECTED_ITEM_ID",
+	}
+
+	values := make([]string, 0)
+	for _, envVariable := range envVariables {
+		value := os.Getenv(envVariable)
+		if value == "" {
+			t.Skipf("Skipping as %q is not specified", envVariable)
+		}
+		values = append(values, value)
+	}
+
+	return siteRecoveryRecoveryPlanTestData{
+		ResourceGroup:   values[0],
+		VaultName:       values[1],
+		SourceFabricId:  values[2],
+		TargetFabricId:  values[3],
+		ProtectedItemId: values[4],
+	}
+}
+
+func TestAccAzureRMSiteRecoveryRecoveryPlan_basic(t *testing.T) {
+	resourceName := "azurerm_site_recovery_recovery_plan.test"
+	data := testAccAzureRMSiteRecoveryRecoveryPlanTestData(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSiteRecoveryRecoveryPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSiteRecoveryRecoveryPlan_basic(ri, data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSiteRecoveryRecoveryPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "boot_recovery_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "boot_recovery_group.0.replicated_protected_items.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSiteRecoveryRecoveryPlan_actions(t *testing.T) {
+	resourceName := "azurerm_site_recovery_recovery_plan.test"
+	data := testAccAzureRMSiteRecoveryRecoveryPlanTestData(t)
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSiteRecoveryRecoveryPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSiteRecoveryRecoveryPlan_basic(ri, data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSiteRecoveryRecoveryPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "boot_recovery_group.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMSiteRecoveryRecoveryPlan_actions(ri, data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSiteRecoveryRecoveryPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failover_recovery_group.0.pre_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "boot_recovery_group.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "boot_recovery_group.1.post_action.0.type", "ManualActionDetails"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSiteRecoveryRecoveryPlanExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Site Recovery Recovery Plan %q (Recovery Vault %q / Resource Group %q) does not exist", name, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on siteRecoveryRecoveryPlansClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSiteRecoveryRecoveryPlanDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_site_recovery_recovery_plan" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).siteRecoveryRecoveryPlansClient(resourceGroup, vaultName)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Site Recovery Recovery Plan still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSiteRecoveryRecoveryPlan_basic(rInt int, data siteRecoveryRecoveryPlanTestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_site_recovery_recovery_plan" "test" {
+  name                      = "acctest-rp-%d"
+  resource_group_name       = "%s"
+  recovery_vault_name       = "%s"
+  source_recovery_fabric_id = "%s"
+  target_recovery_fabric_id = "%s"
+
+  boot_recovery_group {
+    replicated_protected_items = ["%s"]
+  }
+}
+`, rInt, data.ResourceGroup, data.VaultName, data.SourceFabricId, data.TargetFabricId, data.ProtectedItemId)
+}
+
+func testAccAzureRMSiteRecoveryRecoveryPlan_actions(rInt int, data siteRecoveryRecoveryPlanTestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_site_recovery_recovery_plan" "test" {
+  name                      = "acctest-rp-%d"
+  resource_group_name       = "%s"
+  recovery_vault_name       = "%s"
+  source_recovery_fabric_id = "%s"
+  target_recovery_fabric_id = "%s"
+
+  failover_recovery_group {
+    pre_action {
+      name                      = "confirm-failover"
+      type                      = "ManualActionDetails"
+      failover_directions       = ["PrimaryToRecovery"]
+      failover_types            = ["TestFailover", "PlannedFailover", "UnplannedFailover"]
+      manual_action_instruction = "Confirm the failover has been approved"
+    }
+  }
+
+  boot_recovery_group {
+    replicated_protected_items = ["%s"]
+  }
+
+  boot_recovery_group {
+    post_action {
+      name                      = "verify-application"
+      type                      = "ManualActionDetails"
+      failover_directions       = ["PrimaryToRecovery", "RecoveryToPrimary"]
+      failover_types            = ["TestFailover"]
+      manual_action_instruction = "Verify the application is responding"
+    }
+  }
+}
+`, rInt, data.ResourceGroup, data.VaultName, data.SourceFabricId, data.TargetFabricId, data.ProtectedItemId)
+}
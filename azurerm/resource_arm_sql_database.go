@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +21,32 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the Backup Storage Redundancy and Ledger aren't available in the vendored SDK, so these are read & updated using a
+// newer API Version
+const sqlDatabaseExtendedApiVersion = "2021-02-01-preview"
+
+type sqlDatabaseExtended struct {
+	Location   *string                        `json:"location,omitempty"`
+	Tags       map[string]*string             `json:"tags,omitempty"`
+	Sku        *sqlDatabaseExtendedSku        `json:"sku,omitempty"`
+	Properties *sqlDatabaseExtendedProperties `json:"properties,omitempty"`
+}
+
+type sqlDatabaseExtendedSku struct {
+	Name *string `json:"name,omitempty"`
+	Tier *string `json:"tier,omitempty"`
+}
+
+type sqlDatabaseExtendedProperties struct {
+	CreateMode                       *string `json:"createMode,omitempty"`
+	Collation                        *string `json:"collation,omitempty"`
+	MaxSizeBytes                     *int64  `json:"maxSizeBytes,omitempty"`
+	ElasticPoolID                    *string `json:"elasticPoolId,omitempty"`
+	IsLedgerOn                       *bool   `json:"isLedgerOn,omitempty"`
+	CurrentBackupStorageRedundancy   *string `json:"currentBackupStorageRedundancy,omitempty"`
+	RequestedBackupStorageRedundancy *string `json:"requestedBackupStorageRedundancy,omitempty"`
+}
+
 func resourceArmSqlDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSqlDatabaseCreateUpdate,
@@ -190,6 +218,24 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"ledger_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"backup_storage_redundancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Geo",
+					"Local",
+					"Zone",
+				}, false),
+			},
+
 			"encryption": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -205,6 +251,16 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"fully_qualified_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"connection_string_template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"threat_detection_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -291,7 +347,15 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			"force_delete_replicated": forceDeleteReplicatedSchema(),
+
+			"diagnostics": monitorDiagnosticsSchema(),
+
 			"tags": tagsSchema(),
+
+			"response_export_values": azure.SchemaResponseExportValues(),
+
+			"response_export": azure.SchemaResponseExport(),
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
@@ -310,7 +374,11 @@ func resourceArmSqlDatabase() *schema.Resource {
 				}
 			}
 
-			return nil
+			if err := validateArmSqlDatabaseRestore(diff); err != nil {
+				return err
+			}
+
+			return validateArmSqlDatabaseBackupStorageRedundancy(diff)
 		},
 	}
 }
@@ -339,6 +407,32 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// `response_export_values` is only used when reading the resource, so changing it alone requires no API call
+	if onlyResponseExportValuesHaveChanged(d, resourceArmSqlDatabase().Schema) {
+		return resourceArmSqlDatabaseRead(d, meta)
+	}
+
+	if onlyMetadataHasChanged(d, resourceArmSqlDatabase().Schema, "force_delete_replicated") {
+		log.Printf("[DEBUG] Only the tags of SQL Database %q (Resource Group %q, Server %q) have changed - updating them in-place", name, resourceGroup, serverName)
+
+		future, err := client.Update(ctx, resourceGroup, serverName, name, sql.DatabaseUpdate{Tags: expandTags(tags)})
+		if err != nil {
+			return fmt.Errorf("Error issuing update request for the tags of SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on update future for the tags of SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+
+		if err := applyArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+			return fmt.Errorf("Error applying the diagnostics for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
+
+		return resourceArmSqlDatabaseRead(d, meta)
+	}
+
 	threatDetection, err := expandArmSqlServerThreatDetectionPolicy(d, location)
 	if err != nil {
 		return fmt.Errorf("Error parsing the database threat detection policy: %+v", err)
@@ -415,20 +509,73 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// when restoring from a Restorable Dropped Database the API ignores the deletion date, however the Dropped
+	// Database is looked up so that one which is no longer restorable is surfaced with a clearer error
+	if d.IsNewResource() && strings.EqualFold(createMode, string(sql.Restore)) {
+		if droppedId, err := parseArmSqlRestorableDroppedDatabaseID(d.Get("source_database_id").(string)); err == nil {
+			droppedClient := meta.(*ArmClient).sqlRestorableDroppedDatabasesClient
+			dropped, err := droppedClient.Get(ctx, droppedId.ResourceGroup, droppedId.ServerName, droppedId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(dropped.Response) {
+					return fmt.Errorf("Error restoring SQL Database %q (Resource Group %q, Server %q): the Restorable Dropped Database %q was not found - it may no longer be within its retention period", name, resourceGroup, serverName, droppedId.Name)
+				}
+				return fmt.Errorf("Error retrieving Restorable Dropped Database %q (Resource Group %q, Server %q): %+v", droppedId.Name, droppedId.ResourceGroup, droppedId.ServerName, err)
+			}
+
+			if props := dropped.RestorableDroppedDatabaseProperties; props != nil && props.DeletionDate != nil {
+				properties.DatabaseProperties.SourceDatabaseDeletionDate = props.DeletionDate
+			}
+		}
+	}
+
+	// a dropped Database is only recovered with Ledger enabled when it was originally, so a new Database is created instead
+	if d.IsNewResource() && meta.(*ArmClient).recoverDroppedMsSqlDatabases && strings.EqualFold(createMode, string(sql.Default)) && !d.Get("ledger_enabled").(bool) {
+		dropped, err := findArmSqlRestorableDroppedDatabase(meta, resourceGroup, serverName, name)
+		if err != nil {
+			return err
+		}
+
+		if dropped != nil {
+			log.Printf("[DEBUG] Recovering the previously dropped SQL Database %q (Resource Group %q, Server %q) from %q..", name, resourceGroup, serverName, *dropped.ID)
+			properties.DatabaseProperties.CreateMode = sql.Restore
+			properties.DatabaseProperties.SourceDatabaseID = dropped.ID
+			properties.DatabaseProperties.SourceDatabaseDeletionDate = dropped.DeletionDate
+		}
+	}
+
 	// The requested Service Objective Name does not match the requested Service Objective Id.
 	if d.HasChange("requested_service_objective_name") && !d.HasChange("requested_service_objective_id") {
 		properties.DatabaseProperties.RequestedServiceObjectiveID = nil
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
-	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
-	}
+	// the cached Databases are stale once the Database has been written to - including when the Ledger/Restore request
+	// or a later step (such as updating the Backup Storage Redundancy) fails part-way through
+	defer meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+
+	if d.IsNewResource() && d.Get("ledger_enabled").(bool) {
+		// Ledger can only be enabled in the request which creates the Database, which the SDK's API Version doesn't support
+		serverId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s", client.SubscriptionID, resourceGroup, serverName)
+		parameters, err := expandArmSqlDatabaseLedgerParameters(properties, serverId)
+		if err != nil {
+			return err
+		}
+
+		if err := armRawPut(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/databases/%s", serverId, name), sqlDatabaseExtendedApiVersion, parameters); err != nil {
+			return fmt.Errorf("Error creating SQL Database %q (Resource Group %q, Server %q) with Ledger enabled: %+v", name, resourceGroup, serverName, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
+		if err != nil {
+			return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
 	}
 
+	meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+
 	if _, ok := d.GetOk("import"); ok {
 		if !strings.EqualFold(createMode, "default") {
 			return fmt.Errorf("import can only be used when create_mode is Default")
@@ -442,11 +589,13 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		// this is set in config.go, but something sets
 		// it back to 15 minutes, which isn't long enough
 		// for most imports
-		client.Client.PollingDuration = 60 * time.Minute
+		client.Client.PollingDuration = meta.(*ArmClient).pollingOptions.Timeout
 
 		if err = importFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
 			return err
 		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name, "")
@@ -461,6 +610,24 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	if v, ok := d.GetOk("backup_storage_redundancy"); ok && d.HasChange("backup_storage_redundancy") {
+		redundancy := sqlDatabaseExtended{
+			Properties: &sqlDatabaseExtendedProperties{
+				RequestedBackupStorageRedundancy: utils.String(v.(string)),
+			},
+		}
+
+		if err := armRawPatch(ctx, client.Client, client.BaseURI, *resp.ID, sqlDatabaseExtendedApiVersion, redundancy); err != nil {
+			return fmt.Errorf("Error updating the Backup Storage Redundancy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
+	}
+
+	if err := applyArmMonitorDiagnostics(d, meta, *resp.ID); err != nil {
+		return fmt.Errorf("Error applying the diagnostics for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -477,7 +644,10 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
-	resp, err := client.Get(ctx, resourceGroup, serverName, name, "")
+	// the name is only missing from the state when the Database is being imported
+	importing := d.Get("name").(string) == ""
+
+	resp, err := readArmSqlDatabase(ctx, client, meta.(*ArmClient).sqlServerCache, resourceGroup, serverName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Error reading SQL Database %q - removing from state", d.Id())
@@ -505,6 +675,10 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("server_name", serverName)
 
+	fqdn := sqlServerFullyQualifiedDomainName(meta.(*ArmClient), serverName)
+	d.Set("fully_qualified_domain_name", fqdn)
+	d.Set("connection_string_template", sqlDatabaseConnectionStringTemplate(fqdn, name))
+
 	if props := resp.DatabaseProperties; props != nil {
 		// TODO: set `create_mode` & `source_database_id` once this issue is fixed:
 		// https://github.com/Azure/azure-rest-api-specs/issues/1604
@@ -535,8 +709,39 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("encryption", flattenEncryptionStatus(props.TransparentDataEncryption))
 	}
 
+	// the preview API is only queried when these are used (or the Database is being imported), since it's not
+	// available in every region - and otherwise each refresh would require an additional request per Database
+	_, hasBackupStorageRedundancy := d.GetOk("backup_storage_redundancy")
+	if importing || hasBackupStorageRedundancy || d.Get("ledger_enabled").(bool) {
+		var redundancy sqlDatabaseExtended
+		if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), sqlDatabaseExtendedApiVersion, &redundancy); err != nil {
+			log.Printf("[WARN] Unable to retrieve the Ledger/Backup Storage Redundancy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		} else if props := redundancy.Properties; props != nil {
+			ledgerEnabled := false
+			if v := props.IsLedgerOn; v != nil {
+				ledgerEnabled = *v
+			}
+			d.Set("ledger_enabled", ledgerEnabled)
+
+			// the requested value is returned whilst a change is pending, otherwise fall back to the current value
+			if v := props.RequestedBackupStorageRedundancy; v != nil && *v != "" {
+				d.Set("backup_storage_redundancy", *v)
+			} else if v := props.CurrentBackupStorageRedundancy; v != nil {
+				d.Set("backup_storage_redundancy", *v)
+			}
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
+	if err := readArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := setArmResponseExportValues(ctx, d, meta, "2014-04-01"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -553,7 +758,16 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
+	if err := ensureArmSqlDatabaseHasNoGeoReplicas(d, meta, resourceGroup, serverName, name); err != nil {
+		return err
+	}
+
+	if err := deleteArmMonitorDiagnostics(d, meta, d.Id()); err != nil {
+		return err
+	}
+
 	resp, err := client.Delete(ctx, resourceGroup, serverName, name)
+	meta.(*ArmClient).sqlServerCache.invalidate(resourceGroup, serverName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp) {
 			return nil
@@ -569,6 +783,38 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// validateArmSqlDatabaseBackupStorageRedundancy surfaces the restrictions the API places on the
+// Backup Storage Redundancy at plan time, rather than part-way through an apply
+func validateArmSqlDatabaseBackupStorageRedundancy(diff *schema.ResourceDiff) error {
+	// since this is Computed only values which are being changed in the config need validating
+	if !diff.HasChange("backup_storage_redundancy") || !diff.NewValueKnown("backup_storage_redundancy") {
+		return nil
+	}
+	if v, ok := diff.GetOk("backup_storage_redundancy"); !ok || v.(string) == "" {
+		return nil
+	}
+
+	if strings.EqualFold(diff.Get("edition").(string), string(sql.DataWarehouse)) {
+		return fmt.Errorf("`backup_storage_redundancy` cannot be configured for `DataWarehouse` databases")
+	}
+
+	// Hyperscale databases only accept the Backup Storage Redundancy in the request which creates them,
+	// however it's set once the database exists - so the API refuses it being set or changed
+	serviceObjective := diff.Get("requested_service_objective_name").(string)
+	if strings.HasPrefix(strings.ToUpper(serviceObjective), "HS_") {
+		return fmt.Errorf("`backup_storage_redundancy` cannot be set or changed for Hyperscale databases (requested service objective %q)", serviceObjective)
+	}
+
+	switch strings.ToLower(diff.Get("create_mode").(string)) {
+	case "onlinesecondary", "nonreadablesecondary":
+		if diff.Id() != "" {
+			return fmt.Errorf("`backup_storage_redundancy` cannot be changed for a Geo-Replica Secondary Database (`create_mode` is %q) - it must be changed on the Primary Database instead", diff.Get("create_mode").(string))
+		}
+	}
+
+	return nil
+}
+
 func flattenEncryptionStatus(encryption *[]sql.TransparentDataEncryption) string {
 	if encryption != nil {
 		encrypted := *encryption
@@ -702,3 +948,152 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+// readArmSqlDatabase retrieves the SQL Database from the Server-level cache where possible,
+// falling back to retrieving it individually
+func readArmSqlDatabase(ctx context.Context, client sql.DatabasesClient, cache *sqlServerCache, resourceGroup string, serverName string, name string) (sql.Database, error) {
+	if database := cache.sqlDatabase(ctx, client, resourceGroup, serverName, name); database != nil {
+		return *database, nil
+	}
+
+	return client.Get(ctx, resourceGroup, serverName, name, "")
+}
+
+type sqlRestorableDroppedDatabaseID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+// parseArmSqlRestorableDroppedDatabaseID parses the ID of a Restorable Dropped Database, which is named after the
+// dropped Database and its deletion time, e.g. `database1,131403269876900000`
+func parseArmSqlRestorableDroppedDatabaseID(input string) (*sqlRestorableDroppedDatabaseID, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Restorable Dropped Database ID %q: %+v", input, err)
+	}
+
+	droppedId := sqlRestorableDroppedDatabaseID{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if droppedId.ServerName = id.Path["servers"]; droppedId.ServerName == "" {
+		return nil, fmt.Errorf("ID was missing the `servers` element")
+	}
+
+	if droppedId.Name = id.Path["restorableDroppedDatabases"]; droppedId.Name == "" {
+		return nil, fmt.Errorf("ID was missing the `restorableDroppedDatabases` element")
+	}
+
+	return &droppedId, nil
+}
+
+// validateArmSqlDatabaseRestore ensures a Database being restored from a dropped Database specifies what it's being
+// restored from - either the ID of a Restorable Dropped Database, or the ID of the original Database and its deletion date
+func validateArmSqlDatabaseRestore(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" || !strings.EqualFold(diff.Get("create_mode").(string), string(sql.Restore)) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("source_database_id") || !diff.NewValueKnown("source_database_deletion_date") {
+		return nil
+	}
+
+	sourceDatabaseId := diff.Get("source_database_id").(string)
+	if sourceDatabaseId == "" {
+		return fmt.Errorf("`source_database_id` must be specified when `create_mode` is `Restore`")
+	}
+
+	if _, err := parseArmSqlRestorableDroppedDatabaseID(sourceDatabaseId); err == nil {
+		return nil
+	}
+
+	if diff.Get("source_database_deletion_date").(string) == "" {
+		return fmt.Errorf("`source_database_deletion_date` must be specified when `create_mode` is `Restore` and `source_database_id` isn't the ID of a Restorable Dropped Database")
+	}
+
+	return nil
+}
+
+// findArmSqlRestorableDroppedDatabase returns the most recently dropped Database with the specified name which can
+// still be restored, if one exists
+func findArmSqlRestorableDroppedDatabase(meta interface{}, resourceGroup, serverName, name string) (*sql.RestorableDroppedDatabase, error) {
+	client := meta.(*ArmClient).sqlRestorableDroppedDatabasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Restorable Dropped Databases for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if resp.Value == nil {
+		return nil, nil
+	}
+
+	return latestArmSqlRestorableDroppedDatabase(*resp.Value, name), nil
+}
+
+func latestArmSqlRestorableDroppedDatabase(input []sql.RestorableDroppedDatabase, name string) *sql.RestorableDroppedDatabase {
+	var latest *sql.RestorableDroppedDatabase
+
+	for i, v := range input {
+		props := v.RestorableDroppedDatabaseProperties
+		if v.ID == nil || props == nil || props.DatabaseName == nil || props.DeletionDate == nil {
+			continue
+		}
+
+		if !strings.EqualFold(*props.DatabaseName, name) {
+			continue
+		}
+
+		if latest == nil || props.DeletionDate.After(latest.DeletionDate.Time) {
+			latest = &input[i]
+		}
+	}
+
+	return latest
+}
+
+// expandArmSqlDatabaseLedgerParameters converts the SDK model into the newer API Version used to create a Database with
+// Ledger enabled, where the Edition & Service Objective are replaced by a `sku` and the Elastic Pool is referenced by ID
+func expandArmSqlDatabaseLedgerParameters(database sql.Database, serverId string) (*sqlDatabaseExtended, error) {
+	props := database.DatabaseProperties
+	if props == nil {
+		props = &sql.DatabaseProperties{}
+	}
+
+	if props.CreateMode != "" && !strings.EqualFold(string(props.CreateMode), string(sql.Default)) {
+		return nil, fmt.Errorf("`ledger_enabled` can only be used when `create_mode` is `Default`, got %q", string(props.CreateMode))
+	}
+
+	parameters := sqlDatabaseExtended{
+		Location: database.Location,
+		Tags:     database.Tags,
+		Properties: &sqlDatabaseExtendedProperties{
+			CreateMode: utils.String(string(sql.Default)),
+			Collation:  props.Collation,
+			IsLedgerOn: utils.Bool(true),
+		},
+	}
+
+	if v := props.MaxSizeBytes; v != nil && *v != "" {
+		maxSizeBytes, err := strconv.ParseInt(*v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("`max_size_bytes` wasn't a valid number %q: %+v", *v, err)
+		}
+		parameters.Properties.MaxSizeBytes = utils.Int64(maxSizeBytes)
+	}
+
+	if v := props.ElasticPoolName; v != nil && *v != "" {
+		parameters.Properties.ElasticPoolID = utils.String(fmt.Sprintf("%s/elasticPools/%s", serverId, *v))
+	} else if objective := string(props.RequestedServiceObjectiveName); objective != "" && !strings.EqualFold(objective, "ElasticPool") {
+		parameters.Sku = &sqlDatabaseExtendedSku{
+			Name: utils.String(objective),
+		}
+		if props.Edition != "" {
+			parameters.Sku.Tier = utils.String(string(props.Edition))
+		}
+	}
+
+	return &parameters, nil
+}
@@ -2,15 +2,71 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
+	"os"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_sql_database", &resource.Sweeper{
+		Name: "azurerm_sql_database",
+		F:    testSweepSqlDatabases,
+	})
+}
+
+func testSweepSqlDatabases(region string) error {
+	return testSweepForEachAcceptanceTestSqlServer(region, func(client *ArmClient, resourceGroup string, serverName string) error {
+		ctx := client.StopContext
+		databasesClient := client.sqlDatabasesClient
+
+		databases, err := databasesClient.ListByServer(ctx, resourceGroup, serverName, "", "")
+		if err != nil {
+			if utils.ResponseWasNotFound(databases.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Error listing SQL Databases (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+
+		if databases.Value == nil {
+			return nil
+		}
+
+		for _, database := range *databases.Value {
+			if database.Name == nil {
+				continue
+			}
+
+			// the `master` database is managed by the Server and can't be deleted
+			name := *database.Name
+			if name == "master" {
+				continue
+			}
+
+			log.Printf("[DEBUG] Sweeping SQL Database %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+			resp, err := databasesClient.Delete(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp) {
+					continue
+				}
+
+				return fmt.Errorf("Error deleting SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+		}
+
+		return nil
+	})
+}
+
 func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := tf.AccRandTimeInt()
@@ -24,13 +80,15 @@ func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 				Config: testAccAzureRMSqlDatabase_basic(ri, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "fully_qualified_domain_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_string_template"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"create_mode"},
+				ImportStateVerifyIgnore: []string{"create_mode", "force_delete_replicated"},
 			},
 		},
 	})
@@ -103,12 +161,118 @@ func TestAccAzureRMSqlDatabase_elasticPool(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"create_mode"},
+				ImportStateVerifyIgnore: []string{"create_mode", "force_delete_replicated"},
 			},
 		},
 	})
 }
 
+func TestAccAzureRMSqlDatabase_recoverDroppedDatabase(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMSqlDatabase_recoverDroppedDatabase(testAccAzureRMSqlDatabase_basic(ri, location))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_recoverDroppedDatabase(testAccAzureRMSqlDatabase_recoverDroppedDatabaseServerOnly(ri, location)),
+			},
+			{
+				// the dropped database only becomes restorable once its backups are available
+				PreConfig: func() { time.Sleep(10 * time.Minute) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestArmSqlRestorableDroppedDatabase(t *testing.T) {
+	dropped := func(id, name string, deletionDate time.Time) sql.RestorableDroppedDatabase {
+		return sql.RestorableDroppedDatabase{
+			ID: utils.String(id),
+			RestorableDroppedDatabaseProperties: &sql.RestorableDroppedDatabaseProperties{
+				DatabaseName: utils.String(name),
+				DeletionDate: &date.Time{Time: deletionDate},
+			},
+		}
+	}
+
+	now := time.Now()
+	input := []sql.RestorableDroppedDatabase{
+		dropped("first", "database1", now.Add(-2*time.Hour)),
+		dropped("second", "DATABASE1", now.Add(-1*time.Hour)),
+		dropped("other", "database2", now),
+		{
+			ID: utils.String("noProperties"),
+		},
+	}
+
+	if latest := latestArmSqlRestorableDroppedDatabase(input, "database1"); latest == nil || *latest.ID != "second" {
+		t.Fatalf("Expected the most recently dropped database `second` but got %+v", latest)
+	}
+
+	if latest := latestArmSqlRestorableDroppedDatabase(input, "database3"); latest != nil {
+		t.Fatalf("Expected no dropped database but got %+v", latest)
+	}
+}
+
+func TestParseArmSqlRestorableDroppedDatabaseID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *sqlRestorableDroppedDatabaseID
+	}{
+		{
+			Input: "",
+		},
+		{
+			// a Database rather than a Restorable Dropped Database
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/restorableDroppedDatabases/database1,131403269876900000",
+			Expected: &sqlRestorableDroppedDatabaseID{
+				ResourceGroup: "group1",
+				ServerName:    "server1",
+				Name:          "database1,131403269876900000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseArmSqlRestorableDroppedDatabaseID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
 func TestAccAzureRMSqlDatabase_withTags(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := tf.AccRandTimeInt()
@@ -158,7 +322,7 @@ func TestAccAzureRMSqlDatabase_dataWarehouse(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"create_mode"},
+				ImportStateVerifyIgnore: []string{"create_mode", "force_delete_replicated"},
 			},
 		},
 	})
@@ -197,6 +361,42 @@ func TestAccAzureRMSqlDatabase_restorePointInTime(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_forceDeleteReplicated(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+	subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabase_geoReplicated(ri, location, altLocation, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					testCheckAzureRMSqlDatabaseExists("azurerm_sql_database.secondary"),
+					resource.TestCheckResourceAttr(resourceName, "force_delete_replicated", "false"),
+				),
+			},
+			{
+				// removing the Primary Database whilst it has a Geo-Replica should be refused
+				Config:      testAccAzureRMSqlDatabase_geoReplicatedPrimaryRemoved(ri, location, altLocation, subscriptionId),
+				ExpectError: regexp.MustCompile("force_delete_replicated"),
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_geoReplicated(ri, location, altLocation, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_delete_replicated", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSqlDatabase_collation(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := tf.AccRandTimeInt()
@@ -257,6 +457,135 @@ func TestAccAzureRMSqlDatabase_requestedServiceObjectiveName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_backupStorageRedundancy(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabase_backupStorageRedundancy(ri, location, "S0", "Local"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_storage_redundancy", "Local"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_backupStorageRedundancy(ri, location, "S0", "Geo"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_storage_redundancy", "Geo"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_mode"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_backupStorageRedundancyHyperscale(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMSqlDatabase_backupStorageRedundancy(ri, testLocation(), "HS_Gen5_2", "Local"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`backup_storage_redundancy` cannot be set or changed for Hyperscale databases"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_ledger(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabase_ledger(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ledger_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "requested_service_objective_name", "S0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_mode"},
+			},
+		},
+	})
+}
+
+func TestExpandArmSqlDatabaseLedgerParameters(t *testing.T) {
+	serverId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1"
+
+	standalone, err := expandArmSqlDatabaseLedgerParameters(sql.Database{
+		Location: utils.String("westeurope"),
+		DatabaseProperties: &sql.DatabaseProperties{
+			CreateMode:                    sql.Default,
+			Edition:                       sql.Standard,
+			RequestedServiceObjectiveName: sql.ServiceObjectiveName("S0"),
+			MaxSizeBytes:                  utils.String("1073741824"),
+		},
+	}, serverId)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if standalone.Sku == nil || *standalone.Sku.Name != "S0" || *standalone.Sku.Tier != "Standard" {
+		t.Fatalf("Expected the Service Objective to be mapped to the `S0` sku in the `Standard` tier but got %+v", standalone.Sku)
+	}
+	if v := standalone.Properties.MaxSizeBytes; v == nil || *v != 1073741824 {
+		t.Fatalf("Expected the Max Size Bytes to be 1073741824 but got %+v", v)
+	}
+	if v := standalone.Properties.IsLedgerOn; v == nil || !*v {
+		t.Fatalf("Expected Ledger to be enabled")
+	}
+
+	pooled, err := expandArmSqlDatabaseLedgerParameters(sql.Database{
+		DatabaseProperties: &sql.DatabaseProperties{
+			RequestedServiceObjectiveName: sql.ServiceObjectiveName("ElasticPool"),
+			ElasticPoolName:               utils.String("pool1"),
+		},
+	}, serverId)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if pooled.Sku != nil {
+		t.Fatalf("Expected no sku for a Database within an Elastic Pool but got %+v", pooled.Sku)
+	}
+	if v := pooled.Properties.ElasticPoolID; v == nil || *v != serverId+"/elasticPools/pool1" {
+		t.Fatalf("Expected the Elastic Pool to be referenced by ID but got %+v", v)
+	}
+
+	if _, err := expandArmSqlDatabaseLedgerParameters(sql.Database{
+		DatabaseProperties: &sql.DatabaseProperties{
+			CreateMode: sql.Copy,
+		},
+	}, serverId); err == nil {
+		t.Fatalf("Expected an error when `create_mode` isn't `Default`")
+	}
+}
+
 func TestAccAzureRMSqlDatabase_threatDetectionPolicy(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := tf.AccRandTimeInt()
@@ -284,7 +613,7 @@ func TestAccAzureRMSqlDatabase_threatDetectionPolicy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"create_mode", "threat_detection_policy.0.storage_account_access_key"},
+				ImportStateVerifyIgnore: []string{"create_mode", "force_delete_replicated", "threat_detection_policy.0.storage_account_access_key"},
 			},
 			{
 				Config: postConfig,
@@ -616,6 +945,38 @@ resource "azurerm_sql_database" "test" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMSqlDatabase_recoverDroppedDatabase(template string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    mssql {
+      recover_dropped_databases = true
+    }
+  }
+}
+
+%s
+`, template)
+}
+
+func testAccAzureRMSqlDatabase_recoverDroppedDatabaseServerOnly(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMSqlDatabase_collationUpdate(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -744,6 +1105,64 @@ resource "azurerm_sql_database" "test" {
 `, rInt, location, rInt, rInt, requestedServiceObjectiveName)
 }
 
+func testAccAzureRMSqlDatabase_backupStorageRedundancy(rInt int, location string, requestedServiceObjectiveName string, backupStorageRedundancy string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  requested_service_objective_name = %q
+  backup_storage_redundancy        = %q
+}
+`, rInt, location, rInt, rInt, requestedServiceObjectiveName, backupStorageRedundancy)
+}
+
+func testAccAzureRMSqlDatabase_ledger(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+  ledger_enabled                   = true
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMSqlDatabase_threatDetectionPolicy(rInt int, location, state string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -789,3 +1208,72 @@ resource "azurerm_sql_database" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, state)
 }
+
+func testAccAzureRMSqlDatabase_geoReplicatedTemplate(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+  name                         = "acctestsqlserver%d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "%s"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, rInt, location, rInt, rInt, altLocation)
+}
+
+func testAccAzureRMSqlDatabase_geoReplicated(rInt int, location string, altLocation string, forceDelete bool) string {
+	template := testAccAzureRMSqlDatabase_geoReplicatedTemplate(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  requested_service_objective_name = "S0"
+  force_delete_replicated          = %t
+}
+
+resource "azurerm_sql_database" "secondary" {
+  name                = "acctestdb%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.secondary.name}"
+  location            = "${azurerm_sql_server.secondary.location}"
+  create_mode         = "OnlineSecondary"
+  source_database_id  = "${azurerm_sql_database.test.id}"
+}
+`, template, rInt, forceDelete, rInt)
+}
+
+func testAccAzureRMSqlDatabase_geoReplicatedPrimaryRemoved(rInt int, location string, altLocation string, subscriptionId string) string {
+	template := testAccAzureRMSqlDatabase_geoReplicatedTemplate(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "secondary" {
+  name                = "acctestdb%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.secondary.name}"
+  location            = "${azurerm_sql_server.secondary.location}"
+  create_mode         = "OnlineSecondary"
+  source_database_id  = "/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Sql/servers/acctestsqlserver%d/databases/acctestdb%d"
+}
+`, template, rInt, subscriptionId, rInt, rInt, rInt)
+}
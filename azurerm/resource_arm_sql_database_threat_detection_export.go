@@ -0,0 +1,312 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Diagnostic Logs category containing the security audit events of a SQL Database
+const sqlDatabaseSecurityAuditEventsCategory = "SQLSecurityAuditEvents"
+
+// resourceArmSqlDatabaseThreatDetectionExport routes the security audit events of a SQL Database to an Event Hub
+// and/or a Log Analytics Workspace - which requires both a Diagnostic Setting on the Database and the Azure Monitor
+// target to be enabled in the Database's Auditing Policy
+func resourceArmSqlDatabaseThreatDetectionExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlDatabaseThreatDetectionExportCreateUpdate,
+		Read:   resourceArmSqlDatabaseThreatDetectionExportRead,
+		Update: resourceArmSqlDatabaseThreatDetectionExportCreateUpdate,
+		Delete: resourceArmSqlDatabaseThreatDetectionExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the name of the Diagnostic Setting
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateEventHubName(),
+			},
+
+			"eventhub_authorization_rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"audit_actions_and_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			_, hasEventHub := diff.GetOk("eventhub_authorization_rule_id")
+			_, hasWorkspace := diff.GetOk("log_analytics_workspace_id")
+			if !hasEventHub && !hasWorkspace && diff.NewValueKnown("eventhub_authorization_rule_id") && diff.NewValueKnown("log_analytics_workspace_id") {
+				return fmt.Errorf("Either `eventhub_authorization_rule_id` or `log_analytics_workspace_id` must be specified")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmSqlDatabaseThreatDetectionExportCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	auditingClient := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	databaseId := d.Get("database_id").(string)
+
+	id, err := parseAzureResourceID(databaseId)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	targetResourceId := strings.TrimPrefix(databaseId, "/")
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, targetResourceId, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_sql_database_threat_detection_export", fmt.Sprintf("%s|%s", databaseId, name))
+		}
+	}
+
+	// the Auditing Policy must target Azure Monitor for the audit events to be sent to the Diagnostic Setting
+	policy, err := auditingClient.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	policyProps := sqlPreview.DatabaseBlobAuditingPolicyProperties{
+		State:                       sqlPreview.BlobAuditingPolicyStateEnabled,
+		IsAzureMonitorTargetEnabled: utils.Bool(true),
+	}
+	if v, ok := d.GetOk("audit_actions_and_groups"); ok {
+		policyProps.AuditActionsAndGroups = utils.ExpandStringArray(v.([]interface{}))
+	} else if existing := policy.DatabaseBlobAuditingPolicyProperties; existing != nil {
+		policyProps.AuditActionsAndGroups = existing.AuditActionsAndGroups
+	}
+
+	log.Printf("[DEBUG] Enabling the Azure Monitor target for the Auditing Policy of SQL Database %q (Server %q / Resource Group %q)..", databaseName, serverName, resourceGroup)
+	if _, err := auditingClient.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, sqlPreview.DatabaseBlobAuditingPolicy{DatabaseBlobAuditingPolicyProperties: &policyProps}); err != nil {
+		return fmt.Errorf("Error updating Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	logs := []insights.LogSettings{
+		{
+			Category: utils.String(sqlDatabaseSecurityAuditEventsCategory),
+			Enabled:  utils.Bool(true),
+			RetentionPolicy: &insights.RetentionPolicy{
+				Enabled: utils.Bool(false),
+				Days:    utils.Int32(0),
+			},
+		},
+	}
+	metrics := make([]insights.MetricSettings, 0)
+
+	properties := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			Logs:    &logs,
+			Metrics: &metrics,
+		},
+	}
+
+	if v := d.Get("eventhub_authorization_rule_id").(string); v != "" {
+		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(v)
+		properties.DiagnosticSettings.EventHubName = utils.String(d.Get("eventhub_name").(string))
+	}
+
+	if v := d.Get("log_analytics_workspace_id").(string); v != "" {
+		properties.DiagnosticSettings.WorkspaceID = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
+		return fmt.Errorf("Error creating/updating Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, targetResourceId, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q)", name, databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", databaseId, name))
+
+	return resourceArmSqlDatabaseThreatDetectionExportRead(d, meta)
+}
+
+func resourceArmSqlDatabaseThreatDetectionExportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	auditingClient := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseMonitorDiagnosticId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseId, err := parseAzureResourceID(id.resourceID)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := databaseId.ResourceGroup
+	serverName := databaseId.Path["servers"]
+	databaseName := databaseId.Path["databases"]
+
+	resp, err := client.Get(ctx, strings.TrimPrefix(id.resourceID, "/"), id.name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Threat Detection Export %q for SQL Database %q was not found - removing from state", id.name, id.resourceID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q): %+v", id.name, databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", id.name)
+	d.Set("database_id", id.resourceID)
+
+	if props := resp.DiagnosticSettings; props != nil {
+		d.Set("eventhub_name", props.EventHubName)
+		d.Set("eventhub_authorization_rule_id", props.EventHubAuthorizationRuleID)
+		d.Set("log_analytics_workspace_id", props.WorkspaceID)
+	}
+
+	policy, err := auditingClient.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	if props := policy.DatabaseBlobAuditingPolicyProperties; props != nil {
+		if err := d.Set("audit_actions_and_groups", utils.FlattenStringArray(props.AuditActionsAndGroups)); err != nil {
+			return fmt.Errorf("Error setting `audit_actions_and_groups`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSqlDatabaseThreatDetectionExportDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	auditingClient := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseMonitorDiagnosticId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseId, err := parseAzureResourceID(id.resourceID)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := databaseId.ResourceGroup
+	serverName := databaseId.Path["servers"]
+	databaseName := databaseId.Path["databases"]
+
+	targetResourceId := strings.TrimPrefix(id.resourceID, "/")
+	resp, err := client.Delete(ctx, targetResourceId, id.name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Threat Detection Export %q for SQL Database %q (Server %q / Resource Group %q): %+v", id.name, databaseName, serverName, resourceGroup, err)
+		}
+	}
+
+	// API appears to be eventually consistent (identified during tainting this resource)
+	log.Printf("[DEBUG] Waiting for Threat Detection Export %q for SQL Database %q to disappear", id.name, id.resourceID)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, targetResourceId, id.name),
+		Timeout:                   60 * time.Minute,
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Threat Detection Export %q for SQL Database %q to be deleted: %+v", id.name, id.resourceID, err)
+	}
+
+	policy, err := auditingClient.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	// the Auditing Policy is managed by this resource, so is disabled along with the Azure Monitor target
+	policyProps := sqlPreview.DatabaseBlobAuditingPolicyProperties{
+		State:                       sqlPreview.BlobAuditingPolicyStateDisabled,
+		IsAzureMonitorTargetEnabled: utils.Bool(false),
+	}
+	if existing := policy.DatabaseBlobAuditingPolicyProperties; existing != nil {
+		policyProps.AuditActionsAndGroups = existing.AuditActionsAndGroups
+	}
+
+	log.Printf("[DEBUG] Disabling the Azure Monitor target for the Auditing Policy of SQL Database %q (Server %q / Resource Group %q)..", databaseName, serverName, resourceGroup)
+	if _, err := auditingClient.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, sqlPreview.DatabaseBlobAuditingPolicy{DatabaseBlobAuditingPolicyProperties: &policyProps}); err != nil {
+		return fmt.Errorf("Error updating Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlDatabaseThreatDetectionExport_logAnalytics(t *testing.T) {
+	resourceName := "azurerm_sql_database_threat_detection_export.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseThreatDetectionExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseThreatDetectionExport_logAnalytics(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseThreatDetectionExportExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "log_analytics_workspace_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "audit_actions_and_groups.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabaseThreatDetectionExport_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_sql_database_threat_detection_export.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseThreatDetectionExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseThreatDetectionExport_logAnalytics(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseThreatDetectionExportExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSqlDatabaseThreatDetectionExport_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_sql_database_threat_detection_export"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabaseThreatDetectionExport_eventHub(t *testing.T) {
+	resourceName := "azurerm_sql_database_threat_detection_export.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseThreatDetectionExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseThreatDetectionExport_eventHub(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseThreatDetectionExportExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "eventhub_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "eventhub_authorization_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "audit_actions_and_groups.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseThreatDetectionExportExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		name := rs.Primary.Attributes["name"]
+		databaseId := strings.TrimPrefix(rs.Primary.Attributes["database_id"], "/")
+
+		resp, err := client.Get(ctx, databaseId, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Threat Detection Export %q does not exist for SQL Database %q", name, databaseId)
+			}
+
+			return fmt.Errorf("Bad: Get on monitorDiagnosticSettingsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseThreatDetectionExportDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_database_threat_detection_export" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		databaseId := strings.TrimPrefix(rs.Primary.Attributes["database_id"], "/")
+
+		resp, err := client.Get(ctx, databaseId, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Threat Detection Export %q still exists for SQL Database %q", name, databaseId)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseThreatDetectionExport_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMSqlDatabaseThreatDetectionExport_logAnalytics(rInt int, location string) string {
+	template := testAccAzureRMSqlDatabaseThreatDetectionExport_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_sql_database_threat_detection_export" "test" {
+  name                       = "acctest-export-%d"
+  database_id                = "${azurerm_sql_database.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMSqlDatabaseThreatDetectionExport_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMSqlDatabaseThreatDetectionExport_logAnalytics(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_threat_detection_export" "import" {
+  name                       = "${azurerm_sql_database_threat_detection_export.test.name}"
+  database_id                = "${azurerm_sql_database_threat_detection_export.test.database_id}"
+  log_analytics_workspace_id = "${azurerm_sql_database_threat_detection_export.test.log_analytics_workspace_id}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlDatabaseThreatDetectionExport_eventHub(rInt int, location string) string {
+	template := testAccAzureRMSqlDatabaseThreatDetectionExport_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Basic"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_namespace_authorization_rule" "test" {
+  name                = "example"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  listen              = true
+  send                = true
+  manage              = true
+}
+
+resource "azurerm_sql_database_threat_detection_export" "test" {
+  name                           = "acctest-export-%d"
+  database_id                    = "${azurerm_sql_database.test.id}"
+  eventhub_name                  = "${azurerm_eventhub.test.name}"
+  eventhub_authorization_rule_id = "${azurerm_eventhub_namespace_authorization_rule.test.id}"
+
+  audit_actions_and_groups = [
+    "SUCCESSFUL_DATABASE_AUTHENTICATION_GROUP",
+    "FAILED_DATABASE_AUTHENTICATION_GROUP",
+  ]
+}
+`, template, rInt, rInt, rInt)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -78,7 +79,13 @@ func resourceArmSqlElasticPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"force_delete_replicated": forceDeleteReplicatedSchema(),
+
 			"tags": tagsSchema(),
+
+			"response_export_values": azure.SchemaResponseExportValues(),
+
+			"response_export": azure.SchemaResponseExport(),
 		},
 	}
 }
@@ -108,6 +115,28 @@ func resourceArmSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	// `response_export_values` is only used when reading the resource, so changing it alone requires no API call
+	if onlyResponseExportValuesHaveChanged(d, resourceArmSqlElasticPool().Schema) {
+		return resourceArmSqlElasticPoolRead(d, meta)
+	}
+
+	if onlyMetadataHasChanged(d, resourceArmSqlElasticPool().Schema, "force_delete_replicated") {
+		log.Printf("[DEBUG] Only the tags of SQL ElasticPool %q (resource group %q, server %q) have changed - updating them in-place", name, resGroup, serverName)
+
+		future, err := client.Update(ctx, resGroup, serverName, name, sql.ElasticPoolUpdate{Tags: expandTags(tags)})
+		if err != nil {
+			return err
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return err
+		}
+
+		meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+
+		return resourceArmSqlElasticPoolRead(d, meta)
+	}
+
 	elasticPool := sql.ElasticPool{
 		Name:                  &name,
 		Location:              &location,
@@ -124,6 +153,8 @@ func resourceArmSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
+
 	read, err := client.Get(ctx, resGroup, serverName, name)
 	if err != nil {
 		return err
@@ -146,7 +177,7 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	resp, err := client.Get(ctx, resGroup, serverName, name)
+	resp, err := readArmSqlElasticPool(ctx, client, meta.(*ArmClient).sqlServerCache, resGroup, serverName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -176,6 +207,10 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 
 	flattenAndSetTags(d, resp.Tags)
 
+	if err := setArmResponseExportValues(ctx, d, meta, "2014-04-01"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -188,11 +223,26 @@ func resourceArmSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := ensureArmSqlElasticPoolHasNoGeoReplicas(d, meta, resGroup, serverName, name); err != nil {
+		return err
+	}
+
 	_, err = client.Delete(ctx, resGroup, serverName, name)
+	meta.(*ArmClient).sqlServerCache.invalidate(resGroup, serverName)
 
 	return err
 }
 
+// readArmSqlElasticPool retrieves the SQL Elastic Pool from the Server-level cache where possible,
+// falling back to retrieving it individually
+func readArmSqlElasticPool(ctx context.Context, client sql.ElasticPoolsClient, cache *sqlServerCache, resourceGroup string, serverName string, name string) (sql.ElasticPool, error) {
+	if pool := cache.sqlElasticPool(ctx, client, resourceGroup, serverName, name); pool != nil {
+		return *pool, nil
+	}
+
+	return client.Get(ctx, resourceGroup, serverName, name)
+}
+
 func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProperties {
 	edition := sql.ElasticPoolEdition(d.Get("edition").(string))
 	dtu := int32(d.Get("dtu").(int))
@@ -1,8 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +16,34 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the vendored SDK predates the Public Network Access, Minimum TLS Version & Outbound Network Restriction properties
+// and only supports System Assigned Identities - so these are read & updated using a newer API Version
+const sqlServerExtendedApiVersion = "2021-02-01-preview"
+
+type sqlServerExtended struct {
+	Identity   *sqlServerIdentityDetails    `json:"identity,omitempty"`
+	Properties *sqlServerExtendedProperties `json:"properties,omitempty"`
+}
+
+type sqlServerExtendedProperties struct {
+	MinimalTlsVersion             *string `json:"minimalTlsVersion,omitempty"`
+	PublicNetworkAccess           *string `json:"publicNetworkAccess,omitempty"`
+	RestrictOutboundNetworkAccess *string `json:"restrictOutboundNetworkAccess,omitempty"`
+	PrimaryUserAssignedIdentityID *string `json:"primaryUserAssignedIdentityId,omitempty"`
+}
+
+type sqlServerIdentityDetails struct {
+	Type                   *string                                   `json:"type,omitempty"`
+	PrincipalID            *string                                   `json:"principalId,omitempty"`
+	TenantID               *string                                   `json:"tenantId,omitempty"`
+	UserAssignedIdentities map[string]*sqlServerUserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
+
+type sqlServerUserAssignedIdentity struct {
+	PrincipalID *string `json:"principalId,omitempty"`
+	ClientID    *string `json:"clientId,omitempty"`
+}
+
 func resourceArmSqlServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSqlServerCreateUpdate,
@@ -21,9 +52,11 @@ func resourceArmSqlServer() *schema.Resource {
 		Delete: resourceArmSqlServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmSqlServerImport,
 		},
 
+		CustomizeDiff: customizeDiffNameAvailability("SQL Server", resourceArmSqlServerNameAvailability),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,9 +86,18 @@ func resourceArmSqlServer() *schema.Resource {
 			},
 
 			"administrator_login_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"administrator_login_password_key_vault_secret_id"},
+			},
+
+			// the password is retrieved from the Key Vault at apply time, rather than being specified in the config
+			"administrator_login_password_key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateKeyVaultChildId,
+				ConflictsWith: []string{"administrator_login_password"},
 			},
 
 			"fully_qualified_domain_name": {
@@ -63,7 +105,88 @@ func resourceArmSqlServer() *schema.Resource {
 				Computed: true,
 			},
 
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"outbound_network_restriction_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"minimum_tls_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0",
+					"1.1",
+					"1.2",
+				}, false),
+			},
+
+			"connection_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.ServerConnectionTypeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ServerConnectionTypeDefault),
+					string(sql.ServerConnectionTypeProxy),
+					string(sql.ServerConnectionTypeRedirect),
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+								"UserAssigned",
+								"SystemAssigned,UserAssigned",
+							}, false),
+						},
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"primary_user_assigned_identity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"tags": tagsSchema(),
+
+			"response_export_values": azure.SchemaResponseExportValues(),
+
+			"response_export": azure.SchemaResponseExport(),
 		},
 	}
 }
@@ -94,6 +217,32 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	// `response_export_values` is only used when reading the resource, so changing it alone requires no API call
+	if onlyResponseExportValuesHaveChanged(d, resourceArmSqlServer().Schema) {
+		return resourceArmSqlServerRead(d, meta)
+	}
+
+	if onlyMetadataHasChanged(d, resourceArmSqlServer().Schema) {
+		log.Printf("[DEBUG] Only the tags of SQL Server %q (Resource Group %q) have changed - updating them in-place", name, resGroup)
+
+		future, err := client.Update(ctx, resGroup, name, sql.ServerUpdate{Tags: metadata})
+		if err != nil {
+			return fmt.Errorf("Error issuing update request for the tags of SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on update future for the tags of SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		return resourceArmSqlServerRead(d, meta)
+	}
+
+	// the Identity is expanded up-front so that an invalid Primary Identity is surfaced before the SQL Server is created
+	identity, err := expandArmSqlServerIdentity(d)
+	if err != nil {
+		return err
+	}
+
 	parameters := sql.Server{
 		Location: utils.String(location),
 		Tags:     metadata,
@@ -103,8 +252,20 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
-	if d.HasChange("administrator_login_password") {
+	if d.IsNewResource() || d.HasChange("administrator_login_password") || d.HasChange("administrator_login_password_key_vault_secret_id") {
 		adminPassword := d.Get("administrator_login_password").(string)
+		if secretId := d.Get("administrator_login_password_key_vault_secret_id").(string); secretId != "" {
+			secret, err := getKeyVaultSecretValue(ctx, meta.(*ArmClient).keyVaultManagementClient, secretId)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the Administrator Login Password for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+			adminPassword = secret
+		}
+
+		if adminPassword == "" {
+			return fmt.Errorf("either `administrator_login_password` or `administrator_login_password_key_vault_secret_id` must be specified")
+		}
+
 		parameters.ServerProperties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
@@ -129,6 +290,62 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.ID)
 
+	if d.IsNewResource() || d.HasChange("public_network_access_enabled") || d.HasChange("minimum_tls_version") {
+		security := sqlServerExtended{
+			Properties: &sqlServerExtendedProperties{
+				PublicNetworkAccess: utils.String("Enabled"),
+			},
+		}
+
+		if !d.Get("public_network_access_enabled").(bool) {
+			security.Properties.PublicNetworkAccess = utils.String("Disabled")
+		}
+
+		if v, ok := d.GetOk("minimum_tls_version"); ok {
+			security.Properties.MinimalTlsVersion = utils.String(v.(string))
+		}
+
+		if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion, security); err != nil {
+			return fmt.Errorf("Error updating the Public Network Access/Minimum TLS Version for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	if d.HasChange("outbound_network_restriction_enabled") {
+		outboundNetwork := sqlServerExtended{
+			Properties: &sqlServerExtendedProperties{
+				RestrictOutboundNetworkAccess: utils.String("Disabled"),
+			},
+		}
+
+		if d.Get("outbound_network_restriction_enabled").(bool) {
+			outboundNetwork.Properties.RestrictOutboundNetworkAccess = utils.String("Enabled")
+		}
+
+		if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion, outboundNetwork); err != nil {
+			return fmt.Errorf("Error updating the Outbound Network Restriction for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("connection_policy") {
+		connectionPoliciesClient := meta.(*ArmClient).sqlServerConnectionPoliciesClient
+
+		policy := sql.ServerConnectionPolicy{
+			ServerConnectionPolicyProperties: &sql.ServerConnectionPolicyProperties{
+				ConnectionType: sql.ServerConnectionType(d.Get("connection_policy").(string)),
+			},
+		}
+
+		if _, err := connectionPoliciesClient.CreateOrUpdate(ctx, resGroup, name, policy); err != nil {
+			return fmt.Errorf("Error updating the Connection Policy for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	if d.HasChange("identity") || d.HasChange("primary_user_assigned_identity_id") {
+		if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion, identity); err != nil {
+			return fmt.Errorf("Error updating the Identity for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceArmSqlServerRead(d, meta)
 }
 
@@ -167,8 +384,57 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fully_qualified_domain_name", serverProperties.FullyQualifiedDomainName)
 	}
 
+	var extended sqlServerExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), sqlServerExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Public Network Access/Minimum TLS Version/Outbound Network Restriction/Identity for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	publicNetworkAccessEnabled := true
+	minimumTlsVersion := ""
+	outboundNetworkRestrictionEnabled := false
+	primaryUserAssignedIdentityId := ""
+	if props := extended.Properties; props != nil {
+		if v := props.PublicNetworkAccess; v != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*v, "Enabled")
+		}
+
+		if v := props.MinimalTlsVersion; v != nil && !strings.EqualFold(*v, "None") {
+			minimumTlsVersion = *v
+		}
+
+		if v := props.RestrictOutboundNetworkAccess; v != nil {
+			outboundNetworkRestrictionEnabled = strings.EqualFold(*v, "Enabled")
+		}
+
+		if v := props.PrimaryUserAssignedIdentityID; v != nil {
+			primaryUserAssignedIdentityId = *v
+		}
+	}
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+	d.Set("minimum_tls_version", minimumTlsVersion)
+	d.Set("outbound_network_restriction_enabled", outboundNetworkRestrictionEnabled)
+	d.Set("primary_user_assigned_identity_id", primaryUserAssignedIdentityId)
+
+	if err := d.Set("identity", flattenArmSqlServerIdentity(extended.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	connectionPoliciesClient := meta.(*ArmClient).sqlServerConnectionPoliciesClient
+	policy, err := connectionPoliciesClient.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Connection Policy for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if props := policy.ServerConnectionPolicyProperties; props != nil {
+		d.Set("connection_policy", string(props.ConnectionType))
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
+	if err := setArmResponseExportValues(ctx, d, meta, "2015-05-01-preview"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,3 +457,192 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	return future.WaitForCompletionRef(ctx, client.Client)
 }
+
+// sqlServerImportChildrenSuffix can be appended to the ID when importing a SQL Server to also import
+// the Elastic Pools, Databases and Firewall Rules within it
+const sqlServerImportChildrenSuffix = "?include=children"
+
+func resourceArmSqlServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.HasSuffix(d.Id(), sqlServerImportChildrenSuffix) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	d.SetId(strings.TrimSuffix(d.Id(), sqlServerImportChildrenSuffix))
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client := meta.(*ArmClient)
+	ctx := client.StopContext
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	results := []*schema.ResourceData{d}
+	importChild := func(resourceType string, r *schema.Resource, childId *string) {
+		if childId == nil {
+			return
+		}
+
+		child := r.Data(nil)
+		child.SetType(resourceType)
+		child.SetId(*childId)
+		results = append(results, child)
+	}
+
+	pools, err := client.msSqlElasticPoolsClient.ListByServerComplete(ctx, resourceGroup, serverName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Elastic Pools (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	for pools.NotDone() {
+		importChild("azurerm_mssql_elasticpool", resourceArmMsSqlElasticPool(), pools.Value().ID)
+
+		if err := pools.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Elastic Pools (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+	}
+
+	databases, err := client.sqlDatabasesClient.ListByServer(ctx, resourceGroup, serverName, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Databases (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	if databases.Value != nil {
+		for _, database := range *databases.Value {
+			// the `master` database is managed by the Server
+			if database.Name != nil && *database.Name == "master" {
+				continue
+			}
+
+			importChild("azurerm_sql_database", resourceArmSqlDatabase(), database.ID)
+		}
+	}
+
+	rules, err := client.sqlFirewallRulesClient.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Firewall Rules (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	if rules.Value != nil {
+		for _, rule := range *rules.Value {
+			importChild("azurerm_sql_firewall_rule", resourceArmSqlFirewallRule(), rule.ID)
+		}
+	}
+
+	log.Printf("[DEBUG] Importing SQL Server %q (Resource Group %q) along with %d child resources", serverName, resourceGroup, len(results)-1)
+
+	return results, nil
+}
+
+// sqlServerFullyQualifiedDomainName returns the FQDN of the SQL Server, which is derived from the name of the Server and
+// the DNS Suffix of the Azure Environment - so that this is available to child resources without retrieving the Server
+func sqlServerFullyQualifiedDomainName(client *ArmClient, serverName string) string {
+	return fmt.Sprintf("%s.%s", serverName, client.environment.SQLDatabaseDNSSuffix)
+}
+
+// sqlDatabaseConnectionStringTemplate returns an ADO.NET Connection String for the Database, where the credentials are
+// placeholders which need to be substituted before it's used
+func sqlDatabaseConnectionStringTemplate(fqdn string, databaseName string) string {
+	return fmt.Sprintf("Server=tcp:%s,1433;Initial Catalog=%s;Persist Security Info=False;User ID={your_username};Password={your_password};MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;", fqdn, databaseName)
+}
+
+func resourceArmSqlServerNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	input := sql.CheckNameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Sql/servers"),
+	}
+	resp, err := client.sqlServersClient.CheckNameAvailability(ctx, input)
+	if err != nil {
+		return false, "", err
+	}
+
+	available := resp.Available != nil && *resp.Available
+	return available, nameAvailabilityReason(resp.Message, string(resp.Reason)), nil
+}
+
+func expandArmSqlServerIdentity(d *schema.ResourceData) (*sqlServerExtended, error) {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 || identities[0] == nil {
+		// removing the block means disabling the Identity
+		return &sqlServerExtended{
+			Identity: &sqlServerIdentityDetails{
+				Type: utils.String("None"),
+			},
+		}, nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	identityType := identity["type"].(string)
+	identityIds := make([]string, 0)
+	for _, id := range identity["identity_ids"].(*schema.Set).List() {
+		identityIds = append(identityIds, id.(string))
+	}
+	sort.Strings(identityIds)
+	userAssigned := strings.Contains(identityType, "UserAssigned")
+
+	if userAssigned && len(identityIds) == 0 {
+		return nil, fmt.Errorf("`identity_ids` must be specified when `type` is %q", identityType)
+	}
+	if !userAssigned && len(identityIds) > 0 {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+	}
+
+	output := sqlServerExtended{
+		Identity: &sqlServerIdentityDetails{
+			Type: utils.String(identityType),
+		},
+	}
+
+	if userAssigned {
+		output.Identity.UserAssignedIdentities = make(map[string]*sqlServerUserAssignedIdentity)
+		for _, id := range identityIds {
+			output.Identity.UserAssignedIdentities[id] = &sqlServerUserAssignedIdentity{}
+		}
+
+		// a Primary Identity is required when User Assigned Identities are used - when one isn't specified this
+		// defaults to the first (sorted) one, which is also the case when the previous (computed) Primary Identity
+		// has been removed from the `identity_ids`
+		primaryIdentityId := identityIds[0]
+		if v, ok := d.GetOk("primary_user_assigned_identity_id"); ok {
+			if _, exists := output.Identity.UserAssignedIdentities[v.(string)]; exists {
+				primaryIdentityId = v.(string)
+			} else if d.HasChange("primary_user_assigned_identity_id") {
+				return nil, fmt.Errorf("`primary_user_assigned_identity_id` must be one of `identity.0.identity_ids`")
+			}
+		}
+
+		output.Properties = &sqlServerExtendedProperties{
+			PrimaryUserAssignedIdentityID: utils.String(primaryIdentityId),
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenArmSqlServerIdentity(input *sqlServerIdentityDetails) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         strings.Replace(*input.Type, ", ", ",", -1),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
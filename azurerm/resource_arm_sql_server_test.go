@@ -2,14 +2,91 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func init() {
+	resource.AddTestSweepers("azurerm_sql_server", &resource.Sweeper{
+		Name: "azurerm_sql_server",
+		// the Databases & Elastic Pools are removed first so that the Servers can be deleted
+		Dependencies: []string{
+			"azurerm_mssql_elasticpool",
+			"azurerm_sql_database",
+		},
+		F: testSweepSqlServers,
+	})
+}
+
+func testSweepSqlServers(region string) error {
+	return testSweepForEachAcceptanceTestSqlServer(region, func(client *ArmClient, resourceGroup string, serverName string) error {
+		ctx := client.StopContext
+		serversClient := client.sqlServersClient
+
+		log.Printf("[DEBUG] Sweeping SQL Server %q (Resource Group %q)", serverName, resourceGroup)
+		future, err := serversClient.Delete(ctx, resourceGroup, serverName)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+
+			return fmt.Errorf("Error deleting SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, serversClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for deletion of SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+
+		return nil
+	})
+}
+
+// testSweepForEachAcceptanceTestSqlServer invokes `f` for each SQL Server in the region which was created by the acceptance tests
+func testSweepForEachAcceptanceTestSqlServer(region string, f func(client *ArmClient, resourceGroup string, serverName string) error) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	ctx := client.StopContext
+	servers, err := client.sqlServersClient.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing SQL Servers: %+v", err)
+	}
+
+	for servers.NotDone() {
+		server := servers.Value()
+		if server.ID != nil && server.Name != nil && server.Location != nil {
+			if shouldSweepAcceptanceTestResource(*server.Name, *server.Location, region) {
+				id, err := parseAzureResourceID(*server.ID)
+				if err != nil {
+					return err
+				}
+
+				if err := f(client, id.ResourceGroup, *server.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := servers.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing SQL Servers: %+v", err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAzureRMSqlServer_basic(t *testing.T) {
 	resourceName := "azurerm_sql_server.test"
 	ri := tf.AccRandTimeInt()
@@ -119,6 +196,263 @@ func TestAccAzureRMSqlServer_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlServer_publicNetworkAccessAndMinimumTlsVersion(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlServer_publicNetworkAccessAndMinimumTlsVersion(ri, location, false, "1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_tls_version", "1.2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlServer_publicNetworkAccessAndMinimumTlsVersion(ri, location, true, "1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_tls_version", "1.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_outboundNetworkRestriction(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_network_restriction_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlServer_outboundNetworkRestriction(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_network_restriction_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlServer_outboundNetworkRestriction(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_network_restriction_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_connectionPolicy(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_policy", "Default"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlServer_connectionPolicy(ri, location, "Redirect"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_policy", "Redirect"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlServer_connectionPolicy(ri, location, "Proxy"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_policy", "Proxy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_systemAssignedIdentity(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_systemAssignedIdentity(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestMatchResourceAttr(resourceName, "identity.0.principal_id", validate.UUIDRegExp),
+					resource.TestMatchResourceAttr(resourceName, "identity.0.tenant_id", validate.UUIDRegExp),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlServer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_userAssignedIdentity(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_userAssignedIdentity(ri, location, "UserAssigned"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "UserAssigned"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.identity_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_user_assigned_identity_id", "azurerm_user_assigned_identity.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlServer_userAssignedIdentity(ri, location, "SystemAssigned,UserAssigned"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned,UserAssigned"),
+					resource.TestMatchResourceAttr(resourceName, "identity.0.principal_id", validate.UUIDRegExp),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_keyVaultSecretPassword(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_keyVaultSecretPassword(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "administrator_login_password", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_login_password_key_vault_secret_id", "azurerm_key_vault_secret.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_importIncludingChildren(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServer_withChildren(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return rs.Primary.ID + sqlServerImportChildrenSuffix, nil
+				},
+				ImportStateCheck: testCheckAzureRMSqlServerImportedChildren(map[string]int{
+					"azurerm_sql_server":        1,
+					"azurerm_mssql_elasticpool": 1,
+					"azurerm_sql_database":      1,
+					"azurerm_sql_firewall_rule": 1,
+				}),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -199,6 +533,27 @@ func testCheckAzureRMSqlServerDisappears(resourceName string) resource.TestCheck
 	}
 }
 
+func testCheckAzureRMSqlServerImportedChildren(expected map[string]int) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		actual := make(map[string]int)
+		for _, state := range states {
+			actual[state.Ephemeral.Type]++
+		}
+
+		for resourceType, count := range expected {
+			if actual[resourceType] != count {
+				return fmt.Errorf("Expected %d imported %q resources but got %d", count, resourceType, actual[resourceType])
+			}
+		}
+
+		if len(states) != len(expected) {
+			return fmt.Errorf("Expected %d imported resources but got %d", len(expected), len(states))
+		}
+
+		return nil
+	}
+}
+
 func testAccAzureRMSqlServer_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -276,3 +631,277 @@ resource "azurerm_sql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMSqlServer_publicNetworkAccessAndMinimumTlsVersion(rInt int, location string, publicNetworkAccessEnabled bool, minimumTlsVersion string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                          = "acctestsqlserver%[1]d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  version                       = "12.0"
+  administrator_login           = "mradministrator"
+  administrator_login_password  = "thisIsDog11"
+  public_network_access_enabled = %[3]t
+  minimum_tls_version           = "%[4]s"
+}
+`, rInt, location, publicNetworkAccessEnabled, minimumTlsVersion)
+}
+
+func testAccAzureRMSqlServer_outboundNetworkRestriction(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                                 = "acctestsqlserver%[1]d"
+  resource_group_name                  = "${azurerm_resource_group.test.name}"
+  location                             = "${azurerm_resource_group.test.location}"
+  version                              = "12.0"
+  administrator_login                  = "mradministrator"
+  administrator_login_password         = "thisIsDog11"
+  outbound_network_restriction_enabled = %[3]t
+}
+`, rInt, location, enabled)
+}
+
+func testAccAzureRMSqlServer_connectionPolicy(rInt int, location string, connectionPolicy string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+  connection_policy            = "%[3]s"
+}
+`, rInt, location, connectionPolicy)
+}
+
+func testAccAzureRMSqlServer_systemAssignedIdentity(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMSqlServer_userAssignedIdentity(rInt int, location string, identityType string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type         = "%[3]s"
+    identity_ids = ["${azurerm_user_assigned_identity.test.id}"]
+  }
+}
+`, rInt, location, identityType)
+}
+
+func testAccAzureRMSqlServer_keyVaultSecretPassword(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name      = "sql-admin-password"
+  value     = "thisIsDog11"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                                             = "acctestsqlserver%d"
+  resource_group_name                              = "${azurerm_resource_group.test.name}"
+  location                                         = "${azurerm_resource_group.test.location}"
+  version                                          = "12.0"
+  administrator_login                              = "mradministrator"
+  administrator_login_password_key_vault_secret_id = "${azurerm_key_vault_secret.test.id}"
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMSqlServer_withChildren(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-%[2]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[2]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_sql_firewall_rule" "test" {
+  name                = "acctestfwrule%[2]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  start_ip_address    = "0.0.0.0"
+  end_ip_address      = "0.0.0.0"
+}
+`, testAccAzureRMSqlServer_basic(rInt, location), rInt)
+}
+
+func TestSqlDatabaseConnectionStringTemplate(t *testing.T) {
+	expected := "Server=tcp:example.database.windows.net,1433;Initial Catalog=exampledb;Persist Security Info=False;User ID={your_username};Password={your_password};MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;"
+
+	client := &ArmClient{
+		environment: az.PublicCloud,
+	}
+
+	fqdn := sqlServerFullyQualifiedDomainName(client, "example")
+	if fqdn != "example.database.windows.net" {
+		t.Fatalf("Expected the FQDN to be %q but got %q", "example.database.windows.net", fqdn)
+	}
+
+	if actual := sqlDatabaseConnectionStringTemplate(fqdn, "exampledb"); actual != expected {
+		t.Fatalf("Expected the Connection String Template to be %q but got %q", expected, actual)
+	}
+}
+
+func TestExpandArmSqlServerIdentityPrimaryUserAssignedIdentity(t *testing.T) {
+	first := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"
+	second := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second"
+	other := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/other"
+
+	cases := []struct {
+		Primary  string
+		Expected string
+		Errors   bool
+	}{
+		{
+			Primary:  "",
+			Expected: first,
+		},
+		{
+			Primary:  second,
+			Expected: second,
+		},
+		{
+			Primary: other,
+			Errors:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"identity": []interface{}{
+				map[string]interface{}{
+					"type":         "UserAssigned",
+					"identity_ids": []interface{}{second, first},
+				},
+			},
+		}
+		if tc.Primary != "" {
+			raw["primary_user_assigned_identity_id"] = tc.Primary
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceArmSqlServer().Schema, raw)
+		actual, err := expandArmSqlServerIdentity(d)
+		if tc.Errors {
+			if err == nil {
+				t.Fatalf("Expected an error for the Primary Identity %q but didn't get one", tc.Primary)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for the Primary Identity %q but got: %+v", tc.Primary, err)
+		}
+
+		if v := actual.Properties.PrimaryUserAssignedIdentityID; v == nil || *v != tc.Expected {
+			t.Fatalf("Expected the Primary Identity to be %q but got %v", tc.Expected, v)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -29,6 +30,8 @@ func resourceArmStorageAccount() *schema.Resource {
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		CustomizeDiff: customizeDiffNameAvailability("Storage Account", resourceArmStorageAccountNameAvailability),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -977,3 +980,17 @@ func flattenAzureRmStorageAccountIdentity(identity *storage.Identity) []interfac
 
 	return []interface{}{result}
 }
+
+func resourceArmStorageAccountNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	input := storage.AccountCheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Storage/storageAccounts"),
+	}
+	resp, err := client.storageServiceClient.CheckNameAvailability(ctx, input)
+	if err != nil {
+		return false, "", err
+	}
+
+	available := resp.NameAvailable != nil && *resp.NameAvailable
+	return available, nameAvailabilityReason(resp.Message, string(resp.Reason)), nil
+}
@@ -0,0 +1,167 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2018-07-01/storagesync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageSyncCreate,
+		Read:   resourceArmStorageSyncRead,
+		Update: resourceArmStorageSyncUpdate,
+		Delete: resourceArmStorageSyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmStorageSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_sync", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := storagesync.ServiceCreateParameters{
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Storage Sync Service %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageSyncRead(d, meta)
+}
+
+func resourceArmStorageSyncRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["storageSyncServices"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Storage Sync Service %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["storageSyncServices"]
+
+	if d.HasChange("tags") {
+		tags := d.Get("tags").(map[string]interface{})
+		parameters := storagesync.ServiceUpdateParameters{
+			Tags: expandTags(tags),
+		}
+
+		if _, err := client.Update(ctx, resourceGroup, name, &parameters); err != nil {
+			return fmt.Errorf("Error updating Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return resourceArmStorageSyncRead(d, meta)
+}
+
+func resourceArmStorageSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["storageSyncServices"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Storage Sync Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
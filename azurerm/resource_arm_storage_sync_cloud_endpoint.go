@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2018-07-01/storagesync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageSyncCloudEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageSyncCloudEndpointCreate,
+		Read:   resourceArmStorageSyncCloudEndpointRead,
+		Delete: resourceArmStorageSyncCloudEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncName,
+			},
+
+			"storage_sync_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"file_share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"storage_account_tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmStorageSyncCloudEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncCloudEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	syncGroupId, err := parseAzureResourceID(d.Get("storage_sync_group_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := syncGroupId.ResourceGroup
+	storageSyncName := syncGroupId.Path["storageSyncServices"]
+	syncGroupName := syncGroupId.Path["syncGroups"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_sync_cloud_endpoint", *existing.ID)
+		}
+	}
+
+	tenantId := meta.(*ArmClient).tenantId
+	if v, ok := d.GetOk("storage_account_tenant_id"); ok {
+		tenantId = v.(string)
+	}
+
+	parameters := storagesync.CloudEndpointCreateParameters{
+		CloudEndpointCreateParametersProperties: &storagesync.CloudEndpointCreateParametersProperties{
+			StorageAccountResourceID: utils.String(d.Get("storage_account_id").(string)),
+			StorageAccountShareName:  utils.String(d.Get("file_share_name").(string)),
+			StorageAccountTenantID:   utils.String(tenantId),
+		},
+	}
+
+	future, err := client.Create(ctx, resourceGroup, storageSyncName, syncGroupName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) ID", name, syncGroupName, storageSyncName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageSyncCloudEndpointRead(d, meta)
+}
+
+func resourceArmStorageSyncCloudEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncCloudEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["cloudEndpoints"]
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Cloud Endpoint %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("storage_sync_group_id", storageSyncGroupIDFromChildID(id))
+
+	if props := resp.CloudEndpointProperties; props != nil {
+		d.Set("storage_account_id", props.StorageAccountResourceID)
+		d.Set("file_share_name", props.StorageAccountShareName)
+		d.Set("storage_account_tenant_id", props.StorageAccountTenantID)
+	}
+
+	return nil
+}
+
+func resourceArmStorageSyncCloudEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncCloudEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["cloudEndpoints"]
+
+	future, err := client.Delete(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
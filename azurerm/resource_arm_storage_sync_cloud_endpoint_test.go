@@ -0,0 +1,177 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageSyncCloudEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_storage_sync_cloud_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMStorageSyncCloudEndpoint_basic(ri, rs, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageSyncCloudEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncCloudEndpointExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_account_tenant_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageSyncCloudEndpoint_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_sync_cloud_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageSyncCloudEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageSyncCloudEndpoint_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncCloudEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageSyncCloudEndpoint_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_sync_cloud_endpoint"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageSyncCloudEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		syncGroupName := id.Path["syncGroups"]
+		name := id.Path["cloudEndpoints"]
+
+		client := testAccProvider.Meta().(*ArmClient).storageSyncCloudEndpointsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) does not exist", name, syncGroupName, storageSyncName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageSyncCloudEndpointsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageSyncCloudEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storageSyncCloudEndpointsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_sync_cloud_endpoint" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		syncGroupName := id.Path["syncGroups"]
+		name := id.Path["cloudEndpoints"]
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cloud Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) still exists", name, syncGroupName, storageSyncName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageSyncCloudEndpoint_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageSyncGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctest-share-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_storage_sync_cloud_endpoint" "test" {
+  name                  = "acctest-ce-%d"
+  storage_sync_group_id = "${azurerm_storage_sync_group.test.id}"
+  storage_account_id    = "${azurerm_storage_account.test.id}"
+  file_share_name       = "${azurerm_storage_share.test.name}"
+}
+`, template, rString, rInt, rInt)
+}
+
+func testAccAzureRMStorageSyncCloudEndpoint_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageSyncCloudEndpoint_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_cloud_endpoint" "import" {
+  name                  = "${azurerm_storage_sync_cloud_endpoint.test.name}"
+  storage_sync_group_id = "${azurerm_storage_sync_cloud_endpoint.test.storage_sync_group_id}"
+  storage_account_id    = "${azurerm_storage_sync_cloud_endpoint.test.storage_account_id}"
+  file_share_name       = "${azurerm_storage_sync_cloud_endpoint.test.file_share_name}"
+}
+`, template)
+}
@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2018-07-01/storagesync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageSyncGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageSyncGroupCreate,
+		Read:   resourceArmStorageSyncGroupRead,
+		Delete: resourceArmStorageSyncGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncName,
+			},
+
+			"storage_sync_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmStorageSyncGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	storageSyncId, err := parseAzureResourceID(d.Get("storage_sync_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := storageSyncId.ResourceGroup
+	storageSyncName := storageSyncId.Path["storageSyncServices"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, storageSyncName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Sync Group %q (Storage Sync Service %q / Resource Group %q): %+v", name, storageSyncName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_sync_group", *existing.ID)
+		}
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, storageSyncName, name, storagesync.SyncGroupCreateParameters{}); err != nil {
+		return fmt.Errorf("Error creating Sync Group %q (Storage Sync Service %q / Resource Group %q): %+v", name, storageSyncName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Sync Group %q (Storage Sync Service %q / Resource Group %q): %+v", name, storageSyncName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Sync Group %q (Storage Sync Service %q / Resource Group %q) ID", name, storageSyncName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageSyncGroupRead(d, meta)
+}
+
+func resourceArmStorageSyncGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	name := id.Path["syncGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Sync Group %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Sync Group %q (Storage Sync Service %q / Resource Group %q): %+v", name, storageSyncName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("storage_sync_id", storageSyncServiceIDFromChildID(id))
+
+	return nil
+}
+
+func resourceArmStorageSyncGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	name := id.Path["syncGroups"]
+
+	resp, err := client.Delete(ctx, resourceGroup, storageSyncName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Sync Group %q (Storage Sync Service %q / Resource Group %q): %+v", name, storageSyncName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// storageSyncServiceIDFromChildID returns the ID of the Storage Sync Service containing the specified child resource
+func storageSyncServiceIDFromChildID(id *ResourceID) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageSync/storageSyncServices/%s", id.SubscriptionID, id.ResourceGroup, id.Path["storageSyncServices"])
+}
+
+// storageSyncGroupIDFromChildID returns the ID of the Sync Group containing the specified child resource
+func storageSyncGroupIDFromChildID(id *ResourceID) string {
+	return fmt.Sprintf("%s/syncGroups/%s", storageSyncServiceIDFromChildID(id), id.Path["syncGroups"])
+}
@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageSyncGroup_basic(t *testing.T) {
+	resourceName := "azurerm_storage_sync_group.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMStorageSyncGroup_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncGroupExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageSyncGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_sync_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageSyncGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageSyncGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_storage_sync_group"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageSyncGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		name := id.Path["syncGroups"]
+
+		client := testAccProvider.Meta().(*ArmClient).storageSyncGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Sync Group %q (Storage Sync Service %q / Resource Group %q) does not exist", name, storageSyncName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageSyncGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageSyncGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storageSyncGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_sync_group" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		name := id.Path["syncGroups"]
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Sync Group %q (Storage Sync Service %q / Resource Group %q) still exists", name, storageSyncName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageSyncGroup_basic(rInt int, location string) string {
+	template := testAccAzureRMStorageSync_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_group" "test" {
+  name            = "acctest-sg-%d"
+  storage_sync_id = "${azurerm_storage_sync.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStorageSyncGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMStorageSyncGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_group" "import" {
+  name            = "${azurerm_storage_sync_group.test.name}"
+  storage_sync_id = "${azurerm_storage_sync_group.test.storage_sync_id}"
+}
+`, template)
+}
@@ -0,0 +1,258 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2018-07-01/storagesync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageSyncServerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageSyncServerEndpointCreate,
+		Read:   resourceArmStorageSyncServerEndpointRead,
+		Update: resourceArmStorageSyncServerEndpointUpdate,
+		Delete: resourceArmStorageSyncServerEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncName,
+			},
+
+			"storage_sync_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"registered_server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"server_local_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"cloud_tiering_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"volume_free_space_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+
+			"tier_files_older_than_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+		},
+	}
+}
+
+func resourceArmStorageSyncServerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServerEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	syncGroupId, err := parseAzureResourceID(d.Get("storage_sync_group_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := syncGroupId.ResourceGroup
+	storageSyncName := syncGroupId.Path["storageSyncServices"]
+	syncGroupName := syncGroupId.Path["syncGroups"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_sync_server_endpoint", *existing.ID)
+		}
+	}
+
+	cloudTiering := storagesync.Off
+	if d.Get("cloud_tiering_enabled").(bool) {
+		cloudTiering = storagesync.On
+	}
+
+	props := storagesync.ServerEndpointCreateParametersProperties{
+		ServerResourceID:       utils.String(d.Get("registered_server_id").(string)),
+		ServerLocalPath:        utils.String(d.Get("server_local_path").(string)),
+		CloudTiering:           cloudTiering,
+		VolumeFreeSpacePercent: utils.Int32(int32(d.Get("volume_free_space_percent").(int))),
+	}
+
+	if v, ok := d.GetOk("tier_files_older_than_days"); ok {
+		props.TierFilesOlderThanDays = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := storagesync.ServerEndpointCreateParameters{
+		ServerEndpointCreateParametersProperties: &props,
+	}
+
+	future, err := client.Create(ctx, resourceGroup, storageSyncName, syncGroupName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) ID", name, syncGroupName, storageSyncName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageSyncServerEndpointRead(d, meta)
+}
+
+func resourceArmStorageSyncServerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServerEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["serverEndpoints"]
+
+	resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Server Endpoint %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("storage_sync_group_id", storageSyncGroupIDFromChildID(id))
+
+	if props := resp.ServerEndpointProperties; props != nil {
+		d.Set("registered_server_id", props.ServerResourceID)
+		d.Set("server_local_path", props.ServerLocalPath)
+		d.Set("cloud_tiering_enabled", props.CloudTiering == storagesync.CloudTiering2On)
+		d.Set("volume_free_space_percent", props.VolumeFreeSpacePercent)
+		d.Set("tier_files_older_than_days", props.TierFilesOlderThanDays)
+	}
+
+	return nil
+}
+
+func resourceArmStorageSyncServerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServerEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["serverEndpoints"]
+
+	cloudTiering := storagesync.CloudTiering1Off
+	if d.Get("cloud_tiering_enabled").(bool) {
+		cloudTiering = storagesync.CloudTiering1On
+	}
+
+	props := storagesync.ServerEndpointUpdateProperties{
+		CloudTiering:           cloudTiering,
+		VolumeFreeSpacePercent: utils.Int32(int32(d.Get("volume_free_space_percent").(int))),
+	}
+
+	if v, ok := d.GetOk("tier_files_older_than_days"); ok {
+		props.TierFilesOlderThanDays = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := storagesync.ServerEndpointUpdateParameters{
+		ServerEndpointUpdateProperties: &props,
+	}
+
+	future, err := client.Update(ctx, resourceGroup, storageSyncName, syncGroupName, name, &parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	return resourceArmStorageSyncServerEndpointRead(d, meta)
+}
+
+func resourceArmStorageSyncServerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageSyncServerEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageSyncName := id.Path["storageSyncServices"]
+	syncGroupName := id.Path["syncGroups"]
+	name := id.Path["serverEndpoints"]
+
+	future, err := client.Delete(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q): %+v", name, syncGroupName, storageSyncName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageSyncServerEndpoint_basic(t *testing.T) {
+	// Servers can only be registered by the Azure File Sync agent running on them,
+	// as such this test requires an existing Storage Sync Service with a Registered Server
+	storageSyncIdEnvVariable := "ARM_TEST_STORAGE_SYNC_ID"
+	storageSyncId := os.Getenv(storageSyncIdEnvVariable)
+	if storageSyncId == "" {
+		t.Skipf("Skipping as %q is not specified", storageSyncIdEnvVariable)
+	}
+
+	serverNameEnvVariable := "ARM_TEST_STORAGE_SYNC_SERVER_NAME"
+	serverName := os.Getenv(serverNameEnvVariable)
+	if serverName == "" {
+		t.Skipf("Skipping as %q is not specified", serverNameEnvVariable)
+	}
+
+	resourceName := "azurerm_storage_sync_server_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageSyncServerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageSyncServerEndpoint_basic(ri, rs, location, storageSyncId, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncServerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloud_tiering_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageSyncServerEndpoint_cloudTiering(ri, rs, location, storageSyncId, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageSyncServerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloud_tiering_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "volume_free_space_percent", "30"),
+					resource.TestCheckResourceAttr(resourceName, "tier_files_older_than_days", "14"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageSyncServerEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		syncGroupName := id.Path["syncGroups"]
+		name := id.Path["serverEndpoints"]
+
+		client := testAccProvider.Meta().(*ArmClient).storageSyncServerEndpointsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) does not exist", name, syncGroupName, storageSyncName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageSyncServerEndpointsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageSyncServerEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storageSyncServerEndpointsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_sync_server_endpoint" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		storageSyncName := id.Path["storageSyncServices"]
+		syncGroupName := id.Path["syncGroups"]
+		name := id.Path["serverEndpoints"]
+
+		resp, err := client.Get(ctx, resourceGroup, storageSyncName, syncGroupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Server Endpoint %q (Sync Group %q / Storage Sync Service %q / Resource Group %q) still exists", name, syncGroupName, storageSyncName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageSyncServerEndpoint_basic(rInt int, rString string, location string, storageSyncId string, serverName string) string {
+	template := testAccAzureRMStorageSyncServerEndpoint_template(rInt, rString, location, storageSyncId, serverName)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_server_endpoint" "test" {
+  name                  = "acctest-se-%d"
+  storage_sync_group_id = "${azurerm_storage_sync_group.test.id}"
+  registered_server_id  = "${data.azurerm_storage_sync_registered_server.test.id}"
+  server_local_path     = "D:\\acctest-%d"
+
+  depends_on = ["azurerm_storage_sync_cloud_endpoint.test"]
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageSyncServerEndpoint_cloudTiering(rInt int, rString string, location string, storageSyncId string, serverName string) string {
+	template := testAccAzureRMStorageSyncServerEndpoint_template(rInt, rString, location, storageSyncId, serverName)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_server_endpoint" "test" {
+  name                       = "acctest-se-%d"
+  storage_sync_group_id      = "${azurerm_storage_sync_group.test.id}"
+  registered_server_id       = "${data.azurerm_storage_sync_registered_server.test.id}"
+  server_local_path          = "D:\\acctest-%d"
+  cloud_tiering_enabled      = true
+  volume_free_space_percent  = 30
+  tier_files_older_than_days = 14
+
+  depends_on = ["azurerm_storage_sync_cloud_endpoint.test"]
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageSyncServerEndpoint_template(rInt int, rString string, location string, storageSyncId string, serverName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+data "azurerm_storage_sync_registered_server" "test" {
+  storage_sync_id = "%[4]s"
+  friendly_name   = "%[5]s"
+}
+
+resource "azurerm_storage_sync_group" "test" {
+  name            = "acctest-sg-%[1]d"
+  storage_sync_id = "%[4]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctest-share-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_storage_sync_cloud_endpoint" "test" {
+  name                  = "acctest-ce-%[1]d"
+  storage_sync_group_id = "${azurerm_storage_sync_group.test.id}"
+  storage_account_id    = "${azurerm_storage_account.test.id}"
+  file_share_name       = "${azurerm_storage_share.test.name}"
+}
+`, rInt, rString, location, storageSyncId, serverName)
+}
@@ -1,10 +1,14 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -94,9 +98,11 @@ func resourceArmSubnet() *schema.Resource {
 											"Microsoft.Logic/integrationServiceEnvironments",
 											"Microsoft.Netapp/volumes",
 											"Microsoft.ServiceFabricMesh/networks",
+											"Microsoft.ServiceNetworking/trafficControllers",
 											"Microsoft.Sql/managedInstances",
 											"Microsoft.Sql/servers",
 											"Microsoft.Web/serverFarms",
+											"NGINX.NGINXPLUS/nginxDeployments",
 										}, false),
 									},
 									"actions": {
@@ -106,6 +112,7 @@ func resourceArmSubnet() *schema.Resource {
 											Type: schema.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
 												"Microsoft.Network/virtualNetworks/subnets/action",
+												"Microsoft.Network/virtualNetworks/subnets/join/action",
 											}, false),
 										},
 									},
@@ -318,6 +325,10 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	azureRMLockByName(name, subnetResourceName)
 	defer azureRMUnlockByName(name, subnetResourceName)
 
+	if err := cleanupArmSubnetContainerNetworking(ctx, meta.(*ArmClient), resGroup, vnetName, name); err != nil {
+		return fmt.Errorf("Error cleaning up the Container Networking for Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+
 	future, err := client.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
 		return fmt.Errorf("Error deleting Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
@@ -330,6 +341,157 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// cleanupArmSubnetContainerNetworking removes the Service Association Link which Azure Container Instances leaves on a
+// Subnet once the last Network Profile using it has been removed, which otherwise blocks the deletion of the Subnet.
+// Where enabled in the `features` block, Network Profiles which reference the Subnet but are no longer used by any
+// Container Groups are deleted first.
+func cleanupArmSubnetContainerNetworking(ctx context.Context, client *ArmClient, resourceGroup string, virtualNetworkName string, name string) error {
+	subnet, err := client.subnetClient.Get(ctx, resourceGroup, virtualNetworkName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet: %+v", err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil || !subnetHasContainerInstanceServiceAssociationLink(props.ServiceAssociationLinks) {
+		return nil
+	}
+
+	if props.IPConfigurationProfiles != nil && len(*props.IPConfigurationProfiles) > 0 {
+		networkProfileIds := subnetNetworkProfileIds(props.IPConfigurationProfiles)
+		if !client.deleteUnusedNetworkProfiles {
+			return fmt.Errorf("the Subnet is still used by the Network Profiles %s - these must be deleted before the Subnet (or `delete_unused_network_profiles_on_subnet_destroy` enabled in the `features` block to delete these when they're no longer used by any Container Groups)", strings.Join(networkProfileIds, ", "))
+		}
+
+		for _, networkProfileId := range networkProfileIds {
+			if err := deleteArmUnusedNetworkProfile(ctx, client.networkProfilesClient, networkProfileId); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting the Container Instance Service Association Link from Subnet %q (Virtual Network %q / Resource Group %q)", name, virtualNetworkName, resourceGroup)
+	resp, err := client.containerServiceLinksClient.Delete(ctx, resourceGroup, virtualNetworkName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting the Container Instance Service Association Link: %+v", err)
+		}
+	}
+
+	// the Service Association Link is removed from the Subnet asynchronously
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Linked"},
+		Target:  []string{"Unlinked"},
+		Refresh: func() (interface{}, string, error) {
+			subnet, err := client.subnetClient.Get(ctx, resourceGroup, virtualNetworkName, name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(subnet.Response) {
+					return subnet, "Unlinked", nil
+				}
+
+				return nil, "", err
+			}
+
+			if props := subnet.SubnetPropertiesFormat; props != nil && subnetHasContainerInstanceServiceAssociationLink(props.ServiceAssociationLinks) {
+				return subnet, "Linked", nil
+			}
+
+			return subnet, "Unlinked", nil
+		},
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Container Instance Service Association Link to be removed: %+v", err)
+	}
+
+	return nil
+}
+
+// deleteArmUnusedNetworkProfile deletes the Network Profile when no Container Groups are using it
+func deleteArmUnusedNetworkProfile(ctx context.Context, client network.ProfilesClient, networkProfileId string) error {
+	id, err := parseAzureResourceID(networkProfileId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["networkProfiles"]
+
+	azureRMLockByName(name, networkProfileResourceName)
+	defer azureRMUnlockByName(name, networkProfileResourceName)
+
+	profile, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(profile.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if props := profile.ProfilePropertiesFormat; props != nil && props.ContainerNetworkInterfaces != nil && len(*props.ContainerNetworkInterfaces) > 0 {
+		return fmt.Errorf("Network Profile %q (Resource Group %q) is still used by %d Container Network Interfaces - the Container Groups using it must be deleted first", name, resourceGroup, len(*props.ContainerNetworkInterfaces))
+	}
+
+	log.Printf("[DEBUG] Deleting unused Network Profile %q (Resource Group %q)", name, resourceGroup)
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func subnetHasContainerInstanceServiceAssociationLink(input *[]network.ServiceAssociationLink) bool {
+	if input == nil {
+		return false
+	}
+
+	for _, link := range *input {
+		if props := link.ServiceAssociationLinkPropertiesFormat; props != nil && props.LinkedResourceType != nil {
+			if strings.EqualFold(*props.LinkedResourceType, "Microsoft.ContainerInstance/containerGroups") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// subnetNetworkProfileIds returns the IDs of the Network Profiles which the IP Configuration Profiles belong to, which
+// are in the format `{networkProfileId}/containerNetworkInterfaceConfigurations/{name}/ipConfigurations/{name}`
+func subnetNetworkProfileIds(input *[]network.IPConfigurationProfile) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	seen := make(map[string]bool)
+	for _, profile := range *input {
+		if profile.ID == nil {
+			continue
+		}
+
+		networkProfileId := *profile.ID
+		if i := strings.Index(strings.ToLower(networkProfileId), "/containernetworkinterfaceconfigurations/"); i != -1 {
+			networkProfileId = networkProfileId[:i]
+		}
+
+		if !seen[strings.ToLower(networkProfileId)] {
+			seen[strings.ToLower(networkProfileId)] = true
+			results = append(results, networkProfileId)
+		}
+	}
+
+	return results
+}
+
 func expandSubnetServiceEndpoints(d *schema.ResourceData) []network.ServiceEndpointPropertiesFormat {
 	serviceEndpoints := d.Get("service_endpoints").([]interface{})
 	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
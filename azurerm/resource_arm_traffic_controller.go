@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Application Gateway for Containers (Traffic Controllers) aren't present in the vendored SDK, so are managed using raw requests
+const trafficControllerApiVersion = "2023-11-01"
+
+type trafficController struct {
+	ID         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Tags       map[string]*string           `json:"tags"`
+	Properties *trafficControllerProperties `json:"properties,omitempty"`
+}
+
+type trafficControllerProperties struct {
+	ConfigurationEndpoints *[]string                       `json:"configurationEndpoints,omitempty"`
+	Frontends              *[]trafficControllerSubResource `json:"frontends,omitempty"`
+	Associations           *[]trafficControllerSubResource `json:"associations,omitempty"`
+}
+
+type trafficControllerSubResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+func resourceArmTrafficController() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTrafficControllerCreateUpdate,
+		Read:   resourceArmTrafficControllerRead,
+		Update: resourceArmTrafficControllerCreateUpdate,
+		Delete: resourceArmTrafficControllerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficControllerName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"configuration_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"frontend_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"association_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmTrafficControllerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := trafficControllerID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing trafficController
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Traffic Controller %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_traffic_controller", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := trafficController{
+		Location:   utils.String(location),
+		Properties: &trafficControllerProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Traffic Controller %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read trafficController
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Traffic Controller %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Traffic Controller %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmTrafficControllerRead(d, meta)
+}
+
+func resourceArmTrafficControllerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["trafficControllers"]
+
+	var controller trafficController
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion, &controller)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Traffic Controller %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Traffic Controller %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", controller.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := controller.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := controller.Properties; props != nil {
+		if err := d.Set("configuration_endpoints", utils.FlattenStringArray(props.ConfigurationEndpoints)); err != nil {
+			return fmt.Errorf("Error setting `configuration_endpoints`: %+v", err)
+		}
+
+		if err := d.Set("frontend_ids", flattenTrafficControllerSubResourceIDs(props.Frontends)); err != nil {
+			return fmt.Errorf("Error setting `frontend_ids`: %+v", err)
+		}
+
+		if err := d.Set("association_ids", flattenTrafficControllerSubResourceIDs(props.Associations)); err != nil {
+			return fmt.Errorf("Error setting `association_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, controller.Tags)
+
+	return nil
+}
+
+func resourceArmTrafficControllerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["trafficControllers"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Traffic Controller %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func flattenTrafficControllerSubResourceIDs(input *[]trafficControllerSubResource) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			output = append(output, *v.ID)
+		}
+	}
+
+	return output
+}
+
+func trafficControllerID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s", subscriptionId, resourceGroup, name)
+}
@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type trafficControllerAssociation struct {
+	ID         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Location   *string                                 `json:"location,omitempty"`
+	Tags       map[string]*string                      `json:"tags"`
+	Properties *trafficControllerAssociationProperties `json:"properties,omitempty"`
+}
+
+type trafficControllerAssociationProperties struct {
+	AssociationType *string                       `json:"associationType,omitempty"`
+	Subnet          *trafficControllerSubResource `json:"subnet,omitempty"`
+}
+
+func resourceArmTrafficControllerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTrafficControllerAssociationCreateUpdate,
+		Read:   resourceArmTrafficControllerAssociationRead,
+		Update: resourceArmTrafficControllerAssociationCreateUpdate,
+		Delete: resourceArmTrafficControllerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficControllerName,
+			},
+
+			"traffic_controller_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the Subnet must be delegated to `Microsoft.ServiceNetworking/trafficControllers`
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmTrafficControllerAssociationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	trafficControllerId := d.Get("traffic_controller_id").(string)
+	id := fmt.Sprintf("%s/associations/%s", trafficControllerId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing trafficControllerAssociation
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Traffic Controller Association %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_traffic_controller_association", *existing.ID)
+		}
+	}
+
+	// Associations must be created in the same location as the Traffic Controller
+	var controller trafficController
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, trafficControllerId, trafficControllerApiVersion, &controller); err != nil {
+		return fmt.Errorf("Error retrieving Traffic Controller %q: %+v", trafficControllerId, err)
+	}
+
+	subnetId := d.Get("subnet_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := trafficControllerAssociation{
+		Location: controller.Location,
+		Properties: &trafficControllerAssociationProperties{
+			AssociationType: utils.String("subnets"),
+			Subnet: &trafficControllerSubResource{
+				ID: utils.String(subnetId),
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Traffic Controller Association %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+	}
+
+	var read trafficControllerAssociation
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Traffic Controller Association %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Traffic Controller Association %q (Traffic Controller %q)", name, trafficControllerId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmTrafficControllerAssociationRead(d, meta)
+}
+
+func resourceArmTrafficControllerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	controllerName := id.Path["trafficControllers"]
+	name := id.Path["associations"]
+
+	var association trafficControllerAssociation
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion, &association)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Traffic Controller Association %q was not found in Traffic Controller %q - removing from state", name, controllerName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Traffic Controller Association %q (Traffic Controller %q / Resource Group %q): %+v", name, controllerName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", association.Name)
+	d.Set("traffic_controller_id", trafficControllerID(id.SubscriptionID, id.ResourceGroup, controllerName))
+
+	if props := association.Properties; props != nil {
+		if subnet := props.Subnet; subnet != nil {
+			d.Set("subnet_id", subnet.ID)
+		}
+	}
+
+	flattenAndSetTags(d, association.Tags)
+
+	return nil
+}
+
+func resourceArmTrafficControllerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	controllerName := id.Path["trafficControllers"]
+	name := id.Path["associations"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Traffic Controller Association %q (Traffic Controller %q / Resource Group %q): %+v", name, controllerName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
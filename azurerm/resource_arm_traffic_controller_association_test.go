@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTrafficControllerAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_traffic_controller_association.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficControllerAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficControllerAssociation_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_traffic_controller_association.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficControllerAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMTrafficControllerAssociation_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_traffic_controller_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMTrafficControllerAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var association trafficControllerAssociation
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &association)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Traffic Controller Association %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Traffic Controller Association %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTrafficControllerAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_traffic_controller_association" {
+			continue
+		}
+
+		var association trafficControllerAssociation
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &association)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Traffic Controller Association %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTrafficControllerAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name    = "Microsoft.ServiceNetworking/trafficControllers"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+    }
+  }
+}
+
+resource "azurerm_traffic_controller_association" "test" {
+  name                  = "acctest-tca-%d"
+  traffic_controller_id = "${azurerm_traffic_controller.test.id}"
+  subnet_id             = "${azurerm_subnet.test.id}"
+}
+`, testAccAzureRMTrafficController_basic(rInt, location), rInt, rInt, rInt)
+}
+
+func testAccAzureRMTrafficControllerAssociation_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_controller_association" "import" {
+  name                  = "${azurerm_traffic_controller_association.test.name}"
+  traffic_controller_id = "${azurerm_traffic_controller_association.test.traffic_controller_id}"
+  subnet_id             = "${azurerm_traffic_controller_association.test.subnet_id}"
+}
+`, testAccAzureRMTrafficControllerAssociation_basic(rInt, location))
+}
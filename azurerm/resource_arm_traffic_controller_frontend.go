@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type trafficControllerFrontend struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Location   *string                              `json:"location,omitempty"`
+	Tags       map[string]*string                   `json:"tags"`
+	Properties *trafficControllerFrontendProperties `json:"properties,omitempty"`
+}
+
+type trafficControllerFrontendProperties struct {
+	Fqdn *string `json:"fqdn,omitempty"`
+}
+
+func resourceArmTrafficControllerFrontend() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTrafficControllerFrontendCreateUpdate,
+		Read:   resourceArmTrafficControllerFrontendRead,
+		Update: resourceArmTrafficControllerFrontendCreateUpdate,
+		Delete: resourceArmTrafficControllerFrontendDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficControllerName,
+			},
+
+			"traffic_controller_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"fully_qualified_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmTrafficControllerFrontendCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	trafficControllerId := d.Get("traffic_controller_id").(string)
+	id := fmt.Sprintf("%s/frontends/%s", trafficControllerId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing trafficControllerFrontend
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Traffic Controller Frontend %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_traffic_controller_frontend", *existing.ID)
+		}
+	}
+
+	// Frontends must be created in the same location as the Traffic Controller
+	var controller trafficController
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, trafficControllerId, trafficControllerApiVersion, &controller); err != nil {
+		return fmt.Errorf("Error retrieving Traffic Controller %q: %+v", trafficControllerId, err)
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := trafficControllerFrontend{
+		Location:   controller.Location,
+		Properties: &trafficControllerFrontendProperties{},
+		Tags:       expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Traffic Controller Frontend %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+	}
+
+	var read trafficControllerFrontend
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, trafficControllerApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Traffic Controller Frontend %q (Traffic Controller %q): %+v", name, trafficControllerId, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Traffic Controller Frontend %q (Traffic Controller %q)", name, trafficControllerId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmTrafficControllerFrontendRead(d, meta)
+}
+
+func resourceArmTrafficControllerFrontendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	controllerName := id.Path["trafficControllers"]
+	name := id.Path["frontends"]
+
+	var frontend trafficControllerFrontend
+	resp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion, &frontend)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[INFO] Traffic Controller Frontend %q was not found in Traffic Controller %q - removing from state", name, controllerName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Traffic Controller Frontend %q (Traffic Controller %q / Resource Group %q): %+v", name, controllerName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", frontend.Name)
+	d.Set("traffic_controller_id", trafficControllerID(id.SubscriptionID, id.ResourceGroup, controllerName))
+
+	if props := frontend.Properties; props != nil {
+		d.Set("fully_qualified_domain_name", props.Fqdn)
+	}
+
+	flattenAndSetTags(d, frontend.Tags)
+
+	return nil
+}
+
+func resourceArmTrafficControllerFrontendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	controllerName := id.Path["trafficControllers"]
+	name := id.Path["frontends"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), trafficControllerApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Traffic Controller Frontend %q (Traffic Controller %q / Resource Group %q): %+v", name, controllerName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTrafficControllerFrontend_basic(t *testing.T) {
+	resourceName := "azurerm_traffic_controller_frontend.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerFrontendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficControllerFrontend_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerFrontendExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "fully_qualified_domain_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficControllerFrontend_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_traffic_controller_frontend.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerFrontendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficControllerFrontend_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerFrontendExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMTrafficControllerFrontend_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_traffic_controller_frontend"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMTrafficControllerFrontendExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var frontend trafficControllerFrontend
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &frontend)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Traffic Controller Frontend %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Traffic Controller Frontend %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTrafficControllerFrontendDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_traffic_controller_frontend" {
+			continue
+		}
+
+		var frontend trafficControllerFrontend
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &frontend)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Traffic Controller Frontend %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTrafficControllerFrontend_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_controller_frontend" "test" {
+  name                  = "acctest-tcf-%d"
+  traffic_controller_id = "${azurerm_traffic_controller.test.id}"
+}
+`, testAccAzureRMTrafficController_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMTrafficControllerFrontend_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_controller_frontend" "import" {
+  name                  = "${azurerm_traffic_controller_frontend.test.name}"
+  traffic_controller_id = "${azurerm_traffic_controller_frontend.test.traffic_controller_id}"
+}
+`, testAccAzureRMTrafficControllerFrontend_basic(rInt, location))
+}
@@ -0,0 +1,194 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTrafficController_basic(t *testing.T) {
+	resourceName := "azurerm_traffic_controller.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficController_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_endpoints.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficController_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_traffic_controller.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficController_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMTrafficController_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_traffic_controller"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficController_update(t *testing.T) {
+	resourceName := "azurerm_traffic_controller.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficControllerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficController_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMTrafficController_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficControllerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMTrafficControllerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var controller trafficController
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &controller)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Bad: Traffic Controller %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Traffic Controller %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTrafficControllerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_traffic_controller" {
+			continue
+		}
+
+		var controller trafficController
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, trafficControllerApiVersion, &controller)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Traffic Controller %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTrafficController_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_controller" "test" {
+  name                = "acctest-tc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTrafficController_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_controller" "import" {
+  name                = "${azurerm_traffic_controller.test.name}"
+  resource_group_name = "${azurerm_traffic_controller.test.resource_group_name}"
+  location            = "${azurerm_traffic_controller.test.location}"
+}
+`, testAccAzureRMTrafficController_basic(rInt, location))
+}
+
+func testAccAzureRMTrafficController_tags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_controller" "test" {
+  name                = "acctest-tc-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
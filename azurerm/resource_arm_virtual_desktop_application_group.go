@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type virtualDesktopApplicationGroup struct {
+	ID         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Location   *string                                   `json:"location,omitempty"`
+	Tags       map[string]*string                        `json:"tags"`
+	Properties *virtualDesktopApplicationGroupProperties `json:"properties,omitempty"`
+}
+
+type virtualDesktopApplicationGroupProperties struct {
+	FriendlyName         *string `json:"friendlyName,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	HostPoolArmPath      *string `json:"hostPoolArmPath,omitempty"`
+	ApplicationGroupType *string `json:"applicationGroupType,omitempty"`
+}
+
+func resourceArmVirtualDesktopApplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualDesktopApplicationGroupCreateUpdate,
+		Read:   resourceArmVirtualDesktopApplicationGroupRead,
+		Update: resourceArmVirtualDesktopApplicationGroupCreateUpdate,
+		Delete: resourceArmVirtualDesktopApplicationGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualDesktopName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Desktop",
+					"RemoteApp",
+				}, false),
+			},
+
+			"host_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := virtualDesktopApplicationGroupID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing virtualDesktopApplicationGroup
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_desktop_application_group", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := virtualDesktopApplicationGroup{
+		Location: utils.String(location),
+		Properties: &virtualDesktopApplicationGroupProperties{
+			ApplicationGroupType: utils.String(d.Get("type").(string)),
+			HostPoolArmPath:      utils.String(d.Get("host_pool_id").(string)),
+			FriendlyName:         utils.String(d.Get("friendly_name").(string)),
+			Description:          utils.String(d.Get("description").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read virtualDesktopApplicationGroup
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Virtual Desktop Application Group %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualDesktopApplicationGroupRead(d, meta)
+}
+
+func resourceArmVirtualDesktopApplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applicationGroups"]
+
+	var resp virtualDesktopApplicationGroup
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Virtual Desktop Application Group %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("type", props.ApplicationGroupType)
+		d.Set("host_pool_id", props.HostPoolArmPath)
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("description", props.Description)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualDesktopApplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["applicationGroups"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualDesktopApplicationGroupID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/applicationGroups/%s", subscriptionId, resourceGroup, name)
+}
@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMVirtualDesktopApplicationGroup_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_application_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopApplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopApplicationGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopApplicationGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Desktop"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopApplicationGroup_update(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_application_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopApplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopApplicationGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopApplicationGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopApplicationGroup_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopApplicationGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "Acceptance Test Application Group"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopApplicationGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_desktop_application_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopApplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopApplicationGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopApplicationGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualDesktopApplicationGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_desktop_application_group"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualDesktopApplicationGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp virtualDesktopApplicationGroup
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Virtual Desktop Application Group %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Virtual Desktop Application Group %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualDesktopApplicationGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_desktop_application_group" {
+			continue
+		}
+
+		var resp virtualDesktopApplicationGroup
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Desktop Application Group still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualDesktopApplicationGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_application_group" "test" {
+  name                = "acctestAG%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  type                = "Desktop"
+  host_pool_id        = "${azurerm_virtual_desktop_host_pool.test.id}"
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMVirtualDesktopApplicationGroup_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_application_group" "test" {
+  name                = "acctestAG%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  type                = "Desktop"
+  host_pool_id        = "${azurerm_virtual_desktop_host_pool.test.id}"
+  friendly_name       = "Acceptance Test Application Group"
+  description         = "Acceptance Test: An Application Group"
+
+  tags = {
+    Purpose = "Acceptance-Testing"
+  }
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMVirtualDesktopApplicationGroup_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_application_group" "import" {
+  name                = "${azurerm_virtual_desktop_application_group.test.name}"
+  resource_group_name = "${azurerm_virtual_desktop_application_group.test.resource_group_name}"
+  location            = "${azurerm_virtual_desktop_application_group.test.location}"
+  type                = "${azurerm_virtual_desktop_application_group.test.type}"
+  host_pool_id        = "${azurerm_virtual_desktop_application_group.test.host_pool_id}"
+}
+`, testAccAzureRMVirtualDesktopApplicationGroup_basic(rInt, location))
+}
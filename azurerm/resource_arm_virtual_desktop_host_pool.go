@@ -0,0 +1,399 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure Virtual Desktop isn't present in the vendored SDK, so is managed using raw requests
+const virtualDesktopApiVersion = "2022-09-09"
+
+const (
+	virtualDesktopRegistrationTokenOperationDelete = "Delete"
+	virtualDesktopRegistrationTokenOperationNone   = "None"
+	virtualDesktopRegistrationTokenOperationUpdate = "Update"
+)
+
+type virtualDesktopHostPool struct {
+	ID         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Location   *string                           `json:"location,omitempty"`
+	Tags       map[string]*string                `json:"tags"`
+	Properties *virtualDesktopHostPoolProperties `json:"properties,omitempty"`
+}
+
+type virtualDesktopHostPoolProperties struct {
+	FriendlyName                  *string                             `json:"friendlyName,omitempty"`
+	Description                   *string                             `json:"description,omitempty"`
+	HostPoolType                  *string                             `json:"hostPoolType,omitempty"`
+	PersonalDesktopAssignmentType *string                             `json:"personalDesktopAssignmentType,omitempty"`
+	CustomRdpProperty             *string                             `json:"customRdpProperty,omitempty"`
+	MaxSessionLimit               *int32                              `json:"maxSessionLimit,omitempty"`
+	LoadBalancerType              *string                             `json:"loadBalancerType,omitempty"`
+	ValidationEnvironment         *bool                               `json:"validationEnvironment,omitempty"`
+	PreferredAppGroupType         *string                             `json:"preferredAppGroupType,omitempty"`
+	StartVMOnConnect              *bool                               `json:"startVMOnConnect,omitempty"`
+	RegistrationInfo              *virtualDesktopHostPoolRegistration `json:"registrationInfo,omitempty"`
+}
+
+type virtualDesktopHostPoolRegistration struct {
+	ExpirationTime             *string `json:"expirationTime,omitempty"`
+	Token                      *string `json:"token,omitempty"`
+	RegistrationTokenOperation *string `json:"registrationTokenOperation,omitempty"`
+}
+
+func resourceArmVirtualDesktopHostPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualDesktopHostPoolCreateUpdate,
+		Read:   resourceArmVirtualDesktopHostPoolRead,
+		Update: resourceArmVirtualDesktopHostPoolCreateUpdate,
+		Delete: resourceArmVirtualDesktopHostPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualDesktopName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Personal",
+					"Pooled",
+				}, false),
+			},
+
+			"load_balancer_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"BreadthFirst",
+					"DepthFirst",
+					"Persistent",
+				}, false),
+			},
+
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"validate_environment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"start_vm_on_connect": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// e.g. `audiocapturemode:i:1;audiomode:i:0;`
+			"custom_rdp_properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"personal_desktop_assignment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Automatic",
+					"Direct",
+				}, false),
+			},
+
+			"maximum_sessions_allowed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      999999,
+				ValidateFunc: validation.IntBetween(0, 999999),
+			},
+
+			"preferred_app_group_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Desktop",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Desktop",
+					"None",
+					"RailApplications",
+				}, false),
+			},
+
+			// Session Hosts are joined to the Host Pool using the Registration Token, which is valid until the expiration date
+			"registration_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration_date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.RFC3339Time,
+						},
+
+						"token": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			hostPoolType := diff.Get("type").(string)
+			loadBalancerType := diff.Get("load_balancer_type").(string)
+			assignmentType := diff.Get("personal_desktop_assignment_type").(string)
+
+			if hostPoolType == "Personal" {
+				if loadBalancerType != "Persistent" {
+					return fmt.Errorf("`load_balancer_type` must be `Persistent` when `type` is `Personal`")
+				}
+			} else if hostPoolType != "" {
+				if loadBalancerType == "Persistent" {
+					return fmt.Errorf("`load_balancer_type` can only be `Persistent` when `type` is `Personal`")
+				}
+				if assignmentType != "" {
+					return fmt.Errorf("`personal_desktop_assignment_type` can only be specified when `type` is `Personal`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmVirtualDesktopHostPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := virtualDesktopHostPoolID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing virtualDesktopHostPool
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Desktop Host Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_desktop_host_pool", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := virtualDesktopHostPool{
+		Location: utils.String(location),
+		Properties: &virtualDesktopHostPoolProperties{
+			HostPoolType:          utils.String(d.Get("type").(string)),
+			LoadBalancerType:      utils.String(d.Get("load_balancer_type").(string)),
+			FriendlyName:          utils.String(d.Get("friendly_name").(string)),
+			Description:           utils.String(d.Get("description").(string)),
+			ValidationEnvironment: utils.Bool(d.Get("validate_environment").(bool)),
+			StartVMOnConnect:      utils.Bool(d.Get("start_vm_on_connect").(bool)),
+			CustomRdpProperty:     utils.String(d.Get("custom_rdp_properties").(string)),
+			MaxSessionLimit:       utils.Int32(int32(d.Get("maximum_sessions_allowed").(int))),
+			PreferredAppGroupType: utils.String(d.Get("preferred_app_group_type").(string)),
+			RegistrationInfo:      expandArmVirtualDesktopHostPoolRegistration(d),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("personal_desktop_assignment_type"); ok {
+		parameters.Properties.PersonalDesktopAssignmentType = utils.String(v.(string))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Desktop Host Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read virtualDesktopHostPool
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Virtual Desktop Host Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Virtual Desktop Host Pool %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualDesktopHostPoolRead(d, meta)
+}
+
+func resourceArmVirtualDesktopHostPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostPools"]
+
+	var resp virtualDesktopHostPool
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Virtual Desktop Host Pool %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Desktop Host Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("type", props.HostPoolType)
+		d.Set("load_balancer_type", props.LoadBalancerType)
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("description", props.Description)
+		d.Set("custom_rdp_properties", props.CustomRdpProperty)
+		d.Set("personal_desktop_assignment_type", props.PersonalDesktopAssignmentType)
+		d.Set("preferred_app_group_type", props.PreferredAppGroupType)
+
+		validateEnvironment := false
+		if props.ValidationEnvironment != nil {
+			validateEnvironment = *props.ValidationEnvironment
+		}
+		d.Set("validate_environment", validateEnvironment)
+
+		startVMOnConnect := false
+		if props.StartVMOnConnect != nil {
+			startVMOnConnect = *props.StartVMOnConnect
+		}
+		d.Set("start_vm_on_connect", startVMOnConnect)
+
+		if props.MaxSessionLimit != nil {
+			d.Set("maximum_sessions_allowed", *props.MaxSessionLimit)
+		}
+
+		if err := d.Set("registration_info", flattenArmVirtualDesktopHostPoolRegistration(props.RegistrationInfo)); err != nil {
+			return fmt.Errorf("Error setting `registration_info`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualDesktopHostPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostPools"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Desktop Host Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualDesktopHostPoolID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/hostPools/%s", subscriptionId, resourceGroup, name)
+}
+
+// expandArmVirtualDesktopHostPoolRegistration returns the Registration Token operation to perform - the existing
+// Registration Token is left as-is unless the `registration_info` block has been added, changed or removed
+func expandArmVirtualDesktopHostPoolRegistration(d *schema.ResourceData) *virtualDesktopHostPoolRegistration {
+	registrations := d.Get("registration_info").([]interface{})
+
+	if len(registrations) == 0 || registrations[0] == nil {
+		operation := virtualDesktopRegistrationTokenOperationNone
+		if !d.IsNewResource() && d.HasChange("registration_info") {
+			operation = virtualDesktopRegistrationTokenOperationDelete
+		}
+
+		return &virtualDesktopHostPoolRegistration{
+			RegistrationTokenOperation: utils.String(operation),
+		}
+	}
+
+	if !d.IsNewResource() && !d.HasChange("registration_info.0.expiration_date") {
+		return &virtualDesktopHostPoolRegistration{
+			RegistrationTokenOperation: utils.String(virtualDesktopRegistrationTokenOperationNone),
+		}
+	}
+
+	registration := registrations[0].(map[string]interface{})
+	return &virtualDesktopHostPoolRegistration{
+		ExpirationTime:             utils.String(registration["expiration_date"].(string)),
+		RegistrationTokenOperation: utils.String(virtualDesktopRegistrationTokenOperationUpdate),
+	}
+}
+
+func flattenArmVirtualDesktopHostPoolRegistration(input *virtualDesktopHostPoolRegistration) []interface{} {
+	// once the Registration Token has expired it's no longer returned, which causes it to be regenerated
+	if input == nil || input.ExpirationTime == nil || input.Token == nil || *input.Token == "" {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expiration_date": *input.ExpirationTime,
+			"token":           *input.Token,
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMVirtualDesktopHostPool_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_host_pool.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopHostPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Pooled"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_type", "BreadthFirst"),
+					resource.TestCheckResourceAttr(resourceName, "registration_info.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopHostPool_complete(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_host_pool.test"
+	ri := tf.AccRandTimeInt()
+	expirationDate := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopHostPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_complete(ri, testLocation(), expirationDate),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "maximum_sessions_allowed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "start_vm_on_connect", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registration_info.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_info.0.token"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopHostPool_update(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_host_pool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	expirationDate := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopHostPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_info.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_complete(ri, location, expirationDate),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_info.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_info.0.token"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_info.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopHostPool_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_desktop_host_pool.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopHostPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopHostPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopHostPoolExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualDesktopHostPool_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_desktop_host_pool"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualDesktopHostPoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp virtualDesktopHostPool
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Virtual Desktop Host Pool %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Virtual Desktop Host Pool %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualDesktopHostPoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_desktop_host_pool" {
+			continue
+		}
+
+		var resp virtualDesktopHostPool
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Desktop Host Pool still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualDesktopHostPool_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                = "acctestHP%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  type                = "Pooled"
+  load_balancer_type  = "BreadthFirst"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMVirtualDesktopHostPool_complete(rInt int, location string, expirationDate string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                     = "acctestHP%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  type                     = "Pooled"
+  load_balancer_type       = "BreadthFirst"
+  friendly_name            = "Acceptance Test Host Pool"
+  description              = "Acceptance Test: A Pooled Host Pool"
+  validate_environment     = true
+  start_vm_on_connect      = true
+  custom_rdp_properties    = "audiocapturemode:i:1;audiomode:i:0;"
+  maximum_sessions_allowed = 50
+  preferred_app_group_type = "Desktop"
+
+  registration_info {
+    expiration_date = "%s"
+  }
+
+  tags = {
+    Purpose = "Acceptance-Testing"
+  }
+}
+`, rInt, location, rInt, expirationDate)
+}
+
+func testAccAzureRMVirtualDesktopHostPool_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_host_pool" "import" {
+  name                = "${azurerm_virtual_desktop_host_pool.test.name}"
+  resource_group_name = "${azurerm_virtual_desktop_host_pool.test.resource_group_name}"
+  location            = "${azurerm_virtual_desktop_host_pool.test.location}"
+  type                = "${azurerm_virtual_desktop_host_pool.test.type}"
+  load_balancer_type  = "${azurerm_virtual_desktop_host_pool.test.load_balancer_type}"
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location))
+}
@@ -0,0 +1,388 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type virtualDesktopMsixPackage struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *virtualDesktopMsixPackageProperties `json:"properties,omitempty"`
+}
+
+type virtualDesktopMsixPackageProperties struct {
+	ImagePath             *string                                 `json:"imagePath,omitempty"`
+	PackageName           *string                                 `json:"packageName,omitempty"`
+	PackageFamilyName     *string                                 `json:"packageFamilyName,omitempty"`
+	PackageFullName       *string                                 `json:"packageFullName,omitempty"`
+	DisplayName           *string                                 `json:"displayName,omitempty"`
+	PackageRelativePath   *string                                 `json:"packageRelativePath,omitempty"`
+	IsRegularRegistration *bool                                   `json:"isRegularRegistration,omitempty"`
+	IsActive              *bool                                   `json:"isActive,omitempty"`
+	Version               *string                                 `json:"version,omitempty"`
+	LastUpdated           *string                                 `json:"lastUpdated,omitempty"`
+	PackageApplications   *[]virtualDesktopMsixPackageApplication `json:"packageApplications,omitempty"`
+	PackageDependencies   *[]interface{}                          `json:"packageDependencies,omitempty"`
+}
+
+type virtualDesktopMsixPackageApplication struct {
+	AppID          *string `json:"appId,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	AppUserModelID *string `json:"appUserModelID,omitempty"`
+	FriendlyName   *string `json:"friendlyName,omitempty"`
+	IconImageName  *string `json:"iconImageName,omitempty"`
+	RawIcon        *string `json:"rawIcon,omitempty"`
+	RawPng         *string `json:"rawPng,omitempty"`
+}
+
+type virtualDesktopExpandMsixImageList struct {
+	Value *[]virtualDesktopMsixPackage `json:"value,omitempty"`
+}
+
+type virtualDesktopMsixImageURI struct {
+	URI *string `json:"uri,omitempty"`
+}
+
+func resourceArmVirtualDesktopMsixPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualDesktopMsixPackageCreate,
+		Read:   resourceArmVirtualDesktopMsixPackageRead,
+		Update: resourceArmVirtualDesktopMsixPackageUpdate,
+		Delete: resourceArmVirtualDesktopMsixPackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// e.g. `\\fileshare\msix\app.vhdx` - the package details are expanded from this image
+			"image_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"regular_registration_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"package_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"package_family_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"package_relative_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"package_applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"app_user_model_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVirtualDesktopMsixPackageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	hostPoolId := d.Get("host_pool_id").(string)
+	imagePath := d.Get("image_path").(string)
+
+	hostPool, err := parseAzureResourceID(hostPoolId)
+	if err != nil {
+		return err
+	}
+	hostPoolName := hostPool.Path["hostPools"]
+
+	// the details of the MSIX Package (including its name) are only available by expanding the image
+	var expanded virtualDesktopExpandMsixImageList
+	body := virtualDesktopMsixImageURI{
+		URI: utils.String(imagePath),
+	}
+	if err := armRawPostWithResult(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/expandMsixImage", hostPoolId), virtualDesktopApiVersion, body, &expanded); err != nil {
+		return fmt.Errorf("Error expanding MSIX Image %q (Host Pool %q): %+v", imagePath, hostPoolName, err)
+	}
+
+	if expanded.Value == nil || len(*expanded.Value) == 0 {
+		return fmt.Errorf("Error expanding MSIX Image %q (Host Pool %q): no MSIX Packages were found", imagePath, hostPoolName)
+	}
+
+	image := (*expanded.Value)[0]
+	if image.Properties == nil || image.Properties.PackageFullName == nil {
+		return fmt.Errorf("Error expanding MSIX Image %q (Host Pool %q): `packageFullName` was nil", imagePath, hostPoolName)
+	}
+
+	name := *image.Properties.PackageFullName
+	id := fmt.Sprintf("%s/msixPackages/%s", hostPoolId, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing virtualDesktopMsixPackage
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_desktop_msix_package", *existing.ID)
+		}
+	}
+
+	props := image.Properties
+	props.PackageFullName = nil
+	props.IsActive = utils.Bool(d.Get("active").(bool))
+	props.IsRegularRegistration = utils.Bool(d.Get("regular_registration_enabled").(bool))
+	if v, ok := d.GetOk("display_name"); ok {
+		props.DisplayName = utils.String(v.(string))
+	}
+	if props.PackageDependencies == nil {
+		props.PackageDependencies = &[]interface{}{}
+	}
+
+	parameters := virtualDesktopMsixPackage{
+		Properties: props,
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+	}
+
+	var read virtualDesktopMsixPackage
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Virtual Desktop MSIX Package %q (Host Pool %q)", name, hostPoolName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualDesktopMsixPackageRead(d, meta)
+}
+
+func resourceArmVirtualDesktopMsixPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hostPoolName := id.Path["hostPools"]
+	name := id.Path["msixPackages"]
+
+	parameters := virtualDesktopMsixPackage{
+		Properties: &virtualDesktopMsixPackageProperties{
+			IsActive:              utils.Bool(d.Get("active").(bool)),
+			IsRegularRegistration: utils.Bool(d.Get("regular_registration_enabled").(bool)),
+		},
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		parameters.Properties.DisplayName = utils.String(v.(string))
+	}
+
+	if err := armRawPatch(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error updating Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+	}
+
+	return resourceArmVirtualDesktopMsixPackageRead(d, meta)
+}
+
+func resourceArmVirtualDesktopMsixPackageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hostPoolName := id.Path["hostPools"]
+	name := id.Path["msixPackages"]
+
+	var resp virtualDesktopMsixPackage
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Virtual Desktop MSIX Package %q was not found in Host Pool %q - removing from state", name, hostPoolName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+	}
+
+	// the API returns the name as `{hostPoolName}/{packageFullName}`, so this is taken from the ID instead
+	d.Set("name", name)
+	d.Set("host_pool_id", virtualDesktopHostPoolID(id.SubscriptionID, id.ResourceGroup, hostPoolName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("image_path", props.ImagePath)
+		d.Set("display_name", props.DisplayName)
+		d.Set("package_name", props.PackageName)
+		d.Set("package_family_name", props.PackageFamilyName)
+		d.Set("package_relative_path", props.PackageRelativePath)
+		d.Set("version", props.Version)
+		d.Set("last_updated", props.LastUpdated)
+
+		active := false
+		if props.IsActive != nil {
+			active = *props.IsActive
+		}
+		d.Set("active", active)
+
+		regularRegistration := false
+		if props.IsRegularRegistration != nil {
+			regularRegistration = *props.IsRegularRegistration
+		}
+		d.Set("regular_registration_enabled", regularRegistration)
+
+		if err := d.Set("package_applications", flattenArmVirtualDesktopMsixPackageApplications(props.PackageApplications)); err != nil {
+			return fmt.Errorf("Error setting `package_applications`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualDesktopMsixPackageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hostPoolName := id.Path["hostPools"]
+	name := id.Path["msixPackages"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Desktop MSIX Package %q (Host Pool %q): %+v", name, hostPoolName, err)
+	}
+
+	return nil
+}
+
+func flattenArmVirtualDesktopMsixPackageApplications(input *[]virtualDesktopMsixPackageApplication) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		appId := ""
+		if v.AppID != nil {
+			appId = *v.AppID
+		}
+
+		appUserModelId := ""
+		if v.AppUserModelID != nil {
+			appUserModelId = *v.AppUserModelID
+		}
+
+		friendlyName := ""
+		if v.FriendlyName != nil {
+			friendlyName = *v.FriendlyName
+		}
+
+		description := ""
+		if v.Description != nil {
+			description = *v.Description
+		}
+
+		results = append(results, map[string]interface{}{
+			"app_id":            appId,
+			"app_user_model_id": appUserModelId,
+			"friendly_name":     friendlyName,
+			"description":       description,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+// the MSIX Image needs to exist on a File Share which is accessible to the Host Pool, since the API expands it
+func testAccVirtualDesktopMsixImagePath(t *testing.T) string {
+	imagePath := os.Getenv("ARM_TEST_VIRTUAL_DESKTOP_MSIX_IMAGE_PATH")
+	if imagePath == "" {
+		t.Skipf("Skipping as %q is not specified", "ARM_TEST_VIRTUAL_DESKTOP_MSIX_IMAGE_PATH")
+	}
+
+	return imagePath
+}
+
+func TestAccAzureRMVirtualDesktopMsixPackage_basic(t *testing.T) {
+	imagePath := testAccVirtualDesktopMsixImagePath(t)
+	resourceName := "azurerm_virtual_desktop_msix_package.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopMsixPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopMsixPackage_basic(ri, testLocation(), imagePath),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopMsixPackageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "package_family_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopMsixPackage_update(t *testing.T) {
+	imagePath := testAccVirtualDesktopMsixImagePath(t)
+	resourceName := "azurerm_virtual_desktop_msix_package.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopMsixPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopMsixPackage_basic(ri, location, imagePath),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopMsixPackageExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopMsixPackage_complete(ri, location, imagePath),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopMsixPackageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test Package"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "regular_registration_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopMsixPackage_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	imagePath := testAccVirtualDesktopMsixImagePath(t)
+	resourceName := "azurerm_virtual_desktop_msix_package.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopMsixPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopMsixPackage_basic(ri, location, imagePath),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopMsixPackageExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualDesktopMsixPackage_requiresImport(ri, location, imagePath),
+				ExpectError: testRequiresImportError("azurerm_virtual_desktop_msix_package"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualDesktopMsixPackageExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp virtualDesktopMsixPackage
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Virtual Desktop MSIX Package %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Virtual Desktop MSIX Package %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualDesktopMsixPackageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_desktop_msix_package" {
+			continue
+		}
+
+		var resp virtualDesktopMsixPackage
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Desktop MSIX Package still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualDesktopMsixPackage_basic(rInt int, location string, imagePath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_msix_package" "test" {
+  host_pool_id = "${azurerm_virtual_desktop_host_pool.test.id}"
+  image_path   = %q
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location), imagePath)
+}
+
+func testAccAzureRMVirtualDesktopMsixPackage_complete(rInt int, location string, imagePath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_msix_package" "test" {
+  host_pool_id                 = "${azurerm_virtual_desktop_host_pool.test.id}"
+  image_path                   = %q
+  display_name                 = "Acceptance Test Package"
+  active                       = false
+  regular_registration_enabled = true
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location), imagePath)
+}
+
+func testAccAzureRMVirtualDesktopMsixPackage_requiresImport(rInt int, location string, imagePath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_msix_package" "import" {
+  host_pool_id = "${azurerm_virtual_desktop_msix_package.test.host_pool_id}"
+  image_path   = "${azurerm_virtual_desktop_msix_package.test.image_path}"
+}
+`, testAccAzureRMVirtualDesktopMsixPackage_basic(rInt, location, imagePath))
+}
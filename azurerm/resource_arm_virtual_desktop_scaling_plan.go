@@ -0,0 +1,595 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type virtualDesktopScalingPlan struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Location   *string                              `json:"location,omitempty"`
+	Tags       map[string]*string                   `json:"tags"`
+	Properties *virtualDesktopScalingPlanProperties `json:"properties,omitempty"`
+}
+
+type virtualDesktopScalingPlanProperties struct {
+	FriendlyName       *string                                       `json:"friendlyName,omitempty"`
+	Description        *string                                       `json:"description,omitempty"`
+	TimeZone           *string                                       `json:"timeZone,omitempty"`
+	HostPoolType       *string                                       `json:"hostPoolType,omitempty"`
+	ExclusionTag       *string                                       `json:"exclusionTag,omitempty"`
+	Schedules          *[]virtualDesktopScalingPlanSchedule          `json:"schedules,omitempty"`
+	HostPoolReferences *[]virtualDesktopScalingPlanHostPoolReference `json:"hostPoolReferences,omitempty"`
+}
+
+type virtualDesktopScalingPlanSchedule struct {
+	Name                           *string                        `json:"name,omitempty"`
+	DaysOfWeek                     *[]string                      `json:"daysOfWeek,omitempty"`
+	RampUpStartTime                *virtualDesktopScalingPlanTime `json:"rampUpStartTime,omitempty"`
+	RampUpLoadBalancingAlgorithm   *string                        `json:"rampUpLoadBalancingAlgorithm,omitempty"`
+	RampUpMinimumHostsPct          *int32                         `json:"rampUpMinimumHostsPct,omitempty"`
+	RampUpCapacityThresholdPct     *int32                         `json:"rampUpCapacityThresholdPct,omitempty"`
+	PeakStartTime                  *virtualDesktopScalingPlanTime `json:"peakStartTime,omitempty"`
+	PeakLoadBalancingAlgorithm     *string                        `json:"peakLoadBalancingAlgorithm,omitempty"`
+	RampDownStartTime              *virtualDesktopScalingPlanTime `json:"rampDownStartTime,omitempty"`
+	RampDownLoadBalancingAlgorithm *string                        `json:"rampDownLoadBalancingAlgorithm,omitempty"`
+	RampDownMinimumHostsPct        *int32                         `json:"rampDownMinimumHostsPct,omitempty"`
+	RampDownCapacityThresholdPct   *int32                         `json:"rampDownCapacityThresholdPct,omitempty"`
+	RampDownForceLogoffUsers       *bool                          `json:"rampDownForceLogoffUsers,omitempty"`
+	RampDownStopHostsWhen          *string                        `json:"rampDownStopHostsWhen,omitempty"`
+	RampDownWaitTimeMinutes        *int32                         `json:"rampDownWaitTimeMinutes,omitempty"`
+	RampDownNotificationMessage    *string                        `json:"rampDownNotificationMessage,omitempty"`
+	OffPeakStartTime               *virtualDesktopScalingPlanTime `json:"offPeakStartTime,omitempty"`
+	OffPeakLoadBalancingAlgorithm  *string                        `json:"offPeakLoadBalancingAlgorithm,omitempty"`
+}
+
+type virtualDesktopScalingPlanTime struct {
+	Hour   *int32 `json:"hour,omitempty"`
+	Minute *int32 `json:"minute,omitempty"`
+}
+
+type virtualDesktopScalingPlanHostPoolReference struct {
+	HostPoolArmPath    *string `json:"hostPoolArmPath,omitempty"`
+	ScalingPlanEnabled *bool   `json:"scalingPlanEnabled,omitempty"`
+}
+
+func resourceArmVirtualDesktopScalingPlan() *schema.Resource {
+	loadBalancingAlgorithmSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"BreadthFirst",
+				"DepthFirst",
+			}, false),
+		}
+	}
+
+	startTimeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.VirtualDesktopScheduleTime,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceArmVirtualDesktopScalingPlanCreateUpdate,
+		Read:   resourceArmVirtualDesktopScalingPlanRead,
+		Update: resourceArmVirtualDesktopScalingPlanCreateUpdate,
+		Delete: resourceArmVirtualDesktopScalingPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualDesktopName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"time_zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAutoScaleSettingsTimeZone(),
+			},
+
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			// Session Hosts with this tag are excluded from scaling
+			"exclusion_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"host_pool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostpool_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"scaling_plan_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+
+						"ramp_up_start_time": startTimeSchema(),
+
+						"ramp_up_load_balancing_algorithm": loadBalancingAlgorithmSchema(),
+
+						"ramp_up_minimum_hosts_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"ramp_up_capacity_threshold_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"peak_start_time": startTimeSchema(),
+
+						"peak_load_balancing_algorithm": loadBalancingAlgorithmSchema(),
+
+						"ramp_down_start_time": startTimeSchema(),
+
+						"ramp_down_load_balancing_algorithm": loadBalancingAlgorithmSchema(),
+
+						"ramp_down_minimum_hosts_percent": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"ramp_down_capacity_threshold_percent": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"ramp_down_force_logoff_users": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"ramp_down_wait_time_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"ramp_down_notification_message": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"ramp_down_stop_hosts_when": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ZeroActiveSessions",
+								"ZeroSessions",
+							}, false),
+						},
+
+						"off_peak_start_time": startTimeSchema(),
+
+						"off_peak_load_balancing_algorithm": loadBalancingAlgorithmSchema(),
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualDesktopScalingPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := virtualDesktopScalingPlanID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing virtualDesktopScalingPlan
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Desktop Scaling Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_desktop_scaling_plan", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	schedules, err := expandArmVirtualDesktopScalingPlanSchedules(d.Get("schedule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := virtualDesktopScalingPlan{
+		Location: utils.String(location),
+		Properties: &virtualDesktopScalingPlanProperties{
+			TimeZone:           utils.String(d.Get("time_zone").(string)),
+			FriendlyName:       utils.String(d.Get("friendly_name").(string)),
+			Description:        utils.String(d.Get("description").(string)),
+			HostPoolType:       utils.String("Pooled"),
+			Schedules:          schedules,
+			HostPoolReferences: expandArmVirtualDesktopScalingPlanHostPoolReferences(d.Get("host_pool").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("exclusion_tag"); ok {
+		parameters.Properties.ExclusionTag = utils.String(v.(string))
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Desktop Scaling Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read virtualDesktopScalingPlan
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Virtual Desktop Scaling Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Virtual Desktop Scaling Plan %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualDesktopScalingPlanRead(d, meta)
+}
+
+func resourceArmVirtualDesktopScalingPlanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["scalingPlans"]
+
+	var resp virtualDesktopScalingPlan
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Virtual Desktop Scaling Plan %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Desktop Scaling Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("time_zone", props.TimeZone)
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("description", props.Description)
+		d.Set("exclusion_tag", props.ExclusionTag)
+
+		if err := d.Set("schedule", flattenArmVirtualDesktopScalingPlanSchedules(props.Schedules)); err != nil {
+			return fmt.Errorf("Error setting `schedule`: %+v", err)
+		}
+
+		if err := d.Set("host_pool", flattenArmVirtualDesktopScalingPlanHostPoolReferences(props.HostPoolReferences)); err != nil {
+			return fmt.Errorf("Error setting `host_pool`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualDesktopScalingPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["scalingPlans"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Desktop Scaling Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualDesktopScalingPlanID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/scalingPlans/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmVirtualDesktopScalingPlanSchedules(input []interface{}) (*[]virtualDesktopScalingPlanSchedule, error) {
+	results := make([]virtualDesktopScalingPlanSchedule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		daysOfWeek := make([]string, 0)
+		for _, day := range v["days_of_week"].(*schema.Set).List() {
+			daysOfWeek = append(daysOfWeek, day.(string))
+		}
+
+		times := make(map[string]*virtualDesktopScalingPlanTime)
+		for _, key := range []string{"ramp_up_start_time", "peak_start_time", "ramp_down_start_time", "off_peak_start_time"} {
+			t, err := expandArmVirtualDesktopScalingPlanTime(v[key].(string))
+			if err != nil {
+				return nil, fmt.Errorf("Error expanding `%s` of Schedule %q: %+v", key, name, err)
+			}
+			times[key] = t
+		}
+
+		schedule := virtualDesktopScalingPlanSchedule{
+			Name:                           utils.String(name),
+			DaysOfWeek:                     &daysOfWeek,
+			RampUpStartTime:                times["ramp_up_start_time"],
+			RampUpLoadBalancingAlgorithm:   utils.String(v["ramp_up_load_balancing_algorithm"].(string)),
+			PeakStartTime:                  times["peak_start_time"],
+			PeakLoadBalancingAlgorithm:     utils.String(v["peak_load_balancing_algorithm"].(string)),
+			RampDownStartTime:              times["ramp_down_start_time"],
+			RampDownLoadBalancingAlgorithm: utils.String(v["ramp_down_load_balancing_algorithm"].(string)),
+			RampDownMinimumHostsPct:        utils.Int32(int32(v["ramp_down_minimum_hosts_percent"].(int))),
+			RampDownCapacityThresholdPct:   utils.Int32(int32(v["ramp_down_capacity_threshold_percent"].(int))),
+			RampDownForceLogoffUsers:       utils.Bool(v["ramp_down_force_logoff_users"].(bool)),
+			RampDownStopHostsWhen:          utils.String(v["ramp_down_stop_hosts_when"].(string)),
+			RampDownWaitTimeMinutes:        utils.Int32(int32(v["ramp_down_wait_time_minutes"].(int))),
+			RampDownNotificationMessage:    utils.String(v["ramp_down_notification_message"].(string)),
+			OffPeakStartTime:               times["off_peak_start_time"],
+			OffPeakLoadBalancingAlgorithm:  utils.String(v["off_peak_load_balancing_algorithm"].(string)),
+		}
+
+		if minimumHosts := v["ramp_up_minimum_hosts_percent"].(int); minimumHosts > 0 {
+			schedule.RampUpMinimumHostsPct = utils.Int32(int32(minimumHosts))
+		}
+
+		if capacityThreshold := v["ramp_up_capacity_threshold_percent"].(int); capacityThreshold > 0 {
+			schedule.RampUpCapacityThresholdPct = utils.Int32(int32(capacityThreshold))
+		}
+
+		results = append(results, schedule)
+	}
+
+	return &results, nil
+}
+
+func flattenArmVirtualDesktopScalingPlanSchedules(input *[]virtualDesktopScalingPlanSchedule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		result := map[string]interface{}{
+			"days_of_week":         schema.NewSet(schema.HashString, utils.FlattenStringArray(v.DaysOfWeek)),
+			"ramp_up_start_time":   flattenArmVirtualDesktopScalingPlanTime(v.RampUpStartTime),
+			"peak_start_time":      flattenArmVirtualDesktopScalingPlanTime(v.PeakStartTime),
+			"ramp_down_start_time": flattenArmVirtualDesktopScalingPlanTime(v.RampDownStartTime),
+			"off_peak_start_time":  flattenArmVirtualDesktopScalingPlanTime(v.OffPeakStartTime),
+		}
+
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+		if v.RampUpLoadBalancingAlgorithm != nil {
+			result["ramp_up_load_balancing_algorithm"] = *v.RampUpLoadBalancingAlgorithm
+		}
+		if v.RampUpMinimumHostsPct != nil {
+			result["ramp_up_minimum_hosts_percent"] = int(*v.RampUpMinimumHostsPct)
+		}
+		if v.RampUpCapacityThresholdPct != nil {
+			result["ramp_up_capacity_threshold_percent"] = int(*v.RampUpCapacityThresholdPct)
+		}
+		if v.PeakLoadBalancingAlgorithm != nil {
+			result["peak_load_balancing_algorithm"] = *v.PeakLoadBalancingAlgorithm
+		}
+		if v.RampDownLoadBalancingAlgorithm != nil {
+			result["ramp_down_load_balancing_algorithm"] = *v.RampDownLoadBalancingAlgorithm
+		}
+		if v.RampDownMinimumHostsPct != nil {
+			result["ramp_down_minimum_hosts_percent"] = int(*v.RampDownMinimumHostsPct)
+		}
+		if v.RampDownCapacityThresholdPct != nil {
+			result["ramp_down_capacity_threshold_percent"] = int(*v.RampDownCapacityThresholdPct)
+		}
+		if v.RampDownForceLogoffUsers != nil {
+			result["ramp_down_force_logoff_users"] = *v.RampDownForceLogoffUsers
+		}
+		if v.RampDownWaitTimeMinutes != nil {
+			result["ramp_down_wait_time_minutes"] = int(*v.RampDownWaitTimeMinutes)
+		}
+		if v.RampDownNotificationMessage != nil {
+			result["ramp_down_notification_message"] = *v.RampDownNotificationMessage
+		}
+		if v.RampDownStopHostsWhen != nil {
+			result["ramp_down_stop_hosts_when"] = *v.RampDownStopHostsWhen
+		}
+		if v.OffPeakLoadBalancingAlgorithm != nil {
+			result["off_peak_load_balancing_algorithm"] = *v.OffPeakLoadBalancingAlgorithm
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func expandArmVirtualDesktopScalingPlanHostPoolReferences(input []interface{}) *[]virtualDesktopScalingPlanHostPoolReference {
+	results := make([]virtualDesktopScalingPlanHostPoolReference, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, virtualDesktopScalingPlanHostPoolReference{
+			HostPoolArmPath:    utils.String(v["hostpool_id"].(string)),
+			ScalingPlanEnabled: utils.Bool(v["scaling_plan_enabled"].(bool)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmVirtualDesktopScalingPlanHostPoolReferences(input *[]virtualDesktopScalingPlanHostPoolReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		hostPoolId := ""
+		if v.HostPoolArmPath != nil {
+			hostPoolId = *v.HostPoolArmPath
+		}
+
+		scalingPlanEnabled := false
+		if v.ScalingPlanEnabled != nil {
+			scalingPlanEnabled = *v.ScalingPlanEnabled
+		}
+
+		results = append(results, map[string]interface{}{
+			"hostpool_id":          hostPoolId,
+			"scaling_plan_enabled": scalingPlanEnabled,
+		})
+	}
+
+	return results
+}
+
+// expandArmVirtualDesktopScalingPlanTime converts a time in the format `HH:MM` into the `{hour, minute}` object used by the API
+func expandArmVirtualDesktopScalingPlanTime(input string) (*virtualDesktopScalingPlanTime, error) {
+	segments := strings.Split(input, ":")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected %q to be in the format `HH:MM`", input)
+	}
+
+	hour, err := strconv.Atoi(segments[0])
+	if err != nil || hour < 0 || hour > 23 {
+		return nil, fmt.Errorf("Expected the hour of %q to be between 0 and 23", input)
+	}
+
+	minute, err := strconv.Atoi(segments[1])
+	if err != nil || minute < 0 || minute > 59 {
+		return nil, fmt.Errorf("Expected the minute of %q to be between 0 and 59", input)
+	}
+
+	return &virtualDesktopScalingPlanTime{
+		Hour:   utils.Int32(int32(hour)),
+		Minute: utils.Int32(int32(minute)),
+	}, nil
+}
+
+func flattenArmVirtualDesktopScalingPlanTime(input *virtualDesktopScalingPlanTime) string {
+	if input == nil {
+		return ""
+	}
+
+	hour := int32(0)
+	if input.Hour != nil {
+		hour = *input.Hour
+	}
+
+	minute := int32(0)
+	if input.Minute != nil {
+		minute = *input.Minute
+	}
+
+	return fmt.Sprintf("%02d:%02d", hour, minute)
+}
@@ -0,0 +1,403 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestExpandArmVirtualDesktopScalingPlanTime(t *testing.T) {
+	testData := []struct {
+		name           string
+		input          string
+		expectedHour   int32
+		expectedMinute int32
+		shouldError    bool
+	}{
+		{
+			name:        "empty",
+			input:       "",
+			shouldError: true,
+		},
+		{
+			name:        "no separator",
+			input:       "0930",
+			shouldError: true,
+		},
+		{
+			name:        "hour out of range",
+			input:       "24:00",
+			shouldError: true,
+		},
+		{
+			name:        "minute out of range",
+			input:       "09:60",
+			shouldError: true,
+		},
+		{
+			name:        "not a number",
+			input:       "ab:cd",
+			shouldError: true,
+		},
+		{
+			name:           "midnight",
+			input:          "00:00",
+			expectedHour:   0,
+			expectedMinute: 0,
+		},
+		{
+			name:           "morning",
+			input:          "09:30",
+			expectedHour:   9,
+			expectedMinute: 30,
+		},
+		{
+			name:           "evening",
+			input:          "23:59",
+			expectedHour:   23,
+			expectedMinute: 59,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := expandArmVirtualDesktopScalingPlanTime(v.input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.input, err)
+		}
+
+		if v.shouldError {
+			t.Fatalf("Expected an error for %q but didn't get one", v.input)
+		}
+
+		if actual.Hour == nil || *actual.Hour != v.expectedHour {
+			t.Fatalf("Expected the hour of %q to be %d but got %v", v.input, v.expectedHour, actual.Hour)
+		}
+
+		if actual.Minute == nil || *actual.Minute != v.expectedMinute {
+			t.Fatalf("Expected the minute of %q to be %d but got %v", v.input, v.expectedMinute, actual.Minute)
+		}
+	}
+}
+
+func TestFlattenArmVirtualDesktopScalingPlanTime(t *testing.T) {
+	hour := int32(7)
+	minute := int32(5)
+
+	testData := []struct {
+		name     string
+		input    *virtualDesktopScalingPlanTime
+		expected string
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: "",
+		},
+		{
+			name:     "empty",
+			input:    &virtualDesktopScalingPlanTime{},
+			expected: "00:00",
+		},
+		{
+			name: "padded",
+			input: &virtualDesktopScalingPlanTime{
+				Hour:   &hour,
+				Minute: &minute,
+			},
+			expected: "07:05",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := flattenArmVirtualDesktopScalingPlanTime(v.input)
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualDesktopScalingPlan_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_scaling_plan.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopScalingPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopScalingPlan_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopScalingPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.ramp_up_start_time", "06:00"),
+					resource.TestCheckResourceAttr(resourceName, "host_pool.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopScalingPlan_update(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_scaling_plan.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopScalingPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopScalingPlan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopScalingPlanExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopScalingPlan_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopScalingPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "host_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host_pool.0.scaling_plan_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_tag", "scaling-exclude"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopScalingPlan_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_desktop_scaling_plan.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopScalingPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopScalingPlan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopScalingPlanExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualDesktopScalingPlan_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_desktop_scaling_plan"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualDesktopScalingPlanExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp virtualDesktopScalingPlan
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Virtual Desktop Scaling Plan %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Virtual Desktop Scaling Plan %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualDesktopScalingPlanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_desktop_scaling_plan" {
+			continue
+		}
+
+		var resp virtualDesktopScalingPlan
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Desktop Scaling Plan still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualDesktopScalingPlan_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "acctestSP%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  time_zone           = "GMT Standard Time"
+
+  schedule {
+    name                                 = "Weekdays"
+    days_of_week                         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                   = "06:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    ramp_up_minimum_hosts_percent        = 20
+    ramp_up_capacity_threshold_percent   = 10
+    peak_start_time                      = "09:00"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "18:00"
+    ramp_down_load_balancing_algorithm   = "DepthFirst"
+    ramp_down_minimum_hosts_percent      = 10
+    ramp_down_force_logoff_users         = false
+    ramp_down_wait_time_minutes          = 45
+    ramp_down_notification_message       = "Please log off in the next 45 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroSessions"
+    off_peak_start_time                  = "22:00"
+    off_peak_load_balancing_algorithm    = "DepthFirst"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMVirtualDesktopScalingPlan_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "acctestSP%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  time_zone           = "GMT Standard Time"
+  friendly_name       = "Acceptance Test Scaling Plan"
+  description         = "Acceptance Test: A Scaling Plan"
+  exclusion_tag       = "scaling-exclude"
+
+  host_pool {
+    hostpool_id          = "${azurerm_virtual_desktop_host_pool.test.id}"
+    scaling_plan_enabled = true
+  }
+
+  schedule {
+    name                                 = "Weekdays"
+    days_of_week                         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                   = "06:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    ramp_up_minimum_hosts_percent        = 20
+    ramp_up_capacity_threshold_percent   = 10
+    peak_start_time                      = "09:00"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "18:00"
+    ramp_down_load_balancing_algorithm   = "DepthFirst"
+    ramp_down_minimum_hosts_percent      = 10
+    ramp_down_force_logoff_users         = false
+    ramp_down_wait_time_minutes          = 45
+    ramp_down_notification_message       = "Please log off in the next 45 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroSessions"
+    off_peak_start_time                  = "22:00"
+    off_peak_load_balancing_algorithm    = "DepthFirst"
+  }
+
+  schedule {
+    name                                 = "Weekends"
+    days_of_week                         = ["Saturday", "Sunday"]
+    ramp_up_start_time                   = "09:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    ramp_up_minimum_hosts_percent        = 10
+    ramp_up_capacity_threshold_percent   = 10
+    peak_start_time                      = "10:30"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "16:00"
+    ramp_down_load_balancing_algorithm   = "DepthFirst"
+    ramp_down_minimum_hosts_percent      = 0
+    ramp_down_force_logoff_users         = true
+    ramp_down_wait_time_minutes          = 30
+    ramp_down_notification_message       = "Please log off in the next 30 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroActiveSessions"
+    off_peak_start_time                  = "18:00"
+    off_peak_load_balancing_algorithm    = "DepthFirst"
+  }
+}
+`, testAccAzureRMVirtualDesktopHostPool_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMVirtualDesktopScalingPlan_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "import" {
+  name                = "${azurerm_virtual_desktop_scaling_plan.test.name}"
+  resource_group_name = "${azurerm_virtual_desktop_scaling_plan.test.resource_group_name}"
+  location            = "${azurerm_virtual_desktop_scaling_plan.test.location}"
+  time_zone           = "${azurerm_virtual_desktop_scaling_plan.test.time_zone}"
+
+  schedule {
+    name                                 = "Weekdays"
+    days_of_week                         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                   = "06:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    ramp_up_minimum_hosts_percent        = 20
+    ramp_up_capacity_threshold_percent   = 10
+    peak_start_time                      = "09:00"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "18:00"
+    ramp_down_load_balancing_algorithm   = "DepthFirst"
+    ramp_down_minimum_hosts_percent      = 10
+    ramp_down_force_logoff_users         = false
+    ramp_down_wait_time_minutes          = 45
+    ramp_down_notification_message       = "Please log off in the next 45 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroSessions"
+    off_peak_start_time                  = "22:00"
+    off_peak_load_balancing_algorithm    = "DepthFirst"
+  }
+}
+`, testAccAzureRMVirtualDesktopScalingPlan_basic(rInt, location))
+}
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type virtualDesktopWorkspace struct {
+	ID         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Tags       map[string]*string                 `json:"tags"`
+	Properties *virtualDesktopWorkspaceProperties `json:"properties,omitempty"`
+}
+
+type virtualDesktopWorkspaceProperties struct {
+	FriendlyName               *string   `json:"friendlyName,omitempty"`
+	Description                *string   `json:"description,omitempty"`
+	PublicNetworkAccess        *string   `json:"publicNetworkAccess,omitempty"`
+	ApplicationGroupReferences *[]string `json:"applicationGroupReferences,omitempty"`
+}
+
+func resourceArmVirtualDesktopWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualDesktopWorkspaceCreateUpdate,
+		Read:   resourceArmVirtualDesktopWorkspaceRead,
+		Update: resourceArmVirtualDesktopWorkspaceCreateUpdate,
+		Delete: resourceArmVirtualDesktopWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualDesktopName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"friendly_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"application_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualDesktopWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := virtualDesktopWorkspaceID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing virtualDesktopWorkspace
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Desktop Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_desktop_workspace", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	publicNetworkAccess := "Disabled"
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Enabled"
+	}
+
+	applicationGroupIds := make([]string, 0)
+	for _, v := range d.Get("application_group_ids").(*schema.Set).List() {
+		applicationGroupIds = append(applicationGroupIds, v.(string))
+	}
+
+	parameters := virtualDesktopWorkspace{
+		Location: utils.String(location),
+		Properties: &virtualDesktopWorkspaceProperties{
+			FriendlyName:               utils.String(d.Get("friendly_name").(string)),
+			Description:                utils.String(d.Get("description").(string)),
+			PublicNetworkAccess:        utils.String(publicNetworkAccess),
+			ApplicationGroupReferences: &applicationGroupIds,
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Desktop Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var read virtualDesktopWorkspace
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, id, virtualDesktopApiVersion, &read); err != nil {
+		return fmt.Errorf("Error retrieving Virtual Desktop Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Virtual Desktop Workspace %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualDesktopWorkspaceRead(d, meta)
+}
+
+func resourceArmVirtualDesktopWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	var resp virtualDesktopWorkspace
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Virtual Desktop Workspace %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Desktop Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("description", props.Description)
+
+		publicNetworkAccessEnabled := true
+		if props.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = *props.PublicNetworkAccess == "Enabled"
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		if err := d.Set("application_group_ids", schema.NewSet(schema.HashString, utils.FlattenStringArray(props.ApplicationGroupReferences))); err != nil {
+			return fmt.Errorf("Error setting `application_group_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualDesktopWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), virtualDesktopApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Desktop Workspace %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualDesktopWorkspaceID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/workspaces/%s", subscriptionId, resourceGroup, name)
+}
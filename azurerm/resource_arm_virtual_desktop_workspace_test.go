@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMVirtualDesktopWorkspace_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_workspace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopWorkspace_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_group_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopWorkspace_update(t *testing.T) {
+	resourceName := "azurerm_virtual_desktop_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopWorkspace_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_network_access_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "application_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVirtualDesktopWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualDesktopWorkspace_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_desktop_workspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualDesktopWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualDesktopWorkspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualDesktopWorkspace_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_desktop_workspace"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualDesktopWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp virtualDesktopWorkspace
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Virtual Desktop Workspace %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Virtual Desktop Workspace %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualDesktopWorkspaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_desktop_workspace" {
+			continue
+		}
+
+		var resp virtualDesktopWorkspace
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, virtualDesktopApiVersion, &resp)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Desktop Workspace still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualDesktopWorkspace_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_workspace" "test" {
+  name                = "acctestWS%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, testAccAzureRMVirtualDesktopApplicationGroup_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMVirtualDesktopWorkspace_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_workspace" "test" {
+  name                          = "acctestWS%d"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  location                      = "${azurerm_resource_group.test.location}"
+  friendly_name                 = "Acceptance Test Workspace"
+  description                   = "Acceptance Test: A Workspace"
+  public_network_access_enabled = false
+  application_group_ids         = ["${azurerm_virtual_desktop_application_group.test.id}"]
+
+  tags = {
+    Purpose = "Acceptance-Testing"
+  }
+}
+`, testAccAzureRMVirtualDesktopApplicationGroup_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMVirtualDesktopWorkspace_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_workspace" "import" {
+  name                = "${azurerm_virtual_desktop_workspace.test.name}"
+  resource_group_name = "${azurerm_virtual_desktop_workspace.test.resource_group_name}"
+  location            = "${azurerm_virtual_desktop_workspace.test.location}"
+}
+`, testAccAzureRMVirtualDesktopWorkspace_basic(rInt, location))
+}
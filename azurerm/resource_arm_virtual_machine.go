@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
@@ -292,6 +293,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 								string(compute.StorageAccountTypesPremiumLRS),
 								string(compute.StorageAccountTypesStandardLRS),
 								string(compute.StorageAccountTypesStandardSSDLRS),
+								string(compute.StorageAccountTypesUltraSSDLRS),
 							}, true),
 						},
 
@@ -334,6 +336,23 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Default:  false,
 			},
 
+			// enabling (or disabling) this requires the Virtual Machine to be deallocated
+			"additional_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ultra_ssd_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"boot_diagnostics": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -376,6 +395,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Sensitive: true,
 						},
 
+						// the password is retrieved from the Key Vault at apply time, rather than being specified in the config
+						"admin_password_key_vault_secret_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+
 						"custom_data": {
 							Type:      schema.TypeString,
 							ForceNew:  true,
@@ -549,8 +575,20 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Optional: true,
 			},
 
+			"security_type": computeSecurityTypeSchema(),
+
+			"secure_boot_enabled": computeSecurityProfileUefiSettingSchema(),
+
+			"vtpm_enabled": computeSecurityProfileUefiSettingSchema(),
+
+			"os_disk_security_encryption_type": computeOsDiskSecurityEncryptionTypeSchema(),
+
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			return validateComputeSecurityProfile(diff)
+		},
 	}
 }
 
@@ -620,6 +658,10 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 		properties.LicenseType = &license
 	}
 
+	if v, ok := d.GetOk("additional_capabilities"); ok {
+		properties.AdditionalCapabilities = expandAzureRmVirtualMachineAdditionalCapabilities(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("boot_diagnostics"); ok {
 		diagnosticsProfile := expandAzureRmVirtualMachineDiagnosticsProfile(d)
 		if diagnosticsProfile != nil {
@@ -628,7 +670,7 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if _, ok := d.GetOk("os_profile"); ok {
-		osProfile, err2 := expandAzureRmVirtualMachineOsProfile(d)
+		osProfile, err2 := expandAzureRmVirtualMachineOsProfile(ctx, d, meta)
 		if err2 != nil {
 			return err2
 		}
@@ -669,13 +711,25 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
-	if err != nil {
-		return err
-	}
+	if _, ok := d.GetOk("security_type"); ok {
+		parameters, err := expandComputeSecurityProfileParameters(d, vm, "properties")
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+		id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", client.SubscriptionID, resGroup, name)
+		if err := armRawPut(ctx, client.Client, client.BaseURI, id, computeSecurityProfileApiVersion, parameters); err != nil {
+			return fmt.Errorf("Error creating/updating Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
+		if err != nil {
+			return err
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return err
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
@@ -729,6 +783,10 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error making Read request on Azure Virtual Machine %s: %+v", name, err)
 	}
 
+	// the Security Profile is only retrieved when it's used, or when importing (where the `vm_size` isn't yet known)
+	_, hasSecurityType := d.GetOk("security_type")
+	importing := d.Get("vm_size").(string) == ""
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	d.Set("zones", resp.Zones)
@@ -736,6 +794,16 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	if hasSecurityType || importing {
+		var security computeSecurityProfileResource
+		if _, err := armRawGet(ctx, vmClient.Client, vmClient.BaseURI, d.Id(), computeSecurityProfileApiVersion, &security); err != nil {
+			return fmt.Errorf("Error retrieving the Security Profile for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		if props := security.Properties; props != nil {
+			flattenComputeSecurityProfile(d, &props.computeSecurityProfileVirtualMachineProfile)
+		}
+	}
+
 	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
 		return fmt.Errorf("Error setting `plan`: %#v", err)
 	}
@@ -754,6 +822,10 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("vm_size", profile.VMSize)
 		}
 
+		if err := d.Set("additional_capabilities", flattenAzureRmVirtualMachineAdditionalCapabilities(props.AdditionalCapabilities)); err != nil {
+			return fmt.Errorf("Error setting `additional_capabilities`: %+v", err)
+		}
+
 		if profile := props.StorageProfile; profile != nil {
 			if err := d.Set("storage_image_reference", schema.NewSet(resourceArmVirtualMachineStorageImageReferenceHash, flattenAzureRmVirtualMachineImageReference(profile.ImageReference))); err != nil {
 				return fmt.Errorf("[DEBUG] Error setting Virtual Machine Storage Image Reference error: %#v", err)
@@ -998,6 +1070,23 @@ func flattenAzureRmVirtualMachinePlan(plan *compute.Plan) []interface{} {
 	return []interface{}{result}
 }
 
+func flattenAzureRmVirtualMachineAdditionalCapabilities(input *compute.AdditionalCapabilities) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	ultraSSDEnabled := false
+	if input.UltraSSDEnabled != nil {
+		ultraSSDEnabled = *input.UltraSSDEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ultra_ssd_enabled": ultraSSDEnabled,
+		},
+	}
+}
+
 func flattenAzureRmVirtualMachineImageReference(image *compute.ImageReference) []interface{} {
 	if image == nil {
 		return []interface{}{}
@@ -1302,6 +1391,17 @@ func expandAzureRmVirtualMachinePlan(d *schema.ResourceData) (*compute.Plan, err
 	}, nil
 }
 
+func expandAzureRmVirtualMachineAdditionalCapabilities(input []interface{}) *compute.AdditionalCapabilities {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &compute.AdditionalCapabilities{
+		UltraSSDEnabled: utils.Bool(v["ultra_ssd_enabled"].(bool)),
+	}
+}
+
 func expandAzureRmVirtualMachineIdentity(d *schema.ResourceData) *compute.VirtualMachineIdentity {
 	v := d.Get("identity")
 	identities := v.([]interface{})
@@ -1324,15 +1424,28 @@ func expandAzureRmVirtualMachineIdentity(d *schema.ResourceData) *compute.Virtua
 	return &vmIdentity
 }
 
-func expandAzureRmVirtualMachineOsProfile(d *schema.ResourceData) (*compute.OSProfile, error) {
+func expandAzureRmVirtualMachineOsProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) (*compute.OSProfile, error) {
 	osProfiles := d.Get("os_profile").(*schema.Set).List()
 
 	osProfile := osProfiles[0].(map[string]interface{})
 
 	adminUsername := osProfile["admin_username"].(string)
 	adminPassword := osProfile["admin_password"].(string)
+	adminPasswordKeyVaultSecretId := osProfile["admin_password_key_vault_secret_id"].(string)
 	computerName := osProfile["computer_name"].(string)
 
+	if adminPasswordKeyVaultSecretId != "" {
+		if adminPassword != "" {
+			return nil, fmt.Errorf("only one of `admin_password` and `admin_password_key_vault_secret_id` can be specified within the `os_profile` block")
+		}
+
+		secret, err := getKeyVaultSecretValue(ctx, meta.(*ArmClient).keyVaultManagementClient, adminPasswordKeyVaultSecretId)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving the Admin Password for the Virtual Machine: %+v", err)
+		}
+		adminPassword = secret
+	}
+
 	profile := &compute.OSProfile{
 		AdminUsername: &adminUsername,
 		ComputerName:  &computerName,
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -119,6 +120,11 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 	createOption := compute.DiskCreateOptionTypes(d.Get("create_option").(string))
 	writeAcceleratorEnabled := d.Get("write_accelerator_enabled").(bool)
 
+	// these would otherwise only be surfaced once the Virtual Machine update fails, which can take some time
+	if err := validateVirtualMachineDataDiskAttachment(virtualMachine, managedDisk, lun, caching, writeAcceleratorEnabled, d.IsNewResource()); err != nil {
+		return fmt.Errorf("Error attaching Disk %q to Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
 	expandedDisk := compute.DataDisk{
 		Name:         utils.String(name),
 		Caching:      compute.CachingTypes(caching),
@@ -131,11 +137,14 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 		WriteAcceleratorEnabled: utils.Bool(writeAcceleratorEnabled),
 	}
 
-	disks := *virtualMachine.StorageProfile.DataDisks
+	disks := make([]compute.DataDisk, 0)
+	if virtualMachine.StorageProfile.DataDisks != nil {
+		disks = *virtualMachine.StorageProfile.DataDisks
+	}
 
 	existingIndex := -1
 	for i, disk := range disks {
-		if *disk.Name == name {
+		if disk.Name != nil && *disk.Name == name {
 			existingIndex = i
 			break
 		}
@@ -194,7 +203,9 @@ func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, met
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(virtualMachine.Response) {
-			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Data Disk %q from state", virtualMachineName, resourceGroup, name)
+			d.SetId("")
+			return nil
 		}
 
 		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
@@ -254,20 +265,33 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(virtualMachine.Response) {
-			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
+			// the Disk is detached when the Virtual Machine is deleted, so there's nothing to do
+			return nil
 		}
 
 		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
 	}
 
+	found := false
 	dataDisks := make([]compute.DataDisk, 0)
-	for _, dataDisk := range *virtualMachine.StorageProfile.DataDisks {
-		// since this field isn't (and shouldn't be) case-sensitive; we're deliberately not using `strings.EqualFold`
-		if *dataDisk.Name != name {
+	if profile := virtualMachine.StorageProfile; profile != nil && profile.DataDisks != nil {
+		for _, dataDisk := range *profile.DataDisks {
+			// since this field isn't (and shouldn't be) case-sensitive; we're deliberately not using `strings.EqualFold`
+			if dataDisk.Name != nil && *dataDisk.Name == name {
+				found = true
+				continue
+			}
+
 			dataDisks = append(dataDisks, dataDisk)
 		}
 	}
 
+	if !found {
+		log.Printf("[DEBUG] Data Disk %q is no longer attached to Virtual Machine %q (Resource Group %q)", name, virtualMachineName, resourceGroup)
+		return nil
+	}
+
+	// the Virtual Machine is updated in-place, so the Disk can be detached whilst it's running
 	virtualMachine.StorageProfile.DataDisks = &dataDisks
 
 	// fixes #1600
@@ -307,3 +331,70 @@ func retrieveDataDiskAttachmentManagedDisk(meta interface{}, id string) (*comput
 
 	return &resp, nil
 }
+
+// validateVirtualMachineDataDiskAttachment checks that the Managed Disk can be attached to the Virtual Machine
+// using the specified settings - `isNew` is whether the Disk is being attached, rather than updated
+func validateVirtualMachineDataDiskAttachment(virtualMachine compute.VirtualMachine, managedDisk *compute.Disk, lun int32, caching string, writeAcceleratorEnabled bool, isNew bool) error {
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil || props.StorageProfile == nil {
+		return fmt.Errorf("`properties.storageProfile` was nil")
+	}
+
+	name := ""
+	if managedDisk.Name != nil {
+		name = *managedDisk.Name
+	}
+
+	storageAccountType := ""
+	if managedDisk.Sku != nil {
+		storageAccountType = string(managedDisk.Sku.Name)
+	}
+
+	if isNew {
+		// when it's attached to this Virtual Machine this is surfaced as a requires import error instead
+		if managedBy := managedDisk.ManagedBy; managedBy != nil && *managedBy != "" {
+			if virtualMachine.ID == nil || !strings.EqualFold(*managedBy, *virtualMachine.ID) {
+				return fmt.Errorf("the Managed Disk is already attached to %q", *managedBy)
+			}
+		}
+
+		if disks := props.StorageProfile.DataDisks; disks != nil {
+			for _, disk := range *disks {
+				if disk.Lun != nil && *disk.Lun == lun && (disk.Name == nil || *disk.Name != name) {
+					existing := ""
+					if disk.Name != nil {
+						existing = *disk.Name
+					}
+					return fmt.Errorf("LUN %d is already in use by the Disk %q", lun, existing)
+				}
+			}
+		}
+	}
+
+	if strings.EqualFold(storageAccountType, string(compute.UltraSSDLRS)) {
+		ultraSSDEnabled := false
+		if capabilities := props.AdditionalCapabilities; capabilities != nil && capabilities.UltraSSDEnabled != nil {
+			ultraSSDEnabled = *capabilities.UltraSSDEnabled
+		}
+
+		if !ultraSSDEnabled {
+			return fmt.Errorf("`UltraSSD_LRS` Disks can only be attached to a Virtual Machine with `ultra_ssd_enabled` set to `true` within the `additional_capabilities` block")
+		}
+
+		if !strings.EqualFold(caching, string(compute.CachingTypesNone)) {
+			return fmt.Errorf("`caching` must be set to `None` for `UltraSSD_LRS` Disks")
+		}
+	}
+
+	if writeAcceleratorEnabled {
+		if !strings.EqualFold(storageAccountType, string(compute.PremiumLRS)) {
+			return fmt.Errorf("`write_accelerator_enabled` can only be enabled for `Premium_LRS` Disks")
+		}
+
+		if strings.EqualFold(caching, string(compute.CachingTypesReadWrite)) {
+			return fmt.Errorf("`caching` must be set to `None` or `ReadOnly` when `write_accelerator_enabled` is enabled")
+		}
+	}
+
+	return nil
+}
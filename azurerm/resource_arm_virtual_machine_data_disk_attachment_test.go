@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -201,6 +203,217 @@ func TestAccAzureRMVirtualMachineDataDiskAttachment_virtualMachineExtension(t *t
 	})
 }
 
+func TestAccAzureRMVirtualMachineDataDiskAttachment_detaching(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	var before, after compute.VirtualMachine
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					testCheckAzureRMVirtualMachineExists("azurerm_virtual_machine.test", &before),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_template(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists("azurerm_virtual_machine.test", &after),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentVirtualMachineNotRecreated(&before, &after),
+					func(s *terraform.State) error {
+						if disks := after.StorageProfile.DataDisks; disks != nil && len(*disks) > 0 {
+							return fmt.Errorf("Expected no Data Disks to be attached to the Virtual Machine but got %d", len(*disks))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineDataDiskAttachment_ultraDisk(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_ultraDisk(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "caching", "None"),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine.test", "additional_capabilities.0.ultra_ssd_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateVirtualMachineDataDiskAttachment(t *testing.T) {
+	virtualMachineId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1"
+	virtualMachine := func(ultraSSDEnabled bool, disks ...compute.DataDisk) compute.VirtualMachine {
+		return compute.VirtualMachine{
+			ID: utils.String(virtualMachineId),
+			VirtualMachineProperties: &compute.VirtualMachineProperties{
+				AdditionalCapabilities: &compute.AdditionalCapabilities{
+					UltraSSDEnabled: utils.Bool(ultraSSDEnabled),
+				},
+				StorageProfile: &compute.StorageProfile{
+					DataDisks: &disks,
+				},
+			},
+		}
+	}
+	managedDisk := func(storageAccountType compute.DiskStorageAccountTypes, managedBy string) *compute.Disk {
+		disk := compute.Disk{
+			Name: utils.String("disk1"),
+			Sku: &compute.DiskSku{
+				Name: storageAccountType,
+			},
+		}
+		if managedBy != "" {
+			disk.ManagedBy = utils.String(managedBy)
+		}
+		return &disk
+	}
+
+	testData := []struct {
+		name                    string
+		virtualMachine          compute.VirtualMachine
+		managedDisk             *compute.Disk
+		lun                     int32
+		caching                 string
+		writeAcceleratorEnabled bool
+		isNew                   bool
+		expectedError           string
+	}{
+		{
+			name:           "Standard Disk",
+			virtualMachine: virtualMachine(false),
+			managedDisk:    managedDisk(compute.StandardLRS, ""),
+			caching:        "ReadWrite",
+			isNew:          true,
+		},
+		{
+			name:           "Disk attached to another Virtual Machine",
+			virtualMachine: virtualMachine(false),
+			managedDisk:    managedDisk(compute.StandardLRS, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm2"),
+			caching:        "None",
+			isNew:          true,
+			expectedError:  "already attached",
+		},
+		{
+			name:           "Disk attached to this Virtual Machine",
+			virtualMachine: virtualMachine(false, compute.DataDisk{Name: utils.String("disk1"), Lun: utils.Int32(0)}),
+			managedDisk:    managedDisk(compute.StandardLRS, strings.ToUpper(virtualMachineId)),
+			caching:        "None",
+			isNew:          true,
+		},
+		{
+			name:           "LUN in use",
+			virtualMachine: virtualMachine(false, compute.DataDisk{Name: utils.String("disk2"), Lun: utils.Int32(1)}),
+			managedDisk:    managedDisk(compute.StandardLRS, ""),
+			lun:            1,
+			caching:        "None",
+			isNew:          true,
+			expectedError:  "LUN 1 is already in use",
+		},
+		{
+			name:           "Ultra Disk without Ultra SSD enabled",
+			virtualMachine: virtualMachine(false),
+			managedDisk:    managedDisk(compute.UltraSSDLRS, ""),
+			caching:        "None",
+			isNew:          true,
+			expectedError:  "ultra_ssd_enabled",
+		},
+		{
+			name:           "Ultra Disk with caching",
+			virtualMachine: virtualMachine(true),
+			managedDisk:    managedDisk(compute.UltraSSDLRS, ""),
+			caching:        "ReadOnly",
+			isNew:          true,
+			expectedError:  "`caching` must be set to `None`",
+		},
+		{
+			name:           "Ultra Disk",
+			virtualMachine: virtualMachine(true),
+			managedDisk:    managedDisk(compute.UltraSSDLRS, ""),
+			caching:        "None",
+			isNew:          true,
+		},
+		{
+			name:                    "Write Accelerator on a Standard Disk",
+			virtualMachine:          virtualMachine(false),
+			managedDisk:             managedDisk(compute.StandardLRS, ""),
+			caching:                 "None",
+			writeAcceleratorEnabled: true,
+			expectedError:           "Premium_LRS",
+		},
+		{
+			name:                    "Write Accelerator with ReadWrite caching",
+			virtualMachine:          virtualMachine(false),
+			managedDisk:             managedDisk(compute.PremiumLRS, ""),
+			caching:                 "ReadWrite",
+			writeAcceleratorEnabled: true,
+			expectedError:           "`None` or `ReadOnly`",
+		},
+		{
+			name:                    "Write Accelerator",
+			virtualMachine:          virtualMachine(false),
+			managedDisk:             managedDisk(compute.PremiumLRS, ""),
+			caching:                 "ReadOnly",
+			writeAcceleratorEnabled: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateVirtualMachineDataDiskAttachment(v.virtualMachine, v.managedDisk, v.lun, v.caching, v.writeAcceleratorEnabled, v.isNew)
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error containing %q but got none", v.expectedError)
+		}
+		if !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("Expected an error containing %q but got: %+v", v.expectedError, err)
+		}
+	}
+}
+
+func testCheckAzureRMVirtualMachineDataDiskAttachmentVirtualMachineNotRecreated(before, after *compute.VirtualMachine) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.VMID == nil || after.VMID == nil {
+			return fmt.Errorf("Unable to determine the VM ID of the Virtual Machine")
+		}
+
+		if *before.VMID != *after.VMID {
+			return fmt.Errorf("Expected the Virtual Machine not to be recreated but the VM ID changed from %q to %q", *before.VMID, *after.VMID)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -541,6 +754,95 @@ resource "azurerm_managed_disk" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
 }
 
+func testAccAzureRMVirtualMachineDataDiskAttachment_ultraDisk(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_D2s_v3"
+  zones                 = ["1"]
+
+  additional_capabilities {
+    ultra_ssd_enabled = true
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "myosdisk1"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Premium_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "%d-disk1"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "UltraSSD_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+  zones                = ["1"]
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "None"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
 func testAccAzureRMVirtualMachineDataDiskAttachment_virtualMachineExtensionPrep(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -733,6 +733,14 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Set: resourceArmVirtualMachineScaleSetExtensionHash,
 			},
 
+			"security_type": computeSecurityTypeSchema(),
+
+			"secure_boot_enabled": computeSecurityProfileUefiSettingSchema(),
+
+			"vtpm_enabled": computeSecurityProfileUefiSettingSchema(),
+
+			"os_disk_security_encryption_type": computeOsDiskSecurityEncryptionTypeSchema(),
+
 			"tags": tagsSchema(),
 		},
 
@@ -869,13 +877,25 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		properties.Plan = plan
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
-	if err != nil {
-		return err
-	}
+	if _, ok := d.GetOk("security_type"); ok {
+		parameters, err := expandComputeSecurityProfileParameters(d, properties, "properties", "virtualMachineProfile")
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+		id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s", client.SubscriptionID, resGroup, name)
+		if err := armRawPut(ctx, client.Client, client.BaseURI, id, computeSecurityProfileApiVersion, parameters); err != nil {
+			return fmt.Errorf("Error creating/updating Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
+		if err != nil {
+			return err
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return err
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -912,6 +932,10 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error making Read request on Azure Virtual Machine Scale Set %s: %+v", name, err)
 	}
 
+	// the Security Profile is only retrieved when it's used, or when importing (where the `sku` isn't yet known)
+	_, hasSecurityType := d.GetOk("security_type")
+	importing := d.Get("sku.#").(int) == 0
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	if location := resp.Location; location != nil {
@@ -919,6 +943,16 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("zones", resp.Zones)
 
+	if hasSecurityType || importing {
+		var security computeSecurityProfileResource
+		if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), computeSecurityProfileApiVersion, &security); err != nil {
+			return fmt.Errorf("Error retrieving the Security Profile for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		if props := security.Properties; props != nil {
+			flattenComputeSecurityProfile(d, props.VirtualMachineProfile)
+		}
+	}
+
 	if err := d.Set("sku", flattenAzureRmVirtualMachineScaleSetSku(resp.Sku)); err != nil {
 		return fmt.Errorf("[DEBUG] Error setting `sku`: %#v", err)
 	}
@@ -2209,8 +2243,12 @@ func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new str
 	return false
 }
 
-// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
+// Validates the Security Profile, and makes sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
 func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if err := validateComputeSecurityProfile(d); err != nil {
+		return err
+	}
+
 	mode := d.Get("upgrade_policy_mode").(string)
 	if strings.ToLower(mode) != "rolling" {
 		if policyRaw, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -17,6 +18,21 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const virtualNetworkGatewayExtendedApiVersion = "2019-07-01"
+
+type virtualNetworkGatewayExtended struct {
+	Properties *virtualNetworkGatewayExtendedProperties `json:"properties,omitempty"`
+}
+
+type virtualNetworkGatewayExtendedProperties struct {
+	CustomRoutes         *virtualNetworkGatewayCustomRoutes `json:"customRoutes,omitempty"`
+	VpnGatewayGeneration *string                            `json:"vpnGatewayGeneration,omitempty"`
+}
+
+type virtualNetworkGatewayCustomRoutes struct {
+	AddressPrefixes *[]string `json:"addressPrefixes,omitempty"`
+}
+
 func resourceArmVirtualNetworkGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualNetworkGatewayCreateUpdate,
@@ -76,6 +92,37 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 				Computed: true,
 			},
 
+			"generation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Generation1",
+					"Generation2",
+					"None",
+				}, false),
+			},
+
+			"custom_route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.CIDR,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
 			"sku": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -88,6 +135,9 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 					string(network.VirtualNetworkGatewaySkuNameVpnGw1),
 					string(network.VirtualNetworkGatewaySkuNameVpnGw2),
 					string(network.VirtualNetworkGatewaySkuNameVpnGw3),
+					string(network.VirtualNetworkGatewaySkuNameVpnGw1AZ),
+					string(network.VirtualNetworkGatewaySkuNameVpnGw2AZ),
+					string(network.VirtualNetworkGatewaySkuNameVpnGw3AZ),
 					string(network.VirtualNetworkGatewaySkuNameErGw1AZ),
 					string(network.VirtualNetworkGatewaySkuNameErGw2AZ),
 					string(network.VirtualNetworkGatewaySkuNameErGw3AZ),
@@ -304,13 +354,29 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
-	if err != nil {
-		return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	// Custom Routes & the Generation aren't available in the vendored SDK - and since they can't be PATCH'd these need
+	// to be sent in the same request as the rest of the Virtual Network Gateway
+	_, hasGeneration := d.GetOk("generation")
+	_, hasCustomRoute := d.GetOk("custom_route")
+	if hasGeneration || hasCustomRoute || d.HasChange("custom_route") {
+		body, err := expandArmVirtualNetworkGatewayWithExtendedProperties(d, gateway)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkGateways/%s", meta.(*ArmClient).subscriptionId, resGroup, name)
+		if err := armRawPut(ctx, client.Client, client.BaseURI, id, virtualNetworkGatewayExtendedApiVersion, body); err != nil {
+			return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for completion of AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -382,6 +448,21 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 		}
 	}
 
+	var extended virtualNetworkGatewayExtended
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), virtualNetworkGatewayExtendedApiVersion, &extended); err != nil {
+		return fmt.Errorf("Error retrieving the Custom Routes/Generation for AzureRM Virtual Network Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if props := extended.Properties; props != nil {
+		if props.VpnGatewayGeneration != nil {
+			d.Set("generation", props.VpnGatewayGeneration)
+		}
+
+		if err := d.Set("custom_route", flattenArmVirtualNetworkGatewayCustomRoute(props.CustomRoutes)); err != nil {
+			return fmt.Errorf("Error setting `custom_route`: %+v", err)
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -715,6 +796,59 @@ func hashVirtualNetworkGatewayRevokedCert(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// expandArmVirtualNetworkGatewayWithExtendedProperties serializes the Virtual Network Gateway using the SDK and then
+// adds the properties which aren't available in the vendored SDK
+func expandArmVirtualNetworkGatewayWithExtendedProperties(d *schema.ResourceData, gateway network.VirtualNetworkGateway) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Virtual Network Gateway: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(serialized, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing Virtual Network Gateway: %+v", err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+
+	if v, ok := d.GetOk("generation"); ok {
+		properties["vpnGatewayGeneration"] = v.(string)
+	}
+
+	addressPrefixes := make([]string, 0)
+	if v := d.Get("custom_route").([]interface{}); len(v) > 0 && v[0] != nil {
+		for _, prefix := range v[0].(map[string]interface{})["address_prefixes"].(*schema.Set).List() {
+			addressPrefixes = append(addressPrefixes, prefix.(string))
+		}
+	}
+	properties["customRoutes"] = virtualNetworkGatewayCustomRoutes{
+		AddressPrefixes: &addressPrefixes,
+	}
+
+	return body, nil
+}
+
+func flattenArmVirtualNetworkGatewayCustomRoute(input *virtualNetworkGatewayCustomRoutes) []interface{} {
+	if input == nil || input.AddressPrefixes == nil || len(*input.AddressPrefixes) == 0 {
+		return []interface{}{}
+	}
+
+	addressPrefixes := make([]interface{}, 0)
+	for _, prefix := range *input.AddressPrefixes {
+		addressPrefixes = append(addressPrefixes, prefix)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"address_prefixes": schema.NewSet(schema.HashString, addressPrefixes),
+		},
+	}
+}
+
 func resourceGroupAndVirtualNetworkGatewayFromId(virtualNetworkGatewayId string) (string, string, error) {
 	id, err := parseAzureResourceID(virtualNetworkGatewayId)
 	if err != nil {
@@ -766,6 +900,9 @@ func validateArmVirtualNetworkGatewayRouteBasedVpnSku() schema.SchemaValidateFun
 		string(network.VirtualNetworkGatewaySkuNameVpnGw1),
 		string(network.VirtualNetworkGatewaySkuNameVpnGw2),
 		string(network.VirtualNetworkGatewaySkuNameVpnGw3),
+		string(network.VirtualNetworkGatewaySkuNameVpnGw1AZ),
+		string(network.VirtualNetworkGatewaySkuNameVpnGw2AZ),
+		string(network.VirtualNetworkGatewaySkuNameVpnGw3AZ),
 	}, true)
 }
 
@@ -782,6 +919,18 @@ func validateArmVirtualNetworkGatewayExpressRouteSku() schema.SchemaValidateFunc
 
 func resourceArmVirtualNetworkGatewayCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
 
+	activeActive := diff.Get("active_active").(bool)
+	ipConfigurations := len(diff.Get("ip_configuration").([]interface{}))
+	if activeActive && ipConfigurations != 2 {
+		return fmt.Errorf("two `ip_configuration` blocks must be specified when `active_active` is enabled")
+	}
+	if !activeActive && ipConfigurations > 1 {
+		return fmt.Errorf("only one `ip_configuration` block can be specified when `active_active` is disabled")
+	}
+	if activeActive && strings.EqualFold(diff.Get("sku").(string), string(network.VirtualNetworkGatewaySkuTierBasic)) {
+		return fmt.Errorf("`active_active` is not supported for the `Basic` SKU")
+	}
+
 	if vpnClient, ok := diff.GetOk("vpn_client_configuration"); ok {
 		if vpnClientConfig, ok := vpnClient.([]interface{})[0].(map[string]interface{}); ok {
 			hasRadiusAddress := vpnClientConfig["radius_server_address"] != ""
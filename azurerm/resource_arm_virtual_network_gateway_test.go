@@ -129,6 +129,75 @@ func TestAccAzureRMVirtualNetworkGateway_activeActive(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkGateway_activeActiveZoneRedundant(t *testing.T) {
+	resourceName := "azurerm_virtual_network_gateway.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMVirtualNetworkGateway_activeActiveZoneRedundant(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "VpnGw2AZ"),
+					resource.TestCheckResourceAttr(resourceName, "generation", "Generation2"),
+					resource.TestCheckResourceAttr(resourceName, "active_active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkGateway_customRoute(t *testing.T) {
+	resourceName := "azurerm_virtual_network_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_customRoute(ri, location, `"101.168.0.6/32"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_route.0.address_prefixes.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_customRoute(ri, location, `"101.168.0.6/32", "101.168.0.7/32"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_route.0.address_prefixes.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_customRoute(ri, location, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetworkGateway_standard(t *testing.T) {
 	resourceName := "azurerm_virtual_network_gateway.test"
 	ri := tf.AccRandTimeInt()
@@ -564,6 +633,136 @@ resource "azurerm_virtual_network_gateway" "test" {
 
 }
 
+func testAccAzureRMVirtualNetworkGateway_activeActiveZoneRedundant(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "first" {
+  name                = "acctestpip1-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_public_ip" "second" {
+  name                = "acctestpip2-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type       = "Vpn"
+  vpn_type   = "RouteBased"
+  sku        = "VpnGw2AZ"
+  generation = "Generation2"
+
+  active_active = true
+  enable_bgp    = true
+
+  ip_configuration {
+    name                          = "gw-ip1"
+    public_ip_address_id          = "${azurerm_public_ip.first.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  ip_configuration {
+    name                          = "gw-ip2"
+    public_ip_address_id          = "${azurerm_public_ip.second.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  bgp_settings {
+    asn = "65010"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMVirtualNetworkGateway_customRoute(rInt int, location string, addressPrefixes string) string {
+	customRoute := ""
+	if addressPrefixes != "" {
+		customRoute = fmt.Sprintf(`
+  custom_route {
+    address_prefixes = [%s]
+  }`, addressPrefixes)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  vpn_client_configuration {
+    address_space = ["10.2.0.0/24"]
+  }
+%[3]s
+}
+`, rInt, location, customRoute)
+}
+
 func testAccAzureRMVirtualNetworkGateway_vpnClientConfig(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,546 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Web PubSub isn't present in the vendored SDK, so is managed using raw requests
+const webPubSubApiVersion = "2023-02-01"
+
+type webPubSub struct {
+	ID         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Location   *string              `json:"location,omitempty"`
+	Tags       map[string]*string   `json:"tags"`
+	Sku        *webPubSubSku        `json:"sku,omitempty"`
+	Identity   *webPubSubIdentity   `json:"identity,omitempty"`
+	Properties *webPubSubProperties `json:"properties,omitempty"`
+}
+
+type webPubSubSku struct {
+	Name     *string `json:"name,omitempty"`
+	Capacity *int32  `json:"capacity,omitempty"`
+}
+
+type webPubSubIdentity struct {
+	Type        *string `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+type webPubSubProperties struct {
+	DisableLocalAuth    *bool                 `json:"disableLocalAuth,omitempty"`
+	PublicNetworkAccess *string               `json:"publicNetworkAccess,omitempty"`
+	TLS                 *webPubSubTLSSettings `json:"tls,omitempty"`
+	NetworkACLs         *webPubSubNetworkACLs `json:"networkACLs,omitempty"`
+	HostName            *string               `json:"hostName,omitempty"`
+	ExternalIP          *string               `json:"externalIP,omitempty"`
+	PublicPort          *int32                `json:"publicPort,omitempty"`
+	ServerPort          *int32                `json:"serverPort,omitempty"`
+	Version             *string               `json:"version,omitempty"`
+}
+
+type webPubSubTLSSettings struct {
+	ClientCertEnabled *bool `json:"clientCertEnabled,omitempty"`
+}
+
+type webPubSubNetworkACLs struct {
+	DefaultAction *string              `json:"defaultAction,omitempty"`
+	PublicNetwork *webPubSubNetworkACL `json:"publicNetwork,omitempty"`
+}
+
+type webPubSubNetworkACL struct {
+	Allow *[]string `json:"allow,omitempty"`
+	Deny  *[]string `json:"deny,omitempty"`
+}
+
+type webPubSubKeys struct {
+	PrimaryKey                *string `json:"primaryKey,omitempty"`
+	SecondaryKey              *string `json:"secondaryKey,omitempty"`
+	PrimaryConnectionString   *string `json:"primaryConnectionString,omitempty"`
+	SecondaryConnectionString *string `json:"secondaryConnectionString,omitempty"`
+}
+
+func resourceArmWebPubSub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebPubSubCreateUpdate,
+		Read:   resourceArmWebPubSubRead,
+		Update: resourceArmWebPubSubCreateUpdate,
+		Delete: resourceArmWebPubSubDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WebPubSubName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Free_F1",
+					"Standard_S1",
+					"Premium_P1",
+				}, false),
+			},
+
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.IntInSlice([]int{1, 2, 5, 10, 20, 50, 100}),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"local_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tls_client_cert_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Azure applies a default Network ACL when none is specified, which is exposed when this block is omitted
+			"network_acl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Deny",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Deny",
+							}, false),
+						},
+
+						"public_network": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_request_types": webPubSubRequestTypesSchema(),
+
+									"denied_request_types": webPubSubRequestTypesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"external_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"server_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			allowed := diff.Get("network_acl.0.public_network.0.allowed_request_types").(*schema.Set)
+			denied := diff.Get("network_acl.0.public_network.0.denied_request_types").(*schema.Set)
+			if allowed.Len() > 0 && denied.Len() > 0 {
+				return fmt.Errorf("only one of `allowed_request_types` and `denied_request_types` can be specified in the `public_network` block")
+			}
+
+			return nil
+		},
+	}
+}
+
+func webPubSubRequestTypesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ClientConnection",
+				"ServerConnection",
+				"RESTAPI",
+				"Trace",
+			}, false),
+		},
+		Set: schema.HashString,
+	}
+}
+
+func resourceArmWebPubSubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := webPubSubID(meta.(*ArmClient).subscriptionId, resourceGroup, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing webPubSub
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, webPubSubApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Web PubSub %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_web_pubsub", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	publicNetworkAccess := "Enabled"
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Disabled"
+	}
+
+	parameters := webPubSub{
+		Location: utils.String(location),
+		Sku: &webPubSubSku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int32(int32(d.Get("capacity").(int))),
+		},
+		Identity: expandArmWebPubSubIdentity(d.Get("identity").([]interface{})),
+		Properties: &webPubSubProperties{
+			DisableLocalAuth:    utils.Bool(!d.Get("local_authentication_enabled").(bool)),
+			PublicNetworkAccess: utils.String(publicNetworkAccess),
+			TLS: &webPubSubTLSSettings{
+				ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
+			},
+			NetworkACLs: expandArmWebPubSubNetworkACLs(d.Get("network_acl").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, webPubSubApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Web PubSub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmWebPubSubRead(d, meta)
+}
+
+func resourceArmWebPubSubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webPubSub"]
+
+	var resp webPubSub
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), webPubSubApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Web PubSub %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Web PubSub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+		if sku.Capacity != nil {
+			d.Set("capacity", int(*sku.Capacity))
+		}
+	}
+
+	if err := d.Set("identity", flattenArmWebPubSubIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	// Local Authentication & Public Network Access are enabled when omitted by the API
+	localAuthenticationEnabled := true
+	if props := resp.Properties; props != nil {
+		if props.DisableLocalAuth != nil {
+			localAuthenticationEnabled = !*props.DisableLocalAuth
+		}
+
+		publicNetworkAccessEnabled := true
+		if props.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = !strings.EqualFold(*props.PublicNetworkAccess, "Disabled")
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+		tlsClientCertEnabled := false
+		if props.TLS != nil && props.TLS.ClientCertEnabled != nil {
+			tlsClientCertEnabled = *props.TLS.ClientCertEnabled
+		}
+		d.Set("tls_client_cert_enabled", tlsClientCertEnabled)
+
+		if err := d.Set("network_acl", flattenArmWebPubSubNetworkACLs(props.NetworkACLs)); err != nil {
+			return fmt.Errorf("Error setting `network_acl`: %+v", err)
+		}
+
+		d.Set("hostname", props.HostName)
+		d.Set("external_ip", props.ExternalIP)
+		if props.PublicPort != nil {
+			d.Set("public_port", int(*props.PublicPort))
+		}
+		if props.ServerPort != nil {
+			d.Set("server_port", int(*props.ServerPort))
+		}
+		d.Set("version", props.Version)
+	}
+	d.Set("local_authentication_enabled", localAuthenticationEnabled)
+
+	// the access keys can't be used when Local Authentication is disabled, so aren't exported
+	keys := webPubSubKeys{}
+	if localAuthenticationEnabled {
+		if err := armRawPostWithResult(ctx, client.Client, client.BaseURI, fmt.Sprintf("%s/listKeys", d.Id()), webPubSubApiVersion, struct{}{}, &keys); err != nil {
+			return fmt.Errorf("Error retrieving the Access Keys for Web PubSub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+	d.Set("primary_access_key", keys.PrimaryKey)
+	d.Set("primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("secondary_access_key", keys.SecondaryKey)
+	d.Set("secondary_connection_string", keys.SecondaryConnectionString)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWebPubSubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webPubSub"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), webPubSubApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Web PubSub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func webPubSubID(subscriptionId, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SignalRService/webPubSub/%s", subscriptionId, resourceGroup, name)
+}
+
+func expandArmWebPubSubIdentity(input []interface{}) *webPubSubIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &webPubSubIdentity{
+			Type: utils.String("None"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &webPubSubIdentity{
+		Type: utils.String(v["type"].(string)),
+	}
+}
+
+func flattenArmWebPubSubIdentity(input *webPubSubIdentity) []interface{} {
+	if input == nil || input.Type == nil || strings.EqualFold(*input.Type, "None") {
+		return make([]interface{}, 0)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         *input.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandArmWebPubSubNetworkACLs(input []interface{}) *webPubSubNetworkACLs {
+	// when omitted Azure applies the default Network ACL
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	publicNetwork := webPubSubNetworkACL{}
+	if networks := v["public_network"].([]interface{}); len(networks) > 0 && networks[0] != nil {
+		network := networks[0].(map[string]interface{})
+
+		allowed := make([]string, 0)
+		for _, requestType := range network["allowed_request_types"].(*schema.Set).List() {
+			allowed = append(allowed, requestType.(string))
+		}
+		publicNetwork.Allow = &allowed
+
+		denied := make([]string, 0)
+		for _, requestType := range network["denied_request_types"].(*schema.Set).List() {
+			denied = append(denied, requestType.(string))
+		}
+		publicNetwork.Deny = &denied
+	}
+
+	return &webPubSubNetworkACLs{
+		DefaultAction: utils.String(v["default_action"].(string)),
+		PublicNetwork: &publicNetwork,
+	}
+}
+
+func flattenArmWebPubSubNetworkACLs(input *webPubSubNetworkACLs) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	defaultAction := ""
+	if input.DefaultAction != nil {
+		defaultAction = *input.DefaultAction
+	}
+
+	allowed := make([]interface{}, 0)
+	denied := make([]interface{}, 0)
+	if network := input.PublicNetwork; network != nil {
+		allowed = utils.FlattenStringArray(network.Allow)
+		denied = utils.FlattenStringArray(network.Deny)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_action": defaultAction,
+			"public_network": []interface{}{
+				map[string]interface{}{
+					"allowed_request_types": schema.NewSet(schema.HashString, allowed),
+					"denied_request_types":  schema.NewSet(schema.HashString, denied),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,325 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type webPubSubHub struct {
+	ID         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *webPubSubHubProperties `json:"properties,omitempty"`
+}
+
+type webPubSubHubProperties struct {
+	EventHandlers          *[]webPubSubEventHandler `json:"eventHandlers,omitempty"`
+	AnonymousConnectPolicy *string                  `json:"anonymousConnectPolicy,omitempty"`
+}
+
+type webPubSubEventHandler struct {
+	URLTemplate      *string                        `json:"urlTemplate,omitempty"`
+	UserEventPattern *string                        `json:"userEventPattern,omitempty"`
+	SystemEvents     *[]string                      `json:"systemEvents,omitempty"`
+	Auth             *webPubSubUpstreamAuthSettings `json:"auth,omitempty"`
+}
+
+type webPubSubUpstreamAuthSettings struct {
+	Type            *string                           `json:"type,omitempty"`
+	ManagedIdentity *webPubSubManagedIdentitySettings `json:"managedIdentity,omitempty"`
+}
+
+type webPubSubManagedIdentitySettings struct {
+	Resource *string `json:"resource,omitempty"`
+}
+
+func resourceArmWebPubSubHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebPubSubHubCreateUpdate,
+		Read:   resourceArmWebPubSubHubRead,
+		Update: resourceArmWebPubSubHubCreateUpdate,
+		Delete: resourceArmWebPubSubHubDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WebPubSubHubName,
+			},
+
+			"web_pubsub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"anonymous_connections_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// the Event Handlers are evaluated in order, with the first matching Event Handler being used
+			"event_handler": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// e.g. `https://example.com/api/{hub}/{event}`
+						"url_template": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						// e.g. `*`, `event1,event2` or empty (in which case no User Events are sent)
+						"user_event_pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"system_events": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"connect",
+									"connected",
+									"disconnected",
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+
+						"auth": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// the Resource (e.g. the Application ID URI) which the Managed Identity of the
+									// Web PubSub requests a token for when calling the Event Handler
+									"managed_identity_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmWebPubSubHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	webPubSubId := d.Get("web_pubsub_id").(string)
+
+	parsed, err := parseAzureResourceID(webPubSubId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `web_pubsub_id`: %+v", err)
+	}
+	resourceGroup := parsed.ResourceGroup
+	webPubSubName := parsed.Path["webPubSub"]
+	if webPubSubName == "" {
+		return fmt.Errorf("Expected `web_pubsub_id` to be the ID of a Web PubSub but got %q", webPubSubId)
+	}
+
+	id := webPubSubHubID(webPubSubID(parsed.SubscriptionID, resourceGroup, webPubSubName), name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		var existing webPubSubHub
+		resp, err := armRawGet(ctx, client.Client, client.BaseURI, id, webPubSubApiVersion, &existing)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+				return fmt.Errorf("Error checking for presence of existing Hub %q (Web PubSub %q / Resource Group %q): %+v", name, webPubSubName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_web_pubsub_hub", *existing.ID)
+		}
+	}
+
+	anonymousConnectPolicy := "deny"
+	if d.Get("anonymous_connections_enabled").(bool) {
+		anonymousConnectPolicy = "allow"
+	}
+
+	parameters := webPubSubHub{
+		Properties: &webPubSubHubProperties{
+			EventHandlers:          expandArmWebPubSubEventHandlers(d.Get("event_handler").([]interface{})),
+			AnonymousConnectPolicy: utils.String(anonymousConnectPolicy),
+		},
+	}
+
+	if err := armRawPut(ctx, client.Client, client.BaseURI, id, webPubSubApiVersion, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Hub %q (Web PubSub %q / Resource Group %q): %+v", name, webPubSubName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmWebPubSubHubRead(d, meta)
+}
+
+func resourceArmWebPubSubHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	webPubSubName := id.Path["webPubSub"]
+	name := id.Path["hubs"]
+
+	var resp webPubSubHub
+	httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), webPubSubApiVersion, &resp)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+			log.Printf("[INFO] Hub %q was not found in Web PubSub %q (Resource Group %q) - removing from state", name, webPubSubName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Hub %q (Web PubSub %q / Resource Group %q): %+v", name, webPubSubName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("web_pubsub_id", webPubSubID(id.SubscriptionID, resourceGroup, webPubSubName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("anonymous_connections_enabled", props.AnonymousConnectPolicy != nil && strings.EqualFold(*props.AnonymousConnectPolicy, "allow"))
+
+		if err := d.Set("event_handler", flattenArmWebPubSubEventHandlers(props.EventHandlers)); err != nil {
+			return fmt.Errorf("Error setting `event_handler`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmWebPubSubHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).signalRClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	webPubSubName := id.Path["webPubSub"]
+	name := id.Path["hubs"]
+
+	resp, err := armRawDelete(ctx, client.Client, client.BaseURI, d.Id(), webPubSubApiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Hub %q (Web PubSub %q / Resource Group %q): %+v", name, webPubSubName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func webPubSubHubID(webPubSubId, name string) string {
+	return fmt.Sprintf("%s/hubs/%s", webPubSubId, name)
+}
+
+func expandArmWebPubSubEventHandlers(input []interface{}) *[]webPubSubEventHandler {
+	results := make([]webPubSubEventHandler, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		systemEvents := make([]string, 0)
+		for _, event := range v["system_events"].(*schema.Set).List() {
+			systemEvents = append(systemEvents, event.(string))
+		}
+
+		handler := webPubSubEventHandler{
+			URLTemplate:      utils.String(v["url_template"].(string)),
+			UserEventPattern: utils.String(v["user_event_pattern"].(string)),
+			SystemEvents:     &systemEvents,
+		}
+
+		if auth := v["auth"].([]interface{}); len(auth) > 0 && auth[0] != nil {
+			settings := auth[0].(map[string]interface{})
+			handler.Auth = &webPubSubUpstreamAuthSettings{
+				Type: utils.String("ManagedIdentity"),
+				ManagedIdentity: &webPubSubManagedIdentitySettings{
+					Resource: utils.String(settings["managed_identity_id"].(string)),
+				},
+			}
+		}
+
+		results = append(results, handler)
+	}
+
+	return &results
+}
+
+func flattenArmWebPubSubEventHandlers(input *[]webPubSubEventHandler) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, handler := range *input {
+		urlTemplate := ""
+		if handler.URLTemplate != nil {
+			urlTemplate = *handler.URLTemplate
+		}
+
+		userEventPattern := ""
+		if handler.UserEventPattern != nil {
+			userEventPattern = *handler.UserEventPattern
+		}
+
+		auth := make([]interface{}, 0)
+		if settings := handler.Auth; settings != nil && settings.Type != nil && strings.EqualFold(*settings.Type, "ManagedIdentity") {
+			resource := ""
+			if settings.ManagedIdentity != nil && settings.ManagedIdentity.Resource != nil {
+				resource = *settings.ManagedIdentity.Resource
+			}
+
+			auth = append(auth, map[string]interface{}{
+				"managed_identity_id": resource,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"url_template":       urlTemplate,
+			"user_event_pattern": userEventPattern,
+			"system_events":      schema.NewSet(schema.HashString, utils.FlattenStringArray(handler.SystemEvents)),
+			"auth":               auth,
+		})
+	}
+
+	return results
+}
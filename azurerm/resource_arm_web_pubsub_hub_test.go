@@ -0,0 +1,232 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMWebPubSubHub_basic(t *testing.T) {
+	resourceName := "azurerm_web_pubsub_hub.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSubHub_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "anonymous_connections_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebPubSubHub_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_web_pubsub_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSubHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubHubExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWebPubSubHub_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_web_pubsub_hub"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebPubSubHub_eventHandlers(t *testing.T) {
+	resourceName := "azurerm_web_pubsub_hub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSubHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubHubExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMWebPubSubHub_eventHandlers(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "anonymous_connections_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.0.url_template", "https://test.com/api/{hub}/{event}"),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.0.system_events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.1.auth.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMWebPubSubHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_handler.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWebPubSubHubExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).signalRClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp webPubSubHub
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, webPubSubApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Web PubSub Hub %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Web PubSub Hub %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWebPubSubHubDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).signalRClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_web_pubsub_hub" {
+			continue
+		}
+
+		var resp webPubSubHub
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, webPubSubApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Web PubSub Hub still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWebPubSubHub_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_pubsub" "test" {
+  name                = "acctestwps-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard_S1"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWebPubSubHub_basic(rInt int, location string) string {
+	template := testAccAzureRMWebPubSubHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_hub" "test" {
+  name          = "acctestwpsh%d"
+  web_pubsub_id = "${azurerm_web_pubsub.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMWebPubSubHub_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWebPubSubHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_hub" "import" {
+  name          = "${azurerm_web_pubsub_hub.test.name}"
+  web_pubsub_id = "${azurerm_web_pubsub_hub.test.web_pubsub_id}"
+}
+`, template)
+}
+
+func testAccAzureRMWebPubSubHub_eventHandlers(rInt int, location string) string {
+	template := testAccAzureRMWebPubSubHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_hub" "test" {
+  name                          = "acctestwpsh%d"
+  web_pubsub_id                 = "${azurerm_web_pubsub.test.id}"
+  anonymous_connections_enabled = true
+
+  event_handler {
+    url_template       = "https://test.com/api/{hub}/{event}"
+    user_event_pattern = "*"
+    system_events      = ["connect", "connected"]
+  }
+
+  event_handler {
+    url_template       = "https://test.com/api/{hub}/{event}/secure"
+    user_event_pattern = "event1, event2"
+    system_events      = ["disconnected"]
+
+    auth {
+      managed_identity_id = "api://acctest-%d"
+    }
+  }
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandArmWebPubSubNetworkACLs(t *testing.T) {
+	if expandArmWebPubSubNetworkACLs([]interface{}{}) != nil {
+		t.Fatalf("Expected no Network ACL's to be sent when the block is omitted")
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"default_action": "Allow",
+			"public_network": []interface{}{
+				map[string]interface{}{
+					"allowed_request_types": schema.NewSet(schema.HashString, []interface{}{}),
+					"denied_request_types":  schema.NewSet(schema.HashString, []interface{}{"Trace"}),
+				},
+			},
+		},
+	}
+
+	acls := expandArmWebPubSubNetworkACLs(input)
+	if *acls.DefaultAction != "Allow" {
+		t.Fatalf("Expected the Default Action to be `Allow` but got %q", *acls.DefaultAction)
+	}
+
+	if deny := *acls.PublicNetwork.Deny; len(deny) != 1 || deny[0] != "Trace" {
+		t.Fatalf("Expected `Trace` to be denied but got %+v", deny)
+	}
+
+	flattened := flattenArmWebPubSubNetworkACLs(acls)
+	publicNetwork := flattened[0].(map[string]interface{})["public_network"].([]interface{})[0].(map[string]interface{})
+	if !publicNetwork["denied_request_types"].(*schema.Set).Contains("Trace") {
+		t.Fatalf("Expected the Network ACL's to round-trip but got %+v", publicNetwork)
+	}
+}
+
+func TestAccAzureRMWebPubSub_basic(t *testing.T) {
+	resourceName := "azurerm_web_pubsub.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSub_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard_S1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebPubSub_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_web_pubsub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWebPubSub_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_web_pubsub"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebPubSub_complete(t *testing.T) {
+	resourceName := "azurerm_web_pubsub.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebPubSubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebPubSub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMWebPubSub_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "primary_access_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_acl.0.default_action", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "network_acl.0.public_network.0.denied_request_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMWebPubSub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebPubSubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "local_authentication_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWebPubSubExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).signalRClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var resp webPubSub
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, webPubSubApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				return fmt.Errorf("Bad: Web PubSub %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on Web PubSub %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWebPubSubDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).signalRClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_web_pubsub" {
+			continue
+		}
+
+		var resp webPubSub
+		httpResp, err := armRawGet(ctx, client.Client, client.BaseURI, rs.Primary.ID, webPubSubApiVersion, &resp)
+		if err != nil {
+			if utils.ResponseWasNotFound(autorest.Response{Response: httpResp}) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Web PubSub still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWebPubSub_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_pubsub" "test" {
+  name                = "acctestwps-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard_S1"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWebPubSub_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWebPubSub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub" "import" {
+  name                = "${azurerm_web_pubsub.test.name}"
+  resource_group_name = "${azurerm_web_pubsub.test.resource_group_name}"
+  location            = "${azurerm_web_pubsub.test.location}"
+  sku                 = "${azurerm_web_pubsub.test.sku}"
+}
+`, template)
+}
+
+func testAccAzureRMWebPubSub_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_pubsub" "test" {
+  name                         = "acctestwps-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  sku                          = "Standard_S1"
+  capacity                     = 2
+  local_authentication_enabled = false
+  tls_client_cert_enabled      = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_acl {
+    default_action = "Allow"
+
+    public_network {
+      denied_request_types = ["Trace"]
+    }
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
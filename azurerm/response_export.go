@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// setArmResponseExportValues populates `response_export` from the raw ARM response for the resource, using the
+// JMESPath expressions in `response_export_values`. Since this requires an additional API call, this is only
+// done when at least one expression has been specified.
+func setArmResponseExportValues(ctx context.Context, d *schema.ResourceData, meta interface{}, apiVersion string) error {
+	expressions := d.Get("response_export_values").([]interface{})
+	if len(expressions) == 0 {
+		d.Set("response_export", map[string]interface{}{})
+		return nil
+	}
+
+	client := meta.(*ArmClient).resourcesClient
+
+	var response interface{}
+	if _, err := armRawGet(ctx, client.Client, client.BaseURI, d.Id(), apiVersion, &response); err != nil {
+		return fmt.Errorf("Error retrieving the raw response: %+v", err)
+	}
+
+	values, err := azure.FlattenResponseExportValues(response, expressions)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("response_export", values); err != nil {
+		return fmt.Errorf("Error setting `response_export`: %+v", err)
+	}
+
+	return nil
+}
+
+// onlyResponseExportValuesHaveChanged returns whether the only field which has changed on an existing resource is
+// `response_export_values` - which is evaluated when reading the resource, rather than being sent to the API
+func onlyResponseExportValuesHaveChanged(d *schema.ResourceData, resourceSchema map[string]*schema.Schema) bool {
+	if d.IsNewResource() {
+		return false
+	}
+
+	for k := range resourceSchema {
+		switch k {
+		case "response_export_values", "response_export":
+			continue
+		}
+
+		if d.HasChange(k) {
+			return false
+		}
+	}
+
+	return true
+}
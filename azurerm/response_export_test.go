@@ -0,0 +1,110 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestOnlyResponseExportValuesHaveChanged(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/example",
+		Attributes: map[string]string{
+			"name":                     "example",
+			"tags.%":                   "0",
+			"response_export_values.#": "0",
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name: "Only Response Export Values",
+			Config: map[string]interface{}{
+				"name":                   "example",
+				"response_export_values": []interface{}{"properties.state"},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Response Export Values and Tags",
+			Config: map[string]interface{}{
+				"name":                   "example",
+				"response_export_values": []interface{}{"properties.state"},
+				"tags": []map[string]interface{}{
+					{"environment": "Production"},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Only Tags",
+			Config: map[string]interface{}{
+				"name": "example",
+				"tags": []map[string]interface{}{
+					{"environment": "Production"},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			updated := false
+			actual := false
+
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+
+					"tags": tagsSchema(),
+
+					"response_export_values": azure.SchemaResponseExportValues(),
+
+					"response_export": azure.SchemaResponseExport(),
+				},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					return nil
+				},
+			}
+			resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+				updated = true
+				actual = onlyResponseExportValuesHaveChanged(d, resource.Schema)
+				return nil
+			}
+
+			raw, err := config.NewRawConfig(tc.Config)
+			if err != nil {
+				t.Fatalf("Error building config: %+v", err)
+			}
+
+			diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), nil)
+			if err != nil {
+				t.Fatalf("Error building diff: %+v", err)
+			}
+
+			if _, err := resource.Apply(state, diff, nil); err != nil {
+				t.Fatalf("Error applying diff: %+v", err)
+			}
+
+			if !updated {
+				t.Fatalf("Expected the resource to be updated but it wasn't")
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
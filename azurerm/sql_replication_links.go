@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func forceDeleteReplicatedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// sqlDatabaseGeoReplicas returns the Geo-Replicas (in the form `server/database`) of the specified Database,
+// should it be the Primary in one or more Replication Links
+func sqlDatabaseGeoReplicas(ctx context.Context, client sql.ReplicationLinksClient, resourceGroup, serverName, databaseName string) ([]string, error) {
+	replicas := make([]string, 0)
+
+	resp, err := client.ListByDatabase(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return replicas, nil
+		}
+
+		return nil, fmt.Errorf("Error listing Replication Links for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	if resp.Value == nil {
+		return replicas, nil
+	}
+
+	for _, link := range *resp.Value {
+		props := link.ReplicationLinkProperties
+		if props == nil || props.Role != sql.ReplicationRolePrimary {
+			continue
+		}
+
+		if props.PartnerServer != nil && props.PartnerDatabase != nil {
+			replicas = append(replicas, fmt.Sprintf("%s/%s", *props.PartnerServer, *props.PartnerDatabase))
+		}
+	}
+
+	sort.Strings(replicas)
+	return replicas, nil
+}
+
+// ensureArmSqlDatabaseHasNoGeoReplicas refuses to delete a Primary Database with active Geo-Replicas, since
+// doing so tears down the Disaster Recovery setup - unless `force_delete_replicated` is set.
+func ensureArmSqlDatabaseHasNoGeoReplicas(d *schema.ResourceData, meta interface{}, resourceGroup, serverName, databaseName string) error {
+	if d.Get("force_delete_replicated").(bool) {
+		return nil
+	}
+
+	client := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	replicas, err := sqlDatabaseGeoReplicas(ctx, client, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return err
+	}
+
+	if len(replicas) > 0 {
+		return fmt.Errorf("Error deleting SQL Database %q (Server %q / Resource Group %q): the Database is the Primary for the Geo-Replicas %s - set `force_delete_replicated` to `true` to delete it anyway", databaseName, serverName, resourceGroup, strings.Join(replicas, ", "))
+	}
+
+	return nil
+}
+
+// ensureArmSqlElasticPoolHasNoGeoReplicas refuses to delete an Elastic Pool containing a Primary Database with
+// active Geo-Replicas - unless `force_delete_replicated` is set.
+func ensureArmSqlElasticPoolHasNoGeoReplicas(d *schema.ResourceData, meta interface{}, resourceGroup, serverName, poolName string) error {
+	if d.Get("force_delete_replicated").(bool) {
+		return nil
+	}
+
+	databasesClient := meta.(*ArmClient).sqlDatabasesClient
+	linksClient := meta.(*ArmClient).sqlReplicationLinksClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := databasesClient.ListByElasticPool(ctx, resourceGroup, serverName, poolName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error listing SQL Databases in Elastic Pool %q (Server %q / Resource Group %q): %+v", poolName, serverName, resourceGroup, err)
+	}
+
+	if resp.Value == nil {
+		return nil
+	}
+
+	replicated := make([]string, 0)
+	for _, database := range *resp.Value {
+		if database.Name == nil {
+			continue
+		}
+
+		replicas, err := sqlDatabaseGeoReplicas(ctx, linksClient, resourceGroup, serverName, *database.Name)
+		if err != nil {
+			return err
+		}
+
+		if len(replicas) > 0 {
+			log.Printf("[DEBUG] SQL Database %q in Elastic Pool %q has the Geo-Replicas %s", *database.Name, poolName, strings.Join(replicas, ", "))
+			replicated = append(replicated, *database.Name)
+		}
+	}
+
+	if len(replicated) > 0 {
+		sort.Strings(replicated)
+		return fmt.Errorf("Error deleting SQL Elastic Pool %q (Server %q / Resource Group %q): the Databases %s are the Primary for one or more Geo-Replicas - set `force_delete_replicated` to `true` to delete it anyway", poolName, serverName, resourceGroup, strings.Join(replicated, ", "))
+	}
+
+	return nil
+}
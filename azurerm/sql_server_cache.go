@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+)
+
+// sqlServerCache holds the Databases and Elastic Pools within each SQL Server, populated from a single
+// ListByServer call per Server - which means refreshing a large number of Databases/Elastic Pools on the
+// same Server makes one API call rather than one per resource (and avoids being throttled by ARM).
+//
+// Entries are invalidated whenever a Database or Elastic Pool on the Server is written to; anything not
+// present in the cache is retrieved individually, so a stale entry can't cause a resource to be removed.
+// Where the List fails nothing is cached (so that it's retried by the next read) and nil is returned, which
+// means the caller falls back to retrieving the resource individually.
+type sqlServerCache struct {
+	lock    sync.Mutex
+	servers map[string]*sqlServerCacheEntry
+}
+
+type sqlServerCacheEntry struct {
+	// held whilst the entry is being populated, so that concurrent reads wait on a single List
+	lock sync.Mutex
+
+	// a nil map means the entry hasn't been populated (or has been invalidated)
+	databases         map[string]sql.Database
+	elasticPools      map[string]sql.ElasticPool
+	msSqlElasticPools map[string]MsSql.ElasticPool
+}
+
+func newSqlServerCache() *sqlServerCache {
+	return &sqlServerCache{
+		servers: make(map[string]*sqlServerCacheEntry),
+	}
+}
+
+func (c *sqlServerCache) entry(resourceGroup string, serverName string) *sqlServerCacheEntry {
+	key := strings.ToLower(fmt.Sprintf("%s/%s", resourceGroup, serverName))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.servers[key]
+	if !ok {
+		entry = &sqlServerCacheEntry{}
+		c.servers[key] = entry
+	}
+
+	return entry
+}
+
+// invalidate removes everything cached for the specified Server, and should be called after any write
+// to a Database or Elastic Pool within it
+func (c *sqlServerCache) invalidate(resourceGroup string, serverName string) {
+	entry := c.entry(resourceGroup, serverName)
+
+	// this waits for any in-flight List to complete, so that its results are discarded
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	entry.databases = nil
+	entry.elasticPools = nil
+	entry.msSqlElasticPools = nil
+}
+
+func (c *sqlServerCache) sqlDatabase(ctx context.Context, client sql.DatabasesClient, resourceGroup string, serverName string, name string) *sql.Database {
+	entry := c.entry(resourceGroup, serverName)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.databases == nil {
+		result, err := client.ListByServer(ctx, resourceGroup, serverName, "", "")
+		if err != nil {
+			log.Printf("[DEBUG] Unable to list SQL Databases within Server %q (Resource Group %q) - falling back to retrieving them individually: %+v", serverName, resourceGroup, err)
+			return nil
+		}
+
+		databases := make(map[string]sql.Database)
+		if result.Value != nil {
+			for _, v := range *result.Value {
+				if v.Name != nil {
+					databases[strings.ToLower(*v.Name)] = v
+				}
+			}
+		}
+		entry.databases = databases
+	}
+
+	if v, ok := entry.databases[strings.ToLower(name)]; ok {
+		return &v
+	}
+
+	return nil
+}
+
+func (c *sqlServerCache) sqlElasticPool(ctx context.Context, client sql.ElasticPoolsClient, resourceGroup string, serverName string, name string) *sql.ElasticPool {
+	entry := c.entry(resourceGroup, serverName)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.elasticPools == nil {
+		result, err := client.ListByServer(ctx, resourceGroup, serverName)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to list SQL Elastic Pools within Server %q (Resource Group %q) - falling back to retrieving them individually: %+v", serverName, resourceGroup, err)
+			return nil
+		}
+
+		pools := make(map[string]sql.ElasticPool)
+		if result.Value != nil {
+			for _, v := range *result.Value {
+				if v.Name != nil {
+					pools[strings.ToLower(*v.Name)] = v
+				}
+			}
+		}
+		entry.elasticPools = pools
+	}
+
+	if v, ok := entry.elasticPools[strings.ToLower(name)]; ok {
+		return &v
+	}
+
+	return nil
+}
+
+func (c *sqlServerCache) msSqlElasticPool(ctx context.Context, client MsSql.ElasticPoolsClient, resourceGroup string, serverName string, name string) *MsSql.ElasticPool {
+	entry := c.entry(resourceGroup, serverName)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.msSqlElasticPools == nil {
+		pools := make(map[string]MsSql.ElasticPool)
+		results, err := client.ListByServerComplete(ctx, resourceGroup, serverName, nil)
+		for err == nil && results.NotDone() {
+			if v := results.Value(); v.Name != nil {
+				pools[strings.ToLower(*v.Name)] = v
+			}
+			err = results.NextWithContext(ctx)
+		}
+
+		if err != nil {
+			log.Printf("[DEBUG] Unable to list MsSQL Elastic Pools within Server %q (Resource Group %q) - falling back to retrieving them individually: %+v", serverName, resourceGroup, err)
+			return nil
+		}
+		entry.msSqlElasticPools = pools
+	}
+
+	if v, ok := entry.msSqlElasticPools[strings.ToLower(name)]; ok {
+		return &v
+	}
+
+	return nil
+}
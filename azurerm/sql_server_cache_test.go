@@ -0,0 +1,111 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSqlServerCache_populatedEntries(t *testing.T) {
+	cache := newSqlServerCache()
+	ctx := context.TODO()
+
+	entry := cache.entry("acctestRG", "acctestserver")
+	entry.databases = map[string]sql.Database{
+		"database1": {Name: utils.String("Database1")},
+	}
+	entry.elasticPools = map[string]sql.ElasticPool{
+		"pool1": {Name: utils.String("Pool1")},
+	}
+
+	// since the entry is populated no API calls are made, so an unconfigured client is fine here
+	database := cache.sqlDatabase(ctx, sql.DatabasesClient{}, "ACCTESTRG", "AcctestServer", "DATABASE1")
+	if database == nil || *database.Name != "Database1" {
+		t.Fatalf("Expected `Database1` to be returned from the cache but got %+v", database)
+	}
+
+	if database := cache.sqlDatabase(ctx, sql.DatabasesClient{}, "acctestRG", "acctestserver", "database2"); database != nil {
+		t.Fatalf("Expected `database2` not to be returned from the cache but got %+v", database)
+	}
+
+	pool := cache.sqlElasticPool(ctx, sql.ElasticPoolsClient{}, "acctestRG", "acctestserver", "pool1")
+	if pool == nil || *pool.Name != "Pool1" {
+		t.Fatalf("Expected `Pool1` to be returned from the cache but got %+v", pool)
+	}
+}
+
+func TestSqlServerCache_invalidate(t *testing.T) {
+	cache := newSqlServerCache()
+
+	entry := cache.entry("acctestRG", "acctestserver")
+	entry.databases = map[string]sql.Database{
+		"database1": {Name: utils.String("Database1")},
+	}
+	entry.elasticPools = map[string]sql.ElasticPool{}
+
+	other := cache.entry("acctestRG", "otherserver")
+	other.databases = map[string]sql.Database{}
+
+	cache.invalidate("ACCTESTRG", "acctestserver")
+
+	if entry.databases != nil || entry.elasticPools != nil || entry.msSqlElasticPools != nil {
+		t.Fatalf("Expected the entry for `acctestserver` to be invalidated")
+	}
+
+	if other.databases == nil {
+		t.Fatalf("Expected the entry for `otherserver` not to be invalidated")
+	}
+}
+
+func TestSqlServerCache_failedListIsNotCached(t *testing.T) {
+	cache := newSqlServerCache()
+	ctx := context.TODO()
+
+	requests := 0
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			Request:    r,
+		}, nil
+	})
+
+	databasesClient := sql.NewDatabasesClientWithBaseURI("https://management.azure.com", "00000000-0000-0000-0000-000000000000")
+	databasesClient.Sender = sender
+	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI("https://management.azure.com", "00000000-0000-0000-0000-000000000000")
+	elasticPoolsClient.Sender = sender
+	msSqlElasticPoolsClient := MsSql.NewElasticPoolsClientWithBaseURI("https://management.azure.com", "00000000-0000-0000-0000-000000000000")
+	msSqlElasticPoolsClient.Sender = sender
+
+	for i := 0; i < 2; i++ {
+		if database := cache.sqlDatabase(ctx, databasesClient, "acctestRG", "acctestserver", "database1"); database != nil {
+			t.Fatalf("Expected no Database to be returned when the List fails but got %+v", database)
+		}
+
+		if pool := cache.sqlElasticPool(ctx, elasticPoolsClient, "acctestRG", "acctestserver", "pool1"); pool != nil {
+			t.Fatalf("Expected no Elastic Pool to be returned when the List fails but got %+v", pool)
+		}
+
+		if pool := cache.msSqlElasticPool(ctx, msSqlElasticPoolsClient, "acctestRG", "acctestserver", "pool1"); pool != nil {
+			t.Fatalf("Expected no MsSQL Elastic Pool to be returned when the List fails but got %+v", pool)
+		}
+	}
+
+	entry := cache.entry("acctestRG", "acctestserver")
+	if entry.databases != nil || entry.elasticPools != nil || entry.msSqlElasticPools != nil {
+		t.Fatalf("Expected nothing to be cached when the List fails")
+	}
+
+	// each List is retried on the next read, rather than the failure being cached
+	if requests != 6 {
+		t.Fatalf("Expected 6 List requests but got %d", requests)
+	}
+}
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// the prefix used for all resources created during the acceptance tests - which is used to determine what can be swept
+const acceptanceTestResourcePrefix = "acctest"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForRegion returns an ARM Client configured from the same Environment Variables as the
+// acceptance tests, which the sweepers use to remove any resources left behind by failed test runs
+func sharedClientForRegion(region string) (*ArmClient, error) {
+	subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("`ARM_SUBSCRIPTION_ID` must be set for the sweepers to run in %q", region)
+	}
+
+	builder := authentication.Builder{
+		SubscriptionID: subscriptionId,
+		ClientID:       os.Getenv("ARM_CLIENT_ID"),
+		TenantID:       os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:    testArmEnvironmentName(),
+
+		// we intentionally only support Client Secret auth for tests (since those variables are used all over)
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("Error building ARM Client: %+v", err)
+	}
+
+	client, err := getArmClient(config, armClientOptions{skipProviderRegistration: true})
+	if err != nil {
+		return nil, fmt.Errorf("Error building ARM Client: %+v", err)
+	}
+
+	client.StopContext = context.Background()
+	return client, nil
+}
+
+// shouldSweepAcceptanceTestResource returns whether the specified resource was created by the acceptance tests
+// within the region being swept
+func shouldSweepAcceptanceTestResource(name string, resourceLocation string, region string) bool {
+	if !strings.HasPrefix(strings.ToLower(name), acceptanceTestResourcePrefix) {
+		return false
+	}
+
+	return azureRMNormalizeLocation(resourceLocation) == azureRMNormalizeLocation(region)
+}
+
+func TestShouldSweepAcceptanceTestResource(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Location string
+		Region   string
+		Expected bool
+	}{
+		{
+			Name:     "acctestsqlserver1234",
+			Location: "West Europe",
+			Region:   "westeurope",
+			Expected: true,
+		},
+		{
+			Name:     "AccTestRG-1234",
+			Location: "westeurope",
+			Region:   "westeurope",
+			Expected: true,
+		},
+		{
+			Name:     "acctestsqlserver1234",
+			Location: "eastus",
+			Region:   "westeurope",
+			Expected: false,
+		},
+		{
+			Name:     "production-sql",
+			Location: "westeurope",
+			Region:   "westeurope",
+			Expected: false,
+		},
+		{
+			Name:     "my-acctest-server",
+			Location: "westeurope",
+			Region:   "westeurope",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		actual := shouldSweepAcceptanceTestResource(v.Name, v.Location, v.Region)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t for %q in %q (sweeping %q) but got %t", v.Expected, v.Name, v.Location, v.Region, actual)
+		}
+	}
+}
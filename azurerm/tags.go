@@ -33,6 +33,38 @@ func tagsForDataSourceSchema() *schema.Schema {
 	}
 }
 
+// onlyMetadataHasChanged returns whether the only fields within the specified schema which have changed on an
+// existing resource are metadata (such as `tags`) - which allows them to be updated in-place via a PATCH rather
+// than a full Create/Update. Computed-only fields and the specified local-only fields (which are never sent to
+// the API) are ignored, since changes to these don't require the resource to be updated.
+func onlyMetadataHasChanged(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, localOnly ...string) bool {
+	if d.IsNewResource() {
+		return false
+	}
+
+	ignored := make(map[string]bool, len(localOnly))
+	for _, k := range localOnly {
+		ignored[k] = true
+	}
+
+	for k, v := range resourceSchema {
+		switch k {
+		case "tags", "diagnostics", "response_export_values", "response_export":
+			continue
+		}
+
+		if ignored[k] || (v.Computed && !v.Optional && !v.Required) {
+			continue
+		}
+
+		if d.HasChange(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func tagValueToString(v interface{}) (string, error) {
 	switch value := v.(type) {
 	case string: